@@ -7,10 +7,16 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
+	"github.com/thenoetrevino/paso/internal/app"
 	"github.com/thenoetrevino/paso/internal/daemon"
+	"github.com/thenoetrevino/paso/internal/database"
 )
 
+// recurringTasksInterval is how often the daemon generates recurring tasks
+const recurringTasksInterval = time.Minute
+
 func main() {
 	// Set up signal handling for graceful shutdown
 	ctx, cancel := signal.NotifyContext(
@@ -49,6 +55,29 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optionally accept connections over TCP (e.g. from devcontainers)
+	if addr := os.Getenv("PASO_DAEMON_TCP_ADDR"); addr != "" {
+		if err := server.ListenTCP(addr, os.Getenv("PASO_DAEMON_TOKEN")); err != nil {
+			slog.Error("failed to start tcp listener", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Generate recurring tasks while the daemon runs. Events need no
+	// database, so failing to open it only turns this off.
+	db, err := database.InitDB(ctx)
+	if err != nil {
+		slog.Warn("recurring tasks disabled: failed to open database", "error", err)
+	} else {
+		defer func() {
+			if err := db.Close(); err != nil {
+				slog.Error("error closing database", "error", err)
+			}
+		}()
+		application := app.New(db, app.WithEventPublisher(server.Publisher()))
+		server.Schedule("recurring-tasks", recurringTasksInterval, application.GenerateRecurringTasks)
+	}
+
 	slog.Info("paso daemon starting", "socket_path", socketPath, "pid", os.Getpid())
 
 	// Start the daemon (blocks until shutdown)
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// Option is a functional option for configuring a Server
+type Option func(*Server)
+
+// WithToken requires every request to carry "Authorization: Bearer <token>"
+func WithToken(token string) Option {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithAllowedHosts accepts requests addressed to the given host names besides
+// localhost, 127.0.0.1 and ::1
+func WithAllowedHosts(hosts ...string) Option {
+	return func(s *Server) {
+		for _, host := range hosts {
+			s.hosts[strings.ToLower(host)] = true
+		}
+	}
+}
+
+// checkAccess refuses requests for another host name, which is what a web
+// page gets after rebinding its own name to 127.0.0.1, and requests without
+// the server's token when it has one
+func (s *Server) checkAccess(r *http.Request) *Error {
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		host = r.Host // No port
+	}
+	host = strings.Trim(strings.ToLower(host), "[]")
+	if !s.hosts[host] {
+		return &Error{Code: "FORBIDDEN_HOST", Message: "requests for host " + r.Host + " are not accepted",
+			ExitCode: cli.ExitUsage, status: http.StatusForbidden}
+	}
+
+	if s.token == "" {
+		return nil
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+		return &Error{Code: "UNAUTHORIZED", Message: "missing or wrong bearer token",
+			ExitCode: cli.ExitUsage, status: http.StatusUnauthorized}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"net/http"
+
+	columnservice "github.com/thenoetrevino/paso/internal/services/column"
+)
+
+type createColumnRequest struct {
+	Name       string
+	AfterID    *int // Insert after this column (nil = append)
+	Ready      bool // Holds ready tasks
+	Completed  bool // Holds completed tasks
+	InProgress bool // Holds in-progress tasks
+}
+
+type updateColumnRequest struct {
+	Name       *string
+	Ready      bool
+	Completed  bool
+	InProgress bool
+	Force      bool // Take over the completed role from another column
+	WIPLimit   *int // Maximum number of tasks in the column, 0 for no limit
+}
+
+// requireProject returns PROJECT_NOT_FOUND unless the {id} project exists
+func (s *Server) requireProject(r *http.Request) (int, error) {
+	projectID, err := pathID(r, "id")
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.app.ProjectService.GetProjectByID(r.Context(), projectID); err != nil {
+		return 0, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return projectID, nil
+}
+
+func (s *Server) listColumns(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.app.ColumnService.GetColumnsByProject(r.Context(), projectID)
+	if err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+	return columns, nil
+}
+
+func (s *Server) createColumn(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req createColumnRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	column, err := s.app.ColumnService.CreateColumn(r.Context(), columnservice.CreateColumnRequest{
+		Name:                 req.Name,
+		ProjectID:            projectID,
+		AfterID:              req.AfterID,
+		HoldsReadyTasks:      req.Ready,
+		HoldsCompletedTasks:  req.Completed,
+		HoldsInProgressTasks: req.InProgress,
+	})
+	if err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+	return column, nil
+}
+
+func (s *Server) updateColumn(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req updateColumnRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil && !req.Ready && !req.Completed && !req.InProgress && req.WIPLimit == nil {
+		return nil, usageError("NO_UPDATES", "at least one of Name, Ready, Completed, InProgress or WIPLimit must be set")
+	}
+
+	ctx := r.Context()
+	if _, err := s.app.ColumnService.GetColumnByID(ctx, id); err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+
+	if req.Name != nil {
+		if err := s.app.ColumnService.UpdateColumnName(ctx, id, *req.Name); err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+	}
+	if req.Ready {
+		if _, err := s.app.ColumnService.SetHoldsReadyTasks(ctx, id); err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+	}
+	if req.Completed {
+		if _, err := s.app.ColumnService.SetHoldsCompletedTasks(ctx, id, req.Force); err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+	}
+	if req.InProgress {
+		if _, err := s.app.ColumnService.SetHoldsInProgressTasks(ctx, id); err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+	}
+	if req.WIPLimit != nil {
+		if _, err := s.app.ColumnService.SetWIPLimit(ctx, id, *req.WIPLimit); err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+	}
+
+	column, err := s.app.ColumnService.GetColumnByID(ctx, id)
+	if err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+	return column, nil
+}
+
+func (s *Server) deleteColumn(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.app.ColumnService.GetColumnByID(r.Context(), id); err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+	if err := s.app.ColumnService.DeleteColumn(r.Context(), id); err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+	return deleted{ID: id, Deleted: true}, nil
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/filter"
+	columnservice "github.com/thenoetrevino/paso/internal/services/column"
+	labelservice "github.com/thenoetrevino/paso/internal/services/label"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// Error is an API error. Code and ExitCode match what the equivalent CLI
+// command reports, so scripts can share error handling between the two.
+type Error struct {
+	Code     string
+	Message  string
+	ExitCode int
+	status   int // Overrides the status derived from ExitCode
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Status returns the HTTP status for the error's exit code
+func (e *Error) Status() int {
+	if e.status != 0 {
+		return e.status
+	}
+	switch e.ExitCode {
+	case cli.ExitUsage, cli.ExitDataErr:
+		return http.StatusBadRequest
+	case cli.ExitNotFound:
+		return http.StatusNotFound
+	case cli.ExitValidation:
+		return http.StatusUnprocessableEntity
+	case cli.ExitWIPLimit:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func usageError(code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), ExitCode: cli.ExitUsage}
+}
+
+func notFoundError(code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), ExitCode: cli.ExitNotFound}
+}
+
+func validationError(code, format string, args ...any) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), ExitCode: cli.ExitValidation}
+}
+
+// serviceErrors maps service sentinel errors to API error codes
+var serviceErrors = []struct {
+	err      error
+	code     string
+	exitCode int
+}{
+	// Not found
+	{taskservice.ErrTaskNotFound, "TASK_NOT_FOUND", cli.ExitNotFound},
+	{taskservice.ErrCommentNotFound, "COMMENT_NOT_FOUND", cli.ExitNotFound},
+	{projectservice.ErrProjectNotFound, "PROJECT_NOT_FOUND", cli.ExitNotFound},
+	{columnservice.ErrColumnNotFound, "COLUMN_NOT_FOUND", cli.ExitNotFound},
+	{labelservice.ErrLabelNotFound, "LABEL_NOT_FOUND", cli.ExitNotFound},
+
+	// Invalid IDs
+	{taskservice.ErrInvalidTaskID, "INVALID_TASK_ID", cli.ExitValidation},
+	{labelservice.ErrInvalidTaskID, "INVALID_TASK_ID", cli.ExitValidation},
+	{taskservice.ErrInvalidProjectID, "INVALID_PROJECT_ID", cli.ExitValidation},
+	{projectservice.ErrInvalidProjectID, "INVALID_PROJECT_ID", cli.ExitValidation},
+	{columnservice.ErrInvalidProjectID, "INVALID_PROJECT_ID", cli.ExitValidation},
+	{labelservice.ErrInvalidProjectID, "INVALID_PROJECT_ID", cli.ExitValidation},
+	{taskservice.ErrInvalidColumnID, "INVALID_COLUMN", cli.ExitValidation},
+	{columnservice.ErrInvalidColumnID, "INVALID_COLUMN", cli.ExitValidation},
+	{taskservice.ErrInvalidLabelID, "INVALID_LABEL_ID", cli.ExitValidation},
+	{labelservice.ErrInvalidLabelID, "INVALID_LABEL_ID", cli.ExitValidation},
+	{taskservice.ErrInvalidCommentID, "INVALID_COMMENT_ID", cli.ExitValidation},
+
+	// Task validation
+	{taskservice.ErrEmptyTitle, "EMPTY_TITLE", cli.ExitValidation},
+	{taskservice.ErrTitleTooLong, "TITLE_TOO_LONG", cli.ExitValidation},
+	{taskservice.ErrInvalidPriority, "INVALID_PRIORITY", cli.ExitValidation},
+	{taskservice.ErrInvalidType, "INVALID_TYPE", cli.ExitValidation},
+	{taskservice.ErrInvalidPosition, "INVALID_POSITION", cli.ExitValidation},
+	{taskservice.ErrCircularRelation, "CIRCULAR_RELATION", cli.ExitValidation},
+	{taskservice.ErrDuplicateRelation, "DUPLICATE_RELATION", cli.ExitValidation},
+	{taskservice.ErrSelfRelation, "SELF_RELATION", cli.ExitValidation},
+	{taskservice.ErrTaskAlreadyInTargetColumn, "ALREADY_IN_COLUMN", cli.ExitValidation},
+	{taskservice.ErrAlreadyFirstColumn, "NO_PREV_COLUMN", cli.ExitValidation},
+	{taskservice.ErrAlreadyLastColumn, "NO_NEXT_COLUMN", cli.ExitValidation},
+	{taskservice.ErrAlreadyFirstTask, "ALREADY_FIRST", cli.ExitValidation},
+	{taskservice.ErrAlreadyLastTask, "ALREADY_LAST", cli.ExitValidation},
+	{taskservice.ErrEmptyCommentMessage, "EMPTY_MSG", cli.ExitValidation},
+	{taskservice.ErrCommentMessageTooLong, "MESSAGE_TOO_LONG", cli.ExitValidation},
+	{filter.ErrInvalidFilter, "INVALID_FILTER", cli.ExitValidation},
+
+	// Project, column and label validation
+	{projectservice.ErrEmptyName, "EMPTY_NAME", cli.ExitValidation},
+	{columnservice.ErrEmptyName, "EMPTY_NAME", cli.ExitValidation},
+	{labelservice.ErrEmptyName, "EMPTY_NAME", cli.ExitValidation},
+	{projectservice.ErrNameTooLong, "NAME_TOO_LONG", cli.ExitValidation},
+	{columnservice.ErrNameTooLong, "NAME_TOO_LONG", cli.ExitValidation},
+	{labelservice.ErrNameTooLong, "NAME_TOO_LONG", cli.ExitValidation},
+	{labelservice.ErrInvalidColor, "INVALID_COLOR", cli.ExitValidation},
+	{projectservice.ErrProjectHasColumns, "PROJECT_NOT_EMPTY", cli.ExitValidation},
+	{projectservice.ErrProjectHasTasks, "PROJECT_NOT_EMPTY", cli.ExitValidation},
+	{columnservice.ErrColumnHasTasks, "COLUMN_NOT_EMPTY", cli.ExitValidation},
+	{columnservice.ErrCompletedColumnExists, "COMPLETED_COLUMN_EXISTS", cli.ExitValidation},
+	{columnservice.ErrReadyColumnExists, "READY_COLUMN_EXISTS", cli.ExitValidation},
+	{columnservice.ErrInProgressColumnExists, "IN_PROGRESS_COLUMN_EXISTS", cli.ExitValidation},
+	{columnservice.ErrInvalidWIPLimit, "INVALID_WIP_LIMIT", cli.ExitValidation},
+
+	// WIP limits
+	{taskservice.ErrWIPLimitExceeded, "WIP_LIMIT_EXCEEDED", cli.ExitWIPLimit},
+}
+
+// serviceError converts an error returned by a service into an API error.
+// Services report some missing rows as a wrapped sql.ErrNoRows rather than a
+// sentinel; those become notFoundCode.
+func serviceError(err error, notFoundCode string) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			return &Error{Code: e.code, Message: err.Error(), ExitCode: e.exitCode}
+		}
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return notFoundError(notFoundCode, "%s", err.Error())
+	}
+
+	return &Error{Code: "INTERNAL_ERROR", Message: err.Error(), ExitCode: cli.ExitError}
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"net/http"
+
+	labelservice "github.com/thenoetrevino/paso/internal/services/label"
+)
+
+type createLabelRequest struct {
+	Name  string
+	Color string // Hex color like #FF5733
+}
+
+type updateLabelRequest struct {
+	Name  *string
+	Color *string
+}
+
+func (s *Server) listLabels(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.app.LabelService.GetLabelsByProject(r.Context(), projectID)
+	if err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+	return labels, nil
+}
+
+func (s *Server) createLabel(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var req createLabelRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	label, err := s.app.LabelService.CreateLabel(r.Context(), labelservice.CreateLabelRequest{
+		ProjectID: projectID,
+		Name:      req.Name,
+		Color:     req.Color,
+	})
+	if err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+	return label, nil
+}
+
+func (s *Server) updateLabel(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req updateLabelRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil && req.Color == nil {
+		return nil, usageError("NO_UPDATES", "at least one of Name or Color must be set")
+	}
+
+	if err := s.app.LabelService.UpdateLabel(r.Context(), labelservice.UpdateLabelRequest{
+		ID:    id,
+		Name:  req.Name,
+		Color: req.Color,
+	}); err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+	return updated{ID: id, Updated: true}, nil
+}
+
+func (s *Server) deleteLabel(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.app.LabelService.DeleteLabel(r.Context(), id); err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+	return deleted{ID: id, Deleted: true}, nil
+}
@@ -0,0 +1,16 @@
+package api
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// openAPISpec describes the API. Update it together with Server.routes.
+//
+//go:embed openapi.json
+var openAPISpec []byte
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPISpec)
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// jsonFields returns the names encoding/json uses for the fields of struct type t
+func jsonFields(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func TestOpenAPISchemasMatchModels(t *testing.T) {
+	var spec struct {
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]json.RawMessage
+			}
+		}
+	}
+	if err := json.Unmarshal(openAPISpec, &spec); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+
+	schemas := map[string]any{
+		"Deleted":          deleted{},
+		"Updated":          updated{},
+		"Project":          models.Project{},
+		"Column":           models.Column{},
+		"Label":            models.Label{},
+		"TaskReference":    models.TaskReference{},
+		"TaskSummary":      models.TaskSummary{},
+		"TaskDetail":       models.TaskDetail{},
+		"TaskSearchResult": models.TaskSearchResult{},
+		"Comment":          models.Comment{},
+		"ChecklistItem":    models.ChecklistItem{},
+		"TaskEvent":        models.TaskEvent{},
+	}
+	for name, model := range schemas {
+		schema, ok := spec.Components.Schemas[name]
+		if !ok {
+			t.Errorf("openapi.json has no %s schema", name)
+			continue
+		}
+		var properties []string
+		for property := range schema.Properties {
+			properties = append(properties, property)
+		}
+		slices.Sort(properties)
+
+		fields := jsonFields(reflect.TypeOf(model))
+		slices.Sort(fields)
+		if !slices.Equal(properties, fields) {
+			t.Errorf("%s schema properties = %v, want the JSON fields of %T: %v", name, properties, model, fields)
+		}
+	}
+}
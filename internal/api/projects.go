@@ -0,0 +1,95 @@
+package api
+
+import (
+	"net/http"
+
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+)
+
+type createProjectRequest struct {
+	Name        string
+	Description string
+}
+
+type updateProjectRequest struct {
+	Name        *string
+	Description *string
+}
+
+func (s *Server) listProjects(r *http.Request) (any, error) {
+	projects, err := s.app.ProjectService.GetAllProjects(r.Context())
+	if err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return projects, nil
+}
+
+func (s *Server) createProject(r *http.Request) (any, error) {
+	var req createProjectRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+
+	project, err := s.app.ProjectService.CreateProject(r.Context(), projectservice.CreateProjectRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return project, nil
+}
+
+func (s *Server) getProject(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.app.ProjectService.GetProjectByID(r.Context(), id)
+	if err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return project, nil
+}
+
+func (s *Server) updateProject(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req updateProjectRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Name == nil && req.Description == nil {
+		return nil, usageError("NO_UPDATES", "at least one of Name or Description must be set")
+	}
+
+	if err := s.app.ProjectService.UpdateProject(r.Context(), projectservice.UpdateProjectRequest{
+		ID:          id,
+		Name:        req.Name,
+		Description: req.Description,
+	}); err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return s.getProject(r)
+}
+
+func (s *Server) deleteProject(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	force := r.URL.Query().Get("force") == "true"
+
+	// Check existence first; DeleteProject does not report missing projects
+	if _, err := s.app.ProjectService.GetProjectByID(r.Context(), id); err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	if err := s.app.ProjectService.DeleteProject(r.Context(), id, force); err != nil {
+		return nil, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	return deleted{ID: id, Deleted: true}, nil
+}
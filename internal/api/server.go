@@ -0,0 +1,189 @@
+// Package api exposes the service layer as a local HTTP+JSON API (paso serve).
+//
+// Responses use the same envelope as the CLI's --json output:
+//
+//	{"success": true, "data": ...}
+//	{"success": false, "error": {"code": "TASK_NOT_FOUND", "message": "...", "exit_code": 3}}
+//
+// Resources are encoded as their models, like the CLI list commands do.
+// The full description is served at /api/v1/openapi.json.
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/thenoetrevino/paso/internal/app"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// maxBodyBytes bounds request bodies
+const maxBodyBytes = 1 << 20
+
+// Server serves the API for an App. Mutations go through the App's services,
+// so they publish live-update events like the CLI and TUI do.
+type Server struct {
+	app   *app.App
+	mux   *http.ServeMux
+	token string          // Required bearer token ("" = none)
+	hosts map[string]bool // Host names requests may be addressed to
+}
+
+// NewServer creates an API server for the given app. It only answers
+// requests addressed to localhost unless WithAllowedHosts adds other names.
+func NewServer(a *app.App, opts ...Option) *Server {
+	s := &Server{
+		app:   a,
+		mux:   http.NewServeMux(),
+		hosts: map[string]bool{"localhost": true, "127.0.0.1": true, "::1": true},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.routes()
+	return s
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := s.checkAccess(r); err != nil {
+		if err.status == http.StatusUnauthorized {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+		}
+		writeError(w, r, err)
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+// routes registers all endpoints. Keep in sync with openapi.json.
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /api/v1/health", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]any{"success": true})
+	})
+	s.mux.HandleFunc("GET /api/v1/openapi.json", serveOpenAPI)
+
+	// Projects
+	s.handle("GET /api/v1/projects", http.StatusOK, s.listProjects)
+	s.handle("POST /api/v1/projects", http.StatusCreated, s.createProject)
+	s.handle("GET /api/v1/projects/{id}", http.StatusOK, s.getProject)
+	s.handle("PATCH /api/v1/projects/{id}", http.StatusOK, s.updateProject)
+	s.handle("DELETE /api/v1/projects/{id}", http.StatusOK, s.deleteProject)
+
+	// Columns
+	s.handle("GET /api/v1/projects/{id}/columns", http.StatusOK, s.listColumns)
+	s.handle("POST /api/v1/projects/{id}/columns", http.StatusCreated, s.createColumn)
+	s.handle("PATCH /api/v1/columns/{id}", http.StatusOK, s.updateColumn)
+	s.handle("DELETE /api/v1/columns/{id}", http.StatusOK, s.deleteColumn)
+
+	// Labels
+	s.handle("GET /api/v1/projects/{id}/labels", http.StatusOK, s.listLabels)
+	s.handle("POST /api/v1/projects/{id}/labels", http.StatusCreated, s.createLabel)
+	s.handle("PATCH /api/v1/labels/{id}", http.StatusOK, s.updateLabel)
+	s.handle("DELETE /api/v1/labels/{id}", http.StatusOK, s.deleteLabel)
+
+	// Tasks
+	s.handle("GET /api/v1/projects/{id}/tasks", http.StatusOK, s.listTasks)
+	s.handle("GET /api/v1/projects/{id}/search", http.StatusOK, s.searchTasks)
+	s.handle("POST /api/v1/tasks", http.StatusCreated, s.createTask)
+	s.handle("GET /api/v1/tasks/{id}", http.StatusOK, s.getTask)
+	s.handle("PATCH /api/v1/tasks/{id}", http.StatusOK, s.updateTask)
+	s.handle("DELETE /api/v1/tasks/{id}", http.StatusOK, s.deleteTask)
+	s.handle("POST /api/v1/tasks/{id}/move", http.StatusOK, s.moveTask)
+	s.handle("GET /api/v1/tasks/{id}/history", http.StatusOK, s.taskHistory)
+	s.handle("PUT /api/v1/tasks/{id}/labels/{labelID}", http.StatusOK, s.attachLabel)
+	s.handle("DELETE /api/v1/tasks/{id}/labels/{labelID}", http.StatusOK, s.detachLabel)
+
+	// Comments
+	s.handle("GET /api/v1/tasks/{id}/comments", http.StatusOK, s.listComments)
+	s.handle("POST /api/v1/tasks/{id}/comments", http.StatusCreated, s.createComment)
+}
+
+// handlerFunc handles a request and returns the response data or an error.
+// Errors that are not *Error are reported as INTERNAL_ERROR.
+type handlerFunc func(r *http.Request) (any, error)
+
+// handle registers fn for pattern, wrapping its result in the response envelope
+func (s *Server) handle(pattern string, status int, fn handlerFunc) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		data, err := fn(r)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		writeJSON(w, status, map[string]any{
+			"success": true,
+			"data":    data,
+		})
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		slog.Error("failed to writing api response", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		apiErr = &Error{Code: "INTERNAL_ERROR", Message: err.Error(), ExitCode: cli.ExitError}
+	}
+	if apiErr.ExitCode == cli.ExitError {
+		slog.Error("api request failed", "method", r.Method, "path", r.URL.Path, "error", err)
+	}
+
+	writeJSON(w, apiErr.Status(), map[string]any{
+		"success": false,
+		"error": map[string]any{
+			"code":      apiErr.Code,
+			"message":   apiErr.Message,
+			"exit_code": apiErr.ExitCode,
+		},
+	})
+}
+
+// pathID parses a positive integer path parameter
+func pathID(r *http.Request, name string) (int, error) {
+	id, err := strconv.Atoi(r.PathValue(name))
+	if err != nil || id <= 0 {
+		return 0, usageError("INVALID_ID", "%s must be a positive integer, got %q", name, r.PathValue(name))
+	}
+	return id, nil
+}
+
+// decodeBody decodes a JSON request body into v, rejecting unknown fields.
+// The body must be sent as application/json: browsers cannot send that
+// cross-origin without a CORS preflight, which the server never grants, so
+// other web pages cannot make a user's browser change their boards.
+func decodeBody(r *http.Request, v any) error {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		return usageError("UNSUPPORTED_MEDIA_TYPE", "request body must be application/json, got %q", r.Header.Get("Content-Type"))
+	}
+	decoder := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return &Error{Code: "INVALID_JSON", Message: "invalid request body: " + err.Error(), ExitCode: cli.ExitDataErr}
+	}
+	return nil
+}
+
+// deleted is the response data of delete endpoints
+type deleted struct {
+	ID      int  `json:"id"`
+	Deleted bool `json:"deleted"`
+}
+
+// updated is the response data of update endpoints whose service has no
+// getter for the updated resource
+type updated struct {
+	ID      int  `json:"id"`
+	Updated bool `json:"updated"`
+}
@@ -0,0 +1,346 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/app"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+// recordingPublisher records the events services publish
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func (p *recordingPublisher) Connect(ctx context.Context) error { return nil }
+func (p *recordingPublisher) SendEvent(event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+func (p *recordingPublisher) Listen(ctx context.Context) (<-chan events.Event, error) {
+	return make(chan events.Event), nil
+}
+func (p *recordingPublisher) Subscribe(projectID int) error      { return nil }
+func (p *recordingPublisher) SetNotifyFunc(fn events.NotifyFunc) {}
+func (p *recordingPublisher) Close() error                       { return nil }
+
+func (p *recordingPublisher) types() []events.EventType {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	types := make([]events.EventType, len(p.events))
+	for i, e := range p.events {
+		types[i] = e.Type
+	}
+	return types
+}
+
+type envelope struct {
+	Success bool
+	Data    json.RawMessage
+	Error   struct {
+		Code     string `json:"code"`
+		Message  string `json:"message"`
+		ExitCode int    `json:"exit_code"`
+	}
+}
+
+func setupTestServer(t *testing.T) (*httptest.Server, *recordingPublisher) {
+	t.Helper()
+	db := testutil.SetupTestDB(t)
+	publisher := &recordingPublisher{}
+	srv := httptest.NewServer(NewServer(app.New(db, app.WithEventPublisher(publisher))))
+	t.Cleanup(srv.Close)
+	return srv, publisher
+}
+
+func do(t *testing.T, srv *httptest.Server, method, path, body string) (int, envelope) {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(context.Background(), method, srv.URL+"/api/v1"+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatalf("%s %s error = %v", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		t.Fatalf("%s %s: response is not JSON: %v", method, path, err)
+	}
+	return resp.StatusCode, env
+}
+
+// mustDo performs a request that must succeed and decodes its data into v
+func mustDo(t *testing.T, srv *httptest.Server, method, path, body string, wantStatus int, v any) {
+	t.Helper()
+	status, env := do(t, srv, method, path, body)
+	if status != wantStatus || !env.Success {
+		t.Fatalf("%s %s = %d %+v, want %d", method, path, status, env.Error, wantStatus)
+	}
+	if v != nil {
+		if err := json.Unmarshal(env.Data, v); err != nil {
+			t.Fatalf("%s %s: failed to decode data: %v", method, path, err)
+		}
+	}
+}
+
+func TestTaskLifecycle(t *testing.T) {
+	srv, publisher := setupTestServer(t)
+
+	var project struct{ ID int }
+	mustDo(t, srv, "POST", "/projects", `{"Name": "API"}`, http.StatusCreated, &project)
+
+	var columns []struct {
+		ID   int
+		Name string
+	}
+	mustDo(t, srv, "GET", fmt.Sprintf("/projects/%d/columns", project.ID), "", http.StatusOK, &columns)
+	if len(columns) < 2 {
+		t.Fatalf("new project has %d columns, want the defaults", len(columns))
+	}
+
+	var task struct {
+		ID                  int
+		Title               string
+		PriorityDescription string
+		ColumnID            int
+	}
+	mustDo(t, srv, "POST", "/tasks",
+		fmt.Sprintf(`{"Title": "Write docs", "ProjectID": %d, "Priority": "high"}`, project.ID),
+		http.StatusCreated, &task)
+	if task.ColumnID != columns[0].ID {
+		t.Errorf("task created in column %d, want the first column %d", task.ColumnID, columns[0].ID)
+	}
+
+	mustDo(t, srv, "PATCH", fmt.Sprintf("/tasks/%d", task.ID), `{"Priority": "low"}`, http.StatusOK, &task)
+	if !strings.EqualFold(task.PriorityDescription, "low") {
+		t.Errorf("priority after update = %q, want low", task.PriorityDescription)
+	}
+
+	mustDo(t, srv, "POST", fmt.Sprintf("/tasks/%d/move", task.ID), `{"To": "next"}`, http.StatusOK, &task)
+	if task.ColumnID != columns[1].ID {
+		t.Errorf("task in column %d after move, want %d", task.ColumnID, columns[1].ID)
+	}
+
+	mustDo(t, srv, "POST", fmt.Sprintf("/tasks/%d/comments", task.ID), `{"Message": "started", "Author": "api"}`, http.StatusCreated, nil)
+
+	var tasks []struct{ ID int }
+	mustDo(t, srv, "GET", fmt.Sprintf("/projects/%d/tasks?filter=priority:low", project.ID), "", http.StatusOK, &tasks)
+	if len(tasks) != 1 || tasks[0].ID != task.ID {
+		t.Errorf("filtered tasks = %+v, want only task %d", tasks, task.ID)
+	}
+
+	var history []struct{ EventType string }
+	mustDo(t, srv, "GET", fmt.Sprintf("/tasks/%d/history", task.ID), "", http.StatusOK, &history)
+	if len(history) < 4 {
+		t.Errorf("history has %d entries, want created, updated, moved and comment_added", len(history))
+	}
+
+	mustDo(t, srv, "DELETE", fmt.Sprintf("/tasks/%d", task.ID), "", http.StatusOK, nil)
+	if status, _ := do(t, srv, "GET", fmt.Sprintf("/tasks/%d", task.ID), ""); status != http.StatusNotFound {
+		t.Errorf("GET deleted task = %d, want 404", status)
+	}
+
+	// Mutations publish live-update events like the CLI does
+	want := []events.EventType{
+		events.EventProjectCreated, events.EventTaskCreated, events.EventTaskUpdated,
+		events.EventTaskMoved, events.EventCommentAdded, events.EventTaskDeleted,
+	}
+	got := publisher.types()
+	for _, w := range want {
+		found := false
+		for _, g := range got {
+			found = found || g == w
+		}
+		if !found {
+			t.Errorf("published events %v, missing %s", got, w)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	srv, _ := setupTestServer(t)
+
+	var project struct{ ID int }
+	mustDo(t, srv, "POST", "/projects", `{"Name": "Errors"}`, http.StatusCreated, &project)
+
+	tests := []struct {
+		name         string
+		method, path string
+		body         string
+		wantStatus   int
+		wantCode     string
+		wantExitCode int
+	}{
+		{"missing task", "GET", "/tasks/999", "", 404, "TASK_NOT_FOUND", 3},
+		{"missing project", "GET", "/projects/999/tasks", "", 404, "PROJECT_NOT_FOUND", 3},
+		{"non-numeric id", "GET", "/tasks/abc", "", 400, "INVALID_ID", 2},
+		{"malformed json", "POST", "/tasks", `{"Title": `, 400, "INVALID_JSON", 4},
+		{"unknown field", "POST", "/projects", `{"Nmae": "typo"}`, 400, "INVALID_JSON", 4},
+		{"no column or project", "POST", "/tasks", `{"Title": "x"}`, 400, "MISSING_FIELDS", 2},
+		{"empty title", "POST", "/tasks", fmt.Sprintf(`{"Title": "", "ProjectID": %d}`, project.ID), 422, "EMPTY_TITLE", 5},
+		{"invalid priority", "POST", "/tasks", fmt.Sprintf(`{"Title": "x", "ProjectID": %d, "Priority": "urgent"}`, project.ID), 422, "INVALID_PRIORITY", 5},
+		{"invalid filter", "GET", fmt.Sprintf("/projects/%d/tasks?filter=owner:me", project.ID), "", 422, "INVALID_FILTER", 5},
+		{"invalid color", "POST", fmt.Sprintf("/projects/%d/labels", project.ID), `{"Name": "bug", "Color": "red"}`, 422, "INVALID_COLOR", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, env := do(t, srv, tt.method, tt.path, tt.body)
+			if status != tt.wantStatus || env.Success {
+				t.Errorf("status = %d (success %v), want %d", status, env.Success, tt.wantStatus)
+			}
+			if env.Error.Code != tt.wantCode || env.Error.ExitCode != tt.wantExitCode {
+				t.Errorf("error = %s/%d (%s), want %s/%d", env.Error.Code, env.Error.ExitCode, env.Error.Message, tt.wantCode, tt.wantExitCode)
+			}
+		})
+	}
+}
+
+// TestRequiresJSONContentType checks that bodies a cross-site form could send
+// are refused before anything changes
+func TestRequiresJSONContentType(t *testing.T) {
+	srv, _ := setupTestServer(t)
+
+	post := func(contentType string) (int, envelope) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(context.Background(), "POST", srv.URL+"/api/v1/projects", strings.NewReader(`{"Name": "CSRF"}`))
+		if err != nil {
+			t.Fatalf("NewRequest() error = %v", err)
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatalf("POST /projects error = %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		var env envelope
+		if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+			t.Fatalf("response is not JSON: %v", err)
+		}
+		return resp.StatusCode, env
+	}
+
+	for _, contentType := range []string{"", "text/plain", "application/x-www-form-urlencoded"} {
+		status, env := post(contentType)
+		if status != http.StatusBadRequest || env.Error.Code != "UNSUPPORTED_MEDIA_TYPE" {
+			t.Errorf("Content-Type %q: status = %d %+v, want 400 UNSUPPORTED_MEDIA_TYPE", contentType, status, env.Error)
+		}
+	}
+
+	var projects []struct{ ID int }
+	mustDo(t, srv, "GET", "/projects", "", http.StatusOK, &projects)
+	if len(projects) != 0 {
+		t.Errorf("got %d projects after refused requests, want 0", len(projects))
+	}
+
+	if status, env := post("application/json; charset=utf-8"); status != http.StatusCreated {
+		t.Errorf("Content-Type with a charset: status = %d %+v, want 201", status, env.Error)
+	}
+}
+
+// TestAccess checks that requests for other host names, as a DNS-rebound web
+// page sends them, and requests without the token are refused
+func TestAccess(t *testing.T) {
+	handler := NewServer(app.New(testutil.SetupTestDB(t)), WithToken("s3cret"), WithAllowedHosts("paso.lan"))
+
+	tests := []struct {
+		name       string
+		host       string
+		auth       string
+		wantStatus int
+		wantCode   string
+	}{
+		{name: "rebound host", host: "evil.example:8080", auth: "Bearer s3cret", wantStatus: http.StatusForbidden, wantCode: "FORBIDDEN_HOST"},
+		{name: "missing token", host: "127.0.0.1:8080", wantStatus: http.StatusUnauthorized, wantCode: "UNAUTHORIZED"},
+		{name: "wrong token", host: "localhost:8080", auth: "Bearer nope", wantStatus: http.StatusUnauthorized, wantCode: "UNAUTHORIZED"},
+		{name: "loopback", host: "127.0.0.1:8080", auth: "Bearer s3cret", wantStatus: http.StatusOK},
+		{name: "ipv6 loopback", host: "[::1]:8080", auth: "Bearer s3cret", wantStatus: http.StatusOK},
+		{name: "configured host", host: "PASO.lan", auth: "Bearer s3cret", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/projects", nil)
+			req.Host = tt.host
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantCode == "" {
+				return
+			}
+			var env envelope
+			if err := json.NewDecoder(rec.Body).Decode(&env); err != nil {
+				t.Fatalf("response is not JSON: %v", err)
+			}
+			if env.Error.Code != tt.wantCode {
+				t.Errorf("code = %q, want %q", env.Error.Code, tt.wantCode)
+			}
+			if tt.wantStatus == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") != "Bearer" {
+				t.Errorf("WWW-Authenticate = %q, want Bearer", rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+// TestOpenAPI checks that every operation in the spec is routed
+func TestOpenAPI(t *testing.T) {
+	srv, _ := setupTestServer(t)
+
+	resp, err := srv.Client().Get(srv.URL + "/api/v1/openapi.json")
+	if err != nil {
+		t.Fatalf("GET openapi.json error = %v", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var spec struct {
+		Paths map[string]map[string]json.RawMessage
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&spec); err != nil {
+		t.Fatalf("openapi.json is not valid JSON: %v", err)
+	}
+
+	for path, ops := range spec.Paths {
+		for method := range ops {
+			method = strings.ToUpper(method)
+			url := srv.URL + "/api/v1" + strings.NewReplacer("{id}", "999", "{labelID}", "999").Replace(path)
+			req, _ := http.NewRequestWithContext(context.Background(), method, url, bytes.NewReader([]byte("{}")))
+			resp, err := srv.Client().Do(req)
+			if err != nil {
+				t.Fatalf("%s %s error = %v", method, path, err)
+			}
+			_ = resp.Body.Close()
+
+			// Unrouted requests get the mux's plain-text 404/405
+			if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+				t.Errorf("%s %s is in openapi.json but not routed (status %d)", method, path, resp.StatusCode)
+			}
+		}
+	}
+}
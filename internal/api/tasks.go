@@ -0,0 +1,403 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	userutil "github.com/thenoetrevino/paso/internal/user"
+)
+
+type createTaskRequest struct {
+	Title        string
+	Description  string
+	ProjectID    int    // Used to pick the first column when ColumnID is not set
+	ColumnID     int    // Optional: defaults to the project's first column
+	Type         string // task, feature (default task)
+	Priority     string // trivial, low, medium, high, critical (default medium)
+	LabelIDs     []int
+	ParentIDs    []int
+	BlockedByIDs []int
+	BlocksIDs    []int
+	Force        bool // Exceed the column's WIP limit
+}
+
+type updateTaskRequest struct {
+	Title       *string
+	Description *string
+	Type        *string
+	Priority    *string
+}
+
+type moveTaskRequest struct {
+	ColumnID int    // Move to this column, or
+	To       string // next, prev, ready, in-progress, done, up, down
+	Force    bool   // Exceed the target column's WIP limit
+}
+
+type createCommentRequest struct {
+	Message string
+	Author  string // Optional: defaults to the user running paso serve
+}
+
+func (s *Server) listTasks(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.app.TaskService.GetTaskSummariesByFilter(r.Context(), projectID, r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return tasks, nil
+}
+
+func (s *Server) searchTasks(r *http.Request) (any, error) {
+	projectID, err := s.requireProject(r)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		return nil, usageError("EMPTY_QUERY", "q must not be empty")
+	}
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		if limit, err = strconv.Atoi(v); err != nil || limit < 0 {
+			return nil, usageError("INVALID_LIMIT", "limit must be a non-negative integer, got %q", v)
+		}
+	}
+
+	results, err := s.app.TaskService.SearchTasks(r.Context(), projectID, query, limit)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return results, nil
+}
+
+func (s *Server) createTask(r *http.Request) (any, error) {
+	var req createTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	ctx := r.Context()
+
+	columnID, projectID := req.ColumnID, req.ProjectID
+	if columnID == 0 {
+		if req.ProjectID == 0 {
+			return nil, usageError("MISSING_FIELDS", "one of ColumnID or ProjectID is required")
+		}
+		if _, err := s.app.ProjectService.GetProjectByID(ctx, req.ProjectID); err != nil {
+			return nil, serviceError(err, "PROJECT_NOT_FOUND")
+		}
+		columns, err := s.app.ColumnService.GetColumnsByProject(ctx, req.ProjectID)
+		if err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+		if len(columns) == 0 {
+			return nil, validationError("NO_COLUMNS", "project %d has no columns", req.ProjectID)
+		}
+		columnID = columns[0].ID
+	} else {
+		column, err := s.app.ColumnService.GetColumnByID(ctx, columnID)
+		if err != nil {
+			return nil, serviceError(err, "COLUMN_NOT_FOUND")
+		}
+		projectID = column.ProjectID
+	}
+
+	typeID, priorityID, err := s.parseTypeAndPriority(r, projectID, req.Type, req.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Force {
+		ctx = taskservice.WithWIPLimitOverride(ctx)
+	}
+	task, err := s.app.TaskService.CreateTask(ctx, taskservice.CreateTaskRequest{
+		Title:        req.Title,
+		Description:  req.Description,
+		ColumnID:     columnID,
+		Position:     models.DefaultTaskPosition,
+		PriorityID:   priorityID,
+		TypeID:       typeID,
+		LabelIDs:     req.LabelIDs,
+		ParentIDs:    req.ParentIDs,
+		BlockedByIDs: req.BlockedByIDs,
+		BlocksIDs:    req.BlocksIDs,
+	})
+	if err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+
+	detail, err := s.app.TaskService.GetTaskDetail(ctx, task.ID)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return detail, nil
+}
+
+// parseTypeAndPriority resolves type and priority names against a project's
+// own. Empty names resolve to 0, which the task service turns into the
+// project's defaults.
+func (s *Server) parseTypeAndPriority(r *http.Request, projectID int, typeName, priorityName string) (typeID, priorityID int, err error) {
+	if typeName != "" {
+		types, err := s.app.TypeService.GetTypesByProject(r.Context(), projectID)
+		if err != nil {
+			return 0, 0, serviceError(err, "PROJECT_NOT_FOUND")
+		}
+		if typeID, err = cli.ParseTaskType(types, typeName); err != nil {
+			return 0, 0, validationError("INVALID_TYPE", "%s", err.Error())
+		}
+	}
+	if priorityName == "" {
+		return typeID, 0, nil
+	}
+	priorities, err := s.app.PriorityService.GetPrioritiesByProject(r.Context(), projectID)
+	if err != nil {
+		return 0, 0, serviceError(err, "PROJECT_NOT_FOUND")
+	}
+	priorityID, err = cli.ParsePriority(priorities, priorityName)
+	if err != nil {
+		return 0, 0, validationError("INVALID_PRIORITY", "%s", err.Error())
+	}
+	return typeID, priorityID, nil
+}
+
+func (s *Server) getTask(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.app.TaskService.GetTaskDetail(r.Context(), id)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return task, nil
+}
+
+func (s *Server) updateTask(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req updateTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Title == nil && req.Description == nil && req.Type == nil && req.Priority == nil {
+		return nil, usageError("NO_UPDATES", "at least one of Title, Description, Type or Priority must be set")
+	}
+
+	update := taskservice.UpdateTaskRequest{
+		TaskID:      id,
+		Title:       req.Title,
+		Description: req.Description,
+	}
+
+	existing, err := s.app.TaskService.GetTaskDetail(r.Context(), id)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	if req.Type != nil || req.Priority != nil {
+		var typeName, priorityName string
+		if req.Type != nil {
+			if *req.Type == "" {
+				return nil, validationError("INVALID_TYPE", "type cannot be empty")
+			}
+			typeName = *req.Type
+		}
+		if req.Priority != nil {
+			if *req.Priority == "" {
+				return nil, validationError("INVALID_PRIORITY", "priority cannot be empty")
+			}
+			priorityName = *req.Priority
+		}
+		typeID, priorityID, err := s.parseTypeAndPriority(r, existing.ProjectID, typeName, priorityName)
+		if err != nil {
+			return nil, err
+		}
+		if req.Type != nil {
+			update.TypeID = &typeID
+		}
+		if req.Priority != nil {
+			update.PriorityID = &priorityID
+		}
+	}
+
+	if err := s.app.TaskService.UpdateTask(r.Context(), update); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return s.getTask(r)
+}
+
+func (s *Server) deleteTask(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.app.TaskService.GetTaskSummary(r.Context(), id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	if err := s.app.TaskService.DeleteTask(r.Context(), id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return deleted{ID: id, Deleted: true}, nil
+}
+
+func (s *Server) moveTask(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req moveTaskRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if (req.ColumnID == 0) == (req.To == "") {
+		return nil, usageError("INVALID_MOVE", "exactly one of ColumnID or To must be set")
+	}
+
+	ctx := r.Context()
+	if _, err := s.app.TaskService.GetTaskSummary(ctx, id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+
+	if req.Force {
+		ctx = taskservice.WithWIPLimitOverride(ctx)
+	}
+	tasks := s.app.TaskService
+	switch req.To {
+	case "":
+		err = tasks.MoveTaskToColumn(ctx, id, req.ColumnID)
+	case "next":
+		err = tasks.MoveTaskToNextColumn(ctx, id)
+	case "prev":
+		err = tasks.MoveTaskToPrevColumn(ctx, id)
+	case "ready":
+		err = tasks.MoveTaskToReadyColumn(ctx, id)
+	case "in-progress":
+		err = tasks.MoveTaskToInProgressColumn(ctx, id)
+	case "done":
+		err = tasks.MoveTaskToCompletedColumn(ctx, id)
+	case "up":
+		err = tasks.MoveTaskUp(ctx, id)
+	case "down":
+		err = tasks.MoveTaskDown(ctx, id)
+	default:
+		return nil, usageError("INVALID_MOVE", "unknown destination %q (must be: next, prev, ready, in-progress, done, up, down)", req.To)
+	}
+	if err != nil {
+		return nil, serviceError(err, "COLUMN_NOT_FOUND")
+	}
+
+	task, err := tasks.GetTaskSummary(ctx, id)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return task, nil
+}
+
+func (s *Server) taskHistory(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.app.TaskService.GetTaskSummary(r.Context(), id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	history, err := s.app.TaskService.GetTaskHistory(r.Context(), id)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return history, nil
+}
+
+func (s *Server) attachLabel(r *http.Request) (any, error) {
+	return s.changeLabel(r, s.app.TaskService.AttachLabel)
+}
+
+func (s *Server) detachLabel(r *http.Request) (any, error) {
+	return s.changeLabel(r, s.app.TaskService.DetachLabel)
+}
+
+// changeLabel attaches or detaches the {labelID} label on the {id} task and
+// returns the task's labels
+func (s *Server) changeLabel(r *http.Request, change func(ctx context.Context, taskID, labelID int) error) (any, error) {
+	taskID, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+	labelID, err := pathID(r, "labelID")
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := r.Context()
+	if _, err := s.app.TaskService.GetTaskSummary(ctx, taskID); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	if err := change(ctx, taskID, labelID); err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+
+	labels, err := s.app.LabelService.GetLabelsForTask(ctx, taskID)
+	if err != nil {
+		return nil, serviceError(err, "LABEL_NOT_FOUND")
+	}
+	return labels, nil
+}
+
+func (s *Server) listComments(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.app.TaskService.GetTaskSummary(r.Context(), id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	comments, err := s.app.TaskService.GetCommentsByTask(r.Context(), id)
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return comments, nil
+}
+
+func (s *Server) createComment(r *http.Request) (any, error) {
+	id, err := pathID(r, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	var req createCommentRequest
+	if err := decodeBody(r, &req); err != nil {
+		return nil, err
+	}
+	if req.Author == "" {
+		req.Author = userutil.GetCurrentUsername()
+	}
+
+	if _, err := s.app.TaskService.GetTaskSummary(r.Context(), id); err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	comment, err := s.app.TaskService.CreateComment(r.Context(), taskservice.CreateCommentRequest{
+		TaskID:  id,
+		Message: req.Message,
+		Author:  req.Author,
+	})
+	if err != nil {
+		return nil, serviceError(err, "TASK_NOT_FOUND")
+	}
+	return comment, nil
+}
@@ -1,13 +1,19 @@
 package app
 
 import (
+	"context"
 	"database/sql"
+	"log/slog"
+	"time"
 
 	"github.com/thenoetrevino/paso/internal/events"
 	columnservice "github.com/thenoetrevino/paso/internal/services/column"
 	labelservice "github.com/thenoetrevino/paso/internal/services/label"
+	priorityservice "github.com/thenoetrevino/paso/internal/services/priority"
 	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+	relationtypeservice "github.com/thenoetrevino/paso/internal/services/relationtype"
 	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	typeservice "github.com/thenoetrevino/paso/internal/services/tasktype"
 )
 
 // App holds all application services and provides dependency injection.
@@ -17,10 +23,13 @@ type App struct {
 	eventClient events.EventPublisher
 
 	// Service layer (business logic) - ONLY public interface
-	TaskService    taskservice.Service
-	ProjectService projectservice.Service
-	ColumnService  columnservice.Service
-	LabelService   labelservice.Service
+	TaskService         taskservice.Service
+	ProjectService      projectservice.Service
+	ColumnService       columnservice.Service
+	LabelService        labelservice.Service
+	TypeService         typeservice.Service
+	PriorityService     priorityservice.Service
+	RelationTypeService relationtypeservice.Service
 }
 
 // New creates a new App with all services initialized.
@@ -42,11 +51,28 @@ func New(db *sql.DB, opts ...Option) *App {
 	// Create services with database connection
 	// Each service creates its own SQLC queries instance internally
 	return &App{
-		eventClient:    cfg.eventClient,
-		TaskService:    taskservice.NewService(db, cfg.eventClient),
-		ProjectService: projectservice.NewService(db, cfg.eventClient),
-		ColumnService:  columnservice.NewService(db, cfg.eventClient),
-		LabelService:   labelservice.NewService(db, cfg.eventClient),
+		eventClient:         cfg.eventClient,
+		TaskService:         taskservice.NewService(db, cfg.eventClient),
+		ProjectService:      projectservice.NewService(db, cfg.eventClient),
+		ColumnService:       columnservice.NewService(db, cfg.eventClient),
+		LabelService:        labelservice.NewService(db, cfg.eventClient),
+		TypeService:         typeservice.NewService(db, cfg.eventClient),
+		PriorityService:     priorityservice.NewService(db, cfg.eventClient),
+		RelationTypeService: relationtypeservice.NewService(db, cfg.eventClient),
+	}
+}
+
+// GenerateRecurringTasks creates the instances of recurring tasks that have
+// come due. The CLI and TUI run it on startup (except for dry runs) and the
+// daemon runs it on a timer. Failures are logged rather than returned, so
+// that a broken rule never keeps paso from starting.
+func (a *App) GenerateRecurringTasks(ctx context.Context) {
+	created, err := a.TaskService.GenerateRecurringTasks(ctx, time.Now())
+	if err != nil {
+		slog.Error("failed to generate recurring tasks", "error", err)
+	}
+	if len(created) > 0 {
+		slog.Info("generated recurring tasks", "task_ids", created)
 	}
 }
 
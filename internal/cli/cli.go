@@ -21,6 +21,16 @@ type CLI struct {
 	ctx         context.Context
 }
 
+// skipRecurringTasksKey marks a context whose CLI leaves recurring tasks alone
+type skipRecurringTasksKey struct{}
+
+// WithoutRecurringTasks returns a context in which NewCLI does not generate
+// recurring tasks on startup. Dry runs use it so they change nothing, and
+// 'paso task recur run' so it reports the instances it generated itself.
+func WithoutRecurringTasks(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipRecurringTasksKey{}, true)
+}
+
 // GetCLIFromContext retrieves a CLI instance from context (for testing)
 // If not found, creates a new CLI instance
 func GetCLIFromContext(ctx context.Context) (*CLI, error) {
@@ -88,6 +98,12 @@ func NewCLIWithApp(ctx context.Context, testApp *app.App) (*CLI, error) {
 
 	application := app.New(db, appOpts...)
 
+	// Bring recurring tasks up to date before the command runs, so that
+	// commands run from cron see the instances that have come due
+	if ctx.Value(skipRecurringTasksKey{}) == nil {
+		application.GenerateRecurringTasks(ctx)
+	}
+
 	return &CLI{
 		App:         application,
 		eventClient: eventClient,
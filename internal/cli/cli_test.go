@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+func TestNewCLI_GeneratesRecurringTasks(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	ctx := context.Background()
+
+	// occurrences opens the CLI with ctx and reports how many instances the
+	// recurring task has
+	occurrences := func(ctx context.Context, taskID int) int {
+		t.Helper()
+		cliInstance, err := NewCLI(ctx)
+		require.NoError(t, err)
+		defer func() { _ = cliInstance.Close() }()
+
+		recurrence, err := cliInstance.App.TaskService.GetRecurrence(ctx, taskID)
+		require.NoError(t, err)
+		return recurrence.Occurrences
+	}
+
+	cliInstance, err := NewCLI(WithoutRecurringTasks(ctx))
+	require.NoError(t, err)
+	projects, err := cliInstance.App.ProjectService.GetAllProjects(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, projects)
+	columns, err := cliInstance.App.ColumnService.GetColumnsByProject(ctx, projects[0].ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, columns)
+	task, err := cliInstance.App.TaskService.CreateTask(ctx, taskservice.CreateTaskRequest{
+		Title:    "Water the plants",
+		ColumnID: columns[0].ID,
+	})
+	require.NoError(t, err)
+	_, err = cliInstance.App.TaskService.SetRecurrence(ctx, task.ID, "daily", time.Now().Add(-48*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, cliInstance.Close())
+
+	// Dry runs leave the due instance alone; any other command generates it
+	require.Zero(t, occurrences(WithoutRecurringTasks(ctx), task.ID))
+	require.Equal(t, 1, occurrences(ctx, task.ID))
+}
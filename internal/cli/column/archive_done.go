@@ -0,0 +1,130 @@
+package column
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/dates"
+)
+
+// ArchiveDoneCmd returns the column archive-done subcommand
+func ArchiveDoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-done",
+		Short: "Archive old tasks in the completed column",
+		Long: `Archive the tasks that have been in the project's completed column for a
+while, counted from when they were moved there. Archived tasks leave the
+board but keep their history and no longer block other tasks; see
+'paso task unarchive'.
+
+Examples:
+  # Archive tasks finished more than two weeks ago
+  paso column archive-done --older-than 14d --project=1
+
+  # JSON output for agents
+  paso column archive-done --older-than 2w --json
+`,
+		RunE: runArchiveDone,
+	}
+
+	cmd.Flags().String("older-than", "", "Age of the tasks to archive, e.g. 14d, 2w or 12h (required)")
+	if err := cmd.MarkFlagRequired("older-than"); err != nil {
+		slog.Error("failed to mark flag as required", "error", err)
+	}
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs only)")
+
+	return cmd
+}
+
+func runArchiveDone(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	olderThanFlag, _ := cmd.Flags().GetString("older-than")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	olderThan, err := dates.ParseWindow(olderThanFlag)
+	if err != nil || olderThan <= 0 {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_AGE",
+			fmt.Sprintf("invalid --older-than %q", olderThanFlag),
+			"Use an age such as 14d, 2w or 12h"); fmtErr != nil {
+			slog.Error("failed to format error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Get project ID from flag or environment variable
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to format error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to format error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to close CLI", "error", err)
+		}
+	}()
+
+	// Validate project exists
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to format error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	archived, err := cliInstance.App.TaskService.ArchiveCompletedTasks(ctx, projectID, olderThan)
+	if err != nil {
+		if fmtErr := formatter.Error("ARCHIVE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to format error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, id := range archived {
+			fmt.Printf("%d\n", id)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		if archived == nil {
+			archived = []int{}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":  true,
+			"archived": archived,
+		})
+	}
+
+	if len(archived) == 0 {
+		fmt.Printf("No completed tasks older than %s\n", olderThanFlag)
+		return nil
+	}
+	fmt.Printf("✓ Archived %d completed task(s)\n", len(archived))
+	return nil
+}
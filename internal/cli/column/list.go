@@ -109,6 +109,7 @@ func runList(cmd *cobra.Command, args []string) error {
 				"holds_ready_tasks":       col.HoldsReadyTasks,
 				"holds_in_progress_tasks": col.HoldsInProgressTasks,
 				"holds_completed_tasks":   col.HoldsCompletedTasks,
+				"wip_limit":               col.WIPLimit,
 			}
 		}
 		return json.NewEncoder(os.Stdout).Encode(map[string]any{
@@ -135,6 +136,9 @@ func runList(cmd *cobra.Command, args []string) error {
 		if col.HoldsCompletedTasks {
 			flags += " [COMPLETED]"
 		}
+		if col.WIPLimit > 0 {
+			flags += fmt.Sprintf(" [WIP %d]", col.WIPLimit)
+		}
 		fmt.Printf("  %d. %s%s (ID: %d)\n", i+1, col.Name, flags, col.ID)
 	}
 	return nil
@@ -16,12 +16,15 @@ func UpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
 		Short: "Update a column",
-		Long: `Update a column's name.
+		Long: `Update a column's name, special role or WIP limit.
 
 Examples:
   # Update column name (human-readable output)
   paso column update --id=1 --name="Completed"
 
+  # Allow at most 3 tasks in the column (0 removes the limit)
+  paso column update --id=2 --wip-limit=3
+
   # JSON output for agents
   paso column update --id=1 --name="Completed" --json
 
@@ -43,6 +46,7 @@ Examples:
 	cmd.Flags().Bool("completed", false, "Set this column as holding completed tasks")
 	cmd.Flags().Bool("in-progress", false, "Set this column as holding in-progress tasks")
 	cmd.Flags().Bool("force", false, "Force setting completed column even if one already exists")
+	cmd.Flags().Int("wip-limit", 0, "Maximum number of tasks in the column (0 for no limit)")
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -60,17 +64,19 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	setCompleted, _ := cmd.Flags().GetBool("completed")
 	setInProgress, _ := cmd.Flags().GetBool("in-progress")
 	force, _ := cmd.Flags().GetBool("force")
+	wipLimit, _ := cmd.Flags().GetInt("wip-limit")
+	setWIPLimit := cmd.Flags().Changed("wip-limit")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
 	// Validate at least one update flag is provided
-	if columnName == "" && !setReady && !setCompleted && !setInProgress {
-		if fmtErr := formatter.Error("INVALID_INPUT", "at least one of --name, --ready, --completed, or --in-progress must be provided"); fmtErr != nil {
+	if columnName == "" && !setReady && !setCompleted && !setInProgress && !setWIPLimit {
+		if fmtErr := formatter.Error("INVALID_INPUT", "at least one of --name, --ready, --completed, --in-progress or --wip-limit must be provided"); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
-		return fmt.Errorf("at least one of --name, --ready, --completed, or --in-progress must be provided")
+		return fmt.Errorf("at least one of --name, --ready, --completed, --in-progress or --wip-limit must be provided")
 	}
 
 	// Initialize CLI
@@ -150,6 +156,17 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Update WIP limit if flag is set
+	if setWIPLimit {
+		updatedColumn, err = cliInstance.App.ColumnService.SetWIPLimit(ctx, columnID, wipLimit)
+		if err != nil {
+			if fmtErr := formatter.Error("UPDATE_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+	}
+
 	// Output based on mode
 	if quietMode {
 		return nil
@@ -165,6 +182,7 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 				"holds_ready_tasks":       updatedColumn.HoldsReadyTasks,
 				"holds_completed_tasks":   updatedColumn.HoldsCompletedTasks,
 				"holds_in_progress_tasks": updatedColumn.HoldsInProgressTasks,
+				"wip_limit":               updatedColumn.WIPLimit,
 			},
 		})
 	}
@@ -183,5 +201,12 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 	if setInProgress {
 		fmt.Printf("  Now holds in-progress tasks: %v\n", updatedColumn.HoldsInProgressTasks)
 	}
+	if setWIPLimit {
+		if updatedColumn.WIPLimit > 0 {
+			fmt.Printf("  WIP limit: %d\n", updatedColumn.WIPLimit)
+		} else {
+			fmt.Printf("  WIP limit: none\n")
+		}
+	}
 	return nil
 }
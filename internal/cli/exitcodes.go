@@ -31,4 +31,9 @@ const (
 	// Use for: Invalid priority values, invalid type values, invalid status,
 	// or any case where input fails validation rules.
 	ExitValidation = 5
+
+	// ExitWIPLimit indicates a column's WIP limit refused a task.
+	// Use for: Creating or moving a task into a column that already holds
+	// as many tasks as its limit allows. Retry with --force to override.
+	ExitWIPLimit = 7
 )
@@ -2,13 +2,18 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"os"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/models"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
 )
 
 // ValidateColorHex validates that a color string is in valid hex format #RRGGBB
@@ -23,39 +28,51 @@ func ValidateColorHex(color string) error {
 	return nil
 }
 
-// ParseTaskType maps a type string to its ID.
-// Returns an error with a helpful message if the type is not recognized.
-// This uses a map lookup with the ok pattern to detect invalid types.
-func ParseTaskType(typeStr string) (int, error) {
-	types := map[string]int{
-		"task":    models.TaskTypeTask,
-		"feature": models.TaskTypeFeature,
+// ParseTaskType maps a type name to its ID among a project's types
+// (case-insensitive). An empty name returns 0, which services treat as the
+// project's default type. The error lists the project's types.
+func ParseTaskType(types []*models.Type, typeStr string) (int, error) {
+	if typeStr == "" {
+		return 0, nil
 	}
-
-	id, ok := types[strings.ToLower(typeStr)]
-	if !ok {
-		return 0, fmt.Errorf("invalid type '%s' (must be: task, feature)", typeStr)
+	names := make([]string, len(types))
+	for i, t := range types {
+		if strings.EqualFold(t.Description, typeStr) {
+			return t.ID, nil
+		}
+		names[i] = t.Description
 	}
-	return id, nil
+	return 0, fmt.Errorf("invalid type '%s' (must be: %s)", typeStr, strings.Join(names, ", "))
 }
 
-// ParsePriority maps a priority string to its ID.
-// Returns an error with a helpful message if the priority is not recognized.
-// This uses a map lookup with the ok pattern to detect invalid priorities.
-func ParsePriority(priority string) (int, error) {
-	priorities := map[string]int{
-		"trivial":  models.PriorityTrivial,
-		"low":      models.PriorityLow,
-		"medium":   models.PriorityMedium,
-		"high":     models.PriorityHigh,
-		"critical": models.PriorityCritical,
+// ParsePriority maps a priority name to its ID among a project's priorities
+// (case-insensitive). An empty name returns 0, which services treat as the
+// project's default priority. The error lists the project's priorities.
+func ParsePriority(priorities []*models.Priority, priority string) (int, error) {
+	if priority == "" {
+		return 0, nil
+	}
+	names := make([]string, len(priorities))
+	for i, p := range priorities {
+		if strings.EqualFold(p.Description, priority) {
+			return p.ID, nil
+		}
+		names[i] = p.Description
 	}
+	return 0, fmt.Errorf("invalid priority '%s' (must be: %s)", priority, strings.Join(names, ", "))
+}
 
-	id, ok := priorities[strings.ToLower(priority)]
-	if !ok {
-		return 0, fmt.Errorf("invalid priority '%s' (must be: trivial, low, medium, high, critical)", priority)
+// ParseRelationType finds a relation type by name among the built-in and
+// project relation types (case-insensitive). The error lists the valid names.
+func ParseRelationType(relationTypes []*models.RelationType, name string) (*models.RelationType, error) {
+	names := make([]string, len(relationTypes))
+	for i, rt := range relationTypes {
+		if strings.EqualFold(rt.Name, name) {
+			return rt, nil
+		}
+		names[i] = rt.Name
 	}
-	return id, nil
+	return nil, fmt.Errorf("invalid relation type '%s' (must be: %s)", name, strings.Join(names, ", "))
 }
 
 // FindColumnByName finds a column by name (case-insensitive)
@@ -138,12 +155,143 @@ func GetProjectID(cmd *cobra.Command) (int, error) {
 	}
 
 	// Fall back to PASO_PROJECT environment variable
-	if envProject := os.Getenv("PASO_PROJECT"); envProject != "" {
-		var projectID int
-		if _, err := fmt.Sscanf(envProject, "%d", &projectID); err == nil {
-			return projectID, nil
-		}
+	if projectID, ok := EnvProjectID(); ok {
+		return projectID, nil
 	}
 
 	return 0, fmt.Errorf("no project specified: use --project flag or set with 'eval $(paso use project <project-id>)'")
 }
+
+// EnvProjectID returns the project set by PASO_PROJECT, if any
+func EnvProjectID() (int, bool) {
+	envProject := os.Getenv("PASO_PROJECT")
+	if envProject == "" {
+		return 0, false
+	}
+	var projectID int
+	if _, err := fmt.Sscanf(envProject, "%d", &projectID); err != nil {
+		return 0, false
+	}
+	return projectID, true
+}
+
+// TaskRef is a task as given on the command line: a task ID (42), a ticket
+// number in the current project (#42) or a project ticket key with a ticket
+// number (API-42)
+type TaskRef struct {
+	ID     int    // Task ID, 0 for ticket references
+	Key    string // Ticket key of KEY-N, upper case
+	Ticket int    // Ticket number of #N and KEY-N
+}
+
+// taskRefPattern matches #N and KEY-N
+var taskRefPattern = regexp.MustCompile(`^(?:#|([A-Za-z][A-Za-z0-9]*)-)([0-9]+)$`)
+
+// ParseTaskRef parses a task reference. A plain number is returned as a task
+// ID as is, so commands keep validating IDs the way they always have.
+func ParseTaskRef(s string) (TaskRef, error) {
+	s = strings.TrimSpace(s)
+	if m := taskRefPattern.FindStringSubmatch(s); m != nil {
+		ticket, err := strconv.Atoi(m[2])
+		if err != nil || ticket <= 0 {
+			return TaskRef{}, fmt.Errorf("invalid task reference '%s': ticket numbers start at 1", s)
+		}
+		return TaskRef{Key: strings.ToUpper(m[1]), Ticket: ticket}, nil
+	}
+
+	id, err := strconv.Atoi(s)
+	if err != nil {
+		return TaskRef{}, fmt.Errorf("invalid task reference '%s': use a task ID, #N or KEY-N", s)
+	}
+	return TaskRef{ID: id}, nil
+}
+
+// Valid reports whether the reference can point to a task: a positive task
+// ID or a ticket
+func (r TaskRef) Valid() bool {
+	return r.ID > 0 || r.Ticket > 0
+}
+
+// String formats the reference the way it is typed
+func (r TaskRef) String() string {
+	if r.Ticket == 0 {
+		return strconv.Itoa(r.ID)
+	}
+	return models.TicketRef(r.Key, r.Ticket)
+}
+
+// ResolveTaskRef returns the ID of the task a reference points to. KEY-N is
+// looked up in the project with that ticket key and #N in projectID, the
+// current project (0 when there is none). Tickets that do not exist return
+// an error wrapping taskservice.ErrTaskNotFound.
+func (c *CLI) ResolveTaskRef(ctx context.Context, ref TaskRef, projectID int) (int, error) {
+	if ref.Ticket == 0 {
+		return ref.ID, nil
+	}
+
+	if ref.Key != "" {
+		project, err := c.App.ProjectService.GetProjectByTicketKey(ctx, ref.Key)
+		if errors.Is(err, projectservice.ErrProjectNotFound) || errors.Is(err, projectservice.ErrInvalidTicketKey) {
+			return 0, fmt.Errorf("task %s not found: no project has ticket key %s: %w", ref, ref.Key, taskservice.ErrTaskNotFound)
+		}
+		if err != nil {
+			return 0, err
+		}
+		projectID = project.ID
+	} else if projectID <= 0 {
+		return 0, fmt.Errorf("%s needs a current project: set one with 'eval $(paso use project <project-id>)' or use KEY-N", ref)
+	}
+
+	taskID, err := c.App.TaskService.GetTaskIDByTicketNumber(ctx, projectID, ref.Ticket)
+	if errors.Is(err, taskservice.ErrTaskNotFound) {
+		return 0, fmt.Errorf("task %s not found: %w", ref, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+
+// MustResolveTaskRef resolves a task reference of a command, looking #N up in
+// the project of --project or PASO_PROJECT. A failure is reported through the
+// formatter and exits: ExitUsage for #N without a current project,
+// ExitNotFound for a ticket that does not exist and ExitError otherwise.
+func (c *CLI) MustResolveTaskRef(ctx context.Context, cmd *cobra.Command, formatter *OutputFormatter, ref TaskRef) int {
+	code, exitCode := "TASK_NOT_FOUND", ExitNotFound
+
+	var projectID int
+	if ref.Ticket > 0 && ref.Key == "" {
+		var err error
+		if projectID, err = GetProjectID(cmd); err != nil {
+			projectID, code, exitCode = 0, "INVALID_TASK_ID", ExitUsage
+		}
+	}
+
+	taskID, err := c.ResolveTaskRef(ctx, ref, projectID)
+	if err == nil {
+		return taskID
+	}
+
+	if exitCode == ExitNotFound && !errors.Is(err, taskservice.ErrTaskNotFound) {
+		code, exitCode = "TASK_FETCH_ERROR", ExitError
+	}
+	if fmtErr := formatter.Error(code, err.Error()); fmtErr != nil {
+		slog.Error("failed to formatting error message", "error", fmtErr)
+	}
+	os.Exit(exitCode)
+	return 0
+}
+
+// MustResolveTaskArg parses and resolves a task reference given as a flag or
+// positional argument, as MustResolveTaskRef does. A malformed reference exits
+// with ExitUsage.
+func (c *CLI) MustResolveTaskArg(ctx context.Context, cmd *cobra.Command, formatter *OutputFormatter, arg string) int {
+	ref, err := ParseTaskRef(arg)
+	if err != nil {
+		if fmtErr := formatter.Error("INVALID_TASK_ID", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(ExitUsage)
+	}
+	return c.MustResolveTaskRef(ctx, cmd, formatter, ref)
+}
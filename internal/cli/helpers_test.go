@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
 	"github.com/thenoetrevino/paso/internal/testutil"
 	testutilcli "github.com/thenoetrevino/paso/internal/testutil/cli"
 )
@@ -66,6 +67,15 @@ func TestValidateColorHex_Invalid(t *testing.T) {
 // Priority Parsing Tests
 // ============================================================================
 
+// builtinPriorities mirrors the built-in priority scale new projects start with
+var builtinPriorities = []*models.Priority{
+	{ID: 1, Description: "trivial"},
+	{ID: 2, Description: "low"},
+	{ID: 3, Description: "medium"},
+	{ID: 4, Description: "high"},
+	{ID: 5, Description: "critical"},
+}
+
 func TestParsePriority_Valid(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -82,11 +92,13 @@ func TestParsePriority_Valid(t *testing.T) {
 		{"MeDiUm", 3},
 		{"HIGH", 4},
 		{"Critical", 5},
+		// Empty means the project's default
+		{"", 0},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			result, err := ParsePriority(tt.input)
+			result, err := ParsePriority(builtinPriorities, tt.input)
 			assert.NoError(t, err, "ParsePriority should not return error for: %s", tt.input)
 			if result != tt.expected {
 				t.Errorf("Expected %d for '%s', got %d", tt.expected, tt.input, result)
@@ -100,7 +112,6 @@ func TestParsePriority_Invalid(t *testing.T) {
 		"invalid",
 		"normal",
 		"urgent",
-		"",
 		"123",
 		"trivial ",
 		" low",
@@ -108,7 +119,7 @@ func TestParsePriority_Invalid(t *testing.T) {
 
 	for _, input := range tests {
 		t.Run(input, func(t *testing.T) {
-			_, err := ParsePriority(input)
+			_, err := ParsePriority(builtinPriorities, input)
 			if err == nil {
 				t.Errorf("Expected error for invalid priority '%s', got nil", input)
 			}
@@ -116,10 +127,34 @@ func TestParsePriority_Invalid(t *testing.T) {
 	}
 }
 
+func TestParsePriority_CustomScale(t *testing.T) {
+	scale := []*models.Priority{
+		{ID: 20, Description: "later"},
+		{ID: 21, Description: "soon"},
+		{ID: 22, Description: "now"},
+	}
+
+	id, err := ParsePriority(scale, "Soon")
+	assert.NoError(t, err)
+	assert.Equal(t, 21, id)
+
+	_, err = ParsePriority(scale, "medium")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "later, soon, now")
+	}
+}
+
 // ============================================================================
 // Task Type Parsing Tests
 // ============================================================================
 
+// builtinTypes mirrors the built-in types new projects start with
+var builtinTypes = []*models.Type{
+	{ID: 1, Description: "task"},
+	{ID: 2, Description: "feature"},
+	{ID: 3, Description: "bug"},
+}
+
 func TestParseTaskType_Valid(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -127,15 +162,19 @@ func TestParseTaskType_Valid(t *testing.T) {
 	}{
 		{"task", 1},
 		{"feature", 2},
+		{"bug", 3},
 		// Test case insensitivity
 		{"TASK", 1},
 		{"Feature", 2},
 		{"TaSk", 1},
+		{"BUG", 3},
+		// Empty means the project's default
+		{"", 0},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.input, func(t *testing.T) {
-			result, err := ParseTaskType(tt.input)
+			result, err := ParseTaskType(builtinTypes, tt.input)
 			assert.NoError(t, err, "ParseTaskType should not return error for: %s", tt.input)
 			if result != tt.expected {
 				t.Errorf("Expected %d for '%s', got %d", tt.expected, tt.input, result)
@@ -146,10 +185,8 @@ func TestParseTaskType_Valid(t *testing.T) {
 
 func TestParseTaskType_Invalid(t *testing.T) {
 	tests := []string{
-		"bug",
 		"story",
 		"epic",
-		"",
 		"123",
 		"task ",
 		" feature",
@@ -157,7 +194,7 @@ func TestParseTaskType_Invalid(t *testing.T) {
 
 	for _, input := range tests {
 		t.Run(input, func(t *testing.T) {
-			_, err := ParseTaskType(input)
+			_, err := ParseTaskType(builtinTypes, input)
 			if err == nil {
 				t.Errorf("Expected error for invalid type '%s', got nil", input)
 			}
@@ -165,6 +202,23 @@ func TestParseTaskType_Invalid(t *testing.T) {
 	}
 }
 
+func TestParseTaskType_CustomTypes(t *testing.T) {
+	ops := []*models.Type{
+		{ID: 10, Description: "incident"},
+		{ID: 11, Description: "chore"},
+		{ID: 12, Description: "spike"},
+	}
+
+	id, err := ParseTaskType(ops, "Incident")
+	assert.NoError(t, err)
+	assert.Equal(t, 10, id)
+
+	_, err = ParseTaskType(ops, "task")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "incident, chore, spike")
+	}
+}
+
 // ============================================================================
 // GetLabelByID Tests
 // ============================================================================
@@ -650,3 +704,100 @@ func TestGetProjectID_ZeroValueFlag(t *testing.T) {
 		t.Errorf("Expected project ID 789 (from env var), got %d", projectID)
 	}
 }
+
+func TestParseRelationType(t *testing.T) {
+	relationTypes := []*models.RelationType{
+		{ID: 1, Name: "parent"},
+		{ID: 2, Name: "blocker", IsBlocking: true},
+		{ID: 3, Name: "related"},
+		{ID: 9, ProjectID: 4, Name: "verifies"},
+	}
+
+	rt, err := ParseRelationType(relationTypes, "Verifies")
+	assert.NoError(t, err)
+	if rt.ID != 9 {
+		t.Errorf("Expected relation type 9 for 'Verifies', got %d", rt.ID)
+	}
+
+	_, err = ParseRelationType(relationTypes, "duplicates")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "parent, blocker, related, verifies")
+	}
+}
+
+// ============================================================================
+// TaskRef Tests
+// ============================================================================
+
+func TestParseTaskRef(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    TaskRef
+		wantErr bool
+	}{
+		{input: "42", want: TaskRef{ID: 42}},
+		{input: "0", want: TaskRef{ID: 0}}, // Commands reject it themselves
+		{input: "#7", want: TaskRef{Ticket: 7}},
+		{input: "API-12", want: TaskRef{Key: "API", Ticket: 12}},
+		{input: "api-12", want: TaskRef{Key: "API", Ticket: 12}},
+		{input: " W2-3 ", want: TaskRef{Key: "W2", Ticket: 3}},
+		{input: "#0", wantErr: true},
+		{input: "API-0", wantErr: true},
+		{input: "API-", wantErr: true},
+		{input: "2API-3", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseTaskRef(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestResolveTaskRef(t *testing.T) {
+	db, appInstance := testutilcli.SetupCLITest(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	cliInstance := &CLI{ctx: ctx, App: appInstance}
+
+	projectID := testutil.CreateTestProject(t, db, "Backend")
+	columnID := testutil.CreateTestColumn(t, db, projectID, "Todo")
+	taskID := testutil.CreateTestTask(t, db, columnID, "Keyed task")
+	_, err := db.ExecContext(ctx, "UPDATE tasks SET ticket_number = 5 WHERE id = ?", taskID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE projects SET ticket_key = 'API' WHERE id = ?", projectID)
+	require.NoError(t, err)
+
+	// Plain IDs are taken as they are
+	got, err := cliInstance.ResolveTaskRef(ctx, TaskRef{ID: 999}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 999, got)
+
+	got, err = cliInstance.ResolveTaskRef(ctx, TaskRef{Key: "API", Ticket: 5}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, taskID, got)
+
+	got, err = cliInstance.ResolveTaskRef(ctx, TaskRef{Ticket: 5}, projectID)
+	require.NoError(t, err)
+	assert.Equal(t, taskID, got)
+
+	// #N needs a current project
+	_, err = cliInstance.ResolveTaskRef(ctx, TaskRef{Ticket: 5}, 0)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, taskservice.ErrTaskNotFound)
+
+	_, err = cliInstance.ResolveTaskRef(ctx, TaskRef{Key: "API", Ticket: 6}, 0)
+	assert.ErrorIs(t, err, taskservice.ErrTaskNotFound)
+
+	_, err = cliInstance.ResolveTaskRef(ctx, TaskRef{Key: "WEB", Ticket: 5}, 0)
+	assert.ErrorIs(t, err, taskservice.ErrTaskNotFound)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
 )
 
 // AttachCmd returns the label attach subcommand
@@ -15,7 +16,8 @@ func AttachCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "attach",
 		Short: "Attach a label to a task",
-		Long: `Attach a label to a task by their IDs.
+		Long: `Attach a label to a task by their IDs. The task can also be
+given as #N for ticket N of the current project or as KEY-N.
 
 Examples:
   # Attach label to task
@@ -31,7 +33,7 @@ Examples:
 	}
 
 	// Required flags
-	cmd.Flags().Int("task", 0, "Task ID (required)")
+	cmd.Flags().String("task", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("task"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -51,7 +53,7 @@ Examples:
 func runAttach(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	taskID, _ := cmd.Flags().GetInt("task")
+	taskRef, _ := cmd.Flags().GetString("task")
 	labelID, _ := cmd.Flags().GetInt("label")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
@@ -72,6 +74,8 @@ func runAttach(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// Validate task exists
 	task, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
@@ -129,6 +133,12 @@ func runAttach(cmd *cobra.Command, args []string) error {
 		})
 	}
 
-	fmt.Printf("✓ Label '%s' attached to task #%d\n", label.Name, taskID)
+	// Tickets read KEY-N once the project has a ticket key
+	var ticketKey string
+	if project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, taskProjectID); err == nil {
+		ticketKey = project.TicketKey
+	}
+
+	fmt.Printf("✓ Label '%s' attached to task %s\n", label.Name, models.TicketRef(ticketKey, task.TicketNumber))
 	return nil
 }
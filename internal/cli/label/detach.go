@@ -15,7 +15,8 @@ func DetachCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "detach",
 		Short: "Detach a label from a task",
-		Long: `Detach a label from a task by their IDs.
+		Long: `Detach a label from a task by their IDs. The task can also be
+given as #N for ticket N of the current project or as KEY-N.
 
 Examples:
   # Detach label from task
@@ -31,7 +32,7 @@ Examples:
 	}
 
 	// Required flags
-	cmd.Flags().Int("task", 0, "Task ID (required)")
+	cmd.Flags().String("task", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("task"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -51,7 +52,7 @@ Examples:
 func runDetach(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	taskID, _ := cmd.Flags().GetInt("task")
+	taskRef, _ := cmd.Flags().GetString("task")
 	labelID, _ := cmd.Flags().GetInt("label")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
@@ -72,6 +73,8 @@ func runDetach(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// Detach label from task (no validation needed - removing non-existent association is not an error)
 	if err := cliInstance.App.TaskService.DetachLabel(ctx, taskID, labelID); err != nil {
 		if fmtErr := formatter.Error("DETACH_ERROR", err.Error()); fmtErr != nil {
@@ -93,6 +96,6 @@ func runDetach(cmd *cobra.Command, args []string) error {
 		})
 	}
 
-	fmt.Printf("✓ Label #%d detached from task #%d\n", labelID, taskID)
+	fmt.Printf("✓ Label #%d detached from task %d\n", labelID, taskID)
 	return nil
 }
@@ -0,0 +1,52 @@
+// Package mcp holds the paso mcp command, which serves the Model Context
+// Protocol over stdio for coding agents
+package mcp
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/mcp"
+)
+
+// MCPCmd returns the mcp command. version is reported to clients.
+func MCPCmd(version string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mcp",
+		Short: "Serve paso to AI agents over MCP (stdio)",
+		Long: `Serve paso as a Model Context Protocol server on stdin/stdout.
+
+Agents get tools to list, create, update, move and link tasks, find ready
+and blocked work and add comments, plus resources for a project's task
+tree (paso://projects/{id}/tree) and task details (paso://tasks/{id}).
+Changes show up live in running TUIs.
+
+The command is meant to be started by an agent, not run by hand. Register
+it with:
+  paso setup claude --mcp
+  paso setup opencode --mcp
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			cliInstance, err := cli.GetCLIFromContext(ctx)
+			if err != nil {
+				return fmt.Errorf("initialization error: %w", err)
+			}
+			defer func() {
+				if err := cliInstance.Close(); err != nil {
+					slog.Error("failed to closing CLI", "error", err)
+				}
+			}()
+
+			return mcp.NewServer(cliInstance.App, version).Serve(ctx, os.Stdin, os.Stdout)
+		},
+	}
+}
@@ -0,0 +1,136 @@
+package priority
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/cli/handler"
+	priorityservice "github.com/thenoetrevino/paso/internal/services/priority"
+)
+
+// CreateCmd returns the priority create subcommand
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a priority level to a project",
+		Long: `Add a priority level to a project. Levels with a higher order are
+higher priorities.
+
+Examples:
+  # Add a level above the existing ones
+  paso priority create --name=urgent --color="#DC2626" --icon="!!" --project=1
+
+  # Add a level below all others
+  paso priority create --name=someday --order=0 --project=1
+
+  # Quiet mode for bash capture
+  PRIORITY_ID=$(paso priority create --name=blocker --project=1 --quiet)
+`,
+		RunE: handler.Command(&createHandler{}, parseCreateFlags),
+	}
+
+	// Required flags
+	cmd.Flags().String("name", "", "Priority name (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+
+	cmd.Flags().String("color", "#6B7280", "Priority color in hex format #RRGGBB")
+	cmd.Flags().String("icon", "", "Short icon shown next to the priority (up to 4 characters)")
+	cmd.Flags().Int("order", -1, "Sort order, higher is more urgent (defaults to above the highest level)")
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+// createHandler implements handler.Handler for priority creation
+type createHandler struct{}
+
+// Execute implements the Handler interface
+func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (any, error) {
+	name := args.MustGetString("name")
+	color := args.GetString("color", "#6B7280")
+	icon := args.GetString("icon", "")
+	order := args.GetInt("order", -1)
+
+	cmd := args.GetCmd()
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("no project specified: use --project flag or set with 'eval $(paso use project <project-id>)'")
+	}
+
+	if err := cli.ValidateColorHex(color); err != nil {
+		return nil, fmt.Errorf("invalid color: %w", err)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialization error: %w", err)
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("project %d not found", projectID)
+	}
+
+	req := priorityservice.CreatePriorityRequest{
+		ProjectID: projectID,
+		Name:      name,
+		Color:     color,
+		Icon:      icon,
+	}
+	if order >= 0 {
+		req.SortOrder = &order
+	}
+
+	created, err := cliInstance.App.PriorityService.CreatePriority(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("priority creation error: %w", err)
+	}
+
+	return &priorityCreateResult{
+		ID:        created.ID,
+		Name:      created.Description,
+		Color:     created.Color,
+		Icon:      created.Icon,
+		SortOrder: created.SortOrder,
+		ProjectID: created.ProjectID,
+		Project:   project.Name,
+	}, nil
+}
+
+// priorityCreateResult represents the result of priority creation
+type priorityCreateResult struct {
+	ID        int
+	Name      string
+	Color     string
+	Icon      string `json:",omitempty"`
+	SortOrder int
+	ProjectID int
+	Project   string
+}
+
+// GetID implements the GetID interface for quiet mode output
+func (r *priorityCreateResult) GetID() int {
+	return r.ID
+}
+
+func parseCreateFlags(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		return fmt.Errorf("priority name is required")
+	}
+	return nil
+}
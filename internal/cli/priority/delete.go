@@ -0,0 +1,111 @@
+package priority
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	priorityservice "github.com/thenoetrevino/paso/internal/services/priority"
+)
+
+// DeleteCmd returns the priority delete subcommand
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a priority level",
+		Long: `Delete a priority level by ID (requires confirmation unless --force or --quiet).
+
+A priority still used by tasks can only be deleted with --reassign, which
+moves those tasks to another priority of the same project. A project keeps
+at least one priority.
+
+Examples:
+  # Delete an unused priority
+  paso priority delete --id=7 --force
+
+  # Move its tasks to priority 8, then delete it
+  paso priority delete --id=7 --reassign=8 --force
+`,
+		RunE: runDelete,
+	}
+
+	cmd.Flags().Int("id", 0, "Priority ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().Int("reassign", 0, "Priority ID to move the deleted priority's tasks to")
+	cmd.Flags().Bool("force", false, "Skip confirmation")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	priorityID, _ := cmd.Flags().GetInt("id")
+	reassign, _ := cmd.Flags().GetInt("reassign")
+	force, _ := cmd.Flags().GetBool("force")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if !force && !quietMode {
+		fmt.Printf("Delete priority #%d? (y/N): ", priorityID)
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil {
+			slog.Error("failed to reading user input", "error", err)
+		}
+		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+			fmt.Println("Cancelled")
+			return nil
+		}
+	}
+
+	err = cliInstance.App.PriorityService.DeletePriority(ctx, priorityservice.DeletePriorityRequest{ID: priorityID, ReassignTo: reassign})
+	if err != nil {
+		suggestion := ""
+		if errors.Is(err, priorityservice.ErrPriorityInUse) {
+			suggestion = "Move its tasks with --reassign=<priority-id> (see paso priority list)"
+		}
+		if fmtErr := formatter.ErrorWithSuggestion(errorCode(err), err.Error(), suggestion); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode(err))
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":     true,
+			"priority_id": priorityID,
+		})
+	}
+
+	fmt.Printf("✓ Priority %d deleted successfully\n", priorityID)
+	return nil
+}
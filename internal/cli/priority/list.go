@@ -0,0 +1,126 @@
+package priority
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// ListCmd returns the priority list subcommand
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List priority levels in a project",
+		Long: `List a project's priority levels, lowest first. The middle level is the
+default for new tasks.
+
+Examples:
+  # Human-readable list
+  paso priority list --project=1
+
+  # JSON output for agents
+  paso priority list --project=1 --json
+
+  # Quiet mode (one ID per line)
+  paso priority list --project=1 --quiet
+`,
+		RunE: runList,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs only)")
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	priorities, err := cliInstance.App.PriorityService.GetPrioritiesByProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PRIORITY_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, t := range priorities {
+			fmt.Printf("%d\n", t.ID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		priorityList := make([]map[string]any, len(priorities))
+		for i, t := range priorities {
+			priorityList[i] = map[string]any{
+				"id":         t.ID,
+				"name":       t.Description,
+				"color":      t.Color,
+				"icon":       t.Icon,
+				"sort_order": t.SortOrder,
+				"default":    i == len(priorities)/2,
+			}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":    true,
+			"priorities": priorityList,
+		})
+	}
+
+	fmt.Printf("Priorities in project '%s' (lowest first):\n", project.Name)
+	fmt.Printf("  %-4s %-20s %-8s %-5s %s\n", "ID", "Name", "Color", "Icon", "Order")
+	fmt.Println("  " + strings.Repeat("-", 50))
+	for i, t := range priorities {
+		name := t.Description
+		if i == len(priorities)/2 {
+			name += " (default)"
+		}
+		fmt.Printf("  %-4d %-20s %-8s %-5s %d\n", t.ID, name, t.Color, t.Icon, t.SortOrder)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+// Package priority holds all cli commands related to task priorities
+// e.g., paso priority ...
+package priority
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// PriorityCmd returns the priority parent command
+func PriorityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "priority",
+		Short: "Manage a project's priority levels",
+		Long: `Manage a project's priority levels.
+
+Every project starts with trivial, low, medium, high and critical. Levels are
+ordered lowest first; the middle one is the default for new tasks, and
+filters such as priority>=high compare by this order.`,
+	}
+
+	cmd.AddCommand(CreateCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(UpdateCmd())
+	cmd.AddCommand(DeleteCmd())
+
+	return cmd
+}
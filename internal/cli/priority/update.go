@@ -0,0 +1,145 @@
+package priority
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	priorityservice "github.com/thenoetrevino/paso/internal/services/priority"
+)
+
+// UpdateCmd returns the priority update subcommand
+func UpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a priority level",
+		Long: `Rename, recolor or reorder a priority level. Tasks keep their priority.
+
+Examples:
+  # Rename a level
+  paso priority update --id=7 --name=now
+
+  # Change color and icon
+  paso priority update --id=7 --color="#EF4444" --icon="!"
+
+  # Make it the lowest level
+  paso priority update --id=7 --order=0
+`,
+		RunE: runUpdate,
+	}
+
+	cmd.Flags().Int("id", 0, "Priority ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().String("name", "", "New priority name")
+	cmd.Flags().String("color", "", "New color in hex format #RRGGBB")
+	cmd.Flags().String("icon", "", "New icon (empty to remove)")
+	cmd.Flags().Int("order", 0, "New sort order")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	priorityID, _ := cmd.Flags().GetInt("id")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	req := priorityservice.UpdatePriorityRequest{ID: priorityID}
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		req.Name = &name
+	}
+	if cmd.Flags().Changed("color") {
+		color, _ := cmd.Flags().GetString("color")
+		if err := cli.ValidateColorHex(color); err != nil {
+			if fmtErr := formatter.Error("INVALID_COLOR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		req.Color = &color
+	}
+	if cmd.Flags().Changed("icon") {
+		icon, _ := cmd.Flags().GetString("icon")
+		req.Icon = &icon
+	}
+	if cmd.Flags().Changed("order") {
+		order, _ := cmd.Flags().GetInt("order")
+		req.SortOrder = &order
+	}
+
+	if req.Name == nil && req.Color == nil && req.Icon == nil && req.SortOrder == nil {
+		if fmtErr := formatter.Error("MISSING_FLAGS", "at least one of --name, --color, --icon or --order must be provided"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if err := cliInstance.App.PriorityService.UpdatePriority(ctx, req); err != nil {
+		if fmtErr := formatter.Error(errorCode(err), err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode(err))
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":     true,
+			"priority_id": priorityID,
+		})
+	}
+
+	fmt.Printf("✓ Priority %d updated successfully\n", priorityID)
+	return nil
+}
+
+// errorCode maps a priority service error to an output error code
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, priorityservice.ErrPriorityNotFound):
+		return "PRIORITY_NOT_FOUND"
+	case errors.Is(err, priorityservice.ErrPriorityInUse):
+		return "PRIORITY_IN_USE"
+	case errors.Is(err, priorityservice.ErrPriorityExists):
+		return "PRIORITY_EXISTS"
+	default:
+		return "VALIDATION_ERROR"
+	}
+}
+
+// exitCode maps a priority service error to a process exit code
+func exitCode(err error) int {
+	if errors.Is(err, priorityservice.ErrPriorityNotFound) {
+		return cli.ExitNotFound
+	}
+	return cli.ExitValidation
+}
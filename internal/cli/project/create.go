@@ -5,6 +5,7 @@ package project
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -36,6 +37,12 @@ Examples:
   paso project create \
     --title="Backend API" \
     --description="REST API for mobile app"
+
+  # Start from a saved or configured template
+  paso project create --title="Mobile bugs" --template bugtriage
+
+  # With a ticket key, so tasks can be referred to as API-1, API-2, ...
+  paso project create --title="Backend API" --key API
 `,
 		RunE: handler.Command(&createHandler{}, parseCreateFlags),
 	}
@@ -48,6 +55,8 @@ Examples:
 
 	// Optional flags
 	cmd.Flags().String("description", "", "Project description")
+	cmd.Flags().String("template", "", "Template to create the board from (see 'paso project templates')")
+	cmd.Flags().String("key", "", "Ticket key prefix, e.g. API for API-42 (a letter followed by up to 9 letters or digits)")
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -64,6 +73,8 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 	// Get flag values from arguments
 	projectTitle := args.MustGetString("title")
 	projectDescription := args.GetString("description", "")
+	templateName := args.GetString("template", "")
+	ticketKey := args.GetString("key", "")
 
 	// Initialize CLI
 	cliInstance, err := cli.GetCLIFromContext(ctx)
@@ -76,11 +87,23 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		}
 	}()
 
-	// Create project
-	project, err := cliInstance.App.ProjectService.CreateProject(ctx, projectservice.CreateProjectRequest{
+	req := projectservice.CreateProjectRequest{
 		Name:        projectTitle,
 		Description: projectDescription,
-	})
+		TicketKey:   ticketKey,
+	}
+	if templateName != "" {
+		req.Template, err = findTemplate(ctx, cliInstance.App.ProjectService, templateName)
+		if err != nil {
+			if errors.Is(err, projectservice.ErrTemplateNotFound) {
+				return nil, fmt.Errorf("template '%s' not found: use 'paso project templates' to see available templates", templateName)
+			}
+			return nil, err
+		}
+	}
+
+	// Create project
+	project, err := cliInstance.App.ProjectService.CreateProject(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("project creation error: %w", err)
 	}
@@ -89,7 +112,9 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		ID:          project.ID,
 		Name:        project.Name,
 		Description: project.Description,
+		TicketKey:   project.TicketKey,
 		CreatedAt:   project.CreatedAt.String(),
+		Template:    templateName,
 	}, nil
 }
 
@@ -98,7 +123,9 @@ type projectCreateResult struct {
 	ID          int
 	Name        string
 	Description string
+	TicketKey   string `json:",omitempty"`
 	CreatedAt   string
+	Template    string `json:",omitempty"`
 }
 
 // GetID implements the GetID interface for quiet mode output
@@ -2,6 +2,8 @@ package project
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -94,4 +96,40 @@ func TestCreateProject_Positive(t *testing.T) {
 		assert.Contains(t, columns, "In Progress")
 		assert.Contains(t, columns, "Done")
 	})
+	t.Run("Create project from a config template", func(t *testing.T) {
+		configHome := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", configHome)
+		assert.NoError(t, os.MkdirAll(filepath.Join(configHome, "paso"), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(configHome, "paso", "config.yaml"), []byte(`project_templates:
+  - name: review
+    columns:
+      - name: Queue
+        ready: true
+      - name: Reviewing
+        in_progress: true
+      - name: Merged
+        completed: true
+`), 0o644))
+
+		output, err := cli.ExecuteCLICommand(t, app, CreateCmd(), []string{
+			"--title", "Reviews",
+			"--template", "review",
+			"--quiet",
+		})
+		assert.NoError(t, err)
+
+		var columns string
+		err = db.QueryRowContext(context.Background(),
+			"SELECT group_concat(name, ',') FROM (SELECT name FROM columns WHERE project_id = ? ORDER BY id)",
+			strings.TrimSpace(output)).Scan(&columns)
+		assert.NoError(t, err)
+		assert.Equal(t, "Queue,Reviewing,Merged", columns)
+
+		_, err = cli.ExecuteCLICommand(t, app, CreateCmd(), []string{
+			"--title", "Nope",
+			"--template", "missing",
+			"--quiet",
+		})
+		assert.ErrorContains(t, err, "template 'missing' not found")
+	})
 }
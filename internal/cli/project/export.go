@@ -0,0 +1,166 @@
+package project
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+	"gopkg.in/yaml.v3"
+)
+
+// ExportCmd returns the project export subcommand
+func ExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <project-id>",
+		Short: "Export a project to a JSON or YAML file",
+		Long: `Export a project with its columns (in board order), labels, tasks, relations,
+comments, checklists, recurrence rules, task templates and ticket counter. The
+result can be loaded again with 'paso project import', on this machine or
+another.
+
+Examples:
+  # Back up a project
+  paso project export 1 > backup.json
+
+  # Write YAML to a file
+  paso project export 1 --format yaml --output board.yaml
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runExport,
+	}
+
+	cmd.Flags().String("format", "json", "Output format: json or yaml")
+	cmd.Flags().StringP("output", "o", "", "Write to a file instead of stdout")
+
+	return cmd
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	format, _ := cmd.Flags().GetString("format")
+	output, _ := cmd.Flags().GetString("output")
+
+	formatter := &cli.OutputFormatter{}
+
+	projectID, err := strconv.Atoi(args[0])
+	if err != nil || projectID <= 0 {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_PROJECT_ID",
+			"project ID must be a positive integer",
+			"Usage: paso project export <project-id>"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	format = strings.ToLower(format)
+	if format != "json" && format != "yaml" {
+		if fmtErr := formatter.Error("INVALID_FORMAT", fmt.Sprintf("invalid format '%s' (must be: json, yaml)", format)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	export, err := cliInstance.App.ProjectService.ExportProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("EXPORT_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	data, err := encodeExport(export, format)
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(output, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", output, err)
+	}
+	fmt.Fprintf(os.Stderr, "✓ Project %d exported to %s\n", projectID, output)
+	return nil
+}
+
+// encodeExport serializes an export as json or yaml
+func encodeExport(export *projectservice.Export, format string) ([]byte, error) {
+	if format == "yaml" {
+		var buf bytes.Buffer
+		encoder := yaml.NewEncoder(&buf)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(export); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), encoder.Close()
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// decodeExport parses an export. An empty format is guessed from the file
+// extension, defaulting to json.
+func decodeExport(r io.Reader, path, format string) (*projectservice.Export, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml":
+			format = "yaml"
+		default:
+			format = "json"
+		}
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read export: %w", err)
+	}
+
+	var export projectservice.Export
+	switch strings.ToLower(format) {
+	case "json":
+		err = json.Unmarshal(data, &export)
+	case "yaml":
+		err = yaml.Unmarshal(data, &export)
+	default:
+		return nil, fmt.Errorf("invalid format '%s' (must be: json, yaml)", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse export: %w", err)
+	}
+	return &export, nil
+}
@@ -0,0 +1,142 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+)
+
+// ImportCmd returns the project import subcommand
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import a project from a JSON or YAML export",
+		Long: `Create a new project from a file written by 'paso project export'.
+
+Columns, labels and tasks get new IDs; column order, relations, labels,
+comments, checklists, recurrence rules, task templates, ticket numbers and
+timestamps are kept. The import runs
+in a single transaction: if anything in the file is invalid, nothing is
+created.
+
+Use - to read from stdin. The format is taken from the file extension
+(.yaml/.yml, otherwise json) unless --format is given.
+
+Examples:
+  paso project import backup.json
+  paso project import board.yaml --name "Demo board"
+  ssh laptop paso project export 3 | paso project import -
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImport,
+	}
+
+	cmd.Flags().String("format", "", "Input format: json or yaml (default: from file extension)")
+	cmd.Flags().String("name", "", "Name for the imported project (default: name in the export)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+// projectImportResult represents the result of a project import
+type projectImportResult struct {
+	ID      int
+	Name    string
+	Columns int
+	Labels  int
+	Tasks   int
+}
+
+// GetID implements the GetID interface for quiet mode output
+func (r *projectImportResult) GetID() int {
+	return r.ID
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	format, _ := cmd.Flags().GetString("format")
+	name, _ := cmd.Flags().GetString("name")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	path := args[0]
+	var input io.Reader = os.Stdin
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			if fmtErr := formatter.Error("FILE_NOT_FOUND", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		defer func() { _ = file.Close() }()
+		input = file
+	}
+
+	export, err := decodeExport(input, path, format)
+	if err != nil {
+		if fmtErr := formatter.Error("INVALID_EXPORT", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitDataErr)
+	}
+	if name != "" {
+		export.Project.Name = name
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.ImportProject(ctx, export)
+	if err != nil {
+		if errors.Is(err, projectservice.ErrInvalidExport) || errors.Is(err, projectservice.ErrEmptyName) ||
+			errors.Is(err, projectservice.ErrNameTooLong) {
+			if fmtErr := formatter.Error("INVALID_EXPORT", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		if fmtErr := formatter.Error("IMPORT_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	result := &projectImportResult{
+		ID:      project.ID,
+		Name:    project.Name,
+		Columns: len(export.Columns),
+		Labels:  len(export.Labels),
+		Tasks:   len(export.Tasks),
+	}
+	if quietMode || jsonOutput {
+		return formatter.Success(result)
+	}
+
+	fmt.Printf("✓ Imported project %d: %s\n", result.ID, result.Name)
+	fmt.Printf("  %d columns, %d labels, %d tasks\n", result.Columns, result.Labels, result.Tasks)
+	return nil
+}
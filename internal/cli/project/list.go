@@ -82,6 +82,9 @@ func runList(cmd *cobra.Command, args []string) error {
 	fmt.Printf("Found %d projects:\n\n", len(projects))
 	for _, p := range projects {
 		fmt.Printf("  [%d] %s", p.ID, p.Name)
+		if p.TicketKey != "" {
+			fmt.Printf(" (%s)", p.TicketKey)
+		}
 		if p.Description != "" {
 			fmt.Printf(" - %s", p.Description)
 		}
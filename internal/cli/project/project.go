@@ -13,8 +13,14 @@ func ProjectCmd() *cobra.Command {
 
 	cmd.AddCommand(CreateCmd())
 	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(UpdateCmd())
 	cmd.AddCommand(DeleteCmd())
 	cmd.AddCommand(TreeCmd())
+	cmd.AddCommand(ExportCmd())
+	cmd.AddCommand(ImportCmd())
+	cmd.AddCommand(SaveTemplateCmd())
+	cmd.AddCommand(TemplatesCmd())
+	cmd.AddCommand(DeleteTemplateCmd())
 
 	return cmd
 }
@@ -0,0 +1,378 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/config"
+	"github.com/thenoetrevino/paso/internal/models"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+)
+
+// Where a template comes from, as shown by paso project templates
+const (
+	templateSourceSaved  = "saved"
+	templateSourceConfig = "config"
+)
+
+// SaveTemplateCmd returns the project save-template subcommand
+func SaveTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save-template <project-id>",
+		Short: "Save a project's board as a template",
+		Long: `Save the columns (with their ready, in-progress and completed flags and WIP
+limits), labels, types, priorities and relation types of a project as a named
+template for 'paso project create --template'.
+
+Examples:
+  # Capture the team board
+  paso project save-template 3 --name bugtriage
+
+  # Keep the project's open tasks as starter tasks
+  paso project save-template 3 --name onboarding --with-tasks
+
+  # Update a saved template
+  paso project save-template 3 --name bugtriage --force
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSaveTemplate,
+	}
+
+	cmd.Flags().String("name", "", "Template name (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to mark flag as required", "error", err)
+	}
+	cmd.Flags().String("description", "", "Template description")
+	cmd.Flags().Bool("with-tasks", false, "Include unarchived tasks as starter tasks")
+	cmd.Flags().Bool("force", false, "Replace a saved template with the same name")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (name only)")
+
+	return cmd
+}
+
+// TemplatesCmd returns the project templates subcommand
+func TemplatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "List project templates",
+		Long: `List the project templates saved with 'paso project save-template' and those
+defined under project_templates in the config file. A saved template hides a
+config template with the same name.
+
+Examples:
+  paso project templates
+
+  # Full definitions for agents
+  paso project templates --json
+`,
+		RunE: runTemplates,
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (names only)")
+
+	return cmd
+}
+
+// DeleteTemplateCmd returns the project delete-template subcommand
+func DeleteTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-template <name>",
+		Short: "Delete a saved project template",
+		Long: `Delete a template saved with 'paso project save-template'. Projects created
+from it are not affected. Templates from the config file are removed by
+editing the file.
+
+Examples:
+  paso project delete-template bugtriage
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runDeleteTemplate,
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runSaveTemplate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	name, _ := cmd.Flags().GetString("name")
+	description, _ := cmd.Flags().GetString("description")
+	withTasks, _ := cmd.Flags().GetBool("with-tasks")
+	force, _ := cmd.Flags().GetBool("force")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := strconv.Atoi(args[0])
+	if err != nil || projectID <= 0 {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_PROJECT_ID",
+			"project ID must be a positive integer",
+			"Usage: paso project save-template <project-id> --name <name>"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	template, err := cliInstance.App.ProjectService.SaveTemplate(ctx, projectservice.SaveTemplateRequest{
+		ProjectID:    projectID,
+		Name:         name,
+		Description:  description,
+		IncludeTasks: withTasks,
+		Replace:      force,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, projectservice.ErrProjectNotFound):
+			if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		case errors.Is(err, projectservice.ErrTemplateExists):
+			if fmtErr := formatter.ErrorWithSuggestion("TEMPLATE_EXISTS",
+				fmt.Sprintf("template '%s' already exists", name),
+				"Use --force to replace it"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		case errors.Is(err, projectservice.ErrInvalidTemplate),
+			errors.Is(err, projectservice.ErrEmptyName),
+			errors.Is(err, projectservice.ErrNameTooLong):
+			if fmtErr := formatter.Error("INVALID_TEMPLATE", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		if fmtErr := formatter.Error("TEMPLATE_SAVE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Println(template.Name)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":  true,
+			"template": template,
+		})
+	}
+
+	fmt.Printf("✓ Project %d saved as template '%s'\n", projectID, template.Name)
+	fmt.Printf("  %s\n", describeTemplate(template))
+	return nil
+}
+
+func runTemplates(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	templates, sources, err := allTemplates(ctx, cliInstance.App.ProjectService)
+	if err != nil {
+		if fmtErr := formatter.Error("TEMPLATE_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, t := range templates {
+			fmt.Println(t.Name)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		list := make([]map[string]any, len(templates))
+		for i, t := range templates {
+			list[i] = map[string]any{
+				"source":   sources[i],
+				"template": t,
+			}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":   true,
+			"templates": list,
+			"count":     len(list),
+		})
+	}
+
+	if len(templates) == 0 {
+		fmt.Println("No project templates")
+		fmt.Println("Save one with: paso project save-template <project-id> --name <name>")
+		return nil
+	}
+
+	fmt.Printf("Found %d templates:\n\n", len(templates))
+	for i, t := range templates {
+		fmt.Printf("  %s (%s)", t.Name, sources[i])
+		if t.Description != "" {
+			fmt.Printf(" - %s", t.Description)
+		}
+		fmt.Printf("\n    %s\n", describeTemplate(t))
+	}
+	return nil
+}
+
+func runDeleteTemplate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	name := args[0]
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if err := cliInstance.App.ProjectService.DeleteTemplate(ctx, name); err != nil {
+		if errors.Is(err, projectservice.ErrTemplateNotFound) {
+			if fmtErr := formatter.ErrorWithSuggestion("TEMPLATE_NOT_FOUND",
+				fmt.Sprintf("no saved template named '%s'", name),
+				"Templates from the config file are removed by editing the file"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		if fmtErr := formatter.Error("TEMPLATE_DELETE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"name":    name,
+		})
+	}
+
+	fmt.Printf("✓ Template '%s' deleted\n", name)
+	return nil
+}
+
+// findTemplate looks a template up by name, first among the saved templates
+// and then in the config file
+func findTemplate(ctx context.Context, svc projectservice.Service, name string) (*models.ProjectTemplate, error) {
+	template, err := svc.GetTemplate(ctx, name)
+	if !errors.Is(err, projectservice.ErrTemplateNotFound) {
+		return template, err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	for i := range cfg.ProjectTemplates {
+		if cfg.ProjectTemplates[i].Name == name {
+			return &cfg.ProjectTemplates[i], nil
+		}
+	}
+	return nil, projectservice.ErrTemplateNotFound
+}
+
+// allTemplates returns the saved templates followed by the config templates
+// they don't hide, with the source of each
+func allTemplates(ctx context.Context, svc projectservice.Service) ([]*models.ProjectTemplate, []string, error) {
+	templates, err := svc.ListTemplates(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sources := make([]string, len(templates))
+	saved := make(map[string]bool, len(templates))
+	for i, t := range templates {
+		sources[i] = templateSourceSaved
+		saved[t.Name] = true
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	for i := range cfg.ProjectTemplates {
+		if saved[cfg.ProjectTemplates[i].Name] {
+			continue
+		}
+		templates = append(templates, &cfg.ProjectTemplates[i])
+		sources = append(sources, templateSourceConfig)
+	}
+	return templates, sources, nil
+}
+
+// describeTemplate summarizes a template's board on one line
+func describeTemplate(t *models.ProjectTemplate) string {
+	names := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		names[i] = c.Name
+	}
+	summary := strings.Join(names, " → ")
+	if len(t.Labels) > 0 {
+		summary += fmt.Sprintf(", %d labels", len(t.Labels))
+	}
+	if len(t.Tasks) > 0 {
+		summary += fmt.Sprintf(", %d starter tasks", len(t.Tasks))
+	}
+	return summary
+}
@@ -23,7 +23,8 @@ func TreeCmd() *cobra.Command {
 		Short: "Display tasks in a tree structure",
 		Long: `Display all tasks in a project as a hierarchical tree structure.
 Subtasks are indented under their parent tasks. Blocking relationships
-are highlighted in red to show the blocking chain.`,
+are highlighted in red to show the blocking chain. Tasks with children show
+how many of their descendants are in a completed column, e.g. [3/5 60%].`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: runTree,
 	}
@@ -120,8 +121,13 @@ func runTree(cmd *cobra.Command, args []string) error {
 		return outputJSONTree(projectID, tree)
 	}
 
-	// Human-readable output with lipgloss styling
-	return outputStyledTree(tree)
+	// Human-readable output with lipgloss styling; tickets read KEY-N once the
+	// project has a ticket key
+	var ticketKey string
+	if project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err == nil {
+		ticketKey = project.TicketKey
+	}
+	return outputStyledTree(tree, ticketKey)
 }
 
 // markBlockingChains marks nodes that are part of a blocking chain
@@ -171,9 +177,17 @@ type treeNodeJSON struct {
 	ColumnName   string          `json:"column_name"`
 	RelationType string          `json:"relation_type,omitempty"`
 	IsBlocking   bool            `json:"is_blocking,omitempty"`
+	Progress     *progressJSON   `json:"progress,omitempty"`
 	Children     []*treeNodeJSON `json:"children,omitempty"`
 }
 
+// progressJSON is the child completion rollup of a node with children
+type progressJSON struct {
+	Done    int `json:"done"`
+	Total   int `json:"total"`
+	Percent int `json:"percent"`
+}
+
 func convertToJSONTree(nodes []*models.TaskTreeNode) []*treeNodeJSON {
 	result := make([]*treeNodeJSON, 0, len(nodes))
 	for _, node := range nodes {
@@ -186,6 +200,13 @@ func convertToJSONTree(nodes []*models.TaskTreeNode) []*treeNodeJSON {
 			IsBlocking:   node.IsBlocking,
 			Children:     convertToJSONTree(node.Children),
 		}
+		if node.ChildrenTotal > 0 {
+			jsonNode.Progress = &progressJSON{
+				Done:    node.ChildrenDone,
+				Total:   node.ChildrenTotal,
+				Percent: models.ProgressPercent(node.ChildrenDone, node.ChildrenTotal),
+			}
+		}
 		result = append(result, jsonNode)
 	}
 	return result
@@ -199,7 +220,7 @@ func outputJSONTree(projectID int, tree []*models.TaskTreeNode) error {
 	})
 }
 
-func outputStyledTree(tree []*models.TaskTreeNode) error {
+func outputStyledTree(tree []*models.TaskTreeNode, ticketKey string) error {
 	// Load config for color scheme
 	cfg, err := config.Load()
 	if err != nil {
@@ -213,27 +234,30 @@ func outputStyledTree(tree []*models.TaskTreeNode) error {
 	styles.Init(cfg.ColorScheme)
 
 	var output strings.Builder
-	renderTreeNodes(&output, tree, 0, cfg.ColorScheme)
+	renderTreeNodes(&output, tree, 0, ticketKey, cfg.ColorScheme)
 
 	fmt.Print(output.String())
 	return nil
 }
 
-func renderTreeNodes(output *strings.Builder, nodes []*models.TaskTreeNode, depth int, colors colors.ColorScheme) {
+func renderTreeNodes(output *strings.Builder, nodes []*models.TaskTreeNode, depth int, ticketKey string, colors colors.ColorScheme) {
 	for _, node := range nodes {
 		indent := strings.Repeat("  ", depth)
 
+		var line string
 		if depth == 0 {
 			// Root node - render with title style
-			line := styles.RenderTreeRootTask(node.ProjectName, node.TicketNumber, node.Title, node.ColumnName, colors)
-			output.WriteString(line + "\n")
+			line = styles.RenderTreeRootTask(models.TicketRef(ticketKey, node.TicketNumber), node.Title, node.ColumnName, colors)
 		} else {
 			// Child node - render with tree connector and relation chip
-			line := styles.RenderTreeChildLine(indent, node, colors)
-			output.WriteString(line + "\n")
+			line = styles.RenderTreeChildLine(indent, node, ticketKey, colors)
+		}
+		if progress := styles.RenderTreeProgress(node, colors); progress != "" {
+			line += " " + progress
 		}
+		output.WriteString(line + "\n")
 
 		// Recursively render children
-		renderTreeNodes(output, node.Children, depth+1, colors)
+		renderTreeNodes(output, node.Children, depth+1, ticketKey, colors)
 	}
 }
@@ -1,8 +1,10 @@
 package project
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/thenoetrevino/paso/internal/config"
 	"github.com/thenoetrevino/paso/internal/models"
 )
 
@@ -240,3 +242,47 @@ func TestMarkBlockingChainsSelfBlocking(t *testing.T) {
 		t.Errorf("Expected InBlockingPath to be true for self-blocking task, got false")
 	}
 }
+
+func TestRenderTreeNodesTicketRefs(t *testing.T) {
+	tree := []*models.TaskTreeNode{
+		{
+			ID:           1,
+			TicketNumber: 1,
+			Title:        "Parent Task",
+			ProjectName:  "Backend",
+			ColumnName:   "Todo",
+			Children: []*models.TaskTreeNode{
+				{
+					ID:            2,
+					TicketNumber:  2,
+					Title:         "Child Task",
+					ProjectName:   "Backend",
+					ColumnName:    "Todo",
+					RelationLabel: "Child",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		ticketKey string
+		want      []string
+	}{
+		{ticketKey: "API", want: []string{"API-1: Parent Task", "API-2: Child Task"}},
+		{ticketKey: "", want: []string{"#1: Parent Task", "#2: Child Task"}},
+	}
+
+	for _, tt := range tests {
+		var output strings.Builder
+		renderTreeNodes(&output, tree, 0, tt.ticketKey, config.DefaultColorScheme())
+
+		for _, want := range tt.want {
+			if !strings.Contains(output.String(), want) {
+				t.Errorf("ticket key %q: output %q does not contain %q", tt.ticketKey, output.String(), want)
+			}
+		}
+		if strings.Contains(output.String(), "Backend-") {
+			t.Errorf("ticket key %q: output %q uses the project name", tt.ticketKey, output.String())
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+)
+
+// UpdateCmd returns the project update subcommand
+func UpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a project",
+		Long: `Update a project's title, description or ticket key.
+
+The ticket key is the prefix tasks of the project can be referred to by, e.g.
+API-42 for ticket 42 of the project with key API. Keys are a letter followed
+by up to 9 letters or digits, are stored upper case and must be unique.
+An empty --key removes the key.
+
+Examples:
+  # Set the ticket key
+  paso project update --id=1 --key=API
+
+  # Rename the project
+  paso project update --id=1 --title="Backend API"
+
+  # Remove the ticket key
+  paso project update --id=1 --key=""
+`,
+		RunE: runUpdate,
+	}
+
+	// Required flags
+	cmd.Flags().Int("id", 0, "Project ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+
+	// Update flags
+	cmd.Flags().String("title", "", "New project title")
+	cmd.Flags().String("description", "", "New project description")
+	cmd.Flags().String("key", "", "New ticket key (empty to remove it)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	projectID, _ := cmd.Flags().GetInt("id")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	// Only the flags that were given are changed
+	req := projectservice.UpdateProjectRequest{ID: projectID}
+	if cmd.Flags().Changed("title") {
+		title, _ := cmd.Flags().GetString("title")
+		req.Name = &title
+	}
+	if cmd.Flags().Changed("description") {
+		description, _ := cmd.Flags().GetString("description")
+		req.Description = &description
+	}
+	if cmd.Flags().Changed("key") {
+		key, _ := cmd.Flags().GetString("key")
+		req.TicketKey = &key
+	}
+	if req.Name == nil && req.Description == nil && req.TicketKey == nil {
+		if fmtErr := formatter.Error("NO_UPDATES", "at least one of --title, --description or --key must be specified"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	if err := cliInstance.App.ProjectService.UpdateProject(ctx, req); err != nil {
+		switch {
+		case errors.Is(err, projectservice.ErrTicketKeyTaken):
+			if fmtErr := formatter.ErrorWithSuggestion("TICKET_KEY_TAKEN", err.Error(),
+				"Use 'paso project list' to see the keys in use"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		case errors.Is(err, projectservice.ErrInvalidTicketKey),
+			errors.Is(err, projectservice.ErrEmptyName),
+			errors.Is(err, projectservice.ErrNameTooLong):
+			if fmtErr := formatter.Error("VALIDATION_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		if fmtErr := formatter.Error("UPDATE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PROJECT_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	// Output success
+	if quietMode {
+		fmt.Printf("%d\n", project.ID)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"project": project,
+		})
+	}
+
+	fmt.Printf("✓ Project %d updated successfully\n", project.ID)
+	if project.TicketKey != "" {
+		fmt.Printf("  Tasks are referred to as %s-N\n", project.TicketKey)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package relationtype
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/cli/handler"
+	relationtypeservice "github.com/thenoetrevino/paso/internal/services/relationtype"
+)
+
+// CreateCmd returns the relation-type create subcommand
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a relation type to a project",
+		Long: `Add a relation type to a project.
+
+--forward is the label shown from the parent's side of a link and --reverse
+the one shown from the child's side; --reverse defaults to --forward for
+symmetric relations. Links of a --blocking type block the parent task until
+the child is completed.
+
+Examples:
+  # Test plans verify features, without blocking them
+  paso relation-type create --name=verifies --forward="Verified By" --reverse="Verifies" --project=1
+
+  # A symmetric relation
+  paso relation-type create --name=duplicates --forward="Duplicates" --project=1
+
+  # A blocking relation
+  paso relation-type create --name=implements --forward="Implemented By" --reverse="Implements" --blocking --project=1
+`,
+		RunE: handler.Command(&createHandler{}, parseCreateFlags),
+	}
+
+	// Required flags
+	cmd.Flags().String("name", "", "Relation type name used by paso task link --type (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().String("forward", "", "Label from the parent's side (required)")
+	if err := cmd.MarkFlagRequired("forward"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+
+	cmd.Flags().String("reverse", "", "Label from the child's side (defaults to --forward)")
+	cmd.Flags().String("color", "#6B7280", "Relation color in hex format #RRGGBB")
+	cmd.Flags().Bool("blocking", false, "Links of this type block the parent task")
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+// createHandler implements handler.Handler for relation type creation
+type createHandler struct{}
+
+// Execute implements the Handler interface
+func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (any, error) {
+	name := args.MustGetString("name")
+	forward := args.MustGetString("forward")
+	reverse := args.GetString("reverse", "")
+	color := args.GetString("color", "#6B7280")
+	blocking := args.GetBool("blocking")
+
+	cmd := args.GetCmd()
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("no project specified: use --project flag or set with 'eval $(paso use project <project-id>)'")
+	}
+
+	if err := cli.ValidateColorHex(color); err != nil {
+		return nil, fmt.Errorf("invalid color: %w", err)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialization error: %w", err)
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("project %d not found", projectID)
+	}
+
+	created, err := cliInstance.App.RelationTypeService.CreateRelationType(ctx, relationtypeservice.CreateRelationTypeRequest{
+		ProjectID:  projectID,
+		Name:       name,
+		PToCLabel:  forward,
+		CToPLabel:  reverse,
+		Color:      color,
+		IsBlocking: blocking,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("relation type creation error: %w", err)
+	}
+
+	return &relationTypeCreateResult{
+		ID:         created.ID,
+		Name:       created.Name,
+		Forward:    created.PToCLabel,
+		Reverse:    created.CToPLabel,
+		Color:      created.Color,
+		IsBlocking: created.IsBlocking,
+		ProjectID:  created.ProjectID,
+		Project:    project.Name,
+	}, nil
+}
+
+// relationTypeCreateResult represents the result of relation type creation
+type relationTypeCreateResult struct {
+	ID         int
+	Name       string
+	Forward    string
+	Reverse    string
+	Color      string
+	IsBlocking bool
+	ProjectID  int
+	Project    string
+}
+
+// GetID implements the GetID interface for quiet mode output
+func (r *relationTypeCreateResult) GetID() int {
+	return r.ID
+}
+
+func parseCreateFlags(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		return fmt.Errorf("relation type name is required")
+	}
+	forward, _ := cmd.Flags().GetString("forward")
+	if forward == "" {
+		return fmt.Errorf("forward label is required")
+	}
+	return nil
+}
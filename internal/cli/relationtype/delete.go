@@ -0,0 +1,114 @@
+package relationtype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	relationtypeservice "github.com/thenoetrevino/paso/internal/services/relationtype"
+)
+
+// DeleteCmd returns the relation-type delete subcommand
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a relation type",
+		Long: `Delete a project's relation type by ID (requires confirmation unless --force
+or --quiet). Built-in types cannot be deleted.
+
+A type still used by task links can only be deleted with --remove-links,
+which removes those links too.
+
+Examples:
+  # Delete an unused relation type
+  paso relation-type delete --id=4 --force
+
+  # Delete it together with its links
+  paso relation-type delete --id=4 --remove-links --force
+`,
+		RunE: runDelete,
+	}
+
+	cmd.Flags().Int("id", 0, "Relation type ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().Bool("remove-links", false, "Also remove task links of this type")
+	cmd.Flags().Bool("force", false, "Skip confirmation")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	relationTypeID, _ := cmd.Flags().GetInt("id")
+	removeLinks, _ := cmd.Flags().GetBool("remove-links")
+	force, _ := cmd.Flags().GetBool("force")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if !force && !quietMode {
+		fmt.Printf("Delete relation type #%d? (y/N): ", relationTypeID)
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil {
+			slog.Error("failed to reading user input", "error", err)
+		}
+		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+			fmt.Println("Cancelled")
+			return nil
+		}
+	}
+
+	err = cliInstance.App.RelationTypeService.DeleteRelationType(ctx, relationtypeservice.DeleteRelationTypeRequest{
+		ID:          relationTypeID,
+		RemoveLinks: removeLinks,
+	})
+	if err != nil {
+		suggestion := ""
+		if errors.Is(err, relationtypeservice.ErrRelationTypeInUse) {
+			suggestion = "Remove its links too with --remove-links"
+		}
+		if fmtErr := formatter.ErrorWithSuggestion(errorCode(err), err.Error(), suggestion); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode(err))
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":          true,
+			"relation_type_id": relationTypeID,
+		})
+	}
+
+	fmt.Printf("✓ Relation type %d deleted successfully\n", relationTypeID)
+	return nil
+}
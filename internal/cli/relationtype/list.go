@@ -0,0 +1,131 @@
+package relationtype
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// ListCmd returns the relation-type list subcommand
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List relation types available in a project",
+		Long: `List the built-in relation types followed by the project's own.
+
+Examples:
+  # Human-readable list
+  paso relation-type list --project=1
+
+  # JSON output for agents
+  paso relation-type list --project=1 --json
+
+  # Quiet mode (one ID per line)
+  paso relation-type list --project=1 --quiet
+`,
+		RunE: runList,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs only)")
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	relationTypes, err := cliInstance.App.RelationTypeService.GetRelationTypesByProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("RELATION_TYPE_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, rt := range relationTypes {
+			fmt.Printf("%d\n", rt.ID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		typeList := make([]map[string]any, len(relationTypes))
+		for i, rt := range relationTypes {
+			typeList[i] = map[string]any{
+				"id":          rt.ID,
+				"name":        rt.Name,
+				"forward":     rt.PToCLabel,
+				"reverse":     rt.CToPLabel,
+				"color":       rt.Color,
+				"is_blocking": rt.IsBlocking,
+				"builtin":     rt.IsBuiltin(),
+			}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":        true,
+			"relation_types": typeList,
+		})
+	}
+
+	fmt.Printf("Relation types in project '%s':\n", project.Name)
+	fmt.Printf("  %-4s %-16s %-16s %-16s %-8s %s\n", "ID", "Name", "Forward", "Reverse", "Color", "Blocking")
+	fmt.Println("  " + strings.Repeat("-", 70))
+	for _, rt := range relationTypes {
+		name := rt.Name
+		if rt.IsBuiltin() {
+			name += " *"
+		}
+		blocking := "no"
+		if rt.IsBlocking {
+			blocking = "yes"
+		}
+		fmt.Printf("  %-4d %-16s %-16s %-16s %-8s %s\n", rt.ID, name, rt.PToCLabel, rt.CToPLabel, rt.Color, blocking)
+	}
+	fmt.Println("\n  * built-in")
+	return nil
+}
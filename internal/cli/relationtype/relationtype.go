@@ -0,0 +1,27 @@
+// Package relationtype holds all cli commands related to task relation types
+// e.g., paso relation-type ...
+package relationtype
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// RelationTypeCmd returns the relation-type parent command
+func RelationTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relation-type",
+		Short: "Manage a project's task relation types",
+		Long: `Manage the relation types tasks can be linked with.
+
+Every project has the built-in parent, blocker and related types and can add
+its own, each with a label for either side of the link, a colour and a
+blocking flag. Link tasks with them using paso task link --type=<name>.`,
+	}
+
+	cmd.AddCommand(CreateCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(UpdateCmd())
+	cmd.AddCommand(DeleteCmd())
+
+	return cmd
+}
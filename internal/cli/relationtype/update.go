@@ -0,0 +1,149 @@
+package relationtype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	relationtypeservice "github.com/thenoetrevino/paso/internal/services/relationtype"
+)
+
+// UpdateCmd returns the relation-type update subcommand
+func UpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Update a relation type",
+		Long: `Rename, relabel or recolor a project's relation type, or change whether it
+blocks. Existing links keep their type. Built-in types cannot be changed.
+
+Examples:
+  # Change the labels
+  paso relation-type update --id=4 --forward="Tested By" --reverse="Tests"
+
+  # Make links of this type block their parent task
+  paso relation-type update --id=4 --blocking
+`,
+		RunE: runUpdate,
+	}
+
+	cmd.Flags().Int("id", 0, "Relation type ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().String("name", "", "New name")
+	cmd.Flags().String("forward", "", "New label from the parent's side")
+	cmd.Flags().String("reverse", "", "New label from the child's side")
+	cmd.Flags().String("color", "", "New color in hex format #RRGGBB")
+	cmd.Flags().Bool("blocking", false, "Whether links of this type block the parent task")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runUpdate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	relationTypeID, _ := cmd.Flags().GetInt("id")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	req := relationtypeservice.UpdateRelationTypeRequest{ID: relationTypeID}
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		req.Name = &name
+	}
+	if cmd.Flags().Changed("forward") {
+		forward, _ := cmd.Flags().GetString("forward")
+		req.PToCLabel = &forward
+	}
+	if cmd.Flags().Changed("reverse") {
+		reverse, _ := cmd.Flags().GetString("reverse")
+		req.CToPLabel = &reverse
+	}
+	if cmd.Flags().Changed("color") {
+		color, _ := cmd.Flags().GetString("color")
+		if err := cli.ValidateColorHex(color); err != nil {
+			if fmtErr := formatter.Error("INVALID_COLOR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		req.Color = &color
+	}
+	if cmd.Flags().Changed("blocking") {
+		blocking, _ := cmd.Flags().GetBool("blocking")
+		req.IsBlocking = &blocking
+	}
+
+	if req.Name == nil && req.PToCLabel == nil && req.CToPLabel == nil && req.Color == nil && req.IsBlocking == nil {
+		if fmtErr := formatter.Error("MISSING_FLAGS",
+			"at least one of --name, --forward, --reverse, --color or --blocking must be provided"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if err := cliInstance.App.RelationTypeService.UpdateRelationType(ctx, req); err != nil {
+		if fmtErr := formatter.Error(errorCode(err), err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode(err))
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":          true,
+			"relation_type_id": relationTypeID,
+		})
+	}
+
+	fmt.Printf("✓ Relation type %d updated successfully\n", relationTypeID)
+	return nil
+}
+
+// errorCode maps a relation type service error to an output error code
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, relationtypeservice.ErrRelationTypeNotFound):
+		return "RELATION_TYPE_NOT_FOUND"
+	case errors.Is(err, relationtypeservice.ErrRelationTypeInUse):
+		return "RELATION_TYPE_IN_USE"
+	case errors.Is(err, relationtypeservice.ErrRelationTypeExists):
+		return "RELATION_TYPE_EXISTS"
+	default:
+		return "VALIDATION_ERROR"
+	}
+}
+
+// exitCode maps a relation type service error to a process exit code
+func exitCode(err error) int {
+	if errors.Is(err, relationtypeservice.ErrRelationTypeNotFound) {
+		return cli.ExitNotFound
+	}
+	return cli.ExitValidation
+}
@@ -0,0 +1,104 @@
+// Package serve holds the paso serve command, which exposes the service
+// layer as a local HTTP+JSON API
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/api"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// ServeCmd returns the serve command
+func ServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the paso API over HTTP",
+		Long: `Serve projects, columns, labels, tasks and comments as a local HTTP+JSON API.
+
+Responses use the same envelope and error codes as --json output, and each
+error carries the exit code the equivalent CLI command would return.
+Changes made through the API show up live in running TUIs.
+
+Only requests addressed to localhost, 127.0.0.1, ::1 or the host of --addr
+are answered, so web pages cannot reach the API by rebinding their own
+domain to this machine. With --token (or PASO_API_TOKEN) every request must
+also send "Authorization: Bearer <token>".
+
+The OpenAPI description is served at /api/v1/openapi.json.
+
+Examples:
+  paso serve
+  paso serve --addr 127.0.0.1:8080
+  curl -s localhost:7777/api/v1/projects/1/tasks?filter=is:ready
+  PASO_API_TOKEN=s3cret paso serve
+  curl -s -H 'Authorization: Bearer s3cret' localhost:7777/api/v1/projects
+`,
+		RunE: runServe,
+	}
+
+	cmd.Flags().String("addr", "127.0.0.1:7777", "Address to listen on")
+	cmd.Flags().String("token", "", "Bearer token every request must send (default $PASO_API_TOKEN)")
+
+	return cmd
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	addr, _ := cmd.Flags().GetString("addr")
+	token, _ := cmd.Flags().GetString("token")
+	if token == "" {
+		token = os.Getenv("PASO_API_TOKEN")
+	}
+	opts := []api.Option{api.WithToken(token)}
+	if host, _, err := net.SplitHostPort(addr); err == nil && host != "" {
+		opts = append(opts, api.WithAllowedHosts(host))
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("initialization error: %w", err)
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	listener, err := (&net.ListenConfig{}).Listen(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	server := &http.Server{
+		Handler:           api.NewServer(cliInstance.App, opts...),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			slog.Error("failed to shutting down api server", "error", err)
+		}
+	}()
+
+	fmt.Fprintf(os.Stderr, "paso API listening on http://%s/api/v1\n", listener.Addr())
+	if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("api server error: %w", err)
+	}
+	return nil
+}
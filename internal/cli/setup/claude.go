@@ -18,6 +18,7 @@ func ClaudeCmd() *cobra.Command {
 	var projectFlag bool
 	var checkFlag bool
 	var removeFlag bool
+	var mcpFlag bool
 
 	cmd := &cobra.Command{
 		Use:   "claude",
@@ -38,8 +39,23 @@ Examples:
 
   # Remove hooks
   paso setup claude --remove
+
+  # Register the paso MCP server (combines with --project, --check, --remove)
+  paso setup claude --mcp
 `,
 		Run: func(cmd *cobra.Command, args []string) {
+			if mcpFlag {
+				switch {
+				case checkFlag:
+					CheckMCP(claudeMCPConfig(false), claudeMCPConfig(true), "paso setup claude")
+				case removeFlag:
+					RemoveMCP(claudeMCPConfig(projectFlag))
+				default:
+					InstallMCP(claudeMCPConfig(projectFlag))
+				}
+				return
+			}
+
 			if checkFlag {
 				CheckClaude()
 				return
@@ -57,6 +73,7 @@ Examples:
 	cmd.Flags().BoolVar(&projectFlag, "project", false, "Install for current project only")
 	cmd.Flags().BoolVar(&checkFlag, "check", false, "Check installation status")
 	cmd.Flags().BoolVar(&removeFlag, "remove", false, "Remove hooks")
+	cmd.Flags().BoolVar(&mcpFlag, "mcp", false, "Register the paso MCP server (paso mcp) instead")
 
 	return cmd
 }
@@ -0,0 +1,186 @@
+package setup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// mcpServerName is the key paso's server is registered under
+const mcpServerName = "paso"
+
+// mcpConfig describes where an agent tool registers MCP servers
+type mcpConfig struct {
+	path  string         // Config file
+	key   string         // Top-level object holding the servers
+	entry map[string]any // paso's server definition
+}
+
+// claudeMCPConfig returns where Claude Code registers MCP servers:
+// .mcp.json for a project, ~/.claude.json globally
+func claudeMCPConfig(project bool) mcpConfig {
+	config := mcpConfig{
+		path: ".mcp.json",
+		key:  "mcpServers",
+		entry: map[string]any{
+			"type":    "stdio",
+			"command": "paso",
+			"args":    []any{"mcp"},
+		},
+	}
+	if !project {
+		config.path = filepath.Join(homeDir(), ".claude.json")
+	}
+	return config
+}
+
+// openCodeMCPConfig returns where OpenCode registers MCP servers, which is
+// the same opencode.json that lists plugins
+func openCodeMCPConfig(project bool) mcpConfig {
+	config := mcpConfig{
+		path: "opencode.json",
+		key:  "mcp",
+		entry: map[string]any{
+			"type":    "local",
+			"command": []any{"paso", "mcp"},
+			"enabled": true,
+		},
+	}
+	if !project {
+		config.path = filepath.Join(homeDir(), ".config/opencode/opencode.json")
+	}
+	return config
+}
+
+func homeDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to get home directory: %v\n", err)
+		os.Exit(1)
+	}
+	return home
+}
+
+// InstallMCP registers the paso MCP server in an agent tool's config
+func InstallMCP(config mcpConfig) {
+	fmt.Printf("Registering paso MCP server in %s...\n", config.path)
+
+	// Ensure parent directory exists
+	if err := EnsureDir(filepath.Dir(config.path), 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	settings := readJSONConfig(config.path)
+	if settings == nil {
+		settings = make(map[string]any)
+	}
+
+	servers, ok := settings[config.key].(map[string]any)
+	if !ok {
+		servers = make(map[string]any)
+		settings[config.key] = servers
+	}
+
+	if _, exists := servers[mcpServerName]; exists {
+		fmt.Println("✓ MCP server already registered")
+		return
+	}
+	servers[mcpServerName] = config.entry
+
+	writeJSONConfig(config.path, settings)
+
+	fmt.Printf("\n✓ paso MCP server registered\n")
+	fmt.Printf("  Config: %s\n", config.path)
+	fmt.Println("\nRestart your agent for changes to take effect.")
+}
+
+// RemoveMCP unregisters the paso MCP server
+func RemoveMCP(config mcpConfig) {
+	fmt.Printf("Removing paso MCP server from %s...\n", config.path)
+
+	settings := readJSONConfig(config.path)
+	if settings == nil {
+		fmt.Println("No config file found")
+		return
+	}
+
+	servers, ok := settings[config.key].(map[string]any)
+	if !ok {
+		fmt.Println("MCP server not found in config")
+		return
+	}
+	if _, exists := servers[mcpServerName]; !exists {
+		fmt.Println("MCP server not found in config")
+		return
+	}
+	delete(servers, mcpServerName)
+
+	writeJSONConfig(config.path, settings)
+
+	fmt.Println("✓ paso MCP server removed")
+}
+
+// CheckMCP reports whether the paso MCP server is registered globally or
+// for the current project
+func CheckMCP(global, project mcpConfig, setupCommand string) {
+	if hasPasoMCP(global) {
+		fmt.Println("✓ MCP server registered globally:", global.path)
+	} else if hasPasoMCP(project) {
+		fmt.Println("✓ MCP server registered for project:", project.path)
+	} else {
+		fmt.Println("✗ MCP server not registered")
+		fmt.Printf("  Run: %s --mcp\n", setupCommand)
+		os.Exit(1)
+	}
+}
+
+// hasPasoMCP checks if a config file registers the paso MCP server
+func hasPasoMCP(config mcpConfig) bool {
+	data, err := os.ReadFile(config.path)
+	if err != nil {
+		return false
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return false
+	}
+
+	servers, ok := settings[config.key].(map[string]any)
+	if !ok {
+		return false
+	}
+	_, exists := servers[mcpServerName]
+	return exists
+}
+
+// readJSONConfig loads a JSON config file, returning nil if it doesn't exist
+func readJSONConfig(path string) map[string]any {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var settings map[string]any
+	if err := json.Unmarshal(data, &settings); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to parse %s: %v\n", filepath.Base(path), err)
+		os.Exit(1)
+	}
+	return settings
+}
+
+// writeJSONConfig writes a JSON config file atomically
+func writeJSONConfig(path string, settings map[string]any) {
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: marshal config: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := atomicWriteFile(path, data); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: write config: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -14,6 +14,7 @@ func OpenCodeCmd() *cobra.Command {
 	var projectFlag bool
 	var checkFlag bool
 	var removeFlag bool
+	var mcpFlag bool
 
 	cmd := &cobra.Command{
 		Use:   "opencode",
@@ -34,8 +35,23 @@ Examples:
 
   # Remove plugin
   paso setup opencode --remove
+
+  # Register the paso MCP server (combines with --project, --check, --remove)
+  paso setup opencode --mcp
 `,
 		Run: func(cmd *cobra.Command, args []string) {
+			if mcpFlag {
+				switch {
+				case checkFlag:
+					CheckMCP(openCodeMCPConfig(false), openCodeMCPConfig(true), "paso setup opencode")
+				case removeFlag:
+					RemoveMCP(openCodeMCPConfig(projectFlag))
+				default:
+					InstallMCP(openCodeMCPConfig(projectFlag))
+				}
+				return
+			}
+
 			if checkFlag {
 				CheckOpenCode()
 				return
@@ -53,6 +69,7 @@ Examples:
 	cmd.Flags().BoolVar(&projectFlag, "project", false, "Install for current project only")
 	cmd.Flags().BoolVar(&checkFlag, "check", false, "Check installation status")
 	cmd.Flags().BoolVar(&removeFlag, "remove", false, "Remove plugin")
+	cmd.Flags().BoolVar(&mcpFlag, "mcp", false, "Register the paso MCP server (paso mcp) instead")
 
 	return cmd
 }
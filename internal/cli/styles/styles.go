@@ -176,8 +176,8 @@ func RenderRelationChip(label string, color string, isBlocking bool, colors colo
 
 // RenderTreeTaskInfo renders task info for tree display
 // Format: "PROJ-123: Title - ColumnName"
-func RenderTreeTaskInfo(projectName string, ticketNumber int, title string, columnName string, isBlocking bool, colors colors.ColorScheme) string {
-	taskInfo := fmt.Sprintf("%s-%d: %s - %s", projectName, ticketNumber, title, columnName)
+func RenderTreeTaskInfo(ticketRef string, title string, columnName string, isBlocking bool, colors colors.ColorScheme) string {
+	taskInfo := fmt.Sprintf("%s: %s - %s", ticketRef, title, columnName)
 	if isBlocking {
 		return lipgloss.NewStyle().
 			Foreground(lipgloss.Color(colors.ErrorFg)).
@@ -189,22 +189,38 @@ func RenderTreeTaskInfo(projectName string, ticketNumber int, title string, colu
 }
 
 // RenderTreeRootTask renders a root task (no connector, no relation)
-func RenderTreeRootTask(projectName string, ticketNumber int, title string, columnName string, colors colors.ColorScheme) string {
+func RenderTreeRootTask(ticketRef string, title string, columnName string, colors colors.ColorScheme) string {
 	return lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color(colors.Title)).
-		Render(fmt.Sprintf("%s-%d: %s - %s", projectName, ticketNumber, title, columnName))
+		Render(fmt.Sprintf("%s: %s - %s", ticketRef, title, columnName))
+}
+
+// RenderTreeProgress renders the child progress of a tree node
+// Format: "[3/5 60%]", empty for tasks without children
+func RenderTreeProgress(node *models.TaskTreeNode, colors colors.ColorScheme) string {
+	if node.ChildrenTotal == 0 {
+		return ""
+	}
+	color := colors.Subtle
+	if node.ChildrenDone == node.ChildrenTotal {
+		color = colors.Create
+	}
+	return lipgloss.NewStyle().
+		Foreground(lipgloss.Color(color)).
+		Render(fmt.Sprintf("[%d/%d %d%%]", node.ChildrenDone, node.ChildrenTotal, models.ProgressPercent(node.ChildrenDone, node.ChildrenTotal)))
 }
 
 // RenderTreeChildLine renders a complete child line in the tree
-// Format: "  ∟ RelationLabel - PROJ-123: Title - ColumnName"
-func RenderTreeChildLine(indent string, node *models.TaskTreeNode, colors colors.ColorScheme) string {
+// Format: "  ∟ RelationLabel - PROJ-123: Title - ColumnName", with #123 when
+// the project has no ticket key
+func RenderTreeChildLine(indent string, node *models.TaskTreeNode, ticketKey string, colors colors.ColorScheme) string {
 	// The connector (∟) is red if in blocking path OR if it's a blocking relationship
 	connector := RenderTreeConnector(node.InBlockingPath, colors)
 
 	// The relation label and task info are only red if this is actually a blocking relationship
 	relationChip := RenderRelationChip(node.RelationLabel, node.RelationColor, node.IsBlocking, colors)
-	taskInfo := RenderTreeTaskInfo(node.ProjectName, node.TicketNumber, node.Title, node.ColumnName, node.IsBlocking, colors)
+	taskInfo := RenderTreeTaskInfo(models.TicketRef(ticketKey, node.TicketNumber), node.Title, node.ColumnName, node.IsBlocking, colors)
 
 	return fmt.Sprintf("%s%s %s - %s", indent, connector, relationChip, taskInfo)
 }
@@ -0,0 +1,137 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// ArchiveCmd returns the task archive subcommand
+func ArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive <task_id>",
+		Short: "Hide a task from the board without deleting it",
+		Long: `Archive a task. Archived tasks are left out of the board, task list,
+ready and blocked views, but keep their column, links, comments and history.
+
+Find them again with 'paso task list --filter=is:archived' or 'paso task search',
+and bring one back with 'paso task unarchive'.
+
+Examples:
+  paso task archive 42
+
+  # Archive everything finished more than two weeks ago
+  paso column archive-done --older-than 14d --project=1
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchive(cmd, args, true)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+// UnarchiveCmd returns the task unarchive subcommand
+func UnarchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive <task_id>",
+		Short: "Put an archived task back on the board",
+		Long: `Unarchive a task. It goes back to the column it was archived from.
+
+Examples:
+  paso task unarchive 42
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchive(cmd, args, false)
+		},
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func runArchive(cmd *cobra.Command, args []string, archive bool) error {
+	ctx := cmd.Context()
+
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	action := "unarchived"
+	if archive {
+		action = "archived"
+		err = cliInstance.App.TaskService.ArchiveTask(ctx, taskID)
+	} else {
+		err = cliInstance.App.TaskService.UnarchiveTask(ctx, taskID)
+	}
+
+	switch {
+	case errors.Is(err, taskservice.ErrTaskNotFound):
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	case errors.Is(err, taskservice.ErrTaskArchived), errors.Is(err, taskservice.ErrTaskNotArchived):
+		// Already in the requested state: report it, but succeed
+		if !jsonOutput && !quietMode {
+			fmt.Printf("Task %d is already %s\n", taskID, action)
+			return nil
+		}
+	case err != nil:
+		if fmtErr := formatter.Error("ARCHIVE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Printf("%d\n", taskID)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":  true,
+			"task_id":  taskID,
+			"archived": archive,
+		})
+	}
+
+	fmt.Printf("Task %d %s\n", taskID, action)
+	return nil
+}
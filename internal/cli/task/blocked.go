@@ -8,7 +8,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
-	"github.com/thenoetrevino/paso/internal/models"
 )
 
 // BlockedCmd returns the task blocked subcommand
@@ -19,7 +18,8 @@ func BlockedCmd() *cobra.Command {
 		Long: `List all tasks that are blocked by dependencies.
 
 These are tasks that cannot be started until their blocking
-dependencies are completed.
+dependencies are completed. A blocker counts as resolved once it, and
+every task blocking it in turn, is in the completed column.
 
 Examples:
   # Human-readable output
@@ -30,12 +30,16 @@ Examples:
 
   # Quiet mode for bash capture
   TASK_IDS=$(paso task blocked --project=1 --quiet)
+
+  # Narrow down with a filter query (see 'paso task list --help')
+  paso task blocked --filter='label:backend priority>=high'
 `,
 		RunE: runBlocked,
 	}
 
 	// Flags
 	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("filter", "", filterFlagHelp)
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -52,6 +56,8 @@ func runBlocked(cmd *cobra.Command, args []string) error {
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
+	query := resolveFilter(cmd, formatter, "is:blocked")
+
 	// Get project ID from flag or environment variable
 	taskProject, err := cli.GetProjectID(cmd)
 	if err != nil {
@@ -88,8 +94,8 @@ func runBlocked(cmd *cobra.Command, args []string) error {
 		os.Exit(cli.ExitNotFound)
 	}
 
-	// Get all tasks for project (includes IsBlocked field)
-	tasksByColumn, err := cliInstance.App.TaskService.GetTaskSummariesByProject(ctx, taskProject)
+	// Get blocked tasks
+	blockedTasks, err := cliInstance.App.TaskService.GetTaskSummariesByFilter(ctx, taskProject, query)
 	if err != nil {
 		if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
@@ -97,16 +103,6 @@ func runBlocked(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Filter for blocked tasks (IsBlocked == true)
-	var blockedTasks []*models.TaskSummary
-	for _, columnTasks := range tasksByColumn {
-		for _, task := range columnTasks {
-			if task.IsBlocked {
-				blockedTasks = append(blockedTasks, task)
-			}
-		}
-	}
-
 	// Output in appropriate format
 	if quietMode {
 		// Just print IDs
@@ -0,0 +1,332 @@
+package task
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// BulkCmd returns the task bulk subcommand
+func BulkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk [task...]",
+		Short: "Change many tasks at once",
+		Long: `Apply the same changes to a set of tasks in one go.
+
+Tasks are selected by ID, #N or KEY-N, by - to read them from stdin
+(separated by spaces or newlines, e.g. from 'paso task list --quiet'), or by
+--filter with the query language of 'paso task list'.
+
+All changes are made in a single transaction: if one task cannot be changed,
+for instance because it belongs to another project or its new column is at
+its WIP limit, none are. Tasks that already match are left alone. Use
+--dry-run to see what would change first.
+
+Examples:
+  # Raise the priority of three tasks
+  paso task bulk 12 15 API-7 --priority=high
+
+  # Move everything labelled backend out of Todo
+  paso task bulk --filter='column:Todo label:backend' --column="In Progress" --force
+
+  # Re-file all bugs, previewing first
+  paso task bulk --filter='type:bug -is:done' --add-label=triage --remove-label=new --dry-run
+
+  # Archive the tasks another command picked
+  paso task list --filter='is:done updated:>30d' --quiet | paso task bulk - --archive
+`,
+		RunE: runBulk,
+	}
+
+	// Selection flags
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("filter", "", filterFlagHelp)
+
+	// Change flags
+	cmd.Flags().String("column", "", "Move the tasks to this column")
+	cmd.Flags().String("priority", "", "New priority, one of the project's priorities")
+	cmd.Flags().String("type", "", "New type, one of the project's types")
+	cmd.Flags().StringArray("add-label", nil, "Label name to attach (repeatable)")
+	cmd.Flags().StringArray("remove-label", nil, "Label name to detach (repeatable)")
+	cmd.Flags().Bool("archive", false, "Archive the tasks")
+	cmd.Flags().Bool("dry-run", false, "Show what would change without changing anything")
+
+	addForceFlag(cmd)
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs of changed tasks only)")
+
+	return cmd
+}
+
+// taskBulkResult represents the result of a bulk update
+type taskBulkResult struct {
+	Project  string
+	DryRun   bool
+	Selected int
+	Updated  int
+	Tasks    []*taskservice.BulkTaskResult
+}
+
+func runBulk(cmd *cobra.Command, args []string) error {
+	ctx := wipContext(cmd.Context(), cmd)
+
+	columnName, _ := cmd.Flags().GetString("column")
+	priorityName, _ := cmd.Flags().GetString("priority")
+	typeName, _ := cmd.Flags().GetString("type")
+	addLabels, _ := cmd.Flags().GetStringArray("add-label")
+	removeLabels, _ := cmd.Flags().GetStringArray("remove-label")
+	archive, _ := cmd.Flags().GetBool("archive")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+	if dryRun {
+		ctx = cli.WithoutRecurringTasks(ctx)
+	}
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	fail := func(code, message, suggestion string, exitCode int) {
+		if fmtErr := formatter.ErrorWithSuggestion(code, message, suggestion); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode)
+	}
+
+	// Tasks come from the arguments, stdin or a filter, never a mix
+	useFilter := cmd.Flags().Changed("filter")
+	if useFilter && len(args) > 0 {
+		fail("INVALID_SELECTION", "give tasks either as arguments or with --filter, not both", "", cli.ExitUsage)
+	}
+	if len(args) == 1 && args[0] == "-" {
+		data, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			fail("READ_ERROR", fmt.Sprintf("stdin read error: %v", err), "", cli.ExitDataErr)
+		}
+		args = strings.Fields(string(data))
+		if len(args) == 0 {
+			fail("NO_TASKS_SELECTED", "no tasks on stdin", "", cli.ExitUsage)
+		}
+	}
+	if !useFilter && len(args) == 0 {
+		fail("NO_TASKS_SELECTED", "no tasks selected",
+			"Give task IDs, - to read them from stdin, or --filter", cli.ExitUsage)
+	}
+
+	var query string
+	if useFilter {
+		query = resolveFilter(cmd, formatter, "")
+		if query == "" {
+			fail("INVALID_FILTER", "--filter cannot be empty", "Example: --filter='column:Todo label:backend'", cli.ExitUsage)
+		}
+	}
+
+	if columnName == "" && priorityName == "" && typeName == "" && len(addLabels) == 0 && len(removeLabels) == 0 && !archive {
+		fail("NO_UPDATES",
+			"at least one of --column, --priority, --type, --add-label, --remove-label or --archive must be specified", "", cli.ExitUsage)
+	}
+
+	// A filter needs a project; tasks given by ID default to the first one's
+	projectID, projectErr := cli.GetProjectID(cmd)
+	if useFilter && projectErr != nil {
+		fail("NO_PROJECT", projectErr.Error(),
+			"Set project with: eval $(paso use project <project-id>)", cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	var taskIDs []int
+	if useFilter {
+		tasks, err := cliInstance.App.TaskService.GetTaskSummariesByFilter(ctx, projectID, query)
+		if err != nil {
+			if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+		for _, t := range tasks {
+			taskIDs = append(taskIDs, t.ID)
+		}
+	} else {
+		for _, arg := range args {
+			taskIDs = append(taskIDs, cliInstance.MustResolveTaskArg(ctx, cmd, formatter, arg))
+		}
+		if projectErr != nil {
+			task, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskIDs[0])
+			if err != nil {
+				fail("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskIDs[0]), "", cli.ExitNotFound)
+			}
+			projectID = task.ProjectID
+		}
+	}
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		fail("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID), "", cli.ExitNotFound)
+	}
+
+	// Names resolve against the project's columns, labels, types and priorities
+	req := taskservice.BulkUpdateRequest{
+		ProjectID: projectID,
+		TaskIDs:   taskIDs,
+		Archive:   archive,
+		DryRun:    dryRun,
+	}
+	if columnName != "" {
+		columns, err := cliInstance.App.ColumnService.GetColumnsByProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch columns: %w", err)
+		}
+		column, err := cli.FindColumnByName(columns, columnName)
+		if err != nil {
+			fail("COLUMN_NOT_FOUND", err.Error(),
+				fmt.Sprintf("Available columns: %s", cli.FormatAvailableColumns(columns)), cli.ExitNotFound)
+		}
+		req.ColumnID = &column.ID
+	}
+	if priorityName != "" {
+		priorities, err := cliInstance.App.PriorityService.GetPrioritiesByProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch priorities: %w", err)
+		}
+		priorityID, err := cli.ParsePriority(priorities, priorityName)
+		if err != nil {
+			fail("INVALID_PRIORITY", err.Error(), "", cli.ExitValidation)
+		}
+		req.PriorityID = &priorityID
+	}
+	if typeName != "" {
+		types, err := cliInstance.App.TypeService.GetTypesByProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch types: %w", err)
+		}
+		typeID, err := cli.ParseTaskType(types, typeName)
+		if err != nil {
+			fail("INVALID_TYPE", err.Error(), "", cli.ExitValidation)
+		}
+		req.TypeID = &typeID
+	}
+	if len(addLabels) > 0 || len(removeLabels) > 0 {
+		labels, err := cliInstance.App.LabelService.GetLabelsByProject(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch labels: %w", err)
+		}
+		byName := make(map[string]*models.Label, len(labels))
+		names := make([]string, len(labels))
+		for i, label := range labels {
+			byName[strings.ToLower(label.Name)] = label
+			names[i] = label.Name
+		}
+		lookup := func(name string) int {
+			label, ok := byName[strings.ToLower(strings.TrimSpace(name))]
+			if !ok {
+				fail("LABEL_NOT_FOUND", fmt.Sprintf("label '%s' not found in project '%s'", name, project.Name),
+					fmt.Sprintf("Available labels: %s", strings.Join(names, ", ")), cli.ExitNotFound)
+			}
+			return label.ID
+		}
+		for _, name := range addLabels {
+			req.AttachLabelIDs = append(req.AttachLabelIDs, lookup(name))
+		}
+		for _, name := range removeLabels {
+			req.DetachLabelIDs = append(req.DetachLabelIDs, lookup(name))
+		}
+	}
+
+	result := &taskBulkResult{
+		Project:  project.Name,
+		DryRun:   dryRun,
+		Selected: len(taskIDs),
+		Tasks:    []*taskservice.BulkTaskResult{},
+	}
+
+	if len(taskIDs) > 0 {
+		bulk, err := cliInstance.App.TaskService.BulkUpdateTasks(ctx, req)
+		if err != nil {
+			exitIfWIPLimit(formatter, err)
+			switch {
+			case errors.Is(err, taskservice.ErrTaskNotFound):
+				fail("TASK_NOT_FOUND", err.Error(), "", cli.ExitNotFound)
+			case errors.Is(err, taskservice.ErrInvalidTaskID):
+				fail("INVALID_TASK_ID", err.Error(), "", cli.ExitUsage)
+			case errors.Is(err, taskservice.ErrTaskNotInProject):
+				fail("PROJECT_MISMATCH", err.Error(),
+					fmt.Sprintf("All tasks must belong to project '%s'", project.Name), cli.ExitValidation)
+			}
+			if fmtErr := formatter.Error("BULK_UPDATE_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+		result.Selected = len(bulk.Tasks)
+		result.Updated = bulk.Updated
+		result.Tasks = bulk.Tasks
+	}
+
+	if quietMode {
+		if !dryRun {
+			for _, t := range result.Tasks {
+				if len(t.Changes) > 0 {
+					fmt.Printf("%d\n", t.TaskID)
+				}
+			}
+		}
+		return nil
+	}
+	if jsonOutput {
+		return formatter.Success(result)
+	}
+
+	if dryRun {
+		fmt.Printf("Would update %d of %d tasks in project '%s'\n", result.Updated, result.Selected, result.Project)
+	} else {
+		fmt.Printf("✓ Updated %d of %d tasks in project '%s'\n", result.Updated, result.Selected, result.Project)
+	}
+	for _, t := range result.Tasks {
+		if len(t.Changes) == 0 {
+			continue
+		}
+		fmt.Printf("  [%d] %s %s: %s\n", t.TaskID, models.TicketRef(project.TicketKey, t.TicketNumber), t.Title, describeBulkChanges(t.Changes))
+	}
+	return nil
+}
+
+// describeBulkChanges formats a task's changes for human output, e.g.
+// "priority low → high, +backend, archived"
+func describeBulkChanges(changes []taskservice.BulkChange) string {
+	parts := make([]string, len(changes))
+	for i, c := range changes {
+		switch {
+		case c.Field == "label" && c.OldValue == "":
+			parts[i] = "+" + c.NewValue
+		case c.Field == "label":
+			parts[i] = "-" + c.OldValue
+		case c.Field == "archived":
+			parts[i] = "archived"
+		default:
+			parts[i] = fmt.Sprintf("%s %s → %s", c.Field, c.OldValue, c.NewValue)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
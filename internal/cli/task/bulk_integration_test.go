@@ -0,0 +1,96 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestBulkTasks(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Bulk Project")
+	backlogID := cli.CreateTestColumn(t, db, projectID, "Backlog")
+	shippedID := cli.CreateTestColumn(t, db, projectID, "Shipped")
+	first := cli.CreateTestTask(t, db, backlogID, "First")
+	second := cli.CreateTestTask(t, db, backlogID, "Second")
+	untouched := cli.CreateTestTask(t, db, shippedID, "Untouched")
+	_, err := db.ExecContext(context.Background(),
+		"INSERT INTO labels (project_id, name, color) VALUES (?, 'backend', '#FF0000')", projectID)
+	require.NoError(t, err)
+
+	project := fmt.Sprintf("--project=%d", projectID)
+
+	priorityOf := func(taskID int) string {
+		var priority string
+		err := db.QueryRowContext(context.Background(),
+			"SELECT p.description FROM tasks t JOIN priorities p ON t.priority_id = p.id WHERE t.id = ?", taskID).Scan(&priority)
+		require.NoError(t, err)
+		return priority
+	}
+	labelCount := func(taskID int) int {
+		var count int
+		err := db.QueryRowContext(context.Background(),
+			"SELECT COUNT(*) FROM task_labels WHERE task_id = ?", taskID).Scan(&count)
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("Dry run by filter changes nothing", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, BulkCmd(), []string{
+			project, "--filter=column:Backlog", "--priority=critical", "--add-label=Backend", "--dry-run", "--json",
+		})
+		require.NoError(t, err)
+
+		result := cli.ParseJSON(t, output)
+		data := result["data"].(map[string]interface{})
+		assert.Equal(t, true, data["DryRun"])
+		assert.Equal(t, float64(2), data["Selected"])
+		assert.Equal(t, float64(2), data["Updated"])
+
+		assert.NotEqual(t, "critical", priorityOf(first))
+		assert.Equal(t, 0, labelCount(first))
+	})
+
+	t.Run("Tasks from stdin", func(t *testing.T) {
+		cmd := BulkCmd()
+		cmd.SetIn(strings.NewReader(fmt.Sprintf("%d\n%d\n", first, second)))
+
+		output, err := cli.ExecuteCLICommand(t, app, cmd, []string{
+			"-", "--priority=critical", "--add-label=backend",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Updated 2 of 2 tasks")
+		assert.Contains(t, output, "+backend")
+
+		for _, id := range []int{first, second} {
+			assert.Equal(t, "critical", priorityOf(id))
+			assert.Equal(t, 1, labelCount(id))
+		}
+		assert.Equal(t, 0, labelCount(untouched))
+	})
+
+	t.Run("Move and archive by ID", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, BulkCmd(), []string{
+			fmt.Sprintf("%d", first), fmt.Sprintf("%d", untouched), "--column=shipped", "--archive", "--quiet",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d\n%d\n", first, untouched), output)
+
+		var columnID int
+		var archived bool
+		err = db.QueryRowContext(context.Background(),
+			"SELECT column_id, archived_at IS NOT NULL FROM tasks WHERE id = ?", first).Scan(&columnID, &archived)
+		require.NoError(t, err)
+		assert.Equal(t, shippedID, columnID)
+		assert.True(t, archived)
+	})
+}
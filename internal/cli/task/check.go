@@ -0,0 +1,388 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// CheckCmd returns the task check subcommand
+func CheckCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Manage a task's checklist",
+		Long: `Manage the checklist of a task.
+
+A checklist holds the small, ordered steps of a task that don't deserve tasks
+of their own. Items are numbered from 1 in the order they were added; the
+numbers are shown by 'paso task show'. Cards on the board show the progress
+as done/total.
+
+Examples:
+  paso task check add 42 "Write migration"
+  paso task check add 42 "Update docs"
+  paso task check toggle 42 1
+  paso task check remove 42 2
+
+  # Agents can report progress without adding comments
+  paso task check toggle 42 1 --json`,
+	}
+
+	cmd.AddCommand(checkAddCmd())
+	cmd.AddCommand(checkToggleCmd())
+	cmd.AddCommand(checkRemoveCmd())
+
+	return cmd
+}
+
+func checkAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <task_id> <text>",
+		Short: "Add an item to a task's checklist",
+		Long: `Add an item to the end of a task's checklist (max 500 characters).
+
+Examples:
+  paso task check add 42 "Write migration"
+
+  # Quiet mode prints the item number
+  ITEM=$(paso task check add 42 "Update docs" --quiet)
+`,
+		RunE: runCheckAdd,
+		Args: cobra.MinimumNArgs(2),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (item number only)")
+
+	return cmd
+}
+
+func checkToggleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggle <task_id> <item>",
+		Short: "Tick or reopen a checklist item",
+		Long: `Tick an open checklist item, or reopen a ticked one. Items are numbered
+from 1 as shown by 'paso task show'.
+
+Examples:
+  paso task check toggle 42 1
+`,
+		RunE: runCheckToggle,
+		Args: cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (item number only)")
+
+	return cmd
+}
+
+func checkRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <task_id> <item>",
+		Short: "Remove an item from a task's checklist",
+		Long: `Remove an item from a task's checklist. The items after it move up one
+number.
+
+Examples:
+  paso task check remove 42 2
+`,
+		RunE: runCheckRemove,
+		Args: cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (task ID only)")
+
+	return cmd
+}
+
+func runCheckAdd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	text := strings.Join(args[1:], " ")
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	if _, err := cliInstance.App.TaskService.AddChecklistItem(ctx, taskID, text); err != nil {
+		exitIfChecklistError(formatter, taskID, err)
+		if fmtErr := formatter.Error("CHECKLIST_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	checklist, err := cliInstance.App.TaskService.GetChecklist(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	return printChecklistChange(formatter, taskID, checklist, len(checklist), "added")
+}
+
+func runCheckToggle(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, number, err := parseChecklistArgs(args)
+	if err != nil {
+		return err
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+	item := findChecklistItem(ctx, cliInstance, formatter, taskID, number)
+	toggled, err := cliInstance.App.TaskService.ToggleChecklistItem(ctx, item.ID)
+	if err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	checklist, err := cliInstance.App.TaskService.GetChecklist(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	action := "ticked"
+	if !toggled.Done {
+		action = "reopened"
+	}
+	return printChecklistChange(formatter, taskID, checklist, number, action)
+}
+
+func runCheckRemove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, number, err := parseChecklistArgs(args)
+	if err != nil {
+		return err
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+	item := findChecklistItem(ctx, cliInstance, formatter, taskID, number)
+	if err := cliInstance.App.TaskService.RemoveChecklistItem(ctx, item.ID); err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	checklist, err := cliInstance.App.TaskService.GetChecklist(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Printf("%d\n", taskID)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":   true,
+			"task_id":   taskID,
+			"removed":   item.Text,
+			"checklist": checklistJSON(checklist),
+		})
+	}
+
+	done, total := checklistProgress(checklist)
+	fmt.Printf("✓ Removed '%s' from task %d (%d/%d done)\n", item.Text, taskID, done, total)
+	return nil
+}
+
+// parseChecklistArgs parses the task reference and 1-based item number arguments
+func parseChecklistArgs(args []string) (cli.TaskRef, int, error) {
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return cli.TaskRef{}, 0, fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	number, err := strconv.Atoi(args[1])
+	if err != nil || number <= 0 {
+		return cli.TaskRef{}, 0, fmt.Errorf("invalid item number: %s", args[1])
+	}
+	return ref, number, nil
+}
+
+// findChecklistItem returns the numbered item of a task's checklist, exiting
+// when the task or the item does not exist
+func findChecklistItem(ctx context.Context, cliInstance *cli.CLI, formatter *cli.OutputFormatter, taskID, number int) *models.ChecklistItem {
+	if _, err := cliInstance.App.TaskService.GetTaskSummary(ctx, taskID); err != nil {
+		exitIfChecklistError(formatter, taskID, err)
+		if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	checklist, err := cliInstance.App.TaskService.GetChecklist(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("CHECKLIST_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+	if number > len(checklist) {
+		if fmtErr := formatter.ErrorWithSuggestion("ITEM_NOT_FOUND",
+			fmt.Sprintf("task %d has no checklist item %d (it has %d)", taskID, number, len(checklist)),
+			fmt.Sprintf("Use 'paso task show %d' to see the checklist", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+	return checklist[number-1]
+}
+
+// exitIfChecklistError reports a missing task or an invalid item and exits
+func exitIfChecklistError(formatter *cli.OutputFormatter, taskID int, err error) {
+	switch {
+	case errors.Is(err, taskservice.ErrTaskNotFound):
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	case errors.Is(err, taskservice.ErrEmptyChecklistItem), errors.Is(err, taskservice.ErrChecklistItemTooLong):
+		if fmtErr := formatter.Error("INVALID_ITEM", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitValidation)
+	}
+}
+
+// printChecklistChange reports an added or toggled checklist item
+func printChecklistChange(formatter *cli.OutputFormatter, taskID int, checklist []*models.ChecklistItem, number int, action string) error {
+	if formatter.Quiet {
+		fmt.Printf("%d\n", number)
+		return nil
+	}
+
+	if formatter.JSON {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":   true,
+			"task_id":   taskID,
+			"item":      number,
+			"checklist": checklistJSON(checklist),
+		})
+	}
+
+	done, total := checklistProgress(checklist)
+	fmt.Printf("✓ Item %d %s on task %d (%d/%d done)\n", number, action, taskID, done, total)
+	fmt.Printf("  %s\n", formatChecklistItem(number, checklist[number-1]))
+	return nil
+}
+
+// checklistJSON renders a checklist for --json output
+func checklistJSON(checklist []*models.ChecklistItem) map[string]any {
+	items := make([]map[string]any, len(checklist))
+	for i, item := range checklist {
+		items[i] = map[string]any{
+			"number": i + 1,
+			"id":     item.ID,
+			"text":   item.Text,
+			"done":   item.Done,
+		}
+	}
+	done, total := checklistProgress(checklist)
+	return map[string]any{
+		"items": items,
+		"done":  done,
+		"total": total,
+	}
+}
+
+// checklistProgress counts the ticked and total items of a checklist
+func checklistProgress(checklist []*models.ChecklistItem) (int, int) {
+	done := 0
+	for _, item := range checklist {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(checklist)
+}
+
+// formatChecklistItem renders a numbered checklist item as "1. [x] text"
+func formatChecklistItem(number int, item *models.ChecklistItem) string {
+	box := "[ ]"
+	if item.Done {
+		box = "[x]"
+	}
+	return fmt.Sprintf("%d. %s %s", number, box, item.Text)
+}
@@ -0,0 +1,101 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestCheckTask_Positive(t *testing.T) {
+	// Setup test DB and App
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	var columnID int
+	err := db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'",
+		projectID).Scan(&columnID)
+	require.NoError(t, err)
+
+	t.Run("Add, toggle and remove checklist items", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, columnID, "Release")
+		id := strconv.Itoa(taskID)
+
+		output, err := cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"add", id, "Write", "migration"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "✓ Item 1 added")
+		assert.Contains(t, output, "[ ] Write migration")
+
+		output, err = cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"add", id, "Update docs", "--quiet"})
+		require.NoError(t, err)
+		assert.Equal(t, "2\n", output)
+
+		output, err = cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"toggle", id, "1"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "✓ Item 1 ticked")
+		assert.Contains(t, output, "(1/2 done)")
+
+		output, err = cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"remove", id, "2"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "✓ Removed 'Update docs'")
+
+		summary, err := app.TaskService.GetTaskSummary(context.Background(), taskID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.ChecklistDone)
+		assert.Equal(t, 1, summary.ChecklistTotal)
+	})
+
+	t.Run("Toggle in JSON mode", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, columnID, "JSON Task")
+		id := strconv.Itoa(taskID)
+
+		_, err := cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"add", id, "Step one"})
+		require.NoError(t, err)
+
+		output, err := cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"toggle", id, "1", "--json"})
+		require.NoError(t, err)
+
+		var result struct {
+			Success   bool `json:"success"`
+			Item      int  `json:"item"`
+			Checklist struct {
+				Done  int `json:"done"`
+				Total int `json:"total"`
+				Items []struct {
+					Text string `json:"text"`
+					Done bool   `json:"done"`
+				} `json:"items"`
+			} `json:"checklist"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+		assert.True(t, result.Success)
+		assert.Equal(t, 1, result.Item)
+		assert.Equal(t, 1, result.Checklist.Done)
+		assert.Equal(t, 1, result.Checklist.Total)
+		require.Len(t, result.Checklist.Items, 1)
+		assert.Equal(t, "Step one", result.Checklist.Items[0].Text)
+		assert.True(t, result.Checklist.Items[0].Done)
+	})
+
+	t.Run("Show includes the checklist", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, columnID, "Show Task")
+		id := strconv.Itoa(taskID)
+
+		_, err := cli.ExecuteCLICommand(t, app, CheckCmd(), []string{"add", id, "Visible step"})
+		require.NoError(t, err)
+
+		output, err := cli.ExecuteCLICommand(t, app, ShowCmd(), []string{id, "--json"})
+		require.NoError(t, err)
+		assert.Contains(t, output, `"checklist":{`)
+		assert.Contains(t, output, `"text":"Visible step"`)
+	})
+}
@@ -38,7 +38,7 @@ Examples:
 	}
 
 	// Required flags
-	cmd.Flags().Int("id", 0, "Task ID (required)")
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("id"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -60,7 +60,7 @@ Examples:
 func runComment(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	taskID, _ := cmd.Flags().GetInt("id")
+	taskRef, _ := cmd.Flags().GetString("id")
 	message, _ := cmd.Flags().GetString("message")
 	author, _ := cmd.Flags().GetString("author")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
@@ -96,6 +96,8 @@ func runComment(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// Validate task exists
 	taskDetail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
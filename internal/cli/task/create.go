@@ -4,6 +4,7 @@ package task
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -34,34 +35,53 @@ Examples:
   # Quiet mode for bash capture
   TASK_ID=$(paso task create --title="Fix bug" --project=1 --quiet)
 
+  # From a task template, with its subtasks
+  paso task create --template=release --var version=1.4 --project=1
+
   # Full example with all options
   paso task create \
     --title="Add authentication" \
     --description="Implement JWT auth" \
     --type=feature \
     --priority=high \
-    --parent=3 \
+    --parent=API-3 \
+    --due=+3d \
     --project=1
+
+Related tasks (--parent, --blocked-by, --blocks) are given by ID, as #N for
+ticket N of the project or as KEY-N for a project with a ticket key.
+
+Dates accept today, tomorrow, +3d, 2w, friday or 2026-11-01 (optionally
+with a time, 2026-11-01 15:00).
+
+With --template the title, description, type, priority, labels and subtasks
+come from the named task template (see paso task template). Each {{name}} in
+the template needs a --var name=value; --title, --description, --type and
+--priority override the template's.
+
+A column at its WIP limit refuses new tasks (exit code 7) unless --force is
+given.
 `,
 		RunE: handler.Command(&createHandler{}, parseCreateFlags),
 	}
 
 	// Required flags
-	cmd.Flags().String("title", "", "Task title (required)")
-	if err := cmd.MarkFlagRequired("title"); err != nil {
-		slog.Error("failed to marking flag as required", "error", err)
-	}
-
+	cmd.Flags().String("title", "", "Task title (required unless --template is given)")
 	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
 
 	// Optional flags
 	cmd.Flags().String("description", "", "Task description (use - for stdin)")
-	cmd.Flags().String("type", "task", "Task type: task or feature")
-	cmd.Flags().String("priority", "medium", "Priority: trivial, low, medium, high, critical")
-	cmd.Flags().Int("parent", 0, "Parent task ID (creates dependency)")
-	cmd.Flags().Int("blocked-by", 0, "Task ID that blocks this task")
-	cmd.Flags().Int("blocks", 0, "Task ID that is blocked by this task")
+	cmd.Flags().String("type", "", "Task type, one of the project's types (see paso type list; defaults to the first)")
+	cmd.Flags().String("priority", "", "Priority, one of the project's priorities (see paso priority list; defaults to the middle one)")
+	cmd.Flags().String("parent", "", "Parent task ID, #N or KEY-N (creates dependency)")
+	cmd.Flags().String("blocked-by", "", "Task ID, #N or KEY-N of the task that blocks this task")
+	cmd.Flags().String("blocks", "", "Task ID, #N or KEY-N of the task that is blocked by this task")
 	cmd.Flags().String("column", "", "Column name (defaults to first column)")
+	cmd.Flags().String("due", "", "Due date (e.g. tomorrow, +3d, 2026-11-01)")
+	cmd.Flags().String("start", "", "Start date (e.g. today, +1w, 2026-10-20)")
+	cmd.Flags().String("template", "", "Task template to create the task and its subtasks from")
+	cmd.Flags().StringArray("var", nil, "Template variable as name=value (repeatable)")
+	addForceFlag(cmd)
 
 	// Agent-friendly flags (REQUIRED on all commands)
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -76,17 +96,26 @@ type createHandler struct{}
 // Execute implements the Handler interface
 func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (any, error) {
 	// Get flag values from arguments
-	taskTitle := args.MustGetString("title")
+	taskTitle := args.GetString("title", "")
 	taskDescription := args.GetString("description", "")
-	taskType := args.GetString("type", "task")
-	taskPriority := args.GetString("priority", "medium")
-	taskParent := args.GetInt("parent", 0)
-	taskBlockedBy := args.GetInt("blocked-by", 0)
-	taskBlocks := args.GetInt("blocks", 0)
+	taskType := args.GetString("type", "")
+	taskPriority := args.GetString("priority", "")
+	parentRef := args.GetString("parent", "")
+	blockedByRef := args.GetString("blocked-by", "")
+	blocksRef := args.GetString("blocks", "")
 	taskColumn := args.GetString("column", "")
+	taskDue := args.GetString("due", "")
+	taskStart := args.GetString("start", "")
+	taskTemplate := args.GetString("template", "")
 
 	// Get project ID from flag or environment variable
 	cmd := args.GetCmd()
+	rawVars, _ := cmd.Flags().GetStringArray("var")
+	vars, err := parseTemplateVars(rawVars)
+	if err != nil {
+		return nil, err
+	}
+
 	taskProject, err := cli.GetProjectID(cmd)
 	if err != nil {
 		return nil, fmt.Errorf("no project specified: use --project flag or set with 'eval $(paso use project <project-id>)'")
@@ -109,6 +138,20 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		return nil, fmt.Errorf("project %d not found", taskProject)
 	}
 
+	// Related tasks; #N refers to a ticket of the task's project
+	taskParent, err := resolveTaskFlag(ctx, cliInstance, "parent", parentRef, taskProject)
+	if err != nil {
+		return nil, err
+	}
+	taskBlockedBy, err := resolveTaskFlag(ctx, cliInstance, "blocked-by", blockedByRef, taskProject)
+	if err != nil {
+		return nil, err
+	}
+	taskBlocks, err := resolveTaskFlag(ctx, cliInstance, "blocks", blocksRef, taskProject)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get columns for project
 	columns, err := cliInstance.App.ColumnService.GetColumnsByProject(ctx, taskProject)
 	if err != nil {
@@ -140,18 +183,35 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		description = string(data)
 	}
 
-	// Parse type
-	typeID, err := cli.ParseTaskType(taskType)
+	// Parse type and priority against the project's own
+	types, err := cliInstance.App.TypeService.GetTypesByProject(ctx, taskProject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch types: %w", err)
+	}
+	typeID, err := cli.ParseTaskType(types, taskType)
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse priority
-	priorityID, err := cli.ParsePriority(taskPriority)
+	priorities, err := cliInstance.App.PriorityService.GetPrioritiesByProject(ctx, taskProject)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch priorities: %w", err)
+	}
+	priorityID, err := cli.ParsePriority(priorities, taskPriority)
 	if err != nil {
 		return nil, err
 	}
 
+	// Parse dates
+	dueAt, err := parseDateFlag(taskDue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --due: %w", err)
+	}
+	startAt, err := parseDateFlag(taskStart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --start: %w", err)
+	}
+
 	// Create task with all parameters
 	// Position set to DefaultTaskPosition to append to end (will be adjusted if needed)
 	req := taskservice.CreateTaskRequest{
@@ -161,6 +221,8 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		Position:    models.DefaultTaskPosition,
 		PriorityID:  priorityID,
 		TypeID:      typeID,
+		DueAt:       dueAt,
+		StartAt:     startAt,
 	}
 
 	// Add parent relationship if specified
@@ -176,11 +238,39 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		req.BlocksIDs = []int{taskBlocks}
 	}
 
-	task, err := cliInstance.App.TaskService.CreateTask(ctx, req)
+	var task *models.Task
+	var childIDs []int
+	if taskTemplate != "" {
+		template, templateErr := cliInstance.App.TaskService.GetTaskTemplate(ctx, taskProject, taskTemplate)
+		if templateErr != nil {
+			if errors.Is(templateErr, taskservice.ErrTaskTemplateNotFound) {
+				return nil, fmt.Errorf("task template '%s' not found (see paso task template list)", taskTemplate)
+			}
+			return nil, fmt.Errorf("failed to fetch task template: %w", templateErr)
+		}
+		task, childIDs, err = cliInstance.App.TaskService.CreateTaskFromTemplate(wipContext(ctx, cmd), template, vars, req)
+	} else {
+		task, err = cliInstance.App.TaskService.CreateTask(wipContext(ctx, cmd), req)
+	}
 	if err != nil {
+		jsonOutput, _ := cmd.Flags().GetBool("json")
+		quietMode, _ := cmd.Flags().GetBool("quiet")
+		exitIfWIPLimit(&cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}, err)
 		return nil, fmt.Errorf("task creation error: %w", err)
 	}
 
+	// Report the names the task ended up with, defaults included
+	for _, t := range types {
+		if t.ID == task.TypeID {
+			taskType = t.Description
+		}
+	}
+	for _, p := range priorities {
+		if p.ID == task.PriorityID {
+			taskPriority = p.Description
+		}
+	}
+
 	return &taskCreateResult{
 		ID:          task.ID,
 		Title:       task.Title,
@@ -188,6 +278,10 @@ func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (a
 		Project:     project.Name,
 		Type:        taskType,
 		Priority:    taskPriority,
+		DueAt:       formatDate(task.DueAt),
+		StartAt:     formatDate(task.StartAt),
+		Template:    taskTemplate,
+		Subtasks:    childIDs,
 		CreatedAt:   task.CreatedAt.String(),
 	}, nil
 }
@@ -200,6 +294,10 @@ type taskCreateResult struct {
 	Project     string
 	Type        string
 	Priority    string
+	DueAt       string `json:",omitempty"`
+	StartAt     string `json:",omitempty"`
+	Template    string `json:",omitempty"`
+	Subtasks    []int  `json:",omitempty"`
 	CreatedAt   string
 }
 
@@ -211,8 +309,43 @@ func (r *taskCreateResult) GetID() int {
 func parseCreateFlags(cmd *cobra.Command) error {
 	// Validate required flags
 	title, _ := cmd.Flags().GetString("title")
-	if strings.TrimSpace(title) == "" {
+	template, _ := cmd.Flags().GetString("template")
+	if strings.TrimSpace(title) == "" && template == "" {
 		return fmt.Errorf("title is required")
 	}
+	vars, _ := cmd.Flags().GetStringArray("var")
+	if len(vars) > 0 && template == "" {
+		return fmt.Errorf("--var requires --template")
+	}
 	return nil
 }
+
+// resolveTaskFlag resolves the task reference given to a flag, 0 when the
+// flag is not set
+func resolveTaskFlag(ctx context.Context, cliInstance *cli.CLI, flag, value string, projectID int) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	ref, err := cli.ParseTaskRef(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+	taskID, err := cliInstance.ResolveTaskRef(ctx, ref, projectID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s: %w", flag, err)
+	}
+	return taskID, nil
+}
+
+// parseTemplateVars parses name=value pairs given with --var
+func parseTemplateVars(pairs []string) (map[string]string, error) {
+	vars := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid --var '%s' (expected name=value)", pair)
+		}
+		vars[strings.TrimSpace(name)] = value
+	}
+	return vars, nil
+}
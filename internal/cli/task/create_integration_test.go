@@ -2,10 +2,13 @@ package task
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/testutil/cli"
 )
 
@@ -83,4 +86,35 @@ func TestCreateTask_Positive(t *testing.T) {
 		assert.Equal(t, "feature", taskType)
 		assert.Equal(t, columnID, dbColumnID)
 	})
+
+	// Test creating a task and its subtasks from a template
+	t.Run("Create task from template", func(t *testing.T) {
+		ctx := context.Background()
+		err := app.TaskService.SaveTaskTemplate(ctx, projectID, &models.TaskTemplate{
+			Name:     "release",
+			Title:    "Release {{version}}",
+			Priority: "high",
+			Children: []models.TaskTemplateChild{{Title: "Tag v{{version}}"}, {Title: "Announce {{version}}"}},
+		}, false)
+		require.NoError(t, err)
+
+		output, err := cli.ExecuteCLICommand(t, app, CreateCmd(), []string{
+			"--project", "1",
+			"--template", "release",
+			"--var", "version=1.4",
+			"--quiet",
+		})
+		require.NoError(t, err)
+
+		taskID, err := strconv.Atoi(strings.TrimSpace(output))
+		require.NoError(t, err)
+
+		detail, err := app.TaskService.GetTaskDetail(ctx, taskID)
+		require.NoError(t, err)
+		assert.Equal(t, "Release 1.4", detail.Title)
+		assert.Equal(t, "high", detail.PriorityDescription)
+		require.Len(t, detail.ChildTasks, 2)
+		titles := []string{detail.ChildTasks[0].Title, detail.ChildTasks[1].Title}
+		assert.ElementsMatch(t, []string{"Tag v1.4", "Announce 1.4"}, titles)
+	})
 }
@@ -20,7 +20,7 @@ func DeleteCmd() *cobra.Command {
 		RunE:  runDelete,
 	}
 
-	cmd.Flags().Int("id", 0, "Task ID (required)")
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("id"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -36,7 +36,7 @@ func DeleteCmd() *cobra.Command {
 func runDelete(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	taskID, _ := cmd.Flags().GetInt("id")
+	taskRef, _ := cmd.Flags().GetString("id")
 	force, _ := cmd.Flags().GetBool("force")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
@@ -57,6 +57,8 @@ func runDelete(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// Get task details for confirmation
 	task, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
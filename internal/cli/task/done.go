@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -38,6 +37,7 @@ Examples:
 	}
 
 	// Agent-friendly flags
+	addForceFlag(cmd)
 	cmd.Flags().Bool("json", false, "Output in JSON format")
 	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
 
@@ -45,10 +45,10 @@ Examples:
 }
 
 func runDone(cmd *cobra.Command, args []string) error {
-	ctx := cmd.Context()
+	ctx := wipContext(cmd.Context(), cmd)
 
 	// Parse task ID from positional argument
-	taskID, err := strconv.Atoi(args[0])
+	ref, err := cli.ParseTaskRef(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid task ID: %s", args[0])
 	}
@@ -72,6 +72,8 @@ func runDone(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
 	// Get task detail before move for output
 	taskDetail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
@@ -104,6 +106,7 @@ func runDone(cmd *cobra.Command, args []string) error {
 			}
 			os.Exit(cli.ExitValidation)
 		}
+		exitIfWIPLimit(formatter, err)
 		if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
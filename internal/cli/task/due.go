@@ -0,0 +1,214 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/dates"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// DueCmd returns the task due subcommand
+func DueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "due",
+		Short: "List tasks with a due date",
+		Long: `List open tasks that have a due date, soonest first.
+
+Completed tasks are left out. Without flags every open task with a due date
+is listed. --overdue and --within can be combined to list both.
+
+Examples:
+  # Everything past its due date
+  paso task due --overdue --project=1
+
+  # Due within the next week
+  paso task due --within 7d
+
+  # JSON output for agents
+  paso task due --overdue --within 3d --json
+
+  # Narrow down with a filter query (see 'paso task list --help')
+  paso task due --within 2w --filter='label:backend'
+`,
+		RunE: runDue,
+	}
+
+	// Flags
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().Bool("overdue", false, "Only tasks past their due date")
+	cmd.Flags().String("within", "", "Only tasks due within a window, e.g. 7d, 2w, 12h")
+	cmd.Flags().String("filter", "", filterFlagHelp)
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func runDue(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	overdue, _ := cmd.Flags().GetBool("overdue")
+	within, _ := cmd.Flags().GetString("within")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	var window time.Duration
+	if within != "" {
+		var err error
+		if window, err = dates.ParseWindow(within); err != nil {
+			if fmtErr := formatter.ErrorWithSuggestion("INVALID_WINDOW",
+				err.Error(),
+				"Use a number of hours, days or weeks, e.g. --within 7d"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+	}
+
+	query := resolveFilter(cmd, formatter, "-is:done")
+
+	// Get project ID from flag or environment variable
+	taskProject, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	// Validate project exists
+	_, err = cliInstance.App.ProjectService.GetProjectByID(ctx, taskProject)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("PROJECT_NOT_FOUND",
+			fmt.Sprintf("project %d not found", taskProject),
+			"Use 'paso project list' to see available projects"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	tasks, err := cliInstance.App.TaskService.GetTaskSummariesByFilter(ctx, taskProject, query)
+	if err != nil {
+		if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	now := time.Now()
+	dueTasks := filterDue(tasks, now, overdue, window)
+
+	// Output in appropriate format
+	if quietMode {
+		for _, t := range dueTasks {
+			fmt.Printf("%d\n", t.ID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"tasks":   dueTasks,
+			"count":   len(dueTasks),
+		})
+	}
+
+	// Human-readable output
+	if len(dueTasks) == 0 {
+		fmt.Println("No tasks due")
+		return nil
+	}
+
+	fmt.Printf("Found %d tasks due:\n\n", len(dueTasks))
+	for _, t := range dueTasks {
+		status := ""
+		if dates.DueState(t.DueAt, now) == dates.StateOverdue {
+			status = " (OVERDUE)"
+		}
+		fmt.Printf("  [%d] %s - due %s, %s%s\n", t.ID, t.Title, dates.Relative(*t.DueAt, now), dates.Format(*t.DueAt), status)
+	}
+
+	return nil
+}
+
+// filterDue keeps the tasks with a due date, sorted soonest first.
+// With overdue and/or a window only the overdue tasks and/or the tasks due
+// within the window are kept.
+func filterDue(tasks []*models.TaskSummary, now time.Time, overdue bool, window time.Duration) []*models.TaskSummary {
+	result := make([]*models.TaskSummary, 0, len(tasks))
+	for _, t := range tasks {
+		if t.DueAt == nil {
+			continue
+		}
+		isOverdue := dates.DueState(t.DueAt, now) == dates.StateOverdue
+		isWithin := !isOverdue && t.DueAt.Before(now.Add(window))
+		if (overdue || window > 0) && !(overdue && isOverdue) && !(window > 0 && isWithin) {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].DueAt.Before(*result[j].DueAt)
+	})
+	return result
+}
+
+// parseDateFlag parses a --due/--start value relative to now.
+// An empty value means no date and yields nil.
+func parseDateFlag(value string) (*time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return nil, nil
+	}
+	t, err := dates.Parse(value, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// isNoDate reports whether a --due/--start value asks to remove the date
+func isNoDate(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "none", "clear":
+		return true
+	}
+	return false
+}
+
+// formatDate renders an optional date for output, "" when unset
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return dates.Format(*t)
+}
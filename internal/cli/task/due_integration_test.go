@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestDueTask_Positive(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	var todoColumnID, doneColumnID int
+	require.NoError(t, db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'", projectID).Scan(&todoColumnID))
+	require.NoError(t, db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Done'", projectID).Scan(&doneColumnID))
+	_, err := db.ExecContext(context.Background(),
+		"UPDATE columns SET holds_completed_tasks = 1 WHERE id = ?", doneColumnID)
+	require.NoError(t, err)
+
+	setDue := func(taskID int, due string) {
+		t.Helper()
+		_, err := cli.ExecuteCLICommand(t, app, UpdateCmd(),
+			[]string{"--id", strconv.Itoa(taskID), "--due", due})
+		require.NoError(t, err)
+	}
+
+	overdueID := cli.CreateTestTask(t, db, todoColumnID, "Overdue Task")
+	setDue(overdueID, "-2d")
+	soonID := cli.CreateTestTask(t, db, todoColumnID, "Soon Task")
+	setDue(soonID, "+2d")
+	laterID := cli.CreateTestTask(t, db, todoColumnID, "Later Task")
+	setDue(laterID, "+30d")
+	_ = cli.CreateTestTask(t, db, todoColumnID, "Undated Task")
+	doneID := cli.CreateTestTask(t, db, doneColumnID, "Finished Task")
+	setDue(doneID, "-5d")
+
+	project := strconv.Itoa(projectID)
+
+	t.Run("Lists open tasks with a due date soonest first", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, DueCmd(), []string{"--project", project})
+		require.NoError(t, err)
+
+		assert.Contains(t, output, "Found 3 tasks due")
+		assert.NotContains(t, output, "Undated Task")
+		assert.NotContains(t, output, "Finished Task")
+		assert.Less(t, strings.Index(output, "Overdue Task"), strings.Index(output, "Soon Task"))
+		assert.Less(t, strings.Index(output, "Soon Task"), strings.Index(output, "Later Task"))
+	})
+
+	t.Run("Overdue only", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, DueCmd(), []string{"--project", project, "--overdue", "--quiet"})
+		require.NoError(t, err)
+		assert.Equal(t, strconv.Itoa(overdueID), strings.TrimSpace(output))
+	})
+
+	t.Run("Within a window", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, DueCmd(), []string{"--project", project, "--within", "7d", "--json"})
+		require.NoError(t, err)
+
+		var result struct {
+			Count int
+			Tasks []struct{ ID int }
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+		require.Equal(t, 1, result.Count)
+		assert.Equal(t, soonID, result.Tasks[0].ID)
+	})
+
+	t.Run("Overdue and within combine", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, DueCmd(), []string{"--project", project, "--overdue", "--within", "7d", "--quiet"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{strconv.Itoa(overdueID), strconv.Itoa(soonID)}, strings.Fields(output))
+	})
+
+	t.Run("Clearing a due date removes the task", func(t *testing.T) {
+		setDue(laterID, "none")
+
+		output, err := cli.ExecuteCLICommand(t, app, DueCmd(), []string{"--project", project, "--quiet"})
+		require.NoError(t, err)
+		assert.NotContains(t, strings.Fields(output), strconv.Itoa(laterID))
+	})
+}
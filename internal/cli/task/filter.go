@@ -0,0 +1,33 @@
+package task
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/filter"
+)
+
+// filterFlagHelp is the shared help text for the --filter flag
+const filterFlagHelp = `Filter query, e.g. 'priority>=high label:backend is:blocked type:bug column:"In Progress" updated:<7d'`
+
+// resolveFilter combines a command's implicit terms (e.g. "is:ready") with the
+// user's --filter flag and validates the result before any database work.
+// A malformed query exits with ExitValidation.
+func resolveFilter(cmd *cobra.Command, formatter *cli.OutputFormatter, implicit string) string {
+	userFilter, _ := cmd.Flags().GetString("filter")
+	query := strings.TrimSpace(implicit + " " + userFilter)
+
+	if _, err := filter.Parse(query); err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_FILTER",
+			err.Error(),
+			"Fields: priority type label column is created updated epic. Example: --filter='priority>=high is:blocked'"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitValidation)
+	}
+
+	return query
+}
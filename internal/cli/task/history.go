@@ -0,0 +1,146 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// HistoryCmd returns the task history subcommand
+func HistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [id]",
+		Short: "Show the activity log of a task",
+		Long: `Show who changed a task and when, newest first.
+
+Every mutation made through paso (create, edit, move, reorder, labels,
+relations, comments, delete) is recorded. History is kept after a task is
+deleted, so the log of a removed task can still be inspected.
+
+Set PASO_ACTOR to attribute changes to something other than the current
+user, e.g. an agent name.
+
+Examples:
+  # Show history of task #42
+  paso task history 42
+
+  # JSON output for agents
+  paso task history --id=42 --json
+
+  # Quiet mode: one event type per line
+  paso task history 42 --quiet
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runHistory,
+	}
+
+	// Flags
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (can also be provided as positional argument)")
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (event types only)")
+
+	return cmd
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	// Parse task reference from positional arg or flag
+	refArg, _ := cmd.Flags().GetString("id")
+	if len(args) > 0 {
+		refArg = args[0]
+	}
+	ref, refErr := cli.ParseTaskRef(refArg)
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	// Validate task reference
+	if refErr != nil || !ref.Valid() {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_TASK_ID",
+			"task must be a positive task ID, #N or KEY-N",
+			"Usage: paso task history <id> or paso task history --id=<id>"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+		return nil
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	events, err := cliInstance.App.TaskService.GetTaskHistory(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("HISTORY_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	// A task that never existed has no history at all, not even "created"
+	if len(events) == 0 {
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("no history for task %d", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+		return nil
+	}
+
+	// Output in appropriate format
+	if quietMode {
+		for _, e := range events {
+			fmt.Println(e.EventType)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		eventList := make([]map[string]any, 0, len(events))
+		for _, e := range events {
+			eventList = append(eventList, map[string]any{
+				"id":         e.ID,
+				"event_type": e.EventType,
+				"field":      e.Field,
+				"old_value":  e.OldValue,
+				"new_value":  e.NewValue,
+				"actor":      e.Actor,
+				"created_at": e.CreatedAt,
+			})
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"task_id": taskID,
+			"events":  eventList,
+		})
+	}
+
+	// Human-readable output
+	fmt.Printf("History for task %d (%d events):\n\n", taskID, len(events))
+	for _, e := range events {
+		fmt.Printf("  %s  %-12s %s\n",
+			e.CreatedAt.Format("2006-01-02 15:04"),
+			e.Actor,
+			e.Summary())
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestHistoryTask_Positive(t *testing.T) {
+	// Setup test DB and App
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	var columnID int
+	err := db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'",
+		projectID).Scan(&columnID)
+	require.NoError(t, err)
+
+	// Create and edit the task through the service so the changes are logged
+	task, err := app.TaskService.CreateTask(context.Background(), taskservice.CreateTaskRequest{
+		Title:    "Original Title",
+		ColumnID: columnID,
+	})
+	require.NoError(t, err)
+
+	newTitle := "Renamed Title"
+	require.NoError(t, app.TaskService.UpdateTask(context.Background(), taskservice.UpdateTaskRequest{
+		TaskID: task.ID,
+		Title:  &newTitle,
+	}))
+
+	t.Run("Human output", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, HistoryCmd(), []string{strconv.Itoa(task.ID)})
+
+		require.NoError(t, err)
+		assert.Contains(t, output, "updated title: Original Title → Renamed Title")
+		assert.Contains(t, output, "created: Original Title")
+	})
+
+	t.Run("JSON output", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, HistoryCmd(), []string{
+			"--id", strconv.Itoa(task.ID),
+			"--json",
+		})
+		require.NoError(t, err)
+
+		var result struct {
+			Success bool `json:"success"`
+			TaskID  int  `json:"task_id"`
+			Events  []struct {
+				EventType string `json:"event_type"`
+				Field     string `json:"field"`
+				OldValue  string `json:"old_value"`
+				NewValue  string `json:"new_value"`
+				Actor     string `json:"actor"`
+			} `json:"events"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+
+		assert.True(t, result.Success)
+		assert.Equal(t, task.ID, result.TaskID)
+		require.Len(t, result.Events, 2)
+		assert.Equal(t, "updated", result.Events[0].EventType)
+		assert.Equal(t, "title", result.Events[0].Field)
+		assert.Equal(t, "created", result.Events[1].EventType)
+		assert.NotEmpty(t, result.Events[0].Actor)
+	})
+
+	t.Run("Quiet output", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, HistoryCmd(), []string{
+			strconv.Itoa(task.ID),
+			"--quiet",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"updated", "created"}, strings.Fields(output))
+	})
+}
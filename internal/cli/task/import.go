@@ -0,0 +1,482 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/app"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	labelservice "github.com/thenoetrevino/paso/internal/services/label"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	"github.com/thenoetrevino/paso/internal/taskimport"
+)
+
+// defaultImportLabelColor is used for labels created by --create-labels
+const defaultImportLabelColor = "#7D56F4"
+
+// ImportCmd returns the task import subcommand
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <file>",
+		Short: "Import tasks from a markdown checklist, CSV or GitHub issues",
+		Long: `Create tasks from an existing TODO list or issue dump.
+
+Formats:
+  markdown  Checklist items (- [ ] ..., - [x] ...). Nested items become
+            child tasks of the item they are indented under.
+  csv       A header row, then one task per row. Columns named title,
+            description, type, priority, column (or status), labels (or tags)
+            and done are picked up; map others with --map field=Header.
+  github    A JSON array of issues from the GitHub API or
+            'gh issue list --json number,title,body,labels,state,url'.
+            Labels like "high" or "priority: high" set the priority.
+
+The format is taken from the file extension (.md, .csv, .json) unless
+--format is given. Use - to read from stdin.
+
+Every item is mapped onto the project's columns, labels, types and priorities
+before anything is created; if one doesn't match, nothing is imported.
+Checked/closed/done items go to the column holding completed tasks. Labels
+that don't exist yet are an error unless --create-labels is given.
+
+Examples:
+  # Preview what would be created
+  paso task import TODO.md --project=1 --dry-run
+
+  # Import a spreadsheet export with custom headers
+  paso task import tasks.csv --project=1 --map title="Issue Summary" --map labels=Components
+
+  # Import GitHub issues, creating missing labels
+  gh issue list --state all --json number,title,body,labels,state,url | \
+    paso task import - --format=github --project=1 --create-labels
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runImport,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("format", "", "Input format: markdown, csv or github (default: from file extension)")
+	cmd.Flags().String("column", "", "Column for items that don't name one (defaults to first column)")
+	cmd.Flags().String("type", "", "Type for items that don't name one (defaults to the project's default type)")
+	cmd.Flags().String("priority", "", "Priority for items that don't name one (defaults to the project's default priority)")
+	cmd.Flags().StringArray("map", nil, "CSV column mapping field=Header (repeatable)")
+	cmd.Flags().Bool("create-labels", false, "Create labels that don't exist in the project")
+	cmd.Flags().Bool("dry-run", false, "Show what would be imported without creating anything")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs only)")
+
+	return cmd
+}
+
+// importedTask is one task of an import plan. ID is set once it is created.
+type importedTask struct {
+	ID       int `json:",omitempty"`
+	Title    string
+	Column   string
+	Type     string
+	Priority string
+	Labels   []string         `json:",omitempty"`
+	Children []*importedTask  `json:",omitempty"`
+	item     *taskimport.Item // source item
+	columnID int
+	typeID   int
+	priority int
+}
+
+// taskImportResult represents the result of a task import
+type taskImportResult struct {
+	Project   string
+	DryRun    bool
+	Count     int
+	NewLabels []string `json:",omitempty"`
+	Tasks     []*importedTask
+}
+
+// importResolver maps parsed items onto a project
+type importResolver struct {
+	columns         []*models.Column
+	defaultColumn   *models.Column
+	labels          map[string]*models.Label // by lower-case name
+	newLabels       []string
+	createLabels    bool
+	types           []*models.Type
+	priorities      []*models.Priority
+	defaultType     string
+	defaultPriority string
+	problems        []string
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	formatFlag, _ := cmd.Flags().GetString("format")
+	columnName, _ := cmd.Flags().GetString("column")
+	defaultType, _ := cmd.Flags().GetString("type")
+	defaultPriority, _ := cmd.Flags().GetString("priority")
+	mappings, _ := cmd.Flags().GetStringArray("map")
+	createLabels, _ := cmd.Flags().GetBool("create-labels")
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+	if dryRun {
+		ctx = cli.WithoutRecurringTasks(ctx)
+	}
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	fail := func(code, message, suggestion string, exitCode int) {
+		if fmtErr := formatter.ErrorWithSuggestion(code, message, suggestion); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode)
+	}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		fail("NO_PROJECT", err.Error(),
+			"Set default project with: eval $(paso use project <project-id>)", cli.ExitUsage)
+	}
+
+	mapping := make(map[string]string, len(mappings))
+	for _, m := range mappings {
+		field, header, ok := strings.Cut(m, "=")
+		if !ok || strings.TrimSpace(field) == "" || strings.TrimSpace(header) == "" {
+			fail("INVALID_MAPPING", fmt.Sprintf("invalid --map '%s'", m), "Use --map field=Header, e.g. --map title=Summary", cli.ExitUsage)
+		}
+		mapping[strings.TrimSpace(field)] = strings.TrimSpace(header)
+	}
+
+	path := args[0]
+	var format taskimport.Format
+	if formatFlag != "" {
+		format, err = taskimport.ParseFormat(formatFlag)
+	} else if path == "-" {
+		err = fmt.Errorf("cannot tell the format of stdin; use --format")
+	} else {
+		format, err = taskimport.DetectFormat(path)
+	}
+	if err != nil {
+		fail("INVALID_FORMAT", err.Error(), "", cli.ExitUsage)
+	}
+
+	var input io.Reader = cmd.InOrStdin()
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			fail("FILE_NOT_FOUND", err.Error(), "", cli.ExitNotFound)
+		}
+		defer func() { _ = file.Close() }()
+		input = file
+	}
+
+	items, err := taskimport.Parse(input, format, mapping)
+	if err != nil {
+		if errors.Is(err, taskimport.ErrInvalidInput) {
+			fail("INVALID_INPUT", err.Error(), "", cli.ExitDataErr)
+		}
+		fail("READ_ERROR", err.Error(), "", cli.ExitDataErr)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		fail("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID), "", cli.ExitNotFound)
+	}
+
+	columns, err := cliInstance.App.ColumnService.GetColumnsByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch columns: %w", err)
+	}
+	if len(columns) == 0 {
+		fail("NO_COLUMNS", "project has no columns", "Create one with: paso column create", cli.ExitValidation)
+	}
+
+	labels, err := cliInstance.App.LabelService.GetLabelsByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch labels: %w", err)
+	}
+
+	types, err := cliInstance.App.TypeService.GetTypesByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch types: %w", err)
+	}
+	if _, err := cli.ParseTaskType(types, defaultType); err != nil {
+		fail("INVALID_TYPE", err.Error(), "", cli.ExitUsage)
+	}
+
+	priorities, err := cliInstance.App.PriorityService.GetPrioritiesByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch priorities: %w", err)
+	}
+	if _, err := cli.ParsePriority(priorities, defaultPriority); err != nil {
+		fail("INVALID_PRIORITY", err.Error(), "", cli.ExitUsage)
+	}
+
+	// Items without a type or priority get the project's defaults, the same
+	// ones task create picks
+	if defaultType == "" && len(types) > 0 {
+		defaultType = types[0].Description
+	}
+	if defaultPriority == "" && len(priorities) > 0 {
+		defaultPriority = priorities[len(priorities)/2].Description
+	}
+
+	resolver := &importResolver{
+		columns:         columns,
+		defaultColumn:   columns[0],
+		labels:          make(map[string]*models.Label, len(labels)),
+		createLabels:    createLabels,
+		types:           types,
+		priorities:      priorities,
+		defaultType:     defaultType,
+		defaultPriority: defaultPriority,
+	}
+	for _, label := range labels {
+		resolver.labels[strings.ToLower(label.Name)] = label
+	}
+	if columnName != "" {
+		col, err := cli.FindColumnByName(columns, columnName)
+		if err != nil {
+			fail("COLUMN_NOT_FOUND", err.Error(),
+				fmt.Sprintf("Available columns: %s", cli.FormatAvailableColumns(columns)), cli.ExitNotFound)
+		}
+		resolver.defaultColumn = col
+	}
+
+	plan := resolver.resolveAll(items)
+	if len(resolver.problems) > 0 {
+		fail("INVALID_INPUT", "cannot import:\n  "+strings.Join(resolver.problems, "\n  "), "", cli.ExitValidation)
+	}
+
+	result := &taskImportResult{
+		Project:   project.Name,
+		DryRun:    dryRun,
+		Count:     taskimport.Count(items),
+		NewLabels: resolver.newLabels,
+		Tasks:     plan,
+	}
+
+	if !dryRun {
+		if err := createImport(ctx, cliInstance.App, projectID, resolver, plan); err != nil {
+			if fmtErr := formatter.Error("IMPORT_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+	}
+
+	if quietMode {
+		if !dryRun {
+			walkImport(plan, 0, func(task *importedTask, _ int) { fmt.Printf("%d\n", task.ID) })
+		}
+		return nil
+	}
+	if jsonOutput {
+		return formatter.Success(result)
+	}
+
+	if dryRun {
+		fmt.Printf("Would import %d tasks into project '%s':\n", result.Count, result.Project)
+	} else {
+		fmt.Printf("✓ Imported %d tasks into project '%s'\n", result.Count, result.Project)
+	}
+	if len(result.NewLabels) > 0 {
+		verb := "Created"
+		if dryRun {
+			verb = "Would create"
+		}
+		fmt.Printf("  %s labels: %s\n", verb, strings.Join(result.NewLabels, ", "))
+	}
+	walkImport(plan, 0, func(task *importedTask, depth int) {
+		id := ""
+		if task.ID > 0 {
+			id = fmt.Sprintf("%d ", task.ID)
+		}
+		labels := ""
+		if len(task.Labels) > 0 {
+			labels = " #" + strings.Join(task.Labels, " #")
+		}
+		fmt.Printf("  %s%s%s [%s] (%s, %s)%s\n",
+			strings.Repeat("  ", depth), id, task.Title, task.Column, task.Type, task.Priority, labels)
+	})
+	return nil
+}
+
+// resolveAll maps items onto the project, recording every problem found
+func (r *importResolver) resolveAll(items []*taskimport.Item) []*importedTask {
+	tasks := make([]*importedTask, 0, len(items))
+	for _, item := range items {
+		tasks = append(tasks, r.resolve(item))
+	}
+	return tasks
+}
+
+func (r *importResolver) resolve(item *taskimport.Item) *importedTask {
+	where := fmt.Sprintf("'%s' (line %d)", item.Title, item.Line)
+	task := &importedTask{
+		Title:    item.Title,
+		Type:     strings.ToLower(item.Type),
+		Priority: strings.ToLower(item.Priority),
+		item:     item,
+	}
+
+	if len(task.Title) > 255 {
+		r.problems = append(r.problems, fmt.Sprintf("%s: title is longer than 255 characters", where))
+	}
+
+	column := r.defaultColumn
+	switch {
+	case item.Column != "":
+		col, err := cli.FindColumnByName(r.columns, item.Column)
+		if err != nil {
+			r.problems = append(r.problems, fmt.Sprintf("%s: %v (available: %s)",
+				where, err, cli.FormatAvailableColumns(r.columns)))
+		} else {
+			column = col
+		}
+	case item.Done:
+		column = nil
+		for _, col := range r.columns {
+			if col.HoldsCompletedTasks {
+				column = col
+				break
+			}
+		}
+		if column == nil {
+			r.problems = append(r.problems, fmt.Sprintf("%s: item is done but no column holds completed tasks", where))
+			column = r.defaultColumn
+		}
+	}
+	task.Column = column.Name
+	task.columnID = column.ID
+
+	if task.Type == "" {
+		task.Type = r.defaultType
+	}
+	typeID, err := cli.ParseTaskType(r.types, task.Type)
+	if err != nil {
+		r.problems = append(r.problems, fmt.Sprintf("%s: %v", where, err))
+	}
+	task.typeID = typeID
+
+	if task.Priority == "" {
+		task.Priority = r.defaultPriority
+	}
+	priorityID, err := cli.ParsePriority(r.priorities, task.Priority)
+	if err != nil {
+		r.problems = append(r.problems, fmt.Sprintf("%s: %v", where, err))
+	}
+	task.priority = priorityID
+
+	for _, name := range item.Labels {
+		key := strings.ToLower(name)
+		if label, ok := r.labels[key]; ok {
+			if label != nil {
+				name = label.Name
+			}
+		} else if r.createLabels {
+			r.labels[key] = nil // created before the tasks
+			r.newLabels = append(r.newLabels, name)
+		} else {
+			r.problems = append(r.problems, fmt.Sprintf("%s: label '%s' does not exist (use --create-labels)", where, name))
+		}
+		task.Labels = append(task.Labels, name)
+	}
+
+	task.Children = r.resolveAll(item.Children)
+	return task
+}
+
+// createImport creates the new labels, then the tasks parent-first
+func createImport(ctx context.Context, a *app.App, projectID int, resolver *importResolver, plan []*importedTask) error {
+	for _, name := range resolver.newLabels {
+		label, err := a.LabelService.CreateLabel(ctx, labelservice.CreateLabelRequest{
+			ProjectID: projectID,
+			Name:      name,
+			Color:     defaultImportLabelColor,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create label '%s': %w", name, err)
+		}
+		resolver.labels[strings.ToLower(name)] = label
+	}
+
+	// Append after the tasks already in each column
+	summaries, err := a.TaskService.GetTaskSummariesByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+	nextPosition := make(map[int]int)
+	for columnID, tasks := range summaries {
+		for _, task := range tasks {
+			nextPosition[columnID] = max(nextPosition[columnID], task.Position+1)
+		}
+	}
+
+	var create func(tasks []*importedTask, parentID int) error
+	create = func(tasks []*importedTask, parentID int) error {
+		for _, task := range tasks {
+			labelIDs := make([]int, 0, len(task.Labels))
+			for _, name := range task.Labels {
+				labelIDs = append(labelIDs, resolver.labels[strings.ToLower(name)].ID)
+			}
+
+			created, err := a.TaskService.CreateTask(ctx, taskservice.CreateTaskRequest{
+				Title:       task.Title,
+				Description: task.item.Description,
+				ColumnID:    task.columnID,
+				Position:    nextPosition[task.columnID],
+				PriorityID:  task.priority,
+				TypeID:      task.typeID,
+				LabelIDs:    labelIDs,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create task '%s': %w", task.Title, err)
+			}
+			task.ID = created.ID
+			nextPosition[task.columnID]++
+
+			if parentID > 0 {
+				if err := a.TaskService.AddChildRelation(ctx, parentID, task.ID, models.RelationTypeParentChild); err != nil {
+					return fmt.Errorf("failed to link task %d to parent %d: %w", task.ID, parentID, err)
+				}
+			}
+
+			if err := create(task.Children, task.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return create(plan, 0)
+}
+
+// walkImport visits the plan depth-first, parents before their children
+func walkImport(tasks []*importedTask, depth int, visit func(task *importedTask, depth int)) {
+	for _, task := range tasks {
+		visit(task, depth)
+		walkImport(task.Children, depth+1, visit)
+	}
+}
@@ -0,0 +1,118 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func writeImportFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestImportTasks_Markdown(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+	_, err := db.ExecContext(context.Background(),
+		"UPDATE columns SET holds_completed_tasks = true WHERE project_id = ? AND name = 'Done'", projectID)
+	require.NoError(t, err)
+
+	path := writeImportFile(t, "TODO.md", `# Release
+- [ ] Write docs
+  - [x] Install guide
+  - [ ] Setup guide
+- [ ] Tag release
+`)
+
+	t.Run("dry run creates nothing", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ImportCmd(), []string{
+			path, "--project", fmt.Sprintf("%d", projectID), "--dry-run",
+		})
+		require.NoError(t, err)
+		assert.Contains(t, output, "Would import 4 tasks")
+		assert.Contains(t, output, "Install guide [Done]")
+
+		var count int
+		require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM tasks").Scan(&count))
+		assert.Equal(t, 0, count)
+	})
+
+	t.Run("nesting becomes parent/child relations", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ImportCmd(), []string{
+			path, "--project", fmt.Sprintf("%d", projectID), "--json",
+		})
+		require.NoError(t, err)
+
+		var result map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+		data := result["data"].(map[string]interface{})
+		assert.Equal(t, float64(4), data["Count"])
+
+		var parentID, docsID int
+		require.NoError(t, db.QueryRow("SELECT id FROM tasks WHERE title = 'Write docs'").Scan(&docsID))
+
+		var children int
+		require.NoError(t, db.QueryRow(
+			"SELECT COUNT(*) FROM task_subtasks WHERE parent_id = ? AND relation_type_id = 1", docsID).Scan(&children))
+		assert.Equal(t, 2, children)
+
+		require.NoError(t, db.QueryRow(`SELECT s.parent_id FROM task_subtasks s
+			JOIN tasks t ON t.id = s.child_id WHERE t.title = 'Install guide'`).Scan(&parentID))
+		assert.Equal(t, docsID, parentID)
+
+		var column string
+		require.NoError(t, db.QueryRow(`SELECT c.name FROM tasks t
+			JOIN columns c ON c.id = t.column_id WHERE t.title = 'Install guide'`).Scan(&column))
+		assert.Equal(t, "Done", column)
+	})
+}
+
+func TestImportTasks_CSV(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	path := writeImportFile(t, "tasks.csv", `Summary,Priority,Status,Tags
+Fix login,high,In Progress,backend;auth
+Update deps,low,,chore
+`)
+
+	output, err := cli.ExecuteCLICommand(t, app, ImportCmd(), []string{
+		path, "--project", fmt.Sprintf("%d", projectID), "--create-labels",
+		"--map", "title=Summary", "--quiet",
+	})
+	require.NoError(t, err)
+	assert.Regexp(t, `^\d+\n\d+\n$`, output)
+
+	var priority, column string
+	require.NoError(t, db.QueryRow(`SELECT p.description, c.name FROM tasks t
+		JOIN priorities p ON p.id = t.priority_id
+		JOIN columns c ON c.id = t.column_id WHERE t.title = 'Fix login'`).Scan(&priority, &column))
+	assert.Equal(t, "high", priority)
+	assert.Equal(t, "In Progress", column)
+
+	var labels int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM task_labels tl
+		JOIN tasks t ON t.id = tl.task_id WHERE t.title = 'Fix login'`).Scan(&labels))
+	assert.Equal(t, 2, labels)
+
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM labels WHERE project_id = ?", projectID).Scan(&labels))
+	assert.Equal(t, 3, labels)
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
-	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -44,6 +43,7 @@ Examples:
 
 	// Flags
 	cmd.Flags().Int("project", 0, "Project ID (for listing in-progress tasks)")
+	addForceFlag(cmd)
 	cmd.Flags().Bool("json", false, "Output in JSON format")
 	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
 
@@ -73,12 +73,12 @@ func runInProgress(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("either provide a task ID or use --project flag to list tasks")
 	}
 
-	taskID, err := strconv.Atoi(args[0])
+	ref, err := cli.ParseTaskRef(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid task ID: %s", args[0])
 	}
 
-	return moveTaskToInProgress(ctx, taskID, formatter)
+	return moveTaskToInProgress(wipContext(ctx, cmd), cmd, ref, formatter)
 }
 
 func listInProgressTasks(ctx context.Context, projectID int, formatter *cli.OutputFormatter) error {
@@ -183,7 +183,7 @@ func listInProgressTasks(ctx context.Context, projectID int, formatter *cli.Outp
 	return nil
 }
 
-func moveTaskToInProgress(ctx context.Context, taskID int, formatter *cli.OutputFormatter) error {
+func moveTaskToInProgress(ctx context.Context, cmd *cobra.Command, ref cli.TaskRef, formatter *cli.OutputFormatter) error {
 	// Initialize CLI
 	cliInstance, err := cli.GetCLIFromContext(ctx)
 	if err != nil {
@@ -198,6 +198,8 @@ func moveTaskToInProgress(ctx context.Context, taskID int, formatter *cli.Output
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
 	// Get task detail before move for output
 	taskDetail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
@@ -230,6 +232,7 @@ func moveTaskToInProgress(ctx context.Context, taskID int, formatter *cli.Output
 			}
 			os.Exit(cli.ExitValidation)
 		}
+		exitIfWIPLimit(formatter, err)
 		if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
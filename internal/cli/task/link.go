@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
 )
 
 // LinkCmd returns the task link subcommand
@@ -18,33 +20,42 @@ func LinkCmd() *cobra.Command {
 		Long: `Create a relationship between two tasks.
 
 Relationship Types:
-  (default)  Parent-Child: Non-blocking hierarchical relationship
-  --blocker  Blocked By/Blocker: Blocking relationship (parent blocked by child)
-  --related  Related To: Non-blocking associative relationship
+  (default)      Parent-Child: Non-blocking hierarchical relationship
+  --blocker      Blocked By/Blocker: Blocking relationship (parent blocked by child)
+  --related      Related To: Non-blocking associative relationship
+  --type <name>  Any relation type by name, including the project's own
+                 (see paso relation-type list)
 
-The --blocker and --related flags are mutually exclusive. If neither is specified,
-a parent-child relationship is created.
+The --blocker, --related and --type flags are mutually exclusive. If none is
+specified, a parent-child relationship is created.
+
+Tasks are given by ID, as #N for ticket N of the current project or as
+KEY-N for a project with a ticket key.
 
 Examples:
   # Parent-child relationship (default)
   paso task link --parent=5 --child=3
+  paso task link --parent=API-12 --child=API-14
 
   # Blocking relationship (task 5 blocked by task 3)
   paso task link --parent=5 --child=3 --blocker
 
   # Related relationship
   paso task link --parent=5 --child=3 --related
+
+  # Project-defined relationship
+  paso task link --parent=5 --child=3 --type=verifies
 `,
 		RunE: runLink,
 	}
 
 	// Required flags
-	cmd.Flags().Int("parent", 0, "Parent task ID (required)")
+	cmd.Flags().String("parent", "", "Parent task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("parent"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
 
-	cmd.Flags().Int("child", 0, "Child task ID (required)")
+	cmd.Flags().String("child", "", "Child task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("child"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -56,6 +67,7 @@ Examples:
 	// Relationship type flags (mutually exclusive)
 	cmd.Flags().Bool("blocker", false, "Create blocking relationship (Blocked By/Blocker)")
 	cmd.Flags().Bool("related", false, "Create related relationship (Related To)")
+	cmd.Flags().String("type", "", "Relation type name (e.g. blocker, verifies)")
 
 	return cmd
 }
@@ -63,34 +75,39 @@ Examples:
 func runLink(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	parentID, _ := cmd.Flags().GetInt("parent")
-	childID, _ := cmd.Flags().GetInt("child")
+	parentRef, _ := cmd.Flags().GetString("parent")
+	childRef, _ := cmd.Flags().GetString("child")
 	blocker, _ := cmd.Flags().GetBool("blocker")
 	related, _ := cmd.Flags().GetBool("related")
+	typeName, _ := cmd.Flags().GetString("type")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
 	// Validate mutually exclusive flags
-	if blocker && related {
+	set := 0
+	for _, on := range []bool{blocker, related, typeName != ""} {
+		if on {
+			set++
+		}
+	}
+	if set > 1 {
 		if fmtErr := formatter.Error("INVALID_FLAGS",
-			"cannot specify both --blocker and --related flags"); fmtErr != nil {
+			"cannot combine --blocker, --related and --type"); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
 		os.Exit(cli.ExitUsage)
 	}
 
 	// Determine relation type ID
-	relationTypeID := 1 // Default: Parent/Child
-	relationTypeName := "parent-child"
+	relationTypeID := models.RelationTypeParentChild
+	var custom *models.RelationType
 
 	if blocker {
-		relationTypeID = 2 // Blocked By/Blocker
-		relationTypeName = "blocking"
+		relationTypeID = models.RelationTypeBlocking
 	} else if related {
-		relationTypeID = 3 // Related To
-		relationTypeName = "related"
+		relationTypeID = models.RelationTypeRelated
 	}
 
 	// Initialize CLI
@@ -107,6 +124,39 @@ func runLink(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	parentID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, parentRef)
+	childID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, childRef)
+
+	// Resolve --type against the parent task's project
+	if typeName != "" {
+		parent, err := cliInstance.App.TaskService.GetTaskDetail(ctx, parentID)
+		if err != nil {
+			if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", parentID)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		relationTypes, err := cliInstance.App.RelationTypeService.GetRelationTypesByProject(ctx, parent.ProjectID)
+		if err != nil {
+			if fmtErr := formatter.Error("LINK_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+		rt, err := cli.ParseRelationType(relationTypes, typeName)
+		if err != nil {
+			if fmtErr := formatter.ErrorWithSuggestion("INVALID_RELATION_TYPE", err.Error(),
+				"List relation types with: paso relation-type list --project="+fmt.Sprint(parent.ProjectID)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		relationTypeID = rt.ID
+		if !rt.IsBuiltin() {
+			custom = rt
+		}
+	}
+
 	// Create the relationship with specific type
 	if err := cliInstance.App.TaskService.AddChildRelation(ctx, parentID, childID, relationTypeID); err != nil {
 		if fmtErr := formatter.Error("LINK_ERROR", err.Error()); fmtErr != nil {
@@ -120,6 +170,17 @@ func runLink(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Built-in types keep the names this command has always reported
+	relationTypeName := "parent-child"
+	switch {
+	case custom != nil:
+		relationTypeName = custom.Name
+	case relationTypeID == models.RelationTypeBlocking:
+		relationTypeName = "blocking"
+	case relationTypeID == models.RelationTypeRelated:
+		relationTypeName = "related"
+	}
+
 	if jsonOutput {
 		return json.NewEncoder(os.Stdout).Encode(map[string]any{
 			"success":          true,
@@ -131,6 +192,11 @@ func runLink(cmd *cobra.Command, args []string) error {
 	}
 
 	// Human-readable output with relationship type
+	if custom != nil {
+		fmt.Printf("✓ Created %s relationship: task %d %s task %d\n",
+			custom.Name, childID, strings.ToLower(custom.CToPLabel), parentID)
+		return nil
+	}
 	switch relationTypeID {
 	case 2:
 		fmt.Printf("✓ Created blocking relationship: task %d is blocked by task %d\n", parentID, childID)
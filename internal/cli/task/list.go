@@ -16,12 +16,39 @@ func ListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List tasks",
-		Long:  "List all tasks in a project.",
-		RunE:  runList,
+		Long: `List tasks in a project, optionally narrowed down with a filter query.
+
+Filter terms are separated by spaces and must all match. Prefix a term
+with '-' to negate it; words without a field search titles, descriptions
+and comments.
+
+  priority>=high        priority comparison (trivial < low < medium < high < critical)
+  type:bug              task type
+  label:backend         has label
+  column:"In Progress"  in column
+  is:blocked            blocked, ready, done, in-progress or archived
+  updated:<7d           updated less than 7 days ago (also created:, dates like 2025-01-31)
+  epic:12               task #12 and all its subtasks
+
+Examples:
+  # All tasks
+  paso task list --project=1
+
+  # High priority backend work that is not done
+  paso task list --filter='priority>=high label:backend -is:done'
+
+  # What is left of a feature planned as parent task #12
+  paso task list --filter='epic:12 -is:done'
+
+  # Stale tasks, JSON output for agents
+  paso task list --filter='updated:>14d' --json
+`,
+		RunE: runList,
 	}
 
 	// Flags
 	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("filter", "", filterFlagHelp)
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -38,6 +65,8 @@ func runList(cmd *cobra.Command, args []string) error {
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
+	query := resolveFilter(cmd, formatter, "")
+
 	// Get project ID from flag or environment variable
 	taskProject, err := cli.GetProjectID(cmd)
 	if err != nil {
@@ -63,8 +92,8 @@ func runList(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	// Get tasks (returns map[columnID][]*TaskSummary)
-	tasksByColumn, err := cliInstance.App.TaskService.GetTaskSummariesByProject(ctx, taskProject)
+	// Get tasks matching the filter (all tasks when no filter is given)
+	allTasks, err := cliInstance.App.TaskService.GetTaskSummariesByFilter(ctx, taskProject, query)
 	if err != nil {
 		if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
@@ -72,12 +101,6 @@ func runList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	// Flatten tasks from all columns
-	var allTasks []*models.TaskSummary
-	for _, columnTasks := range tasksByColumn {
-		allTasks = append(allTasks, columnTasks...)
-	}
-
 	// Output in appropriate format
 	if quietMode {
 		// Just print IDs
@@ -100,9 +123,15 @@ func runList(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Tickets read KEY-N once the project has a ticket key
+	var ticketKey string
+	if project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, taskProject); err == nil {
+		ticketKey = project.TicketKey
+	}
+
 	fmt.Printf("Found %d tasks:\n\n", len(allTasks))
 	for _, t := range allTasks {
-		fmt.Printf("  [%d] %s\n", t.ID, t.Title)
+		fmt.Printf("  [%d] %s %s\n", t.ID, models.TicketRef(ticketKey, t.TicketNumber), t.Title)
 	}
 
 	return nil
@@ -94,6 +94,44 @@ func TestListTask_Positive(t *testing.T) {
 	})
 }
 
+func TestListTask_Filter(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+	col := cli.CreateTestColumn(t, db, projectID, "Col1")
+	urgent := cli.CreateTestTask(t, db, col, "Fix login crash")
+	cli.CreateTestTask(t, db, col, "Write docs")
+
+	_, err := db.Exec("UPDATE tasks SET priority_id = 5 WHERE id = ?", urgent)
+	assert.NoError(t, err)
+
+	t.Run("Filter by priority", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ListCmd(), []string{
+			"--project", convertIntToString(projectID),
+			"--filter", "priority>=high",
+			"--quiet",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, convertIntToString(urgent), strings.TrimSpace(output))
+	})
+
+	t.Run("Filter combines terms", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ListCmd(), []string{
+			"--project", convertIntToString(projectID),
+			"--filter", `column:"col1" -login`,
+		})
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "Found 1 tasks")
+		assert.Contains(t, output, "Write docs")
+		assert.NotContains(t, output, "Fix login crash")
+	})
+}
+
 // Helper to convert int to string for assertions
 func convertIntToString(i int) string {
 	return fmt.Sprintf("%d", i)
@@ -2,6 +2,7 @@ package task
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -10,18 +11,33 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
 	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
 )
 
 // MoveCmd returns the task move subcommand
 func MoveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "move",
-		Short: "Move a task to another column",
-		Long: `Move a task to another column by direction or column name.
+		Short: "Move a task to another column or project",
+		Long: `Move a task to another column by direction or column name, or to
+another project with --project.
+
+A task moved to another project gets that project's next ticket number and
+lands in --column, or in the project's ready column (its first column when
+it has none). Labels are matched by name and created when the project lacks
+them; type and priority are matched by name, falling back to the project's
+defaults. Comments, checklist and history move with the task. Relations with
+tasks outside the target project must be kept (--relations keep) or dropped
+(--relations drop); without --relations such a move is refused.
+
+The task is given by ID, as #N for ticket N of the current project or as
+KEY-N for a project with a ticket key. With --project, #N still refers to
+the current project set by PASO_PROJECT.
 
 Examples:
   # Move to next column
   paso task move --id 1 next
+  paso task move --id API-7 next
 
   # Move to previous column
   paso task move --id 1 prev
@@ -30,6 +46,13 @@ Examples:
   paso task move --id 1 "In Progress"
   paso task move --id 1 done
 
+  # Move to another project
+  paso task move --id 1 --project 2
+  paso task move --id 1 --project 2 --column "In Progress" --relations drop
+
+  # Move past the column's WIP limit
+  paso task move --id 1 next --force
+
   # JSON output for agents
   paso task move --id 1 next --json
 
@@ -37,15 +60,22 @@ Examples:
   paso task move --id 1 next --quiet
 `,
 		RunE: runMove,
-		Args: cobra.ExactArgs(1),
+		Args: moveArgs,
 	}
 
 	// Required flags
-	cmd.Flags().Int("id", 0, "Task ID (required)")
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("id"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
 
+	// Project move flags
+	cmd.Flags().Int("project", 0, "Move the task to this project instead of another column")
+	cmd.Flags().String("column", "", "Target column name in --project (defaults to its ready column)")
+	cmd.Flags().String("relations", "", "Relations that would cross projects: keep or drop")
+
+	addForceFlag(cmd)
+
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
 	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
@@ -53,10 +83,25 @@ Examples:
 	return cmd
 }
 
+// moveArgs takes a column target, or no argument when moving to a project
+func moveArgs(cmd *cobra.Command, args []string) error {
+	if cmd.Flags().Changed("project") {
+		return cobra.NoArgs(cmd, args)
+	}
+	if cmd.Flags().Changed("column") || cmd.Flags().Changed("relations") {
+		return fmt.Errorf("--column and --relations require --project")
+	}
+	return cobra.ExactArgs(1)(cmd, args)
+}
+
 func runMove(cmd *cobra.Command, args []string) error {
-	ctx := cmd.Context()
+	if cmd.Flags().Changed("project") {
+		return runMoveToProject(cmd)
+	}
 
-	taskID, _ := cmd.Flags().GetInt("id")
+	ctx := wipContext(cmd.Context(), cmd)
+
+	taskRef, _ := cmd.Flags().GetString("id")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
 	target := args[0]
@@ -77,6 +122,8 @@ func runMove(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// Get task detail to find current column and project
 	taskDetail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
@@ -119,6 +166,7 @@ func runMove(cmd *cobra.Command, args []string) error {
 				}
 				os.Exit(cli.ExitValidation)
 			}
+			exitIfWIPLimit(formatter, err)
 			if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
 				slog.Error("failed to formatting error message", "error", fmtErr)
 			}
@@ -137,6 +185,7 @@ func runMove(cmd *cobra.Command, args []string) error {
 				}
 				os.Exit(cli.ExitValidation)
 			}
+			exitIfWIPLimit(formatter, err)
 			if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
 				slog.Error("failed to formatting error message", "error", fmtErr)
 			}
@@ -165,6 +214,7 @@ func runMove(cmd *cobra.Command, args []string) error {
 		} else {
 			err = cliInstance.App.TaskService.MoveTaskToColumn(ctx, taskID, targetColumn.ID)
 			if err != nil {
+				exitIfWIPLimit(formatter, err)
 				if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
 					slog.Error("failed to formatting error message", "error", fmtErr)
 				}
@@ -198,6 +248,165 @@ func runMove(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runMoveToProject(cmd *cobra.Command) error {
+	ctx := wipContext(cmd.Context(), cmd)
+
+	taskRef, _ := cmd.Flags().GetString("id")
+	projectID, _ := cmd.Flags().GetInt("project")
+	columnName, _ := cmd.Flags().GetString("column")
+	relationsFlag, _ := cmd.Flags().GetString("relations")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	relations, err := taskservice.ParseRelationPolicy(relationsFlag)
+	if err != nil {
+		if fmtErr := formatter.Error("INVALID_FLAGS", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	// --project names the target here, so #N is looked up in PASO_PROJECT
+	ref, err := cli.ParseTaskRef(taskRef)
+	if err != nil {
+		if fmtErr := formatter.Error("INVALID_TASK_ID", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+	currentProjectID, _ := cli.EnvProjectID()
+	taskID, err := cliInstance.ResolveTaskRef(ctx, ref, currentProjectID)
+	if err != nil {
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	taskDetail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	columns, err := cliInstance.App.ColumnService.GetColumnsByProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("COLUMN_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	var columnID int
+	if columnName != "" {
+		targetColumn, err := cli.FindColumnByName(columns, columnName)
+		if err != nil {
+			if fmtErr := formatter.ErrorWithSuggestion("COLUMN_NOT_FOUND",
+				fmt.Sprintf("column '%s' not found in project '%s'", columnName, project.Name),
+				fmt.Sprintf("Available columns: %s", cli.FormatAvailableColumns(columns))); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		columnID = targetColumn.ID
+	}
+
+	result, err := cliInstance.App.TaskService.MoveTaskToProject(ctx, taskservice.MoveToProjectRequest{
+		TaskID:    taskID,
+		ProjectID: projectID,
+		ColumnID:  columnID,
+		Relations: relations,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, taskservice.ErrTaskAlreadyInProject):
+			if fmtErr := formatter.Error("TASK_ALREADY_IN_PROJECT",
+				fmt.Sprintf("task %d is already in project '%s'", taskID, project.Name)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		case errors.Is(err, taskservice.ErrCrossProjectRelations):
+			if fmtErr := formatter.ErrorWithSuggestion("CROSS_PROJECT_RELATIONS", err.Error(),
+				"Use --relations keep to keep them as links between projects, or --relations drop to remove them"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		case errors.Is(err, taskservice.ErrInvalidColumnID):
+			if fmtErr := formatter.Error("NO_COLUMNS", fmt.Sprintf("project '%s' has no columns", project.Name)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitValidation)
+		}
+		exitIfWIPLimit(formatter, err)
+		if fmtErr := formatter.Error("MOVE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	toColumnName := cli.GetCurrentColumnName(columns, result.ColumnID)
+
+	// Output success
+	if quietMode {
+		fmt.Printf("%d\n", taskID)
+		return nil
+	}
+
+	if jsonOutput {
+		createdLabels := result.CreatedLabels
+		if createdLabels == nil {
+			createdLabels = []string{}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":           true,
+			"task_id":           taskID,
+			"from_project":      taskDetail.ProjectName,
+			"to_project":        project.Name,
+			"to_column":         toColumnName,
+			"old_ticket_number": result.OldTicketNumber,
+			"ticket_number":     result.TicketNumber,
+			"created_labels":    createdLabels,
+			"dropped_relations": result.DroppedRelations,
+		})
+	}
+
+	// Human-readable output
+	fmt.Printf("Task %d moved to '%s' as %s in '%s'\n", taskID, project.Name, models.TicketRef(project.TicketKey, result.TicketNumber), toColumnName)
+	if len(result.CreatedLabels) > 0 {
+		fmt.Printf("Created labels: %s\n", strings.Join(result.CreatedLabels, ", "))
+	}
+	if result.DroppedRelations > 0 {
+		fmt.Printf("Dropped %d relation(s) with tasks outside '%s'\n", result.DroppedRelations, project.Name)
+	}
+	return nil
+}
+
 // findNextColumnName finds the name of the next column in the linked list
 func findNextColumnName(columns []*models.Column, currentColumnID int) string {
 	for _, col := range columns {
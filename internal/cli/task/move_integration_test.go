@@ -393,6 +393,66 @@ func TestMoveTask_Positive(t *testing.T) {
 	})
 }
 
+func TestMoveTask_ToProject(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, sourceTodo, _, _ := setupLinkedColumns(t, db)
+	targetProject, _, targetDoing, _ := setupLinkedColumns(t, db)
+
+	t.Run("Move to a column of another project", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, sourceTodo, "Task changing projects")
+
+		output, err := cli.ExecuteCLICommand(t, app, MoveCmd(), []string{
+			"--id", fmt.Sprintf("%d", taskID),
+			"--project", fmt.Sprintf("%d", targetProject),
+			"--column", "in progress",
+			"--json",
+		})
+		require.NoError(t, err)
+
+		var result struct {
+			Success      bool   `json:"success"`
+			ToColumn     string `json:"to_column"`
+			TicketNumber int    `json:"ticket_number"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+		assert.True(t, result.Success)
+		assert.Equal(t, "In Progress", result.ToColumn)
+		assert.Equal(t, 1, result.TicketNumber)
+
+		var columnID int
+		err = db.QueryRowContext(context.Background(),
+			"SELECT column_id FROM tasks WHERE id = ?", taskID).Scan(&columnID)
+		assert.NoError(t, err)
+		assert.Equal(t, targetDoing, columnID)
+	})
+
+	t.Run("Column target and --project are exclusive", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, sourceTodo, "Task with two targets")
+
+		_, err := cli.ExecuteCLICommand(t, app, MoveCmd(), []string{
+			"--id", fmt.Sprintf("%d", taskID),
+			"--project", fmt.Sprintf("%d", targetProject),
+			"next",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("--relations without --project", func(t *testing.T) {
+		taskID := cli.CreateTestTask(t, db, sourceTodo, "Task with stray flag")
+
+		_, err := cli.ExecuteCLICommand(t, app, MoveCmd(), []string{
+			"--id", fmt.Sprintf("%d", taskID),
+			"--relations", "drop",
+			"next",
+		})
+		assert.Error(t, err)
+	})
+}
+
 func TestMoveTask_Negative(t *testing.T) {
 	// Setup test DB and App
 	db, app := cli.SetupCLITest(t)
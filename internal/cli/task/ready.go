@@ -8,7 +8,6 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
-	"github.com/thenoetrevino/paso/internal/models"
 )
 
 // ReadyCmd returns the task ready subcommand
@@ -30,12 +29,16 @@ Examples:
 
   # Quiet mode for bash capture
   TASK_IDS=$(paso task ready --project=1 --quiet)
+
+  # Narrow down with a filter query (see 'paso task list --help')
+  paso task ready --filter='priority>=high label:backend'
 `,
 		RunE: runReady,
 	}
 
 	// Flags
 	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("filter", "", filterFlagHelp)
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -52,6 +55,8 @@ func runReady(cmd *cobra.Command, args []string) error {
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
+	query := resolveFilter(cmd, formatter, "is:ready")
+
 	// Get project ID from flag or environment variable
 	taskProject, err := cli.GetProjectID(cmd)
 	if err != nil {
@@ -89,8 +94,7 @@ func runReady(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get ready tasks (tasks in ready columns and not blocked)
-	var readyTasks []*models.TaskSummary
-	readyTasks, err = cliInstance.App.TaskService.GetReadyTaskSummariesByProject(ctx, taskProject)
+	readyTasks, err := cliInstance.App.TaskService.GetTaskSummariesByFilter(ctx, taskProject, query)
 	if err != nil {
 		if fmtErr := formatter.Error("TASK_FETCH_ERROR", err.Error()); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
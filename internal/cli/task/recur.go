@@ -0,0 +1,445 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/dates"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/recurrence"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// RecurCmd returns the task recur subcommand
+func RecurCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recur",
+		Short: "Manage recurring tasks",
+		Long: `Manage recurring tasks.
+
+A repeat rule is attached to a task, which becomes the template of the rule.
+Each time the schedule comes due, or the latest instance is completed, the
+template is copied with its type, priority, labels, relations and checklist
+(unticked) into the project's ready column, due on the day of the occurrence.
+Its child tasks are copied along with it, so every instance has subtasks of
+its own; the template's parent task is not shared with the instances.
+
+Instances are generated whenever a paso command (other than a dry run) or the
+TUI starts, every minute while the daemon is running, and by
+'paso task recur run'.
+
+Rules are RRULE-style. Accepted forms:
+  daily, weekdays, weekly, monthly
+  every 3 days, every 2 weeks, every month
+  weekly on mon,thu              monthly on the 1st
+  monthly on the last day        monthly on the 2nd tue
+  FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH
+
+A monthly rule without a day repeats on the day of the task's due date, or
+of today if it has none.`,
+	}
+
+	cmd.AddCommand(recurSetCmd())
+	cmd.AddCommand(recurListCmd())
+	cmd.AddCommand(recurRemoveCmd())
+	cmd.AddCommand(recurRunCmd())
+
+	return cmd
+}
+
+func recurSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <task_id> <rule>",
+		Short: "Make a task recur",
+		Long: `Attach a repeat rule to a task, replacing any rule it already has.
+
+The task counts as the first instance: the next one is generated at the first
+occurrence after today, or as soon as the task is completed.
+
+Examples:
+  # Weekly dependency review
+  paso task recur set 42 "weekly on mon"
+
+  # Rotate credentials on the first of every month
+  paso task recur set 43 "monthly on the 1st"
+
+  # Standup notes on work days
+  paso task recur set 44 weekdays --json
+`,
+		RunE: runRecurSet,
+		Args: cobra.ExactArgs(2),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func recurListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List recurring tasks in a project",
+		Long: `List a project's recurring tasks with their rules and next runs.
+
+Examples:
+  paso task recur list --project=1
+
+  # JSON output for agents
+  paso task recur list --json
+`,
+		RunE: runRecurList,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (template task IDs only)")
+
+	return cmd
+}
+
+func recurRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <task_id>",
+		Short: "Stop a task from recurring",
+		Long: `Detach the repeat rule from a task. Instances generated so far are kept.
+
+Examples:
+  paso task recur remove 42
+`,
+		RunE: runRecurRemove,
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func recurRunCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Generate recurring tasks that have come due",
+		Long: `Generate the next instance of every recurring task whose schedule has come
+due or whose latest instance has been completed.
+
+Every other paso command, the TUI and the daemon do this on their own; run
+it to generate instances without doing anything else, e.g. from cron.
+
+Examples:
+  paso task recur run
+
+  # IDs of the generated tasks only
+  paso task recur run --quiet
+`,
+		RunE: runRecurRun,
+		Args: cobra.NoArgs,
+	}
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (generated task IDs only)")
+
+	return cmd
+}
+
+func runRecurSet(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+
+	// Validate the rule before initializing CLI
+	if _, err := recurrence.Parse(args[1]); err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("INVALID_RULE",
+			err.Error(),
+			"See 'paso task recur --help' for the accepted rules"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitValidation)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	rule, err := cliInstance.App.TaskService.SetRecurrence(ctx, taskID, args[1], time.Now())
+	if err != nil {
+		if errors.Is(err, taskservice.ErrTaskNotFound) {
+			if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		if fmtErr := formatter.Error("RECURRENCE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Printf("%d\n", taskID)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":    true,
+			"recurrence": recurrenceJSON(rule),
+		})
+	}
+
+	fmt.Printf("✓ Task %d recurs %s\n", taskID, rule.Description)
+	fmt.Printf("  Next: %s\n", dates.Format(rule.NextRunAt))
+	return nil
+}
+
+func runRecurList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("PROJECT_NOT_FOUND",
+			fmt.Sprintf("project %d not found", projectID),
+			"Use 'paso project list' to see available projects"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	rules, err := cliInstance.App.TaskService.GetRecurrencesByProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("RECURRENCE_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, r := range rules {
+			fmt.Printf("%d\n", r.TaskID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		list := make([]map[string]any, len(rules))
+		for i, r := range rules {
+			list[i] = recurrenceJSON(r)
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":     true,
+			"recurrences": list,
+			"count":       len(list),
+		})
+	}
+
+	if len(rules) == 0 {
+		fmt.Println("No recurring tasks")
+		return nil
+	}
+
+	fmt.Printf("Found %d recurring tasks:\n\n", len(rules))
+	for _, r := range rules {
+		fmt.Printf("  [%d] %s - %s, next %s (%d generated)\n",
+			r.TaskID, r.Title, r.Description, dates.Format(r.NextRunAt), r.Occurrences)
+	}
+	return nil
+}
+
+func runRecurRemove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	if err := cliInstance.App.TaskService.RemoveRecurrence(ctx, taskID); err != nil {
+		if errors.Is(err, taskservice.ErrRecurrenceNotFound) {
+			if fmtErr := formatter.Error("RECURRENCE_NOT_FOUND", fmt.Sprintf("task %d does not recur", taskID)); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		if fmtErr := formatter.Error("RECURRENCE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Printf("%d\n", taskID)
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"task_id": taskID,
+		})
+	}
+
+	fmt.Printf("✓ Task %d no longer recurs\n", taskID)
+	return nil
+}
+
+func runRecurRun(cmd *cobra.Command, args []string) error {
+	// Generate below rather than on startup, to report what was generated
+	ctx := cli.WithoutRecurringTasks(cmd.Context())
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	// A failing rule does not stop the others: report what was generated
+	// before the error
+	created, err := cliInstance.App.TaskService.GenerateRecurringTasks(ctx, time.Now())
+
+	switch {
+	case quietMode:
+		for _, id := range created {
+			fmt.Printf("%d\n", id)
+		}
+	case jsonOutput && err == nil:
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":  true,
+			"task_ids": created,
+			"count":    len(created),
+		})
+	case jsonOutput:
+		// One envelope with both the error and the tasks generated before it
+		if encErr := json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": false,
+			"error": map[string]any{
+				"code":    "RECURRENCE_ERROR",
+				"message": err.Error(),
+			},
+			"task_ids": created,
+			"count":    len(created),
+		}); encErr != nil {
+			slog.Error("failed to encoding JSON output", "error", encErr)
+		}
+		return err
+	case len(created) == 0 && err == nil:
+		fmt.Println("No recurring tasks due")
+	case len(created) > 0:
+		fmt.Printf("✓ Generated %d recurring tasks:", len(created))
+		for _, id := range created {
+			fmt.Printf(" %d", id)
+		}
+		fmt.Println()
+	}
+
+	if err != nil {
+		if fmtErr := formatter.Error("RECURRENCE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// recurrenceJSON renders a repeat rule for --json output
+func recurrenceJSON(r *models.TaskRecurrence) map[string]any {
+	out := map[string]any{
+		"task_id":     r.TaskID,
+		"rule":        r.Rule,
+		"description": r.Description,
+		"next_run_at": r.NextRunAt,
+		"occurrences": r.Occurrences,
+	}
+	if r.Title != "" {
+		out["title"] = r.Title
+	}
+	if r.LastInstanceID > 0 {
+		out["last_instance_id"] = r.LastInstanceID
+	}
+	return out
+}
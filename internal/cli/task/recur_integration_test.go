@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestRecurRun(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	var todoColumnID int
+	require.NoError(t, db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'", projectID).Scan(&todoColumnID))
+	templateID := cli.CreateTestTask(t, db, todoColumnID, "Dependency review")
+
+	_, err := cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"set", strconv.Itoa(templateID), "daily"})
+	require.NoError(t, err)
+
+	t.Run("Nothing due", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"run"})
+		require.NoError(t, err)
+		assert.Contains(t, output, "No recurring tasks due")
+	})
+
+	t.Run("Generates the due instance once", func(t *testing.T) {
+		_, err := db.ExecContext(context.Background(),
+			"UPDATE task_recurrences SET next_run_at = ? WHERE task_id = ?", time.Now().Add(-time.Hour), templateID)
+		require.NoError(t, err)
+
+		output, err := cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"run", "--quiet"})
+		require.NoError(t, err)
+		instanceID, err := strconv.Atoi(strings.TrimSpace(output))
+		require.NoError(t, err, "output %q", output)
+		assert.NotEqual(t, templateID, instanceID)
+
+		output, err = cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"run", "--quiet"})
+		require.NoError(t, err)
+		assert.Empty(t, strings.TrimSpace(output))
+	})
+
+	t.Run("JSON reports a partial failure in one envelope", func(t *testing.T) {
+		brokenID := cli.CreateTestTask(t, db, todoColumnID, "Broken chore")
+		_, err := cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"set", strconv.Itoa(brokenID), "daily"})
+		require.NoError(t, err)
+		_, err = db.ExecContext(context.Background(),
+			"UPDATE task_recurrences SET rule = 'FREQ=FORTNIGHTLY' WHERE task_id = ?", brokenID)
+		require.NoError(t, err)
+		_, err = db.ExecContext(context.Background(),
+			"UPDATE task_recurrences SET next_run_at = ?", time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		output, err := cli.ExecuteCLICommand(t, app, RecurCmd(), []string{"run", "--json"})
+		require.Error(t, err)
+
+		var result struct {
+			Success bool
+			Error   struct{ Code string }
+			TaskIDs []int `json:"task_ids"`
+		}
+		decoder := json.NewDecoder(strings.NewReader(output))
+		require.NoError(t, decoder.Decode(&result), "output %q", output)
+		assert.False(t, decoder.More(), "output %q has more than one JSON value", output)
+		assert.False(t, result.Success)
+		assert.Equal(t, "RECURRENCE_ERROR", result.Error.Code)
+		assert.Len(t, result.TaskIDs, 1)
+	})
+}
@@ -0,0 +1,151 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// SearchCmd returns the task search subcommand
+func SearchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search tasks",
+		Long: `Full-text search over task titles, descriptions and comments.
+
+Every word must match; words also match longer words they start with.
+Results are ranked best match first, with title matches weighing the most.
+
+Examples:
+  # Find tasks mentioning the auth refactor
+  paso task search "auth refactor" --project=1
+
+  # JSON output for agents (includes a snippet of the matching text)
+  paso task search "flaky test" --json
+
+  # Quiet mode: IDs only, best match first
+  paso task search migration --quiet --limit=5
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: runSearch,
+	}
+
+	// Flags
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().Int("limit", 20, "Maximum number of results (0 for no limit)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	query := strings.TrimSpace(args[0])
+	limit, _ := cmd.Flags().GetInt("limit")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	if query == "" {
+		if fmtErr := formatter.ErrorWithSuggestion("EMPTY_QUERY",
+			"search query cannot be empty",
+			"Usage: paso task search \"<query>\" --project=<id>"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Get project ID from flag or environment variable
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	// Initialize CLI
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	results, err := cliInstance.App.TaskService.SearchTasks(ctx, projectID, query, limit)
+	if err != nil {
+		if fmtErr := formatter.Error("SEARCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	// Output in appropriate format
+	if quietMode {
+		for _, r := range results {
+			fmt.Printf("%d\n", r.ID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		resultList := make([]map[string]any, 0, len(results))
+		for _, r := range results {
+			resultList = append(resultList, map[string]any{
+				"id":            r.ID,
+				"ticket_number": r.TicketNumber,
+				"title":         r.Title,
+				"column": map[string]any{
+					"id":   r.ColumnID,
+					"name": r.ColumnName,
+				},
+				"archived": r.Archived,
+				"snippet":  r.Snippet,
+				"rank":     r.Rank,
+			})
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"query":   query,
+			"results": resultList,
+		})
+	}
+
+	// Human-readable output
+	if len(results) == 0 {
+		fmt.Printf("No tasks matching %q\n", query)
+		return nil
+	}
+
+	fmt.Printf("Found %d tasks matching %q:\n\n", len(results), query)
+	for _, r := range results {
+		column := r.ColumnName
+		if r.Archived {
+			column += ", archived"
+		}
+		fmt.Printf("  [%d] #%d %s (%s)\n", r.ID, r.TicketNumber, r.Title, column)
+		if r.Snippet != "" && r.Snippet != r.Title {
+			fmt.Printf("      %s\n", strings.ReplaceAll(r.Snippet, "\n", " "))
+		}
+	}
+
+	return nil
+}
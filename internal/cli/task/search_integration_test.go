@@ -0,0 +1,99 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/testutil/cli"
+)
+
+func TestSearchTask_Positive(t *testing.T) {
+	// Setup test DB and App
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		require.NoError(t, db.Close(), "Failed to close database")
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Test Project")
+
+	var columnID int
+	err := db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'",
+		projectID).Scan(&columnID)
+	require.NoError(t, err)
+
+	titleMatch := cli.CreateTestTask(t, db, columnID, "Migrate billing service")
+	descMatch := cli.CreateTestTask(t, db, columnID, "Cleanup")
+	cli.CreateTestTask(t, db, columnID, "Unrelated task")
+
+	_, err = db.ExecContext(context.Background(),
+		"UPDATE tasks SET description = ? WHERE id = ?",
+		"blocked until the billing export lands", descMatch)
+	require.NoError(t, err)
+
+	t.Run("Human output", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, SearchCmd(), []string{
+			"billing",
+			"--project", strconv.Itoa(projectID),
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, output, "Found 2 tasks")
+		assert.Contains(t, output, "Migrate billing service")
+		assert.Contains(t, output, "**billing** export")
+		assert.NotContains(t, output, "Unrelated task")
+	})
+
+	t.Run("JSON output", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, SearchCmd(), []string{
+			"billing",
+			"--project", strconv.Itoa(projectID),
+			"--json",
+		})
+		require.NoError(t, err)
+
+		var result struct {
+			Success bool   `json:"success"`
+			Query   string `json:"query"`
+			Results []struct {
+				ID      int    `json:"id"`
+				Title   string `json:"title"`
+				Snippet string `json:"snippet"`
+			} `json:"results"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(output), &result))
+
+		assert.True(t, result.Success)
+		assert.Equal(t, "billing", result.Query)
+		require.Len(t, result.Results, 2)
+		assert.Equal(t, titleMatch, result.Results[0].ID)
+		assert.Equal(t, descMatch, result.Results[1].ID)
+	})
+
+	t.Run("Quiet output with limit", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, SearchCmd(), []string{
+			"billing",
+			"--project", strconv.Itoa(projectID),
+			"--quiet",
+			"--limit", "1",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{strconv.Itoa(titleMatch)}, strings.Fields(output))
+	})
+
+	t.Run("No matches", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, SearchCmd(), []string{
+			"nonexistent",
+			"--project", strconv.Itoa(projectID),
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, output, "No tasks matching")
+	})
+}
@@ -2,17 +2,21 @@ package task
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli"
 	"github.com/thenoetrevino/paso/internal/cli/styles"
 	"github.com/thenoetrevino/paso/internal/config"
 	"github.com/thenoetrevino/paso/internal/config/colors"
+	"github.com/thenoetrevino/paso/internal/dates"
 	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
 )
 
 // ShowCmd returns the task show subcommand
@@ -20,13 +24,22 @@ func ShowCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show [id]",
 		Short: "Show task details",
-		Long:  "Display all details of a task including description, relationships, labels, and metadata.",
-		Args:  cobra.MaximumNArgs(1),
-		RunE:  runShow,
+		Long: `Display all details of a task including description, relationships, labels, and metadata.
+
+The task is given by ID, as #N for ticket N of the current project or as
+KEY-N for a project with a ticket key.
+
+Examples:
+  paso task show 42
+  paso task show API-7
+  paso task show '#7'
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: runShow,
 	}
 
 	// Flags
-	cmd.Flags().Int("id", 0, "Task ID (can also be provided as positional argument)")
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (can also be provided as positional argument)")
 	cmd.Flags().Bool("json", false, "Output in JSON format")
 	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
 
@@ -36,25 +49,22 @@ func ShowCmd() *cobra.Command {
 func runShow(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	// Parse task ID from positional arg or flag
-	var taskID int
+	// Parse task reference from positional arg or flag
+	refArg, _ := cmd.Flags().GetString("id")
 	if len(args) > 0 {
-		if _, err := fmt.Sscanf(args[0], "%d", &taskID); err != nil {
-			taskID = 0 // Invalid input, will be caught by validation below
-		}
-	} else {
-		taskID, _ = cmd.Flags().GetInt("id")
+		refArg = args[0]
 	}
+	ref, refErr := cli.ParseTaskRef(refArg)
 
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
 
 	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
 
-	// Validate task ID
-	if taskID <= 0 {
+	// Validate task reference
+	if refErr != nil || !ref.Valid() {
 		if fmtErr := formatter.ErrorWithSuggestion("INVALID_TASK_ID",
-			"task ID must be a positive integer",
+			"task must be a positive task ID, #N or KEY-N",
 			"Usage: paso task show <id> or paso task show --id=<id>"); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
@@ -76,6 +86,8 @@ func runShow(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
 	// Get task details
 	task, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 	if err != nil {
@@ -86,6 +98,18 @@ func runShow(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
+	// Ticket key of the task's project, shown as KEY-N
+	var ticketKey string
+	if project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, task.ProjectID); err == nil {
+		ticketKey = project.TicketKey
+	}
+
+	// Repeat rule, if the task is the template of one
+	rule, err := cliInstance.App.TaskService.GetRecurrence(ctx, taskID)
+	if err != nil && !errors.Is(err, taskservice.ErrRecurrenceNotFound) {
+		slog.Error("failed to get recurrence", "task_id", taskID, "error", err)
+	}
+
 	// Output in appropriate format
 	if quietMode {
 		fmt.Printf("%d\n", task.ID)
@@ -93,7 +117,7 @@ func runShow(cmd *cobra.Command, args []string) error {
 	}
 
 	if jsonOutput {
-		return outputJSON(task)
+		return outputJSON(task, ticketKey, rule)
 	}
 
 	// Load config for color scheme
@@ -106,15 +130,28 @@ func runShow(cmd *cobra.Command, args []string) error {
 	}
 
 	// Human-readable output with lipgloss
-	return outputHuman(task, cfg.ColorScheme)
+	return outputHuman(task, ticketKey, rule, cfg.ColorScheme)
 }
 
-func outputJSON(task *models.TaskDetail) error {
+func outputJSON(task *models.TaskDetail, ticketKey string, rule *models.TaskRecurrence) error {
+	var recurrence map[string]any
+	if rule != nil {
+		recurrence = recurrenceJSON(rule)
+	}
+
+	// Child completion rollup over all descendants
+	progress := map[string]int{
+		"done":    task.ChildrenDone,
+		"total":   task.ChildrenTotal,
+		"percent": models.ProgressPercent(task.ChildrenDone, task.ChildrenTotal),
+	}
+
 	return json.NewEncoder(os.Stdout).Encode(map[string]any{
 		"success": true,
 		"task": map[string]any{
 			"id":            task.ID,
 			"ticket_number": task.TicketNumber,
+			"ticket_key":    ticketKey,
 			"project_name":  task.ProjectName,
 			"title":         task.Title,
 			"description":   task.Description,
@@ -132,20 +169,29 @@ func outputJSON(task *models.TaskDetail) error {
 			"labels":       task.Labels,
 			"parent_tasks": task.ParentTasks,
 			"child_tasks":  task.ChildTasks,
+			"checklist":    checklistJSON(task.Checklist),
+			"progress":     progress,
+			"due_at":       task.DueAt,
+			"start_at":     task.StartAt,
+			"archived_at":  task.ArchivedAt,
+			"recurrence":   recurrence,
 			"created_at":   task.CreatedAt,
 			"updated_at":   task.UpdatedAt,
 		},
 	})
 }
 
-func outputHuman(task *models.TaskDetail, colors colors.ColorScheme) error {
+func outputHuman(task *models.TaskDetail, ticketKey string, rule *models.TaskRecurrence, colors colors.ColorScheme) error {
 	// Initialize styles with the color scheme
 	styles.Init(colors)
 
 	var content strings.Builder
 
-	// Header with ticket ID
+	// Header with ticket ID, KEY-N once the project has a ticket key
 	ticketID := fmt.Sprintf("%s-%d", task.ProjectName, task.TicketNumber)
+	if ticketKey != "" {
+		ticketID = models.TicketRef(ticketKey, task.TicketNumber)
+	}
 	header := styles.TitleStyle.Render(ticketID + ": " + task.Title)
 	content.WriteString(header)
 	content.WriteString("\n\n")
@@ -157,6 +203,12 @@ func outputHuman(task *models.TaskDetail, colors colors.ColorScheme) error {
 		content.WriteString("\n\n")
 	}
 
+	// Archived indicator
+	if task.ArchivedAt != nil {
+		content.WriteString(styles.SubtitleStyle.Render("ARCHIVED " + task.ArchivedAt.Format("Jan 2, 2006")))
+		content.WriteString("\n\n")
+	}
+
 	// Description
 	if task.Description != "" {
 		content.WriteString(styles.SectionStyle.Render("Description"))
@@ -168,6 +220,17 @@ func outputHuman(task *models.TaskDetail, colors colors.ColorScheme) error {
 		content.WriteString("\n")
 	}
 
+	// Checklist
+	if len(task.Checklist) > 0 {
+		done, total := checklistProgress(task.Checklist)
+		content.WriteString(styles.SectionStyle.Render(fmt.Sprintf("Checklist (%d/%d)", done, total)))
+		content.WriteString("\n")
+		for i, item := range task.Checklist {
+			content.WriteString("  " + styles.ValueStyle.Render(formatChecklistItem(i+1, item)) + "\n")
+		}
+		content.WriteString("\n")
+	}
+
 	// Metadata row
 	metaLine := fmt.Sprintf("%s %s  %s %s",
 		styles.LabelStyle.Render("Type:"),
@@ -183,6 +246,37 @@ func outputHuman(task *models.TaskDetail, colors colors.ColorScheme) error {
 		styles.ValueStyle.Render(task.ColumnName),
 	))
 
+	// Child progress
+	if task.ChildrenTotal > 0 {
+		content.WriteString(fmt.Sprintf("%s %s\n",
+			styles.LabelStyle.Render("Progress:"),
+			styles.ValueStyle.Render(fmt.Sprintf("%d/%d subtasks done (%d%%)",
+				task.ChildrenDone, task.ChildrenTotal, models.ProgressPercent(task.ChildrenDone, task.ChildrenTotal))),
+		))
+	}
+
+	// Dates
+	now := time.Now()
+	if task.StartAt != nil {
+		content.WriteString(fmt.Sprintf("%s %s\n",
+			styles.LabelStyle.Render("Start:"),
+			styles.ValueStyle.Render(dates.Format(*task.StartAt)),
+		))
+	}
+	if task.DueAt != nil {
+		due := styles.ValueStyle.Render(fmt.Sprintf("%s (%s)", dates.Format(*task.DueAt), dates.Relative(*task.DueAt, now)))
+		if dates.DueState(task.DueAt, now) == dates.StateOverdue {
+			due += " " + styles.BlockedStyle.Render("OVERDUE")
+		}
+		content.WriteString(fmt.Sprintf("%s %s\n", styles.LabelStyle.Render("Due:"), due))
+	}
+	if rule != nil {
+		content.WriteString(fmt.Sprintf("%s %s\n",
+			styles.LabelStyle.Render("Recurs:"),
+			styles.ValueStyle.Render(fmt.Sprintf("%s (next %s)", rule.Description, dates.Format(rule.NextRunAt))),
+		))
+	}
+
 	// Timestamps
 	if !task.CreatedAt.IsZero() {
 		content.WriteString(fmt.Sprintf("%s %s\n",
@@ -431,3 +431,54 @@ Each line should be properly displayed.`
 		assert.Contains(t, output, "Child of Middle")
 	})
 }
+
+func TestShowTask_TicketReferences(t *testing.T) {
+	db, app := cli.SetupCLITest(t)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	projectID := cli.CreateTestProject(t, db, "Backend")
+	_, err := db.ExecContext(context.Background(),
+		"UPDATE projects SET ticket_key = 'API' WHERE id = ?", projectID)
+	assert.NoError(t, err)
+
+	var todoColumnID int
+	err = db.QueryRowContext(context.Background(),
+		"SELECT id FROM columns WHERE project_id = ? AND name = 'Todo'",
+		projectID).Scan(&todoColumnID)
+	assert.NoError(t, err)
+
+	taskID := cli.CreateTestTask(t, db, todoColumnID, "Keyed Task")
+	_, err = db.ExecContext(context.Background(),
+		"UPDATE tasks SET ticket_number = 42 WHERE id = ?", taskID)
+	assert.NoError(t, err)
+
+	t.Run("Show task by ticket key", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ShowCmd(), []string{"api-42"})
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "Keyed Task")
+		assert.Contains(t, output, "API-42")
+	})
+
+	t.Run("Show task by ticket number in the current project", func(t *testing.T) {
+		t.Setenv("PASO_PROJECT", fmt.Sprintf("%d", projectID))
+
+		output, err := cli.ExecuteCLICommand(t, app, ShowCmd(), []string{"--id", "#42", "--quiet"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d\n", taskID), output)
+	})
+
+	t.Run("Show task in JSON mode includes the ticket key", func(t *testing.T) {
+		output, err := cli.ExecuteCLICommand(t, app, ShowCmd(), []string{"API-42", "--json"})
+		assert.NoError(t, err)
+
+		var result map[string]any
+		assert.NoError(t, json.Unmarshal([]byte(output), &result))
+		task := result["task"].(map[string]any)
+		assert.Equal(t, "API", task["ticket_key"])
+		assert.Equal(t, float64(taskID), task["id"])
+	})
+}
@@ -16,13 +16,23 @@ func TaskCmd() *cobra.Command {
 	cmd.AddCommand(ShowCmd())
 	cmd.AddCommand(UpdateCmd())
 	cmd.AddCommand(DeleteCmd())
+	cmd.AddCommand(ArchiveCmd())
+	cmd.AddCommand(UnarchiveCmd())
+	cmd.AddCommand(RecurCmd())
+	cmd.AddCommand(TemplateCmd())
 	cmd.AddCommand(LinkCmd())
 	cmd.AddCommand(ReadyCmd())
 	cmd.AddCommand(BlockedCmd())
+	cmd.AddCommand(DueCmd())
 	cmd.AddCommand(MoveCmd())
+	cmd.AddCommand(BulkCmd())
 	cmd.AddCommand(ReadyMoveCmd())
 	cmd.AddCommand(DoneCmd())
 	cmd.AddCommand(InProgressCmd())
 	cmd.AddCommand(CommentCmd())
+	cmd.AddCommand(CheckCmd())
+	cmd.AddCommand(HistoryCmd())
+	cmd.AddCommand(SearchCmd())
+	cmd.AddCommand(ImportCmd())
 	return cmd
 }
@@ -0,0 +1,496 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// TemplateCmd returns the task template subcommand
+func TemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "template",
+		Short: "Manage task templates",
+		Long: `Manage a project's task templates.
+
+A task template is a named blueprint for repeatable work: a title, a
+description, a type, a priority, labels and a set of child tasks. Titles
+and descriptions may contain {{variables}} that are filled in when the
+template is used:
+
+  paso task create --template release --var version=1.4
+
+creates the task and its children in one go, each child linked to the task
+as a subtask.`,
+	}
+
+	cmd.AddCommand(templateAddCmd())
+	cmd.AddCommand(templateSaveCmd())
+	cmd.AddCommand(templateListCmd())
+	cmd.AddCommand(templateDeleteCmd())
+
+	return cmd
+}
+
+func templateAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add a task template",
+		Long: `Add a task template to a project.
+
+Type, priority and label names must exist in the project. Each --child adds
+a child task with the given title.
+
+Examples:
+  paso task template add --name=release \
+    --title="Release {{version}}" \
+    --description="Ship {{version}} to production" \
+    --type=feature --label=release \
+    --child="Tag v{{version}}" \
+    --child="Write changelog for {{version}}" \
+    --child="Announce {{version}}"
+`,
+		RunE: runTemplateAdd,
+	}
+
+	cmd.Flags().String("name", "", "Template name (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().String("title", "", "Task title, may use {{variables}} (required)")
+	if err := cmd.MarkFlagRequired("title"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+	cmd.Flags().String("description", "", "Task description, may use {{variables}}")
+	cmd.Flags().String("type", "", "Task type, one of the project's types")
+	cmd.Flags().String("priority", "", "Priority, one of the project's priorities")
+	cmd.Flags().StringArray("label", nil, "Label name (repeatable)")
+	cmd.Flags().StringArray("child", nil, "Child task title (repeatable)")
+	cmd.Flags().Bool("force", false, "Replace a template with the same name")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (template name only)")
+
+	return cmd
+}
+
+func templateSaveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save <task_id>",
+		Short: "Save a task and its subtasks as a template",
+		Long: `Save an existing task, with its type, priority, labels and child tasks,
+as a template of its project. Edit the task first to put {{variables}} in
+its title or description, or add the template from flags instead.
+
+Examples:
+  paso task template save 42 --name=onboarding
+`,
+		RunE: runTemplateSave,
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().String("name", "", "Template name (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().Bool("force", false, "Replace a template with the same name")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (template name only)")
+
+	return cmd
+}
+
+func templateListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List a project's task templates",
+		Long: `List a project's task templates with their variables.
+
+Examples:
+  paso task template list --project=1
+
+  # JSON output for agents
+  paso task template list --json
+`,
+		RunE: runTemplateList,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (template names only)")
+
+	return cmd
+}
+
+func templateDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete <name>",
+		Short: "Delete a task template",
+		Long: `Delete a task template. Tasks created from it are kept.
+
+Examples:
+  paso task template delete release --project=1
+`,
+		RunE: runTemplateDelete,
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (template name only)")
+
+	return cmd
+}
+
+func runTemplateAdd(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	name, _ := cmd.Flags().GetString("name")
+	title, _ := cmd.Flags().GetString("title")
+	description, _ := cmd.Flags().GetString("description")
+	taskType, _ := cmd.Flags().GetString("type")
+	priority, _ := cmd.Flags().GetString("priority")
+	labels, _ := cmd.Flags().GetStringArray("label")
+	childTitles, _ := cmd.Flags().GetStringArray("child")
+	force, _ := cmd.Flags().GetBool("force")
+
+	template := &models.TaskTemplate{
+		Name:        name,
+		Title:       title,
+		Description: description,
+		Type:        taskType,
+		Priority:    priority,
+		Labels:      labels,
+	}
+	for _, child := range childTitles {
+		template.Children = append(template.Children, models.TaskTemplateChild{Title: child})
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("PROJECT_NOT_FOUND",
+			fmt.Sprintf("project %d not found", projectID),
+			"Use 'paso project list' to see available projects"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	if err := cliInstance.App.TaskService.SaveTaskTemplate(ctx, projectID, template, force); err != nil {
+		exitIfTemplateSaveError(formatter, name, err)
+		return err
+	}
+
+	return printTemplateSaved(formatter, template)
+}
+
+func runTemplateSave(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	ref, err := cli.ParseTaskRef(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid task ID: %s", args[0])
+	}
+	name, _ := cmd.Flags().GetString("name")
+	force, _ := cmd.Flags().GetBool("force")
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	taskID := cliInstance.MustResolveTaskRef(ctx, cmd, formatter, ref)
+
+	detail, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	template, err := cliInstance.App.TaskService.TaskTemplateFromTask(ctx, taskID, name)
+	if err != nil {
+		if fmtErr := formatter.Error("TEMPLATE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if err := cliInstance.App.TaskService.SaveTaskTemplate(ctx, detail.ProjectID, template, force); err != nil {
+		exitIfTemplateSaveError(formatter, name, err)
+		return err
+	}
+
+	return printTemplateSaved(formatter, template)
+}
+
+func runTemplateList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if _, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID); err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("PROJECT_NOT_FOUND",
+			fmt.Sprintf("project %d not found", projectID),
+			"Use 'paso project list' to see available projects"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	templates, err := cliInstance.App.TaskService.GetTaskTemplates(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("TEMPLATE_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, t := range templates {
+			fmt.Println(t.Name)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		list := make([]map[string]any, len(templates))
+		for i, t := range templates {
+			list[i] = taskTemplateJSON(t)
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":   true,
+			"templates": list,
+			"count":     len(list),
+		})
+	}
+
+	if len(templates) == 0 {
+		fmt.Println("No task templates")
+		return nil
+	}
+
+	fmt.Printf("Found %d task templates:\n\n", len(templates))
+	for _, t := range templates {
+		fmt.Printf("  %s - %s (%d subtasks)\n", t.Name, t.Title, len(t.Children))
+		if vars := taskservice.TemplateVariables(t); len(vars) > 0 {
+			fmt.Printf("    Variables: %s\n", strings.Join(vars, ", "))
+		}
+	}
+	return nil
+}
+
+func runTemplateDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if err := cliInstance.App.TaskService.DeleteTaskTemplate(ctx, projectID, args[0]); err != nil {
+		if errors.Is(err, taskservice.ErrTaskTemplateNotFound) {
+			if fmtErr := formatter.ErrorWithSuggestion("TEMPLATE_NOT_FOUND",
+				fmt.Sprintf("task template '%s' not found", args[0]),
+				"Use 'paso task template list' to see available templates"); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			os.Exit(cli.ExitNotFound)
+		}
+		if fmtErr := formatter.Error("TEMPLATE_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		fmt.Println(args[0])
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"name":    args[0],
+		})
+	}
+
+	fmt.Printf("✓ Task template '%s' deleted\n", args[0])
+	return nil
+}
+
+// exitIfTemplateSaveError reports the errors a template save is refused with
+// and exits
+func exitIfTemplateSaveError(formatter *cli.OutputFormatter, name string, err error) {
+	switch {
+	case errors.Is(err, taskservice.ErrTaskTemplateExists):
+		if fmtErr := formatter.ErrorWithSuggestion("TEMPLATE_EXISTS",
+			fmt.Sprintf("task template '%s' already exists", name),
+			"Use --force to replace it"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitValidation)
+	case errors.Is(err, taskservice.ErrInvalidTaskTemplate):
+		if fmtErr := formatter.Error("INVALID_TEMPLATE", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitValidation)
+	}
+	if fmtErr := formatter.Error("TEMPLATE_ERROR", err.Error()); fmtErr != nil {
+		slog.Error("failed to formatting error message", "error", fmtErr)
+	}
+}
+
+// printTemplateSaved reports a saved task template
+func printTemplateSaved(formatter *cli.OutputFormatter, t *models.TaskTemplate) error {
+	if formatter.Quiet {
+		fmt.Println(t.Name)
+		return nil
+	}
+
+	if formatter.JSON {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success":  true,
+			"template": taskTemplateJSON(t),
+		})
+	}
+
+	fmt.Printf("✓ Task template '%s' saved (%d subtasks)\n", t.Name, len(t.Children))
+	if vars := taskservice.TemplateVariables(t); len(vars) > 0 {
+		fmt.Printf("  Variables: %s\n", strings.Join(vars, ", "))
+	}
+	return nil
+}
+
+// taskTemplateJSON renders a task template for --json output
+func taskTemplateJSON(t *models.TaskTemplate) map[string]any {
+	variables := taskservice.TemplateVariables(t)
+	if variables == nil {
+		variables = []string{}
+	}
+	subtasks := t.Children
+	if subtasks == nil {
+		subtasks = []models.TaskTemplateChild{}
+	}
+	out := map[string]any{
+		"name":      t.Name,
+		"title":     t.Title,
+		"subtasks":  subtasks,
+		"variables": variables,
+	}
+	if t.Description != "" {
+		out["description"] = t.Description
+	}
+	if t.Type != "" {
+		out["type"] = t.Type
+	}
+	if t.Priority != "" {
+		out["priority"] = t.Priority
+	}
+	if len(t.Labels) > 0 {
+		out["labels"] = t.Labels
+	}
+	return out
+}
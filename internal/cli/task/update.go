@@ -2,6 +2,7 @@ package task
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"os"
@@ -16,12 +17,18 @@ func UpdateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
 		Short: "Update a task",
-		Long:  "Update task title, description, or priority.",
-		RunE:  runUpdate,
+		Long: `Update task title, description, type, priority, or dates.
+
+Types and priorities are the task's project's own (see paso type list and
+paso priority list).
+
+Dates accept today, tomorrow, +3d, 2w, friday or 2026-11-01 (optionally
+with a time, 2026-11-01 15:00). Use "none" to remove a date.`,
+		RunE: runUpdate,
 	}
 
 	// Required flags
-	cmd.Flags().Int("id", 0, "Task ID (required)")
+	cmd.Flags().String("id", "", "Task ID, #N or KEY-N (required)")
 	if err := cmd.MarkFlagRequired("id"); err != nil {
 		slog.Error("failed to marking flag as required", "error", err)
 	}
@@ -29,7 +36,10 @@ func UpdateCmd() *cobra.Command {
 	// Optional update flags
 	cmd.Flags().String("title", "", "New task title")
 	cmd.Flags().String("description", "", "New task description")
-	cmd.Flags().String("priority", "", "New priority: trivial, low, medium, high, critical")
+	cmd.Flags().String("type", "", "New type, one of the project's types")
+	cmd.Flags().String("priority", "", "New priority, one of the project's priorities")
+	cmd.Flags().String("due", "", "New due date (e.g. tomorrow, +3d, 2026-11-01, or none)")
+	cmd.Flags().String("start", "", "New start date (e.g. today, +1w, 2026-10-20, or none)")
 
 	// Agent-friendly flags
 	cmd.Flags().Bool("json", false, "Output in JSON format")
@@ -41,10 +51,13 @@ func UpdateCmd() *cobra.Command {
 func runUpdate(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
-	taskID, _ := cmd.Flags().GetInt("id")
+	taskRef, _ := cmd.Flags().GetString("id")
 	taskTitle, _ := cmd.Flags().GetString("title")
 	taskDescription, _ := cmd.Flags().GetString("description")
+	taskType, _ := cmd.Flags().GetString("type")
 	taskPriority, _ := cmd.Flags().GetString("priority")
+	taskDue, _ := cmd.Flags().GetString("due")
+	taskStart, _ := cmd.Flags().GetString("start")
 	jsonOutput, _ := cmd.Flags().GetBool("json")
 	quietMode, _ := cmd.Flags().GetBool("quiet")
 
@@ -64,13 +77,18 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
+	taskID := cliInstance.MustResolveTaskArg(ctx, cmd, formatter, taskRef)
+
 	// At least one update field must be provided
 	titleFlag := cmd.Flags().Lookup("title")
 	descFlag := cmd.Flags().Lookup("description")
+	typeFlag := cmd.Flags().Lookup("type")
 	priorityFlag := cmd.Flags().Lookup("priority")
+	dueFlag := cmd.Flags().Lookup("due")
+	startFlag := cmd.Flags().Lookup("start")
 
-	if !titleFlag.Changed && !descFlag.Changed && !priorityFlag.Changed {
-		if fmtErr := formatter.Error("NO_UPDATES", "at least one of --title, --description, or --priority must be specified"); fmtErr != nil {
+	if !titleFlag.Changed && !descFlag.Changed && !typeFlag.Changed && !priorityFlag.Changed && !dueFlag.Changed && !startFlag.Changed {
+		if fmtErr := formatter.Error("NO_UPDATES", "at least one of --title, --description, --type, --priority, --due, or --start must be specified"); fmtErr != nil {
 			slog.Error("failed to formatting error message", "error", fmtErr)
 		}
 		os.Exit(cli.ExitUsage)
@@ -95,19 +113,54 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	// Update priority if provided
-	if priorityFlag.Changed {
-		priorityID, err := cli.ParsePriority(taskPriority)
+	// Update type and priority if provided; names resolve against the
+	// task's project
+	if typeFlag.Changed || priorityFlag.Changed {
+		task, err := cliInstance.App.TaskService.GetTaskDetail(ctx, taskID)
 		if err != nil {
-			if fmtErr := formatter.Error("INVALID_PRIORITY", err.Error()); fmtErr != nil {
+			if fmtErr := formatter.Error("TASK_NOT_FOUND", fmt.Sprintf("task %d not found", taskID)); fmtErr != nil {
 				slog.Error("failed to formatting error message", "error", fmtErr)
 			}
-			os.Exit(cli.ExitValidation)
+			os.Exit(cli.ExitNotFound)
 		}
-		req := taskservice.UpdateTaskRequest{
-			TaskID:     taskID,
-			PriorityID: &priorityID,
+		req := taskservice.UpdateTaskRequest{TaskID: taskID}
+
+		if typeFlag.Changed {
+			types, err := cliInstance.App.TypeService.GetTypesByProject(ctx, task.ProjectID)
+			if err != nil {
+				return err
+			}
+			typeID, err := cli.ParseTaskType(types, taskType)
+			if err == nil && typeID == 0 {
+				err = fmt.Errorf("type cannot be empty")
+			}
+			if err != nil {
+				if fmtErr := formatter.Error("INVALID_TYPE", err.Error()); fmtErr != nil {
+					slog.Error("failed to formatting error message", "error", fmtErr)
+				}
+				os.Exit(cli.ExitValidation)
+			}
+			req.TypeID = &typeID
+		}
+
+		if priorityFlag.Changed {
+			priorities, err := cliInstance.App.PriorityService.GetPrioritiesByProject(ctx, task.ProjectID)
+			if err != nil {
+				return err
+			}
+			priorityID, err := cli.ParsePriority(priorities, taskPriority)
+			if err == nil && priorityID == 0 {
+				err = fmt.Errorf("priority cannot be empty")
+			}
+			if err != nil {
+				if fmtErr := formatter.Error("INVALID_PRIORITY", err.Error()); fmtErr != nil {
+					slog.Error("failed to formatting error message", "error", fmtErr)
+				}
+				os.Exit(cli.ExitValidation)
+			}
+			req.PriorityID = &priorityID
 		}
+
 		if err := cliInstance.App.TaskService.UpdateTask(ctx, req); err != nil {
 			if fmtErr := formatter.Error("PRIORITY_UPDATE_ERROR", err.Error()); fmtErr != nil {
 				slog.Error("failed to formatting error message", "error", fmtErr)
@@ -116,6 +169,43 @@ func runUpdate(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	// Update dates if provided
+	if dueFlag.Changed || startFlag.Changed {
+		req := taskservice.UpdateTaskRequest{TaskID: taskID}
+		if dueFlag.Changed {
+			if isNoDate(taskDue) {
+				req.ClearDueAt = true
+			} else if req.DueAt, err = parseDateFlag(taskDue); err != nil {
+				if fmtErr := formatter.Error("INVALID_DATE", err.Error()); fmtErr != nil {
+					slog.Error("failed to formatting error message", "error", fmtErr)
+				}
+				os.Exit(cli.ExitValidation)
+			}
+		}
+		if startFlag.Changed {
+			if isNoDate(taskStart) {
+				req.ClearStartAt = true
+			} else if req.StartAt, err = parseDateFlag(taskStart); err != nil {
+				if fmtErr := formatter.Error("INVALID_DATE", err.Error()); fmtErr != nil {
+					slog.Error("failed to formatting error message", "error", fmtErr)
+				}
+				os.Exit(cli.ExitValidation)
+			}
+		}
+		if err := cliInstance.App.TaskService.UpdateTask(ctx, req); err != nil {
+			if errors.Is(err, taskservice.ErrStartAfterDue) {
+				if fmtErr := formatter.Error("INVALID_DATE", err.Error()); fmtErr != nil {
+					slog.Error("failed to formatting error message", "error", fmtErr)
+				}
+				os.Exit(cli.ExitValidation)
+			}
+			if fmtErr := formatter.Error("DATE_UPDATE_ERROR", err.Error()); fmtErr != nil {
+				slog.Error("failed to formatting error message", "error", fmtErr)
+			}
+			return err
+		}
+	}
+
 	// Output success
 	if quietMode {
 		fmt.Printf("%d\n", taskID)
@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+)
+
+// addForceFlag adds the --force flag that lets a task into a column past its
+// WIP limit
+func addForceFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("force", false, "Exceed the target column's WIP limit")
+}
+
+// wipContext returns ctx with WIP limits lifted if --force is set
+func wipContext(ctx context.Context, cmd *cobra.Command) context.Context {
+	if force, _ := cmd.Flags().GetBool("force"); force {
+		return taskservice.WithWIPLimitOverride(ctx)
+	}
+	return ctx
+}
+
+// exitIfWIPLimit reports err and exits with cli.ExitWIPLimit if a column's
+// WIP limit refused the task; any other error is left to the caller
+func exitIfWIPLimit(formatter *cli.OutputFormatter, err error) {
+	if !errors.Is(err, taskservice.ErrWIPLimitExceeded) {
+		return
+	}
+	if fmtErr := formatter.ErrorWithSuggestion("WIP_LIMIT_EXCEEDED", err.Error(),
+		"Finish or move out a task in that column first, or use --force to exceed the limit"); fmtErr != nil {
+		slog.Error("failed to formatting error message", "error", fmtErr)
+	}
+	os.Exit(cli.ExitWIPLimit)
+}
@@ -0,0 +1,135 @@
+package tasktype
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/cli/handler"
+	typeservice "github.com/thenoetrevino/paso/internal/services/tasktype"
+)
+
+// CreateCmd returns the type create subcommand
+func CreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Add a task type to a project",
+		Long: `Add a task type to a project.
+
+Examples:
+  # Add a type after the existing ones
+  paso type create --name=incident --color="#EF4444" --icon="!" --project=1
+
+  # Put it first, making it the default for new tasks
+  paso type create --name=chore --order=0 --project=1
+
+  # Quiet mode for bash capture
+  TYPE_ID=$(paso type create --name=spike --project=1 --quiet)
+`,
+		RunE: handler.Command(&createHandler{}, parseCreateFlags),
+	}
+
+	// Required flags
+	cmd.Flags().String("name", "", "Type name (required)")
+	if err := cmd.MarkFlagRequired("name"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+
+	cmd.Flags().String("color", "#6B7280", "Type color in hex format #RRGGBB")
+	cmd.Flags().String("icon", "", "Short icon shown next to the type (up to 4 characters)")
+	cmd.Flags().Int("order", -1, "Sort order (defaults to after the last type)")
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (ID only)")
+
+	return cmd
+}
+
+// createHandler implements handler.Handler for type creation
+type createHandler struct{}
+
+// Execute implements the Handler interface
+func (h *createHandler) Execute(ctx context.Context, args *handler.Arguments) (any, error) {
+	name := args.MustGetString("name")
+	color := args.GetString("color", "#6B7280")
+	icon := args.GetString("icon", "")
+	order := args.GetInt("order", -1)
+
+	cmd := args.GetCmd()
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("no project specified: use --project flag or set with 'eval $(paso use project <project-id>)'")
+	}
+
+	if err := cli.ValidateColorHex(color); err != nil {
+		return nil, fmt.Errorf("invalid color: %w", err)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("initialization error: %w", err)
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("project %d not found", projectID)
+	}
+
+	req := typeservice.CreateTypeRequest{
+		ProjectID: projectID,
+		Name:      name,
+		Color:     color,
+		Icon:      icon,
+	}
+	if order >= 0 {
+		req.SortOrder = &order
+	}
+
+	created, err := cliInstance.App.TypeService.CreateType(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("type creation error: %w", err)
+	}
+
+	return &typeCreateResult{
+		ID:        created.ID,
+		Name:      created.Description,
+		Color:     created.Color,
+		Icon:      created.Icon,
+		SortOrder: created.SortOrder,
+		ProjectID: created.ProjectID,
+		Project:   project.Name,
+	}, nil
+}
+
+// typeCreateResult represents the result of type creation
+type typeCreateResult struct {
+	ID        int
+	Name      string
+	Color     string
+	Icon      string `json:",omitempty"`
+	SortOrder int
+	ProjectID int
+	Project   string
+}
+
+// GetID implements the GetID interface for quiet mode output
+func (r *typeCreateResult) GetID() int {
+	return r.ID
+}
+
+func parseCreateFlags(cmd *cobra.Command) error {
+	name, _ := cmd.Flags().GetString("name")
+	if name == "" {
+		return fmt.Errorf("type name is required")
+	}
+	return nil
+}
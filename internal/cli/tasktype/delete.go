@@ -0,0 +1,111 @@
+package tasktype
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+	typeservice "github.com/thenoetrevino/paso/internal/services/tasktype"
+)
+
+// DeleteCmd returns the type delete subcommand
+func DeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete a task type",
+		Long: `Delete a task type by ID (requires confirmation unless --force or --quiet).
+
+A type still used by tasks can only be deleted with --reassign, which moves
+those tasks to another type of the same project. A project keeps at least
+one type.
+
+Examples:
+  # Delete an unused type
+  paso type delete --id=7 --force
+
+  # Move its tasks to type 8, then delete it
+  paso type delete --id=7 --reassign=8 --force
+`,
+		RunE: runDelete,
+	}
+
+	cmd.Flags().Int("id", 0, "Type ID (required)")
+	if err := cmd.MarkFlagRequired("id"); err != nil {
+		slog.Error("failed to marking flag as required", "error", err)
+	}
+	cmd.Flags().Int("reassign", 0, "Type ID to move the deleted type's tasks to")
+	cmd.Flags().Bool("force", false, "Skip confirmation")
+
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output")
+
+	return cmd
+}
+
+func runDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	typeID, _ := cmd.Flags().GetInt("id")
+	reassign, _ := cmd.Flags().GetInt("reassign")
+	force, _ := cmd.Flags().GetBool("force")
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	if !force && !quietMode {
+		fmt.Printf("Delete type #%d? (y/N): ", typeID)
+		var response string
+		if _, err := fmt.Scanln(&response); err != nil {
+			slog.Error("failed to reading user input", "error", err)
+		}
+		if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+			fmt.Println("Cancelled")
+			return nil
+		}
+	}
+
+	err = cliInstance.App.TypeService.DeleteType(ctx, typeservice.DeleteTypeRequest{ID: typeID, ReassignTo: reassign})
+	if err != nil {
+		suggestion := ""
+		if errors.Is(err, typeservice.ErrTypeInUse) {
+			suggestion = "Move its tasks with --reassign=<type-id> (see paso type list)"
+		}
+		if fmtErr := formatter.ErrorWithSuggestion(errorCode(err), err.Error(), suggestion); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(exitCode(err))
+	}
+
+	if quietMode {
+		return nil
+	}
+
+	if jsonOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"type_id": typeID,
+		})
+	}
+
+	fmt.Printf("✓ Type %d deleted successfully\n", typeID)
+	return nil
+}
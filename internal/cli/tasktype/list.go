@@ -0,0 +1,125 @@
+package tasktype
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/thenoetrevino/paso/internal/cli"
+)
+
+// ListCmd returns the type list subcommand
+func ListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List task types in a project",
+		Long: `List a project's task types in sort order.
+
+Examples:
+  # Human-readable list
+  paso type list --project=1
+
+  # JSON output for agents
+  paso type list --project=1 --json
+
+  # Quiet mode (one ID per line)
+  paso type list --project=1 --quiet
+`,
+		RunE: runList,
+	}
+
+	cmd.Flags().Int("project", 0, "Project ID (uses PASO_PROJECT env var if not specified)")
+
+	// Agent-friendly flags
+	cmd.Flags().Bool("json", false, "Output in JSON format")
+	cmd.Flags().Bool("quiet", false, "Minimal output (IDs only)")
+
+	return cmd
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	jsonOutput, _ := cmd.Flags().GetBool("json")
+	quietMode, _ := cmd.Flags().GetBool("quiet")
+
+	formatter := &cli.OutputFormatter{JSON: jsonOutput, Quiet: quietMode}
+
+	projectID, err := cli.GetProjectID(cmd)
+	if err != nil {
+		if fmtErr := formatter.ErrorWithSuggestion("NO_PROJECT",
+			err.Error(),
+			"Set project with: eval $(paso use project <project-id>)"); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitUsage)
+	}
+
+	cliInstance, err := cli.GetCLIFromContext(ctx)
+	if err != nil {
+		if fmtErr := formatter.Error("INITIALIZATION_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+	defer func() {
+		if err := cliInstance.Close(); err != nil {
+			slog.Error("failed to closing CLI", "error", err)
+		}
+	}()
+
+	project, err := cliInstance.App.ProjectService.GetProjectByID(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("PROJECT_NOT_FOUND", fmt.Sprintf("project %d not found", projectID)); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		os.Exit(cli.ExitNotFound)
+	}
+
+	types, err := cliInstance.App.TypeService.GetTypesByProject(ctx, projectID)
+	if err != nil {
+		if fmtErr := formatter.Error("TYPE_FETCH_ERROR", err.Error()); fmtErr != nil {
+			slog.Error("failed to formatting error message", "error", fmtErr)
+		}
+		return err
+	}
+
+	if quietMode {
+		for _, t := range types {
+			fmt.Printf("%d\n", t.ID)
+		}
+		return nil
+	}
+
+	if jsonOutput {
+		typeList := make([]map[string]any, len(types))
+		for i, t := range types {
+			typeList[i] = map[string]any{
+				"id":         t.ID,
+				"name":       t.Description,
+				"color":      t.Color,
+				"icon":       t.Icon,
+				"sort_order": t.SortOrder,
+				"default":    i == 0,
+			}
+		}
+		return json.NewEncoder(os.Stdout).Encode(map[string]any{
+			"success": true,
+			"types":   typeList,
+		})
+	}
+
+	fmt.Printf("Task types in project '%s':\n", project.Name)
+	fmt.Printf("  %-4s %-20s %-8s %-5s %s\n", "ID", "Name", "Color", "Icon", "Order")
+	fmt.Println("  " + strings.Repeat("-", 50))
+	for i, t := range types {
+		name := t.Description
+		if i == 0 {
+			name += " (default)"
+		}
+		fmt.Printf("  %-4d %-20s %-8s %-5s %d\n", t.ID, name, t.Color, t.Icon, t.SortOrder)
+	}
+	return nil
+}
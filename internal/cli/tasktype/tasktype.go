@@ -0,0 +1,26 @@
+// Package tasktype holds all cli commands related to task types
+// e.g., paso type ...
+package tasktype
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// TypeCmd returns the type parent command
+func TypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "type",
+		Short: "Manage a project's task types",
+		Long: `Manage a project's task types.
+
+Every project starts with task, feature and bug. Types are listed and picked
+in sort order; the first one is the default for new tasks.`,
+	}
+
+	cmd.AddCommand(CreateCmd())
+	cmd.AddCommand(ListCmd())
+	cmd.AddCommand(UpdateCmd())
+	cmd.AddCommand(DeleteCmd())
+
+	return cmd
+}
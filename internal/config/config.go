@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 
 	"github.com/thenoetrevino/paso/internal/config/colors"
+	"github.com/thenoetrevino/paso/internal/models"
 	"gopkg.in/yaml.v3"
 )
 
@@ -12,6 +13,10 @@ import (
 type Config struct {
 	KeyMappings KeyMappings        `yaml:"key_mappings"`
 	ColorScheme colors.ColorScheme `yaml:"theme"`
+
+	// ProjectTemplates are offered by `paso project create --template`
+	// alongside the templates saved in the database
+	ProjectTemplates []models.ProjectTemplate `yaml:"project_templates,omitempty"`
 }
 
 // loadThemeFile loads and merges theme from PASO_THEME_FILE environment variable
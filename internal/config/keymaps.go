@@ -14,10 +14,16 @@ type KeyMappings struct {
 	EditLabels     string `yaml:"edit_labels"`
 	EditParentTask string `yaml:"edit_parent_task"`
 	EditChildTask  string `yaml:"edit_child_task"`
+	ArchiveTask    string `yaml:"archive_task"`
+	MoveToProject  string `yaml:"move_to_project"`
 
 	// Forms
 	SaveForm string `yaml:"save_form"`
 
+	// History
+	Undo string `yaml:"undo"`
+	Redo string `yaml:"redo"`
+
 	// Columns
 	CreateColumn string `yaml:"create_column"`
 	RenameColumn string `yaml:"rename_column"`
@@ -44,6 +50,8 @@ type KeyMappings struct {
 	ToggleView   string `yaml:"toggle_view"`
 	ChangeStatus string `yaml:"change_status"`
 	SortList     string `yaml:"sort_list"`
+	ShowArchive  string `yaml:"show_archive"`
+	FilterEpic   string `yaml:"filter_epic"`
 }
 
 // DefaultKeyMappings returns the default key mappings
@@ -61,8 +69,14 @@ func DefaultKeyMappings() KeyMappings {
 		EditLabels:     "l",
 		EditParentTask: "p",
 		EditChildTask:  "c",
+		ArchiveTask:    "A",
+		MoveToProject:  "M",
 		SaveForm:       "ctrl+s",
 
+		// History
+		Undo: "u",
+		Redo: "ctrl+r",
+
 		// Columns
 		CreateColumn: "C",
 		RenameColumn: "R",
@@ -89,6 +103,8 @@ func DefaultKeyMappings() KeyMappings {
 		ToggleView:   "v",
 		ChangeStatus: "s",
 		SortList:     "S",
+		ShowArchive:  "B",
+		FilterEpic:   "E",
 	}
 }
 
@@ -129,9 +145,21 @@ func (k *KeyMappings) applyDefaults() {
 	if k.EditChildTask == "" {
 		k.EditChildTask = defaults.EditChildTask
 	}
+	if k.ArchiveTask == "" {
+		k.ArchiveTask = defaults.ArchiveTask
+	}
+	if k.MoveToProject == "" {
+		k.MoveToProject = defaults.MoveToProject
+	}
 	if k.SaveForm == "" {
 		k.SaveForm = defaults.SaveForm
 	}
+	if k.Undo == "" {
+		k.Undo = defaults.Undo
+	}
+	if k.Redo == "" {
+		k.Redo = defaults.Redo
+	}
 	if k.CreateColumn == "" {
 		k.CreateColumn = defaults.CreateColumn
 	}
@@ -183,4 +211,10 @@ func (k *KeyMappings) applyDefaults() {
 	if k.SortList == "" {
 		k.SortList = defaults.SortList
 	}
+	if k.ShowArchive == "" {
+		k.ShowArchive = defaults.ShowArchive
+	}
+	if k.FilterEpic == "" {
+		k.FilterEpic = defaults.FilterEpic
+	}
 }
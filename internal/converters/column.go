@@ -17,6 +17,8 @@ import (
 // - HoldsCompletedTasks: Column contains finished tasks
 // - HoldsInProgressTasks: Column contains tasks currently being worked on
 //
+// A NULL wip_limit becomes WIPLimit 0 (no limit).
+//
 // Type conversions:
 // - All ID fields: int64 → int
 // - Nullable IDs: interface{} → *int using database.AnyToIntPtr()
@@ -30,6 +32,7 @@ func ColumnToModel(c generated.Column) *models.Column {
 		HoldsReadyTasks:      c.HoldsReadyTasks,
 		HoldsCompletedTasks:  c.HoldsCompletedTasks,
 		HoldsInProgressTasks: c.HoldsInProgressTasks,
+		WIPLimit:             int(c.WipLimit.Int64),
 	}
 }
 
@@ -46,6 +49,7 @@ func ColumnFromIDRowToModel(r generated.GetColumnByIDRow) *models.Column {
 		HoldsReadyTasks:      r.HoldsReadyTasks,
 		HoldsCompletedTasks:  r.HoldsCompletedTasks,
 		HoldsInProgressTasks: r.HoldsInProgressTasks,
+		WIPLimit:             int(r.WipLimit.Int64),
 	}
 }
 
@@ -64,6 +68,7 @@ func ColumnsFromRowsToModels(rows []generated.GetColumnsByProjectRow) []*models.
 			HoldsReadyTasks:      r.HoldsReadyTasks,
 			HoldsCompletedTasks:  r.HoldsCompletedTasks,
 			HoldsInProgressTasks: r.HoldsInProgressTasks,
+			WIPLimit:             int(r.WipLimit.Int64),
 		}
 	}
 	return result
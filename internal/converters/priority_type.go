@@ -0,0 +1,80 @@
+package converters
+
+import (
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// TypeToModel converts a generated.Type (SQLC database model) to models.Type (domain model).
+//
+// Built-in types have a NULL project_id, which becomes ProjectID 0.
+func TypeToModel(t generated.Type) *models.Type {
+	return &models.Type{
+		ID:          int(t.ID),
+		ProjectID:   int(t.ProjectID.Int64),
+		Description: t.Description,
+		Color:       t.Color,
+		Icon:        t.Icon,
+		SortOrder:   int(t.SortOrder),
+	}
+}
+
+// TypesToModels converts a slice of generated.Type to a slice of models.Type,
+// preserving order. Returns an empty slice (not nil) for nil input.
+func TypesToModels(types []generated.Type) []*models.Type {
+	result := make([]*models.Type, len(types))
+	for i, t := range types {
+		result[i] = TypeToModel(t)
+	}
+	return result
+}
+
+// PriorityToModel converts a generated.Priority (SQLC database model) to models.Priority (domain model).
+//
+// Built-in priorities have a NULL project_id, which becomes ProjectID 0.
+func PriorityToModel(p generated.Priority) *models.Priority {
+	return &models.Priority{
+		ID:          int(p.ID),
+		ProjectID:   int(p.ProjectID.Int64),
+		Description: p.Description,
+		Color:       p.Color,
+		Icon:        p.Icon,
+		SortOrder:   int(p.SortOrder),
+	}
+}
+
+// PrioritiesToModels converts a slice of generated.Priority to a slice of
+// models.Priority, preserving order. Returns an empty slice (not nil) for nil input.
+func PrioritiesToModels(priorities []generated.Priority) []*models.Priority {
+	result := make([]*models.Priority, len(priorities))
+	for i, p := range priorities {
+		result[i] = PriorityToModel(p)
+	}
+	return result
+}
+
+// RelationTypeToModel converts a generated.RelationType (SQLC database model)
+// to models.RelationType (domain model).
+//
+// Built-in relation types have a NULL project_id, which becomes ProjectID 0.
+func RelationTypeToModel(r generated.RelationType) *models.RelationType {
+	return &models.RelationType{
+		ID:         int(r.ID),
+		ProjectID:  int(r.ProjectID.Int64),
+		Name:       r.Name,
+		PToCLabel:  r.PToCLabel,
+		CToPLabel:  r.CToPLabel,
+		Color:      r.Color,
+		IsBlocking: r.IsBlocking,
+	}
+}
+
+// RelationTypesToModels converts a slice of generated.RelationType to a slice
+// of models.RelationType, preserving order. Returns an empty slice (not nil) for nil input.
+func RelationTypesToModels(relationTypes []generated.RelationType) []*models.RelationType {
+	result := make([]*models.RelationType, len(relationTypes))
+	for i, r := range relationTypes {
+		result[i] = RelationTypeToModel(r)
+	}
+	return result
+}
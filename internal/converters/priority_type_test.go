@@ -0,0 +1,97 @@
+package converters
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// ============================================================================
+// TEST CASES - TypeToModel / PriorityToModel
+// ============================================================================
+
+func TestTypeToModel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    generated.Type
+		expected *models.Type
+	}{
+		{
+			name: "project type",
+			input: generated.Type{
+				ID:          7,
+				ProjectID:   sql.NullInt64{Int64: 2, Valid: true},
+				Description: "incident",
+				Color:       "#EF4444",
+				Icon:        "!",
+				SortOrder:   1,
+			},
+			expected: &models.Type{ID: 7, ProjectID: 2, Description: "incident", Color: "#EF4444", Icon: "!", SortOrder: 1},
+		},
+		{
+			name:     "built-in type has no project",
+			input:    generated.Type{ID: 1, Description: "task", Color: "#3B82F6", SortOrder: 1},
+			expected: &models.Type{ID: 1, Description: "task", Color: "#3B82F6", SortOrder: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := TypeToModel(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("TypeToModel() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPrioritiesToModels(t *testing.T) {
+	t.Parallel()
+
+	input := []generated.Priority{
+		{ID: 10, ProjectID: sql.NullInt64{Int64: 3, Valid: true}, Description: "low", Color: "#22C55E", SortOrder: 1},
+		{ID: 11, ProjectID: sql.NullInt64{Int64: 3, Valid: true}, Description: "high", Color: "#EF4444", SortOrder: 2},
+	}
+
+	got := PrioritiesToModels(input)
+	if len(got) != 2 {
+		t.Fatalf("len = %d, want 2", len(got))
+	}
+	if got[0].Description != "low" || got[1].SortOrder != 2 || got[1].ProjectID != 3 {
+		t.Errorf("unexpected conversion: %+v, %+v", got[0], got[1])
+	}
+
+	if empty := PrioritiesToModels(nil); empty == nil || len(empty) != 0 {
+		t.Errorf("PrioritiesToModels(nil) = %v, want empty slice", empty)
+	}
+}
+
+func TestRelationTypeToModel(t *testing.T) {
+	t.Parallel()
+
+	got := RelationTypeToModel(generated.RelationType{
+		ID:         4,
+		ProjectID:  sql.NullInt64{Int64: 2, Valid: true},
+		Name:       "verifies",
+		PToCLabel:  "Verifies",
+		CToPLabel:  "Verified By",
+		Color:      "#10B981",
+		IsBlocking: false,
+	})
+	want := &models.RelationType{ID: 4, ProjectID: 2, Name: "verifies", PToCLabel: "Verifies", CToPLabel: "Verified By", Color: "#10B981"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RelationTypeToModel() = %+v, want %+v", got, want)
+	}
+	if got.IsBuiltin() {
+		t.Error("project relation type reported as built-in")
+	}
+
+	if builtin := RelationTypeToModel(generated.RelationType{ID: 2, Name: "blocker", IsBlocking: true}); !builtin.IsBuiltin() {
+		t.Error("relation type without a project should be built-in")
+	}
+}
@@ -21,11 +21,14 @@
 package converters
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/thenoetrevino/paso/internal/database/generated"
 	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/recurrence"
 )
 
 // labelSeparator is used to separate concatenated label fields in queries
@@ -37,6 +40,7 @@ const labelSeparator = string(rune(31))
 // Handles NULL values for optional fields:
 // - description (sql.NullString)
 // - created_at, updated_at (sql.NullTime)
+// - due_at, start_at, archived_at (sql.NullTime → *time.Time)
 //
 // Type conversions:
 // - All ID fields: int64 → int
@@ -59,10 +63,31 @@ func TaskToModel(t generated.Task) *models.Task {
 	if t.UpdatedAt.Valid {
 		task.UpdatedAt = t.UpdatedAt.Time
 	}
+	task.DueAt = NullTimeToPtr(t.DueAt)
+	task.StartAt = NullTimeToPtr(t.StartAt)
+	task.ArchivedAt = NullTimeToPtr(t.ArchivedAt)
 
 	return task
 }
 
+// NullTimeToPtr converts an optional date column to a local time pointer,
+// nil when NULL. Dates are stored in UTC.
+func NullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time.Local()
+	return &v
+}
+
+// PtrToNullTime converts an optional date to its column value
+func PtrToNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
+
 // ParentTasksToReferences converts parent task rows to TaskReference slice
 func ParentTasksToReferences(rows []generated.GetParentTasksRow) []*models.TaskReference {
 	result := make([]*models.TaskReference, 0, len(rows))
@@ -120,15 +145,144 @@ func CommentsToModels(comments []generated.TaskComment) []*models.Comment {
 	return result
 }
 
+// ChecklistToModels converts generated.TaskChecklistItem slice to models.ChecklistItem slice
+func ChecklistToModels(items []generated.TaskChecklistItem) []*models.ChecklistItem {
+	result := make([]*models.ChecklistItem, 0, len(items))
+	for _, i := range items {
+		result = append(result, ChecklistItemToModel(i))
+	}
+	return result
+}
+
+// ChecklistItemToModel converts a generated.TaskChecklistItem to models.ChecklistItem
+func ChecklistItemToModel(item generated.TaskChecklistItem) *models.ChecklistItem {
+	return &models.ChecklistItem{
+		ID:        int(item.ID),
+		TaskID:    int(item.TaskID),
+		Text:      item.Content,
+		Done:      item.Done,
+		Position:  int(item.Position),
+		CreatedAt: item.CreatedAt.Time,
+		UpdatedAt: item.UpdatedAt.Time,
+	}
+}
+
+// TaskEventsToModels converts generated.TaskEvent slice to models.TaskEvent slice
+func TaskEventsToModels(rows []generated.TaskEvent) []*models.TaskEvent {
+	result := make([]*models.TaskEvent, 0, len(rows))
+	for _, e := range rows {
+		event := &models.TaskEvent{
+			ID:        int(e.ID),
+			ProjectID: int(e.ProjectID),
+			EventType: e.EventType,
+			Field:     e.Field,
+			OldValue:  e.OldValue,
+			NewValue:  e.NewValue,
+			Actor:     e.Actor,
+		}
+		if e.TaskID.Valid {
+			event.TaskID = int(e.TaskID.Int64)
+		}
+		if e.CreatedAt.Valid {
+			event.CreatedAt = e.CreatedAt.Time
+		}
+		result = append(result, event)
+	}
+	return result
+}
+
+// SearchResultsToModels converts search rows to models.TaskSearchResult slice
+func SearchResultsToModels(rows []generated.SearchTasksRow) []*models.TaskSearchResult {
+	result := make([]*models.TaskSearchResult, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, &models.TaskSearchResult{
+			ID:           int(r.ID),
+			TicketNumber: int(r.TicketNumber.Int64),
+			Title:        r.Title,
+			ColumnID:     int(r.ColumnID),
+			ColumnName:   r.ColumnName,
+			Archived:     r.ArchivedAt.Valid,
+			Snippet:      r.Snippet,
+			Rank:         r.Rank,
+		})
+	}
+	return result
+}
+
+// ArchivedTasksToModels converts archived task rows to models.ArchivedTask slice
+func ArchivedTasksToModels(rows []generated.GetArchivedTasksByProjectRow) []*models.ArchivedTask {
+	result := make([]*models.ArchivedTask, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, &models.ArchivedTask{
+			ID:           int(r.ID),
+			TicketNumber: int(r.TicketNumber.Int64),
+			Title:        r.Title,
+			ColumnName:   r.ColumnName,
+			ArchivedAt:   r.ArchivedAt.Time,
+		})
+	}
+	return result
+}
+
+// TaskRecurrenceToModel converts a task recurrence row to models.TaskRecurrence
+func TaskRecurrenceToModel(r generated.TaskRecurrence) *models.TaskRecurrence {
+	return &models.TaskRecurrence{
+		ID:             int(r.ID),
+		TaskID:         int(r.TaskID),
+		Rule:           r.Rule,
+		Description:    describeRule(r.Rule),
+		NextRunAt:      r.NextRunAt,
+		LastInstanceID: int(r.LastInstanceID.Int64),
+		Occurrences:    int(r.Occurrences),
+		CreatedAt:      r.CreatedAt.Time,
+	}
+}
+
+// TaskRecurrencesToModels converts a project's recurrence rows to models.TaskRecurrence slice
+func TaskRecurrencesToModels(rows []generated.GetTaskRecurrencesByProjectRow) []*models.TaskRecurrence {
+	result := make([]*models.TaskRecurrence, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, &models.TaskRecurrence{
+			ID:             int(r.ID),
+			TaskID:         int(r.TaskID),
+			Title:          r.Title,
+			TicketNumber:   int(r.TicketNumber.Int64),
+			Rule:           r.Rule,
+			Description:    describeRule(r.Rule),
+			NextRunAt:      r.NextRunAt,
+			LastInstanceID: int(r.LastInstanceID.Int64),
+			Occurrences:    int(r.Occurrences),
+			CreatedAt:      r.CreatedAt.Time,
+		})
+	}
+	return result
+}
+
+// describeRule renders a stored rule for people, falling back to the rule itself
+func describeRule(rule string) string {
+	parsed, err := recurrence.Parse(rule)
+	if err != nil {
+		return rule
+	}
+	return parsed.Describe()
+}
+
 // TaskSummaryFromRowToModel converts a task summary row to models.TaskSummary
 func TaskSummaryFromRowToModel(row generated.GetTaskSummariesByProjectRow) *models.TaskSummary {
 	summary := &models.TaskSummary{
-		ID:        int(row.ID),
-		Title:     row.Title,
-		ColumnID:  int(row.ColumnID),
-		Position:  int(row.Position),
-		IsBlocked: row.IsBlocked > 0,
-		Labels:    ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		ID:             int(row.ID),
+		Title:          row.Title,
+		TicketNumber:   int(row.TicketNumber.Int64),
+		ColumnID:       int(row.ColumnID),
+		Position:       int(row.Position),
+		IsBlocked:      row.IsBlocked > 0,
+		Labels:         ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		DueAt:          NullTimeToPtr(row.DueAt),
+		ArchivedAt:     NullTimeToPtr(row.ArchivedAt),
+		ChecklistDone:  int(row.ChecklistDone),
+		ChecklistTotal: int(row.ChecklistTotal),
+		ChildrenDone:   int(row.ChildrenDone),
+		ChildrenTotal:  int(row.ChildrenTotal),
 	}
 
 	if row.TypeDescription.Valid {
@@ -147,12 +301,14 @@ func TaskSummaryFromRowToModel(row generated.GetTaskSummariesByProjectRow) *mode
 // ReadyTaskSummaryFromRowToModel converts a ready task summary row to models.TaskSummary
 func ReadyTaskSummaryFromRowToModel(row generated.GetReadyTaskSummariesByProjectRow) *models.TaskSummary {
 	summary := &models.TaskSummary{
-		ID:        int(row.ID),
-		Title:     row.Title,
-		ColumnID:  int(row.ColumnID),
-		Position:  int(row.Position),
-		IsBlocked: row.IsBlocked > 0,
-		Labels:    ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		ID:           int(row.ID),
+		Title:        row.Title,
+		TicketNumber: int(row.TicketNumber.Int64),
+		ColumnID:     int(row.ColumnID),
+		Position:     int(row.Position),
+		IsBlocked:    row.IsBlocked > 0,
+		Labels:       ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		DueAt:        NullTimeToPtr(row.DueAt),
 	}
 
 	if row.TypeDescription.Valid {
@@ -171,12 +327,14 @@ func ReadyTaskSummaryFromRowToModel(row generated.GetReadyTaskSummariesByProject
 // FilteredTaskSummaryFromRowToModel converts a filtered task summary row to models.TaskSummary
 func FilteredTaskSummaryFromRowToModel(row generated.GetTaskSummariesByProjectFilteredRow) *models.TaskSummary {
 	summary := &models.TaskSummary{
-		ID:        int(row.ID),
-		Title:     row.Title,
-		ColumnID:  int(row.ColumnID),
-		Position:  int(row.Position),
-		IsBlocked: row.IsBlocked > 0,
-		Labels:    ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		ID:           int(row.ID),
+		Title:        row.Title,
+		TicketNumber: int(row.TicketNumber.Int64),
+		ColumnID:     int(row.ColumnID),
+		Position:     int(row.Position),
+		IsBlocked:    row.IsBlocked > 0,
+		Labels:       ParseLabelsFromConcatenated(row.LabelIds, row.LabelNames, row.LabelColors),
+		DueAt:        NullTimeToPtr(row.DueAt),
 	}
 
 	if row.TypeDescription.Valid {
@@ -690,6 +690,87 @@ func TestCommentsToModels(t *testing.T) {
 	}
 }
 
+func TestTaskEventsToModels(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		input    []generated.TaskEvent
+		expected []*models.TaskEvent
+	}{
+		{
+			name:     "empty slice",
+			input:    []generated.TaskEvent{},
+			expected: []*models.TaskEvent{},
+		},
+		{
+			name: "task event with field change",
+			input: []generated.TaskEvent{
+				{
+					ID:        1,
+					ProjectID: 10,
+					TaskID:    sql.NullInt64{Int64: 100, Valid: true},
+					EventType: "moved",
+					Field:     "column",
+					OldValue:  "Todo",
+					NewValue:  "Done",
+					Actor:     "alice",
+					CreatedAt: sql.NullTime{Time: now, Valid: true},
+				},
+			},
+			expected: []*models.TaskEvent{
+				{
+					ID:        1,
+					ProjectID: 10,
+					TaskID:    100,
+					EventType: "moved",
+					Field:     "column",
+					OldValue:  "Todo",
+					NewValue:  "Done",
+					Actor:     "alice",
+					CreatedAt: now,
+				},
+			},
+		},
+		{
+			name: "project-level event has no task ID",
+			input: []generated.TaskEvent{
+				{
+					ID:        2,
+					ProjectID: 10,
+					TaskID:    sql.NullInt64{Valid: false},
+					EventType: "column_renamed",
+					CreatedAt: sql.NullTime{Valid: false},
+				},
+			},
+			expected: []*models.TaskEvent{
+				{
+					ID:        2,
+					ProjectID: 10,
+					TaskID:    0,
+					EventType: "column_renamed",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := TaskEventsToModels(tt.input)
+
+			if len(result) != len(tt.expected) {
+				t.Fatalf("length = %d, want %d", len(result), len(tt.expected))
+			}
+
+			for i := range result {
+				if *result[i] != *tt.expected[i] {
+					t.Errorf("[%d] = %+v, want %+v", i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
 // ============================================================================
 // TEST CASES - ParseLabelsFromConcatenated
 // ============================================================================
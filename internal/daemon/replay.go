@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"sync"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+// replayBuffer is a bounded ring buffer of the most recent broadcast events.
+// Clients that reconnect send a resume message with the last sequence they
+// saw, and the daemon replays the gap from here.
+type replayBuffer struct {
+	mu     sync.Mutex
+	events []events.Event
+	next   int // index of the next write
+	count  int
+}
+
+// newReplayBuffer creates a buffer holding up to capacity events
+func newReplayBuffer(capacity int) *replayBuffer {
+	return &replayBuffer{events: make([]events.Event, capacity)}
+}
+
+// Add appends an event, evicting the oldest one when the buffer is full.
+// Events must be added in sequence order.
+func (b *replayBuffer) Add(event events.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.events) == 0 {
+		return
+	}
+	b.events[b.next] = event
+	b.next = (b.next + 1) % len(b.events)
+	if b.count < len(b.events) {
+		b.count++
+	}
+}
+
+// Since returns the buffered events with a sequence after seq, oldest first.
+// ok is false when some of those events were already evicted, i.e. the gap
+// cannot be replayed. current is the latest sequence handed out.
+func (b *replayBuffer) Since(seq, current int64) (missed []events.Event, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if seq > current {
+		return nil, false
+	}
+	if seq == current {
+		return nil, true
+	}
+
+	all := b.snapshotLocked()
+	if len(all) == 0 || all[0].SequenceID > seq+1 {
+		return nil, false
+	}
+	for _, event := range all {
+		if event.SequenceID > seq {
+			missed = append(missed, event)
+		}
+	}
+	return missed, true
+}
+
+// Snapshot returns the buffered events, oldest first
+func (b *replayBuffer) Snapshot() []events.Event {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.snapshotLocked()
+}
+
+func (b *replayBuffer) snapshotLocked() []events.Event {
+	out := make([]events.Event, 0, b.count)
+	start := (b.next - b.count + len(b.events)) % max(len(b.events), 1)
+	for i := 0; i < b.count; i++ {
+		out = append(out, b.events[(start+i)%len(b.events)])
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+func addSequence(b *replayBuffer, from, to int64) {
+	for seq := from; seq <= to; seq++ {
+		b.Add(events.Event{Type: events.EventDatabaseChanged, SequenceID: seq})
+	}
+}
+
+func TestReplayBuffer_Since(t *testing.T) {
+	b := newReplayBuffer(5)
+	addSequence(b, 1, 8) // keeps 4..8
+
+	tests := []struct {
+		name   string
+		seq    int64
+		want   []int64
+		wantOK bool
+	}{
+		{name: "up to date", seq: 8, want: nil, wantOK: true},
+		{name: "gap fully buffered", seq: 5, want: []int64{6, 7, 8}, wantOK: true},
+		{name: "gap starts at oldest buffered event", seq: 3, want: []int64{4, 5, 6, 7, 8}, wantOK: true},
+		{name: "gap partly evicted", seq: 2, wantOK: false},
+		{name: "sequence from the future", seq: 9, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			missed, ok := b.Since(tt.seq, 8)
+			if ok != tt.wantOK {
+				t.Fatalf("Since(%d) ok = %v, want %v", tt.seq, ok, tt.wantOK)
+			}
+			if len(missed) != len(tt.want) {
+				t.Fatalf("Since(%d) returned %d events, want %d", tt.seq, len(missed), len(tt.want))
+			}
+			for i, event := range missed {
+				if event.SequenceID != tt.want[i] {
+					t.Errorf("Since(%d)[%d] = %d, want %d", tt.seq, i, event.SequenceID, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReplayBuffer_Disabled(t *testing.T) {
+	b := newReplayBuffer(0)
+	addSequence(b, 1, 3)
+
+	if _, ok := b.Since(1, 3); ok {
+		t.Error("Since() ok = true with a zero-capacity buffer, want false")
+	}
+	if got := b.Snapshot(); len(got) != 0 {
+		t.Errorf("Snapshot() = %v, want empty", got)
+	}
+}
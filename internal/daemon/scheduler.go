@@ -0,0 +1,78 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+// Job is work the daemon repeats while it is running, such as generating
+// recurring tasks
+type Job func(ctx context.Context)
+
+// scheduledJob is a Job with the interval it runs on
+type scheduledJob struct {
+	name     string
+	interval time.Duration
+	run      Job
+}
+
+// Schedule runs job when the daemon starts and then every interval until it
+// shuts down. Runs of the same job never overlap. It must be called before
+// Start.
+func (s *Server) Schedule(name string, interval time.Duration, job Job) {
+	s.jobs = append(s.jobs, scheduledJob{name: name, interval: interval, run: job})
+}
+
+// runJob runs a scheduled job until ctx is cancelled
+func (s *Server) runJob(ctx context.Context, job scheduledJob) {
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		slog.Debug("running scheduled job", "job", job.name)
+		job.run(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Publisher returns an event publisher for services running inside the
+// daemon. Their events go straight to the connected clients.
+func (s *Server) Publisher() events.EventPublisher {
+	return &localPublisher{server: s}
+}
+
+// localPublisher implements events.EventPublisher on top of Broadcast
+type localPublisher struct {
+	server *Server
+}
+
+// Compile-time verification that *localPublisher implements EventPublisher
+var _ events.EventPublisher = (*localPublisher)(nil)
+
+func (p *localPublisher) Connect(ctx context.Context) error { return nil }
+
+func (p *localPublisher) SendEvent(event events.Event) error {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	return p.server.Broadcast(event)
+}
+
+func (p *localPublisher) Listen(ctx context.Context) (<-chan events.Event, error) {
+	return nil, errors.New("the daemon publisher cannot listen for events")
+}
+
+func (p *localPublisher) Subscribe(projectID int) error { return nil }
+
+func (p *localPublisher) SetNotifyFunc(fn events.NotifyFunc) {}
+
+func (p *localPublisher) Close() error { return nil }
@@ -0,0 +1,73 @@
+package daemon
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+func TestSchedule_RunsUntilShutdown(t *testing.T) {
+	server, err := NewServer(getTestSocketPath(t))
+	if err != nil {
+		t.Fatalf("Failed to create test daemon: %v", err)
+	}
+
+	var runs atomic.Int32
+	server.Schedule("count", 10*time.Millisecond, func(ctx context.Context) {
+		runs.Add(1)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.Start(ctx) }()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runs.Load() < 3 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := runs.Load(); got < 3 {
+		t.Fatalf("job ran %d times, want at least 3", got)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("daemon did not shut down")
+	}
+
+	after := runs.Load()
+	time.Sleep(50 * time.Millisecond)
+	if got := runs.Load(); got != after {
+		t.Errorf("job kept running after shutdown: %d runs, then %d", after, got)
+	}
+}
+
+func TestPublisher_BroadcastsToClients(t *testing.T) {
+	server, socketPath := setupTestDaemon(t)
+	conn, encoder, decoder := connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, encoder, 0)
+	time.Sleep(50 * time.Millisecond)
+
+	if err := server.Publisher().SendEvent(events.Event{Type: events.EventTaskCreated, ProjectID: 1, TaskID: 7}); err != nil {
+		t.Fatalf("SendEvent() error = %v", err)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		var msg events.Message
+		if err := decoder.Decode(&msg); err != nil {
+			t.Fatalf("Failed to read message: %v", err)
+		}
+		if msg.Type != "event" || msg.Event == nil {
+			continue
+		}
+		if msg.Event.TaskID != 7 || msg.Event.Timestamp.IsZero() {
+			t.Errorf("got event %+v, want task 7 with a timestamp", msg.Event)
+		}
+		return
+	}
+}
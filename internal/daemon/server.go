@@ -22,14 +22,19 @@ type client struct {
 	send         chan events.Message
 	subscription events.SubscribeMessage
 	lastPong     time.Time
-	mu           sync.Mutex // Protects subscription and lastPong
-	closeOnce    sync.Once  // Ensures send channel is closed only once
+	version      int           // Negotiated protocol version (see events.NegotiateVersion)
+	remote       bool          // Connected over TCP; pinned to its subscribed project
+	decoder      *json.Decoder // Reads client messages (may hold bytes read during the handshake)
+	mu           sync.Mutex    // Protects subscription, lastPong and version
+	closeOnce    sync.Once     // Ensures send channel is closed only once
 }
 
 // Server represents the Paso event daemon
 type Server struct {
 	socketPath       string
 	listener         net.Listener
+	tcpListener      net.Listener // Optional, see ListenTCP
+	token            string       // Shared token TCP clients authenticate with
 	clients          map[*client]bool
 	mu               sync.RWMutex
 	ctx              context.Context
@@ -37,7 +42,10 @@ type Server struct {
 	broadcast        chan events.Event
 	metrics          *Metrics
 	sequenceCounter  atomic.Int64
-	clientBufferSize int // Configurable client send queue size
+	epoch            int64          // Identifies this daemon instance's sequence numbers
+	replay           *replayBuffer  // Recent events for clients resuming after a disconnect
+	clientBufferSize int            // Configurable client send queue size
+	jobs             []scheduledJob // Run while the daemon is up, see Schedule
 	shutdownOnce     sync.Once
 }
 
@@ -81,8 +89,11 @@ func NewServer(socketPath string) (*Server, error) {
 	// Read buffer sizes from environment variables (configurable for performance tuning)
 	broadcastBuffer := getEnvInt("PASO_DAEMON_BROADCAST_BUFFER", 100)
 	clientBuffer := getEnvInt("PASO_DAEMON_CLIENT_BUFFER", 10)
+	replayBufferSize := getEnvInt("PASO_DAEMON_REPLAY_BUFFER", 1000)
 
-	return &Server{
+	// The epoch is new on every start: the database may have changed while
+	// no daemon was running, so clients resuming from before must resync
+	s := &Server{
 		socketPath:       socketPath,
 		listener:         listener,
 		clients:          make(map[*client]bool),
@@ -91,8 +102,12 @@ func NewServer(socketPath string) (*Server, error) {
 		broadcast:        make(chan events.Event, broadcastBuffer),
 		metrics:          NewMetrics(),
 		sequenceCounter:  atomic.Int64{},
+		epoch:            time.Now().UnixNano(),
+		replay:           newReplayBuffer(replayBufferSize),
 		clientBufferSize: clientBuffer,
-	}, nil
+	}
+
+	return s, nil
 }
 
 // Start runs the daemon server
@@ -112,15 +127,30 @@ func (s *Server) Start(ctx context.Context) error {
 	// Start accept loop
 	acceptErr := make(chan error, 1)
 	go func() {
-		acceptErr <- s.acceptLoop(combinedCtx)
+		acceptErr <- s.acceptLoop(combinedCtx, s.listener, false)
 	}()
 
+	// Start TCP accept loop, if enabled
+	if s.tcpListener != nil {
+		slog.Info("daemon listening on tcp", "addr", s.tcpListener.Addr().String())
+		go func() {
+			if err := s.acceptLoop(combinedCtx, s.tcpListener, true); err != nil {
+				slog.Error("tcp accept loop error", "error", err)
+			}
+		}()
+	}
+
 	// Start broadcast loop
 	go s.broadcastLoop(combinedCtx)
 
 	// Start health monitor
 	go s.monitorHealth(combinedCtx)
 
+	// Start scheduled jobs
+	for _, job := range s.jobs {
+		go s.runJob(combinedCtx, job)
+	}
+
 	// Wait for context or accept error
 	select {
 	case <-combinedCtx.Done():
@@ -135,8 +165,9 @@ func (s *Server) Start(ctx context.Context) error {
 	return s.Shutdown()
 }
 
-// acceptLoop accepts incoming client connections
-func (s *Server) acceptLoop(ctx context.Context) error {
+// acceptLoop accepts incoming client connections. Remote (TCP) connections
+// must authenticate before they are registered.
+func (s *Server) acceptLoop(ctx context.Context, listener net.Listener, remote bool) error {
 	for {
 		select {
 		case <-ctx.Done():
@@ -145,11 +176,13 @@ func (s *Server) acceptLoop(ctx context.Context) error {
 		}
 
 		// Set a read deadline so we can check for context cancellation
-		if err := s.listener.(*net.UnixListener).SetDeadline(time.Now().Add(1 * time.Second)); err != nil {
-			slog.Error("failed to setting listener deadline", "error", err)
+		if dl, ok := listener.(interface{ SetDeadline(time.Time) error }); ok {
+			if err := dl.SetDeadline(time.Now().Add(1 * time.Second)); err != nil {
+				slog.Error("failed to setting listener deadline", "error", err)
+			}
 		}
 
-		conn, err := s.listener.Accept()
+		conn, err := listener.Accept()
 		if err != nil {
 			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
 				continue
@@ -157,27 +190,40 @@ func (s *Server) acceptLoop(ctx context.Context) error {
 			return fmt.Errorf("accept error: %w", err)
 		}
 
-		// Create new client
-		c := &client{
-			conn:     conn,
-			send:     make(chan events.Message, s.clientBufferSize),
-			lastPong: time.Now(),
+		if remote {
+			go s.authenticateClient(conn)
+			continue
 		}
+		s.addClient(conn, json.NewDecoder(conn), false)
+	}
+}
 
-		// Register client
-		s.mu.Lock()
-		s.clients[c] = true
-		s.mu.Unlock()
+// addClient registers a connection and starts its handler goroutines
+func (s *Server) addClient(conn net.Conn, decoder *json.Decoder, remote bool) {
+	// Clients announce their protocol version with their first message;
+	// until then, assume the oldest supported one
+	c := &client{
+		conn:     conn,
+		send:     make(chan events.Message, s.clientBufferSize),
+		lastPong: time.Now(),
+		version:  events.MinProtocolVersion,
+		remote:   remote,
+		decoder:  decoder,
+	}
 
-		// Update metrics
-		s.updateClientCount()
+	// Register client
+	s.mu.Lock()
+	s.clients[c] = true
+	s.mu.Unlock()
 
-		slog.Info("client connected", "total_clients", s.getClientCount())
+	// Update metrics
+	s.updateClientCount()
 
-		// Start client handler goroutines
-		go s.handleClient(c)
-		go s.clientWriter(c)
-	}
+	slog.Info("client connected", "remote", remote, "total_clients", s.getClientCount())
+
+	// Start client handler goroutines
+	go s.handleClient(c)
+	go s.clientWriter(c)
 }
 
 // broadcastLoop distributes events to subscribed clients
@@ -188,25 +234,33 @@ func (s *Server) broadcastLoop(ctx context.Context) {
 			return
 
 		case event := <-s.broadcast:
+			s.metrics.IncRefreshesTotal()
+
+			// Sequence, buffer and send while holding the lock, so a client
+			// replaying missed events (which takes the write lock) sees either
+			// all of this event or none of it
+			s.mu.RLock()
+
 			// Add sequence number to event
 			event.SequenceID = s.sequenceCounter.Add(1)
-
-			s.metrics.IncRefreshesTotal()
+			event.Epoch = s.epoch
+			s.replay.Add(event)
 
 			// Send to all subscribed clients
-			s.mu.RLock()
 			for c := range s.clients {
 				// Check if client is subscribed to this project (protected by client mutex)
 				c.mu.Lock()
-				// Send event if: event is for all projects (0), OR client subscribed to all (0), OR client subscribed to specific project
-				isSubscribed := event.ProjectID == 0 || c.subscription.ProjectID == 0 || c.subscription.ProjectID == event.ProjectID
+				isSubscribed := c.wants(event)
+				version := c.version
 				c.mu.Unlock()
 
 				if isSubscribed {
+					// Version 1 clients receive typed events as db_changed
+					clientEvent := event.ForVersion(version)
 					msg := events.Message{
-						Version: events.ProtocolVersion,
+						Version: version,
 						Type:    "event",
-						Event:   &event,
+						Event:   &clientEvent,
 					}
 
 					// Non-blocking send - if client is slow, skip
@@ -227,27 +281,33 @@ func (s *Server) handleClient(c *client) {
 		slog.Info("client disconnected", "total_clients", s.getClientCount())
 	}()
 
-	decoder := json.NewDecoder(c.conn)
-
 	for {
 		var msg events.Message
 
-		if err := decoder.Decode(&msg); err != nil {
+		if err := c.decoder.Decode(&msg); err != nil {
 			return
 		}
 
-		// Check protocol version - log warning if mismatch
-		if msg.Version != 0 && msg.Version != events.ProtocolVersion {
+		// Check protocol version - log warning if the client is newer than us
+		if msg.Version > events.ProtocolVersion {
 			slog.Warn("received message with protocol version mismatch", "received", msg.Version, "expected", events.ProtocolVersion)
 		}
+		c.mu.Lock()
+		c.version = events.NegotiateVersion(msg.Version)
+		c.mu.Unlock()
 
 		switch msg.Type {
 		case "event":
 			if msg.Event != nil {
+				event, ok := c.scopeEvent(*msg.Event)
+				if !ok {
+					slog.Warn("dropped event from remote client without a project subscription")
+					continue
+				}
 				s.metrics.IncEventsReceived()
 				// Broadcast event to other clients
 				select {
-				case s.broadcast <- *msg.Event:
+				case s.broadcast <- event:
 				default:
 					slog.Warn("broadcast channel full")
 				}
@@ -255,12 +315,19 @@ func (s *Server) handleClient(c *client) {
 
 		case "subscribe":
 			if msg.Subscribe != nil {
-				c.mu.Lock()
-				c.subscription = *msg.Subscribe
-				c.mu.Unlock()
+				if !c.subscribe(*msg.Subscribe) {
+					slog.Warn("remote client tried to change its project subscription",
+						"projectID", msg.Subscribe.ProjectID)
+					continue
+				}
 				slog.Info("client subscribed to project", "projectID", msg.Subscribe.ProjectID)
 			}
 
+		case "resume":
+			if msg.Resume != nil {
+				s.resumeClient(c, *msg.Resume)
+			}
+
 		case "pong":
 			c.mu.Lock()
 			c.lastPong = time.Now()
@@ -269,6 +336,66 @@ func (s *Server) handleClient(c *client) {
 	}
 }
 
+// resumeClient sends a reconnecting client the events it missed since
+// resume.LastSequence, or a resync message if they are no longer buffered.
+// It holds the server write lock so no live event can overtake the replay.
+func (s *Server) resumeClient(c *client, resume events.ResumeMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c.mu.Lock()
+	version := c.version
+	c.mu.Unlock()
+
+	current := s.sequenceCounter.Load()
+	missed, ok := s.replay.Since(resume.LastSequence, current)
+	if resume.Epoch != s.epoch {
+		ok = false // Sequence numbers from another daemon instance
+	}
+
+	if !ok {
+		slog.Info("client resume gap not buffered, requesting resync",
+			"last_sequence", resume.LastSequence, "current_sequence", current)
+		msg := events.Message{
+			Version: version,
+			Type:    "resync",
+			Event: &events.Event{
+				Type:       events.EventDatabaseChanged,
+				Timestamp:  time.Now(),
+				SequenceID: current,
+				Epoch:      s.epoch,
+			},
+		}
+		if !s.sendToClient(c, msg) {
+			slog.Warn("client send queue full, resync dropped")
+		}
+		return
+	}
+
+	c.mu.Lock()
+	replay := make([]events.Event, 0, len(missed))
+	for _, event := range missed {
+		if c.wants(event) {
+			replay = append(replay, event.ForVersion(version))
+		}
+	}
+	c.mu.Unlock()
+
+	if len(replay) == 0 {
+		return
+	}
+
+	slog.Info("replaying missed events to client", "count", len(replay), "last_sequence", resume.LastSequence)
+	msg := events.Message{
+		Version: version,
+		Type:    "replay",
+		Events:  replay,
+	}
+	if !s.sendToClient(c, msg) {
+		slog.Warn("client send queue full, replay dropped")
+	}
+}
+
 // clientWriter sends messages to a client
 func (s *Server) clientWriter(c *client) {
 	encoder := json.NewEncoder(c.conn)
@@ -302,15 +429,18 @@ func (s *Server) monitorHealth(ctx context.Context) {
 			}
 			s.mu.RUnlock()
 
-			pingMsg := events.Message{
-				Version: events.ProtocolVersion,
-				Type:    "ping",
-				Event: &events.Event{
-					Type: events.EventPing,
-				},
-			}
-
 			for _, c := range clients {
+				c.mu.Lock()
+				version := c.version
+				c.mu.Unlock()
+
+				pingMsg := events.Message{
+					Version: version,
+					Type:    "ping",
+					Event: &events.Event{
+						Type: events.EventPing,
+					},
+				}
 				if !s.sendToClient(c, pingMsg) {
 					slog.Warn("failed to send ping to client (queue full)")
 				}
@@ -360,12 +490,17 @@ func (s *Server) Shutdown() error {
 
 		s.cancel()
 
-		// Close listener
+		// Close listeners
 		if s.listener != nil {
 			if closeErr := s.listener.Close(); closeErr != nil {
 				slog.Error("failed to closing listener", "error", closeErr)
 			}
 		}
+		if s.tcpListener != nil {
+			if closeErr := s.tcpListener.Close(); closeErr != nil {
+				slog.Error("failed to closing tcp listener", "error", closeErr)
+			}
+		}
 
 		// Close all client connections
 		s.mu.Lock()
@@ -394,6 +529,51 @@ func (s *Server) Shutdown() error {
 
 // Helper methods
 
+// wants reports whether the client's subscription covers the event: the event
+// is for all projects (0), the client subscribed to all (0), or to its project.
+// Remote clients cannot subscribe to all projects; until they subscribe to
+// one they receive nothing. Callers must hold c.mu.
+func (c *client) wants(event events.Event) bool {
+	if c.remote && c.subscription.ProjectID == 0 {
+		return false
+	}
+	return event.ProjectID == 0 || c.subscription.ProjectID == 0 || c.subscription.ProjectID == event.ProjectID
+}
+
+// subscribe sets the client's subscription. A remote client is pinned to the
+// first project it subscribes to; subscribing to another project afterwards
+// is refused and reported as false.
+func (c *client) subscribe(sub events.SubscribeMessage) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.remote && c.subscription.ProjectID != 0 && sub.ProjectID != c.subscription.ProjectID {
+		return false
+	}
+	c.subscription = sub
+	return true
+}
+
+// scopeEvent returns an event published by the client as it may be
+// broadcast. Events of remote clients are forced to their subscribed project,
+// so they cannot reach other projects' clients; before subscribing to a
+// project, a remote client cannot publish at all.
+func (c *client) scopeEvent(event events.Event) (events.Event, bool) {
+	if !c.remote {
+		return event, true
+	}
+
+	c.mu.Lock()
+	projectID := c.subscription.ProjectID
+	c.mu.Unlock()
+
+	if projectID == 0 {
+		return event, false
+	}
+	event.ProjectID = projectID
+	return event, true
+}
+
 func (s *Server) getClientCount() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
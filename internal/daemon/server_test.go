@@ -361,6 +361,68 @@ func TestBroadcast_SingleClient(t *testing.T) {
 	t.Logf("✓ Event broadcast and received successfully (sequence: %d)", receivedEvent.SequenceID)
 }
 
+func TestBroadcast_ProtocolVersions(t *testing.T) {
+	server, socketPath := setupTestDaemon(t)
+
+	// A v1 client (e.g. an older TUI) only understands db_changed
+	_, v1Encoder, v1Decoder := connectRawClient(t, socketPath)
+	if err := v1Encoder.Encode(events.Message{
+		Version:   1,
+		Type:      "subscribe",
+		Subscribe: &events.SubscribeMessage{ProjectID: 1},
+	}); err != nil {
+		t.Fatalf("Failed to send subscribe: %v", err)
+	}
+
+	// A current client receives typed events unchanged
+	_, v2Encoder, v2Decoder := connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, v2Encoder, 1)
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := server.Broadcast(events.Event{
+		Type:      events.EventTaskMoved,
+		ProjectID: 1,
+		TaskID:    42,
+		ColumnID:  3,
+		Fields:    []string{"column"},
+	}); err != nil {
+		t.Fatalf("Failed to broadcast: %v", err)
+	}
+
+	readEvent := func(decoder *json.Decoder) events.Message {
+		t.Helper()
+		for {
+			var msg events.Message
+			if err := decoder.Decode(&msg); err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+			if msg.Type == "event" {
+				return msg
+			}
+		}
+	}
+
+	v1Msg := readEvent(v1Decoder)
+	if v1Msg.Version != 1 {
+		t.Errorf("v1 client got message version %d, want 1", v1Msg.Version)
+	}
+	if v1Msg.Event.Type != events.EventDatabaseChanged || v1Msg.Event.TaskID != 0 {
+		t.Errorf("v1 client got %+v, want a bare db_changed", *v1Msg.Event)
+	}
+	if v1Msg.Event.ProjectID != 1 || v1Msg.Event.SequenceID == 0 {
+		t.Errorf("v1 client event lost project or sequence: %+v", *v1Msg.Event)
+	}
+
+	v2Msg := readEvent(v2Decoder)
+	if v2Msg.Version != events.ProtocolVersion {
+		t.Errorf("v2 client got message version %d, want %d", v2Msg.Version, events.ProtocolVersion)
+	}
+	if v2Msg.Event.Type != events.EventTaskMoved || v2Msg.Event.TaskID != 42 || v2Msg.Event.ColumnID != 3 {
+		t.Errorf("v2 client got %+v, want the typed task.moved event", *v2Msg.Event)
+	}
+}
+
 func TestBroadcast_MultipleClients(t *testing.T) {
 	server, socketPath := setupTestDaemon(t)
 
@@ -535,6 +597,152 @@ func TestBroadcast_SequenceNumbers(t *testing.T) {
 	t.Logf("✓ Sequence numbers are monotonically increasing: %v", sequences)
 }
 
+func TestResume_ReplaysMissedEvents(t *testing.T) {
+	server, socketPath := setupTestDaemon(t)
+
+	_, encoder, decoder := connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, encoder, 1)
+	time.Sleep(50 * time.Millisecond)
+
+	// Events for another project are not replayed to this subscription
+	for _, projectID := range []int{1, 2, 1} {
+		if err := server.Broadcast(events.Event{Type: events.EventTaskUpdated, ProjectID: projectID}); err != nil {
+			t.Fatalf("Failed to broadcast: %v", err)
+		}
+	}
+
+	readMessage := func(msgType string) events.Message {
+		t.Helper()
+		for {
+			var msg events.Message
+			if err := decoder.Decode(&msg); err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+			if msg.Type == msgType {
+				return msg
+			}
+		}
+	}
+
+	first := readMessage("event")
+	readMessage("event")
+
+	// Resume as if only the first event had arrived
+	if err := encoder.Encode(events.Message{
+		Version: events.ProtocolVersion,
+		Type:    "resume",
+		Resume:  &events.ResumeMessage{Epoch: first.Event.Epoch, LastSequence: first.Event.SequenceID},
+	}); err != nil {
+		t.Fatalf("Failed to send resume: %v", err)
+	}
+
+	replay := readMessage("replay")
+	if len(replay.Events) != 1 {
+		t.Fatalf("replay has %d events, want 1: %+v", len(replay.Events), replay.Events)
+	}
+	if got := replay.Events[0]; got.ProjectID != 1 || got.SequenceID != first.Event.SequenceID+2 {
+		t.Errorf("replayed %+v, want the project 1 event with sequence %d", got, first.Event.SequenceID+2)
+	}
+}
+
+func TestResume_ResyncWhenGapUnavailable(t *testing.T) {
+	server, socketPath := setupTestDaemon(t)
+
+	_, encoder, decoder := connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, encoder, 0)
+	time.Sleep(50 * time.Millisecond)
+
+	if err := server.Broadcast(events.Event{Type: events.EventTaskCreated, ProjectID: 1}); err != nil {
+		t.Fatalf("Failed to broadcast: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	// Sequence numbers from a previous daemon instance cannot be replayed
+	if err := encoder.Encode(events.Message{
+		Version: events.ProtocolVersion,
+		Type:    "resume",
+		Resume:  &events.ResumeMessage{Epoch: server.epoch - 1, LastSequence: 1},
+	}); err != nil {
+		t.Fatalf("Failed to send resume: %v", err)
+	}
+
+	for {
+		var msg events.Message
+		if err := decoder.Decode(&msg); err != nil {
+			t.Fatalf("Failed to decode: %v", err)
+		}
+		if msg.Type == "replay" {
+			t.Fatalf("got replay %+v, want resync", msg.Events)
+		}
+		if msg.Type != "resync" {
+			continue
+		}
+		if msg.Event == nil || msg.Event.Epoch != server.epoch || msg.Event.SequenceID != 1 {
+			t.Errorf("resync event = %+v, want the current epoch and sequence 1", msg.Event)
+		}
+		return
+	}
+}
+
+func TestResume_ResyncAfterRestart(t *testing.T) {
+	socketPath := getTestSocketPath(t)
+
+	start := func() *Server {
+		t.Helper()
+		server, err := NewServer(socketPath)
+		if err != nil {
+			t.Fatalf("Failed to create test daemon: %v", err)
+		}
+		t.Cleanup(func() { _ = server.Shutdown() })
+		go func() { _ = server.Start(context.Background()) }()
+		time.Sleep(50 * time.Millisecond)
+		return server
+	}
+
+	readMessage := func(decoder *json.Decoder, msgType string) events.Message {
+		t.Helper()
+		for {
+			var msg events.Message
+			if err := decoder.Decode(&msg); err != nil {
+				t.Fatalf("Failed to decode: %v", err)
+			}
+			if msg.Type == msgType {
+				return msg
+			}
+		}
+	}
+
+	first := start()
+	_, encoder, decoder := connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, encoder, 0)
+	time.Sleep(50 * time.Millisecond)
+	if err := first.Broadcast(events.Event{Type: events.EventTaskCreated, ProjectID: 1}); err != nil {
+		t.Fatalf("Failed to broadcast: %v", err)
+	}
+	seen := readMessage(decoder, "event")
+	if err := first.Shutdown(); err != nil {
+		t.Fatalf("Failed to shut down: %v", err)
+	}
+
+	// Whatever changed while no daemon ran is unknown to the new one, so a
+	// client that was up to date must still resync
+	second := start()
+	_, encoder, decoder = connectRawClient(t, socketPath)
+	sendSubscribeMessage(t, encoder, 0)
+	if err := encoder.Encode(events.Message{
+		Version: events.ProtocolVersion,
+		Type:    "resume",
+		Resume:  &events.ResumeMessage{Epoch: seen.Event.Epoch, LastSequence: seen.Event.SequenceID},
+	}); err != nil {
+		t.Fatalf("Failed to send resume: %v", err)
+	}
+
+	resync := readMessage(decoder, "resync")
+	if resync.Event == nil || resync.Event.Epoch != second.epoch || resync.Event.Epoch == seen.Event.Epoch {
+		t.Errorf("resync event = %+v, want the new daemon's epoch", resync.Event)
+	}
+}
+
 // ============================================================================
 // Shutdown Tests
 // ============================================================================
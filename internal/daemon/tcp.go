@@ -0,0 +1,85 @@
+package daemon
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+// authTimeout bounds how long a TCP connection may take to authenticate
+const authTimeout = 5 * time.Second
+
+// ListenTCP opens an additional TCP listener for sessions that cannot reach
+// the Unix socket, such as devcontainers or other machines. It must be called
+// before Start.
+//
+// TCP connections speak the same protocol as the socket, but must first send
+// an "auth" message carrying token. They are pinned to the first project
+// they subscribe to: they only receive that project's events (see
+// client.wants), and events they publish are broadcast to it alone (see
+// client.scopeEvent).
+func (s *Server) ListenTCP(addr, token string) error {
+	if token == "" {
+		return errors.New("a token is required to listen on TCP")
+	}
+
+	listener, err := (&net.ListenConfig{}).Listen(s.ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	s.tcpListener = listener
+	s.token = token
+	return nil
+}
+
+// TCPAddr returns the address of the TCP listener, or nil if there is none
+func (s *Server) TCPAddr() net.Addr {
+	if s.tcpListener == nil {
+		return nil
+	}
+	return s.tcpListener.Addr()
+}
+
+// authenticateClient performs the token handshake on a new TCP connection
+// and registers it as a remote client. The connection is closed if the
+// first message is not an "auth" message with the right token.
+func (s *Server) authenticateClient(conn net.Conn) {
+	decoder := json.NewDecoder(conn)
+	encoder := json.NewEncoder(conn)
+
+	if err := conn.SetReadDeadline(time.Now().Add(authTimeout)); err != nil {
+		slog.Error("failed to setting auth deadline", "error", err)
+	}
+
+	var msg events.Message
+	err := decoder.Decode(&msg)
+	version := events.NegotiateVersion(msg.Version)
+
+	if err != nil || msg.Type != "auth" || msg.Auth == nil ||
+		subtle.ConstantTimeCompare([]byte(msg.Auth.Token), []byte(s.token)) != 1 {
+		slog.Warn("rejected tcp client", "remote_addr", conn.RemoteAddr().String())
+		if err == nil {
+			_ = encoder.Encode(events.Message{Version: version, Type: "auth_failed"})
+		}
+		_ = conn.Close()
+		return
+	}
+
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		slog.Error("failed to clearing auth deadline", "error", err)
+	}
+	if err := encoder.Encode(events.Message{Version: version, Type: "auth_ok"}); err != nil {
+		_ = conn.Close()
+		return
+	}
+
+	slog.Info("tcp client authenticated", "remote_addr", conn.RemoteAddr().String())
+	s.addClient(conn, decoder, true)
+}
@@ -0,0 +1,241 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/events"
+)
+
+const testToken = "s3cret"
+
+func setupTestDaemonTCP(t *testing.T) *Server {
+	t.Helper()
+
+	server, err := NewServer(getTestSocketPath(t))
+	if err != nil {
+		t.Fatalf("Failed to create test daemon: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = server.Shutdown()
+	})
+
+	if err := server.ListenTCP("127.0.0.1:0", testToken); err != nil {
+		t.Fatalf("Failed to listen on tcp: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() { _ = server.Start(ctx) }()
+
+	return server
+}
+
+func connectTCPClient(t *testing.T, server *Server, token string) (*json.Encoder, *json.Decoder, events.Message) {
+	t.Helper()
+
+	conn, err := (&net.Dialer{}).DialContext(context.Background(), "tcp", server.TCPAddr().String())
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	_ = conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+
+	encoder, decoder := json.NewEncoder(conn), json.NewDecoder(conn)
+	if err := encoder.Encode(events.Message{
+		Version: events.ProtocolVersion,
+		Type:    "auth",
+		Auth:    &events.AuthMessage{Token: token},
+	}); err != nil {
+		t.Fatalf("Failed to send auth: %v", err)
+	}
+
+	var reply events.Message
+	if err := decoder.Decode(&reply); err != nil {
+		t.Fatalf("Failed to read auth reply: %v", err)
+	}
+	return encoder, decoder, reply
+}
+
+func TestListenTCP_RequiresToken(t *testing.T) {
+	server, err := NewServer(getTestSocketPath(t))
+	if err != nil {
+		t.Fatalf("Failed to create test daemon: %v", err)
+	}
+	defer func() { _ = server.Shutdown() }()
+
+	if err := server.ListenTCP("127.0.0.1:0", ""); err == nil {
+		t.Error("ListenTCP() with an empty token succeeded, want an error")
+	}
+	if server.TCPAddr() != nil {
+		t.Error("TCPAddr() is set after a failed ListenTCP")
+	}
+}
+
+func TestTCP_Auth(t *testing.T) {
+	server := setupTestDaemonTCP(t)
+
+	_, decoder, reply := connectTCPClient(t, server, "wrong")
+	if reply.Type != "auth_failed" {
+		t.Errorf("reply to a wrong token = %q, want auth_failed", reply.Type)
+	}
+	var msg events.Message
+	if err := decoder.Decode(&msg); err == nil {
+		t.Errorf("connection still open after auth_failed, got %+v", msg)
+	}
+	if got := server.getClientCount(); got != 0 {
+		t.Errorf("client count = %d after a rejected connection, want 0", got)
+	}
+
+	_, _, reply = connectTCPClient(t, server, testToken)
+	if reply.Type != "auth_ok" {
+		t.Errorf("reply to the right token = %q, want auth_ok", reply.Type)
+	}
+}
+
+func TestTCP_ProjectScoping(t *testing.T) {
+	server := setupTestDaemonTCP(t)
+
+	encoder, decoder, reply := connectTCPClient(t, server, testToken)
+	if reply.Type != "auth_ok" {
+		t.Fatalf("auth reply = %q, want auth_ok", reply.Type)
+	}
+
+	// Remote connections cannot subscribe to every project
+	sendSubscribeMessage(t, encoder, 0)
+	time.Sleep(50 * time.Millisecond)
+	if err := server.Broadcast(events.Event{Type: events.EventTaskCreated, ProjectID: 1, TaskID: 1}); err != nil {
+		t.Fatalf("Failed to broadcast: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	sendSubscribeMessage(t, encoder, 2)
+	time.Sleep(50 * time.Millisecond)
+	for _, projectID := range []int{1, 2} {
+		if err := server.Broadcast(events.Event{Type: events.EventTaskCreated, ProjectID: projectID, TaskID: 10 + projectID}); err != nil {
+			t.Fatalf("Failed to broadcast: %v", err)
+		}
+	}
+
+	var msg events.Message
+	for msg.Type != "event" {
+		msg = events.Message{}
+		if err := decoder.Decode(&msg); err != nil {
+			t.Fatalf("Failed to decode: %v", err)
+		}
+	}
+	if msg.Event.ProjectID != 2 || msg.Event.TaskID != 12 {
+		t.Errorf("first event = %+v, want the project 2 task", *msg.Event)
+	}
+}
+
+func TestTCP_SubscriptionPinned(t *testing.T) {
+	server := setupTestDaemonTCP(t)
+
+	encoder, decoder, reply := connectTCPClient(t, server, testToken)
+	if reply.Type != "auth_ok" {
+		t.Fatalf("auth reply = %q, want auth_ok", reply.Type)
+	}
+
+	// Once subscribed to a project, a remote connection cannot switch to another
+	sendSubscribeMessage(t, encoder, 2)
+	sendSubscribeMessage(t, encoder, 1)
+	time.Sleep(50 * time.Millisecond)
+	for _, projectID := range []int{1, 2} {
+		if err := server.Broadcast(events.Event{Type: events.EventTaskCreated, ProjectID: projectID, TaskID: 10 + projectID}); err != nil {
+			t.Fatalf("Failed to broadcast: %v", err)
+		}
+	}
+
+	var msg events.Message
+	for msg.Type != "event" {
+		msg = events.Message{}
+		if err := decoder.Decode(&msg); err != nil {
+			t.Fatalf("Failed to decode: %v", err)
+		}
+	}
+	if msg.Event.ProjectID != 2 || msg.Event.TaskID != 12 {
+		t.Errorf("first event = %+v, want the project 2 task", *msg.Event)
+	}
+}
+
+func TestTCP_PublishScopedToSubscription(t *testing.T) {
+	server := setupTestDaemonTCP(t)
+
+	_, localEncoder, localDecoder := connectRawClient(t, server.socketPath)
+	sendSubscribeMessage(t, localEncoder, 0)
+
+	encoder, _, reply := connectTCPClient(t, server, testToken)
+	if reply.Type != "auth_ok" {
+		t.Fatalf("auth reply = %q, want auth_ok", reply.Type)
+	}
+	publish := func(taskID int) {
+		t.Helper()
+		if err := encoder.Encode(events.Message{
+			Version: events.ProtocolVersion,
+			Type:    "event",
+			Event:   &events.Event{Type: events.EventTaskUpdated, ProjectID: 1, TaskID: taskID},
+		}); err != nil {
+			t.Fatalf("Failed to publish: %v", err)
+		}
+	}
+
+	// Dropped: the remote connection has no project yet
+	publish(1)
+	time.Sleep(50 * time.Millisecond)
+
+	// Delivered, but for the subscribed project rather than the claimed one
+	sendSubscribeMessage(t, encoder, 2)
+	time.Sleep(50 * time.Millisecond)
+	publish(2)
+
+	var msg events.Message
+	for msg.Type != "event" {
+		msg = events.Message{}
+		if err := localDecoder.Decode(&msg); err != nil {
+			t.Fatalf("Failed to decode: %v", err)
+		}
+	}
+	if msg.Event.TaskID != 2 || msg.Event.ProjectID != 2 {
+		t.Errorf("first event = %+v, want task 2 in project 2", *msg.Event)
+	}
+}
+
+func TestTCP_EventsClient(t *testing.T) {
+	server := setupTestDaemonTCP(t)
+
+	t.Setenv("PASO_DAEMON_ADDR", server.TCPAddr().String())
+	t.Setenv("PASO_DAEMON_TOKEN", "wrong")
+	rejected, err := events.NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer func() { _ = rejected.Close() }()
+	err = rejected.Connect(context.Background())
+	if !errors.Is(err, events.ErrAuthRejected) {
+		t.Errorf("Connect() with a wrong token error = %v, want ErrAuthRejected", err)
+	}
+
+	t.Setenv("PASO_DAEMON_TOKEN", testToken)
+	client := setupTestClient(t, "")
+	if err := client.Subscribe(3); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	eventChan, _ := client.Listen(context.Background())
+	time.Sleep(50 * time.Millisecond)
+
+	if err := server.Broadcast(events.Event{Type: events.EventTaskUpdated, ProjectID: 3, TaskID: 7}); err != nil {
+		t.Fatalf("Failed to broadcast: %v", err)
+	}
+
+	event := waitForEvent(t, eventChan, 2*time.Second)
+	if event.Type != events.EventTaskUpdated || event.TaskID != 7 {
+		t.Errorf("received %+v, want task.updated for task 7", event)
+	}
+}
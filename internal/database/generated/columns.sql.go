@@ -7,6 +7,7 @@ package generated
 
 import (
 	"context"
+	"database/sql"
 )
 
 const clearCompletedColumnByProject = `-- name: ClearCompletedColumnByProject :exec
@@ -73,7 +74,7 @@ insert into columns (
     holds_in_progress_tasks
 )
 values (?, ?, ?, ?, ?, ?, ?)
-returning id, name, prev_id, next_id, project_id, holds_ready_tasks, holds_completed_tasks, holds_in_progress_tasks
+returning id, name, prev_id, next_id, project_id, holds_ready_tasks, holds_completed_tasks, holds_in_progress_tasks, wip_limit
 `
 
 type CreateColumnParams struct {
@@ -108,6 +109,7 @@ func (q *Queries) CreateColumn(ctx context.Context, arg CreateColumnParams) (Col
 		&i.HoldsReadyTasks,
 		&i.HoldsCompletedTasks,
 		&i.HoldsInProgressTasks,
+		&i.WipLimit,
 	)
 	return i, err
 }
@@ -143,7 +145,8 @@ select
     next_id,
     holds_ready_tasks,
     holds_completed_tasks,
-    holds_in_progress_tasks
+    holds_in_progress_tasks,
+    wip_limit
 from columns
 where id = ?
 `
@@ -157,6 +160,7 @@ type GetColumnByIDRow struct {
 	HoldsReadyTasks      bool
 	HoldsCompletedTasks  bool
 	HoldsInProgressTasks bool
+	WipLimit             sql.NullInt64
 }
 
 // Retrieves a column by its ID with all metadata
@@ -172,6 +176,7 @@ func (q *Queries) GetColumnByID(ctx context.Context, id int64) (GetColumnByIDRow
 		&i.HoldsReadyTasks,
 		&i.HoldsCompletedTasks,
 		&i.HoldsInProgressTasks,
+		&i.WipLimit,
 	)
 	return i, err
 }
@@ -222,7 +227,8 @@ select
     next_id,
     holds_ready_tasks,
     holds_completed_tasks,
-    holds_in_progress_tasks
+    holds_in_progress_tasks,
+    wip_limit
 from columns
 where project_id = ?
 `
@@ -236,6 +242,7 @@ type GetColumnsByProjectRow struct {
 	HoldsReadyTasks      bool
 	HoldsCompletedTasks  bool
 	HoldsInProgressTasks bool
+	WipLimit             sql.NullInt64
 }
 
 // Retrieves all columns for a specific project
@@ -257,6 +264,7 @@ func (q *Queries) GetColumnsByProject(ctx context.Context, projectID int64) ([]G
 			&i.HoldsReadyTasks,
 			&i.HoldsCompletedTasks,
 			&i.HoldsInProgressTasks,
+			&i.WipLimit,
 		); err != nil {
 			return nil, err
 		}
@@ -398,6 +406,49 @@ func (q *Queries) GetTailColumnForProject(ctx context.Context, projectID int64)
 	return id, err
 }
 
+const restoreColumn = `-- name: RestoreColumn :exec
+insert into columns (
+    id,
+    name,
+    project_id,
+    prev_id,
+    next_id,
+    holds_ready_tasks,
+    holds_completed_tasks,
+    holds_in_progress_tasks,
+    wip_limit
+)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type RestoreColumnParams struct {
+	ID                   int64
+	Name                 string
+	ProjectID            int64
+	PrevID               interface{}
+	NextID               interface{}
+	HoldsReadyTasks      bool
+	HoldsCompletedTasks  bool
+	HoldsInProgressTasks bool
+	WipLimit             sql.NullInt64
+}
+
+// Re-creates a deleted column under its original ID
+func (q *Queries) RestoreColumn(ctx context.Context, arg RestoreColumnParams) error {
+	_, err := q.db.ExecContext(ctx, restoreColumn,
+		arg.ID,
+		arg.Name,
+		arg.ProjectID,
+		arg.PrevID,
+		arg.NextID,
+		arg.HoldsReadyTasks,
+		arg.HoldsCompletedTasks,
+		arg.HoldsInProgressTasks,
+		arg.WipLimit,
+	)
+	return err
+}
+
 const updateColumnHoldsCompletedTasks = `-- name: UpdateColumnHoldsCompletedTasks :exec
 update columns
 set holds_completed_tasks = ?
@@ -499,3 +550,20 @@ func (q *Queries) UpdateColumnPrevID(ctx context.Context, arg UpdateColumnPrevID
 	_, err := q.db.ExecContext(ctx, updateColumnPrevID, arg.PrevID, arg.ID)
 	return err
 }
+
+const updateColumnWipLimit = `-- name: UpdateColumnWipLimit :exec
+update columns
+set wip_limit = ?
+where id = ?
+`
+
+type UpdateColumnWipLimitParams struct {
+	WipLimit sql.NullInt64
+	ID       int64
+}
+
+// Sets a column's work-in-progress limit; NULL removes it
+func (q *Queries) UpdateColumnWipLimit(ctx context.Context, arg UpdateColumnWipLimitParams) error {
+	_, err := q.db.ExecContext(ctx, updateColumnWipLimit, arg.WipLimit, arg.ID)
+	return err
+}
@@ -7,6 +7,7 @@ package generated
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createComment = `-- name: CreateComment :one
@@ -85,6 +86,52 @@ func (q *Queries) GetCommentCountByTask(ctx context.Context, taskID int64) (int6
 	return count, err
 }
 
+const getCommentsByProject = `-- name: GetCommentsByProject :many
+select
+    tc.id,
+    tc.task_id,
+    tc.content,
+    tc.author,
+    tc.created_at,
+    tc.updated_at
+from task_comments tc
+inner join tasks t on tc.task_id = t.id
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by tc.task_id, tc.created_at, tc.id
+`
+
+// Retrieves every comment on the tasks of a project, for export
+func (q *Queries) GetCommentsByProject(ctx context.Context, projectID int64) ([]TaskComment, error) {
+	rows, err := q.db.QueryContext(ctx, getCommentsByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskComment{}
+	for rows.Next() {
+		var i TaskComment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TaskID,
+			&i.Content,
+			&i.Author,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCommentsByTask = `-- name: GetCommentsByTask :many
 select id, task_id, content, author, created_at, updated_at
 from task_comments
@@ -123,6 +170,68 @@ func (q *Queries) GetCommentsByTask(ctx context.Context, taskID int64) ([]TaskCo
 	return items, nil
 }
 
+const importComment = `-- name: ImportComment :one
+insert into task_comments (task_id, content, author, created_at, updated_at)
+values (?, ?, ?, coalesce(?, current_timestamp), coalesce(?, current_timestamp))
+returning id, task_id, content, author, created_at, updated_at
+`
+
+type ImportCommentParams struct {
+	TaskID    int64
+	Content   string
+	Author    string
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+// Creates a comment keeping its timestamps
+func (q *Queries) ImportComment(ctx context.Context, arg ImportCommentParams) (TaskComment, error) {
+	row := q.db.QueryRowContext(ctx, importComment,
+		arg.TaskID,
+		arg.Content,
+		arg.Author,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	var i TaskComment
+	err := row.Scan(
+		&i.ID,
+		&i.TaskID,
+		&i.Content,
+		&i.Author,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const restoreComment = `-- name: RestoreComment :exec
+insert into task_comments (id, task_id, content, author, created_at, updated_at)
+values (?, ?, ?, ?, ?, ?)
+`
+
+type RestoreCommentParams struct {
+	ID        int64
+	TaskID    int64
+	Content   string
+	Author    string
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
+}
+
+// Re-creates a deleted comment under its original ID and timestamps
+func (q *Queries) RestoreComment(ctx context.Context, arg RestoreCommentParams) error {
+	_, err := q.db.ExecContext(ctx, restoreComment,
+		arg.ID,
+		arg.TaskID,
+		arg.Content,
+		arg.Author,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
 const updateComment = `-- name: UpdateComment :exec
 update task_comments
 set content = ?, updated_at = current_timestamp
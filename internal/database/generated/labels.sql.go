@@ -165,6 +165,69 @@ func (q *Queries) GetLabelsForTask(ctx context.Context, taskID int64) ([]Label,
 	return items, nil
 }
 
+const getTaskIDsByLabel = `-- name: GetTaskIDsByLabel :many
+select task_id from task_labels where label_id = ?
+`
+
+// Retrieves the IDs of all tasks a label is attached to
+func (q *Queries) GetTaskIDsByLabel(ctx context.Context, labelID int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskIDsByLabel, labelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var task_id int64
+		if err := rows.Scan(&task_id); err != nil {
+			return nil, err
+		}
+		items = append(items, task_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskLabelsByProject = `-- name: GetTaskLabelsByProject :many
+select tl.task_id, tl.label_id
+from task_labels tl
+inner join labels l on tl.label_id = l.id
+where l.project_id = ?
+order by tl.task_id, tl.label_id
+`
+
+// Retrieves every task/label association of a project, for export
+func (q *Queries) GetTaskLabelsByProject(ctx context.Context, projectID int64) ([]TaskLabel, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskLabelsByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskLabel{}
+	for rows.Next() {
+		var i TaskLabel
+		if err := rows.Scan(
+			&i.TaskID,
+			&i.LabelID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const insertTaskLabel = `-- name: InsertTaskLabel :exec
 insert into task_labels (task_id, label_id) values (?, ?)
 `
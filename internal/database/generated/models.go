@@ -6,6 +6,7 @@ package generated
 
 import (
 	"database/sql"
+	"time"
 )
 
 type Column struct {
@@ -17,6 +18,7 @@ type Column struct {
 	HoldsReadyTasks      bool
 	HoldsCompletedTasks  bool
 	HoldsInProgressTasks bool
+	WipLimit             sql.NullInt64
 }
 
 type Label struct {
@@ -28,8 +30,11 @@ type Label struct {
 
 type Priority struct {
 	ID          int64
+	ProjectID   sql.NullInt64
 	Description string
 	Color       string
+	Icon        string
+	SortOrder   int64
 }
 
 type Project struct {
@@ -38,6 +43,7 @@ type Project struct {
 	Description sql.NullString
 	CreatedAt   sql.NullTime
 	UpdatedAt   sql.NullTime
+	TicketKey   sql.NullString
 }
 
 type ProjectCounter struct {
@@ -45,12 +51,22 @@ type ProjectCounter struct {
 	NextTicketNumber sql.NullInt64
 }
 
+type ProjectTemplate struct {
+	ID         int64
+	Name       string
+	Definition string
+	CreatedAt  sql.NullTime
+	UpdatedAt  sql.NullTime
+}
+
 type RelationType struct {
 	ID         int64
 	PToCLabel  string
 	CToPLabel  string
 	Color      string
 	IsBlocking bool
+	ProjectID  sql.NullInt64
+	Name       string
 }
 
 type Task struct {
@@ -64,6 +80,19 @@ type Task struct {
 	PriorityID   int64
 	CreatedAt    sql.NullTime
 	UpdatedAt    sql.NullTime
+	DueAt        sql.NullTime
+	StartAt      sql.NullTime
+	ArchivedAt   sql.NullTime
+}
+
+type TaskChecklistItem struct {
+	ID        int64
+	TaskID    int64
+	Content   string
+	Done      bool
+	Position  int64
+	CreatedAt sql.NullTime
+	UpdatedAt sql.NullTime
 }
 
 type TaskComment struct {
@@ -75,18 +104,53 @@ type TaskComment struct {
 	UpdatedAt sql.NullTime
 }
 
+type TaskEvent struct {
+	ID        int64
+	ProjectID int64
+	TaskID    sql.NullInt64
+	EventType string
+	Field     string
+	OldValue  string
+	NewValue  string
+	Actor     string
+	CreatedAt sql.NullTime
+}
+
 type TaskLabel struct {
 	TaskID  int64
 	LabelID int64
 }
 
+type TaskRecurrence struct {
+	ID             int64
+	TaskID         int64
+	Rule           string
+	NextRunAt      time.Time
+	LastInstanceID sql.NullInt64
+	Occurrences    int64
+	CreatedAt      sql.NullTime
+}
+
 type TaskSubtask struct {
 	ParentID       int64
 	ChildID        int64
 	RelationTypeID int64
 }
 
+type TaskTemplate struct {
+	ID         int64
+	ProjectID  int64
+	Name       string
+	Definition string
+	CreatedAt  sql.NullTime
+	UpdatedAt  sql.NullTime
+}
+
 type Type struct {
 	ID          int64
+	ProjectID   sql.NullInt64
 	Description string
+	Color       string
+	Icon        string
+	SortOrder   int64
 }
@@ -13,7 +13,7 @@ import (
 const createProjectRecord = `-- name: CreateProjectRecord :one
 insert into projects (name, description)
 values (?, ?)
-returning id, name, description, created_at, updated_at
+returning id, name, description, created_at, updated_at, ticket_key
 `
 
 type CreateProjectRecordParams struct {
@@ -31,6 +31,7 @@ func (q *Queries) CreateProjectRecord(ctx context.Context, arg CreateProjectReco
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TicketKey,
 	)
 	return i, err
 }
@@ -78,7 +79,7 @@ func (q *Queries) DeleteTasksByProject(ctx context.Context, projectID int64) err
 }
 
 const getAllProjects = `-- name: GetAllProjects :many
-select id, name, description, created_at, updated_at from projects order by id
+select id, name, description, created_at, updated_at, ticket_key from projects order by id
 `
 
 // Retrieves all projects ordered by ID
@@ -97,6 +98,7 @@ func (q *Queries) GetAllProjects(ctx context.Context) ([]Project, error) {
 			&i.Description,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.TicketKey,
 		); err != nil {
 			return nil, err
 		}
@@ -117,7 +119,8 @@ select
     name,
     description,
     created_at,
-    updated_at
+    updated_at,
+    ticket_key
 from projects where id = ?
 `
 
@@ -131,6 +134,33 @@ func (q *Queries) GetProjectByID(ctx context.Context, id int64) (Project, error)
 		&i.Description,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.TicketKey,
+	)
+	return i, err
+}
+
+const getProjectByTicketKey = `-- name: GetProjectByTicketKey :one
+select
+    id,
+    name,
+    description,
+    created_at,
+    updated_at,
+    ticket_key
+from projects where ticket_key = ?
+`
+
+// Retrieves the project whose ticket key is given (keys are stored upper case)
+func (q *Queries) GetProjectByTicketKey(ctx context.Context, ticketKey sql.NullString) (Project, error) {
+	row := q.db.QueryRowContext(ctx, getProjectByTicketKey, ticketKey)
+	var i Project
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TicketKey,
 	)
 	return i, err
 }
@@ -160,6 +190,23 @@ func (q *Queries) InitializeProjectCounter(ctx context.Context, projectID int64)
 	return err
 }
 
+const setNextTicketNumber = `-- name: SetNextTicketNumber :exec
+update project_counters
+set next_ticket_number = ?
+where project_id = ?
+`
+
+type SetNextTicketNumberParams struct {
+	NextTicketNumber sql.NullInt64
+	ProjectID        int64
+}
+
+// Sets the next ticket number of a project
+func (q *Queries) SetNextTicketNumber(ctx context.Context, arg SetNextTicketNumberParams) error {
+	_, err := q.db.ExecContext(ctx, setNextTicketNumber, arg.NextTicketNumber, arg.ProjectID)
+	return err
+}
+
 const updateProject = `-- name: UpdateProject :exec
 update projects set name = ?,
 description = ?,
@@ -177,3 +224,19 @@ func (q *Queries) UpdateProject(ctx context.Context, arg UpdateProjectParams) er
 	_, err := q.db.ExecContext(ctx, updateProject, arg.Name, arg.Description, arg.ID)
 	return err
 }
+
+const updateProjectTicketKey = `-- name: UpdateProjectTicketKey :exec
+update projects set ticket_key = ?,
+updated_at = current_timestamp where id = ?
+`
+
+type UpdateProjectTicketKeyParams struct {
+	TicketKey sql.NullString
+	ID        int64
+}
+
+// Sets or clears (NULL) the ticket key of a project
+func (q *Queries) UpdateProjectTicketKey(ctx context.Context, arg UpdateProjectTicketKeyParams) error {
+	_, err := q.db.ExecContext(ctx, updateProjectTicketKey, arg.TicketKey, arg.ID)
+	return err
+}
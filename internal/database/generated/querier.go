@@ -16,6 +16,16 @@ type Querier interface {
 	AddSubtask(ctx context.Context, arg AddSubtaskParams) error
 	// Creates or updates a parent-child relationship with a specific relation type
 	AddSubtaskWithRelationType(ctx context.Context, arg AddSubtaskWithRelationTypeParams) error
+	// Archives the tasks that have been in a project's completed column for
+	// longer than the given age (a datetime modifier such as '-14 days'),
+	// returning their IDs. A task's last move, or its creation if it never
+	// moved, marks when it got there; later edits do not restart the clock.
+	ArchiveCompletedTasks(ctx context.Context, arg ArchiveCompletedTasksParams) ([]int64, error)
+	// Hides a task from the board, keeping it and its history
+	ArchiveTask(ctx context.Context, id int64) error
+	// Moves a rule past the occurrence about to be generated. Affects no rows
+	// when another process has generated it first.
+	ClaimTaskRecurrence(ctx context.Context, arg ClaimTaskRecurrenceParams) (int64, error)
 	// Clears the completed task flag from all columns in a project
 	ClearCompletedColumnByProject(ctx context.Context, projectID int64) error
 	// Clears the in-progress task flag from all columns in a project
@@ -24,6 +34,18 @@ type Querier interface {
 	ClearReadyColumnByProject(ctx context.Context, projectID int64) error
 	// Checks if a column exists with the given ID
 	ColumnExists(ctx context.Context, id int64) (int64, error)
+	// Counts the priorities a project owns (built-in rows are not counted)
+	CountPrioritiesByProject(ctx context.Context, projectID sql.NullInt64) (int64, error)
+	// Counts the task links using a relation type
+	CountTaskRelationsWithType(ctx context.Context, relationTypeID int64) (int64, error)
+	// Counts the tasks using a priority
+	CountTasksWithPriority(ctx context.Context, priorityID int64) (int64, error)
+	// Counts the tasks using a type
+	CountTasksWithType(ctx context.Context, typeID int64) (int64, error)
+	// Counts the types a project owns (built-in rows are not counted)
+	CountTypesByProject(ctx context.Context, projectID sql.NullInt64) (int64, error)
+	// Appends an item to the end of a task's checklist
+	CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) (TaskChecklistItem, error)
 	// Creates a new column in a project with optional
 	// linked list positioning and task type flags
 	CreateColumn(ctx context.Context, arg CreateColumnParams) (Column, error)
@@ -31,12 +53,22 @@ type Querier interface {
 	CreateComment(ctx context.Context, arg CreateCommentParams) (TaskComment, error)
 	// Creates a new label with name, color, and project association
 	CreateLabel(ctx context.Context, arg CreateLabelParams) (Label, error)
+	// Creates a priority for a project
+	CreatePriority(ctx context.Context, arg CreatePriorityParams) (Priority, error)
 	// Creates a new project with name and description
 	CreateProjectRecord(ctx context.Context, arg CreateProjectRecordParams) (Project, error)
+	// Creates a relation type for a project
+	CreateRelationType(ctx context.Context, arg CreateRelationTypeParams) (RelationType, error)
 	// Creates a new task with title, description, position, and ticket number
 	CreateTask(ctx context.Context, arg CreateTaskParams) (Task, error)
+	// Appends an entry to the task activity log
+	CreateTaskEvent(ctx context.Context, arg CreateTaskEventParams) error
+	// Creates a type for a project
+	CreateType(ctx context.Context, arg CreateTypeParams) (Type, error)
 	// Removes all labels from a task
 	DeleteAllLabelsFromTask(ctx context.Context, taskID int64) error
+	// Deletes a checklist item by ID
+	DeleteChecklistItem(ctx context.Context, id int64) error
 	// Permanently deletes a column by ID
 	DeleteColumn(ctx context.Context, id int64) error
 	// Deletes all columns belonging to a project
@@ -45,24 +77,57 @@ type Querier interface {
 	DeleteComment(ctx context.Context, id int64) error
 	// Permanently deletes a label by ID
 	DeleteLabel(ctx context.Context, id int64) error
+	// Deletes a priority
+	DeletePriority(ctx context.Context, id int64) error
 	// Permanently deletes a project by ID
 	DeleteProject(ctx context.Context, id int64) error
 	// Deletes the ticket counter for a project
 	DeleteProjectCounter(ctx context.Context, projectID int64) error
+	// Deletes a saved project template by name
+	DeleteProjectTemplate(ctx context.Context, name string) (int64, error)
+	// Deletes a relation type
+	DeleteRelationType(ctx context.Context, id int64) error
 	// Permanently deletes a task by ID
 	DeleteTask(ctx context.Context, id int64) error
+	// Detaches the rule from a template task
+	DeleteTaskRecurrence(ctx context.Context, taskID int64) error
+	// Removes every task link of a relation type
+	DeleteTaskRelationsWithType(ctx context.Context, relationTypeID int64) error
+	// Deletes a task template of a project
+	DeleteTaskTemplate(ctx context.Context, arg DeleteTaskTemplateParams) (int64, error)
 	// Deletes all tasks within a specific column
 	DeleteTasksByColumn(ctx context.Context, columnID int64) error
 	// Deletes all tasks belonging to a project
 	DeleteTasksByProject(ctx context.Context, projectID int64) error
-	// Retrieves all available priority levels
+	// Deletes a type
+	DeleteType(ctx context.Context, id int64) error
+	// Returns the number of tasks in a column that are not archived
+	GetActiveTaskCountByColumn(ctx context.Context, columnID int64) (int64, error)
+	// Retrieves every priority level, built-in and per-project
 	GetAllPriorities(ctx context.Context) ([]Priority, error)
+	// Retrieves every saved project template ordered by name
+	GetAllProjectTemplates(ctx context.Context) ([]ProjectTemplate, error)
 	// Retrieves all projects ordered by ID
 	GetAllProjects(ctx context.Context) ([]Project, error)
-	// Retrieves all available relationship types for task links
+	// Retrieves every relationship type for task links, built-in and per-project
 	GetAllRelationTypes(ctx context.Context) ([]RelationType, error)
-	// Retrieves all available task types
+	// Retrieves every task type, built-in and per-project
 	GetAllTypes(ctx context.Context) ([]Type, error)
+	// Retrieves the archived tasks of a project, most recently archived first
+	GetArchivedTasksByProject(ctx context.Context, projectID int64) ([]GetArchivedTasksByProjectRow, error)
+	// Retrieves the tasks a task blocks, directly or through a blocker chain,
+	// and whether each of them is currently blocked
+	GetBlockingDependents(ctx context.Context, childID int64) ([]GetBlockingDependentsRow, error)
+	// Retrieves the built-in priorities that new projects start with
+	GetBuiltinPriorities(ctx context.Context) ([]Priority, error)
+	// Retrieves the built-in types that new projects start with
+	GetBuiltinTypes(ctx context.Context) ([]Type, error)
+	// Retrieves the checklist of a task in order
+	GetChecklistByTask(ctx context.Context, taskID int64) ([]TaskChecklistItem, error)
+	// Retrieves a single checklist item by ID
+	GetChecklistItem(ctx context.Context, id int64) (TaskChecklistItem, error)
+	// Retrieves the checklists of the tasks of a project, for export
+	GetChecklistItemsByProject(ctx context.Context, projectID int64) ([]TaskChecklistItem, error)
 	// Retrieves all child tasks for a given parent task with relationship details
 	GetChildTasks(ctx context.Context, parentID int64) ([]GetChildTasksRow, error)
 	// Retrieves a column by its ID with all metadata
@@ -77,10 +142,14 @@ type Querier interface {
 	GetComment(ctx context.Context, id int64) (TaskComment, error)
 	// Returns the number of comments for a task
 	GetCommentCountByTask(ctx context.Context, taskID int64) (int64, error)
+	// Retrieves every comment on the tasks of a project, for export
+	GetCommentsByProject(ctx context.Context, projectID int64) ([]TaskComment, error)
 	// Retrieves all comments for a task, ordered by creation time (newest first)
 	GetCommentsByTask(ctx context.Context, taskID int64) ([]TaskComment, error)
 	// Retrieves the column designated for completed tasks in a project
 	GetCompletedColumnByProject(ctx context.Context, projectID int64) (GetCompletedColumnByProjectRow, error)
+	// Retrieves every column of a task by ID
+	GetFullTask(ctx context.Context, id int64) (Task, error)
 	// Retrieves the column designated for in-progress tasks in a project
 	GetInProgressColumnByProject(ctx context.Context, projectID int64) (GetInProgressColumnByProjectRow, error)
 	// Retrieves comprehensive details for all in-progress tasks using GROUP_CONCAT to avoid N+1 queries
@@ -101,18 +170,33 @@ type Querier interface {
 	GetParentTasks(ctx context.Context, childID int64) ([]GetParentTasksRow, error)
 	// Retrieves the ID of the previous column in the linked list
 	GetPrevColumnID(ctx context.Context, id int64) (interface{}, error)
+	// Retrieves the priorities of a project in sort order, or the built-in priorities
+	// while the project has none of its own
+	GetPrioritiesByProject(ctx context.Context, arg GetPrioritiesByProjectParams) ([]Priority, error)
+	// Retrieves a single priority by ID
+	GetPriorityByID(ctx context.Context, id int64) (Priority, error)
 	// Retrieves a project by its ID with all metadata
 	GetProjectByID(ctx context.Context, id int64) (Project, error)
+	// Retrieves the project whose ticket key is given (keys are stored upper case)
+	GetProjectByTicketKey(ctx context.Context, ticketKey sql.NullString) (Project, error)
 	// Retrieves the project ID for a given column
 	GetProjectIDFromColumn(ctx context.Context, id int64) (int64, error)
 	// Retrieves the project ID for a given task by joining through its column
 	GetProjectIDFromTask(ctx context.Context, id int64) (int64, error)
 	// Returns the total number of tasks in a project
 	GetProjectTaskCount(ctx context.Context, projectID int64) (int64, error)
+	// Retrieves a saved project template by name
+	GetProjectTemplate(ctx context.Context, name string) (ProjectTemplate, error)
 	// Retrieves the column designated for ready tasks in a project
 	GetReadyColumnByProject(ctx context.Context, projectID int64) (GetReadyColumnByProjectRow, error)
 	// Retrieves task summaries for ready tasks (tasks in columns marked as holds_ready_tasks)
 	GetReadyTaskSummariesByProject(ctx context.Context, projectID int64) ([]GetReadyTaskSummariesByProjectRow, error)
+	// Lists every rule with whether its last instance has been completed
+	GetRecurrenceSchedule(ctx context.Context) ([]GetRecurrenceScheduleRow, error)
+	// Retrieves a single relation type by ID
+	GetRelationTypeByID(ctx context.Context, id int64) (RelationType, error)
+	// Retrieves the built-in relation types followed by the project's own
+	GetRelationTypesByProject(ctx context.Context, projectID sql.NullInt64) ([]RelationType, error)
 	// Retrieves the last column in a project's linked list (where next_id is NULL)
 	GetTailColumnForProject(ctx context.Context, projectID int64) (int64, error)
 	// Retrieves basic task information by ID
@@ -121,48 +205,116 @@ type Querier interface {
 	GetTaskAbove(ctx context.Context, arg GetTaskAboveParams) (GetTaskAboveRow, error)
 	// Retrieves the task immediately below the given position in a column
 	GetTaskBelow(ctx context.Context, arg GetTaskBelowParams) (GetTaskBelowRow, error)
+	// Reports whether a task has an open task in its blocker chain
+	GetTaskBlocked(ctx context.Context, id int64) (int64, error)
 	// Returns the number of tasks in a specific column
 	GetTaskCountByColumn(ctx context.Context, columnID int64) (int64, error)
 	// Retrieves comprehensive task details including:
-	// type, priority, column, project, and blocking status
+	// type, priority, column, project, blocking status and child progress
 	GetTaskDetail(ctx context.Context, id int64) (GetTaskDetailRow, error)
+	// Retrieves the ID of the task with the given ticket number in a project
+	GetTaskIDByTicketNumber(ctx context.Context, arg GetTaskIDByTicketNumberParams) (int64, error)
+	// Retrieves the activity log for a task, newest first
+	GetTaskEventsByTask(ctx context.Context, taskID sql.NullInt64) ([]TaskEvent, error)
+	// Retrieves the IDs of all tasks a label is attached to
+	GetTaskIDsByLabel(ctx context.Context, labelID int64) ([]int64, error)
 	// Retrieves all labels attached to a specific task
 	GetTaskLabels(ctx context.Context, taskID int64) ([]Label, error)
+	// Retrieves every task/label association of a project, for export
+	GetTaskLabelsByProject(ctx context.Context, projectID int64) ([]TaskLabel, error)
+	// Retrieves every relation a task takes part in, as parent or as child
+	GetTaskLinks(ctx context.Context, taskID int64) ([]TaskSubtask, error)
 	// Retrieves the current column and position of a task
 	GetTaskPosition(ctx context.Context, id int64) (GetTaskPositionRow, error)
+	// Retrieves the rule attached to a template task
+	GetTaskRecurrence(ctx context.Context, taskID int64) (TaskRecurrence, error)
+	// Lists the recurring tasks of a project with their templates
+	GetTaskRecurrencesByProject(ctx context.Context, projectID int64) ([]GetTaskRecurrencesByProjectRow, error)
 	// Retrieves basic task references for all tasks in a project
 	GetTaskReferencesForProject(ctx context.Context, id int64) ([]GetTaskReferencesForProjectRow, error)
 	// Retrieves all parent-child task relationships
 	// in a project for tree visualization
 	GetTaskRelationsForProject(ctx context.Context, projectID int64) ([]GetTaskRelationsForProjectRow, error)
+	// Retrieves the relations between tasks of a project, for export
+	GetTaskSubtasksByProject(ctx context.Context, projectID int64) ([]TaskSubtask, error)
 	// Retrieves task summaries with aggregated labels for a specific column using GROUP_CONCAT to avoid N+1 queries
 	GetTaskSummariesByColumn(ctx context.Context, columnID int64) ([]GetTaskSummariesByColumnRow, error)
-	// Retrieves task summaries with aggregated labels and blocking status
-	// for all tasks in a project
+	// Retrieves task summaries with aggregated labels, blocking status,
+	// checklist progress and child progress for all tasks in a project
 	GetTaskSummariesByProject(ctx context.Context, projectID int64) ([]GetTaskSummariesByProjectRow, error)
 	// Retrieves task summaries filtered by title search pattern with aggregated labels
 	GetTaskSummariesByProjectFiltered(ctx context.Context, arg GetTaskSummariesByProjectFilteredParams) ([]GetTaskSummariesByProjectFilteredRow, error)
+	// Retrieves the summary of a single task, in the same shape as
+	// GetTaskSummariesByProject (used to apply live updates incrementally)
+	GetTaskSummary(ctx context.Context, id int64) (GetTaskSummaryRow, error)
+	// Retrieves a task template of a project by name
+	GetTaskTemplate(ctx context.Context, arg GetTaskTemplateParams) (TaskTemplate, error)
+	// Retrieves the task templates of a project ordered by name
+	GetTaskTemplatesByProject(ctx context.Context, projectID int64) ([]TaskTemplate, error)
 	// Retrieves all tasks in a column, ordered by position
 	GetTasksByColumn(ctx context.Context, columnID int64) ([]GetTasksByColumnRow, error)
-	// Retrieves all tasks in a project with column
-	// and project names for tree visualization
+	// Retrieves every task of a project with all columns, for export
+	GetTasksByProject(ctx context.Context, projectID int64) ([]Task, error)
+	// Retrieves all tasks in a project with column and project names
+	// and child progress for tree visualization
 	GetTasksForTree(ctx context.Context, id int64) ([]GetTasksForTreeRow, error)
+	// Retrieves a single type by ID
+	GetTypeByID(ctx context.Context, id int64) (Type, error)
+	// Retrieves the types of a project in sort order, or the built-in types
+	// while the project has none of its own
+	GetTypesByProject(ctx context.Context, arg GetTypesByProjectParams) ([]Type, error)
+	// Creates a checklist item with its state and position, for import
+	ImportChecklistItem(ctx context.Context, arg ImportChecklistItemParams) error
+	// Creates a comment keeping its timestamps
+	ImportComment(ctx context.Context, arg ImportCommentParams) (TaskComment, error)
+	// Creates a task with every column given, keeping its ticket number and timestamps
+	ImportTask(ctx context.Context, arg ImportTaskParams) (Task, error)
+	// Attaches a rule to a template task keeping its schedule and run count, for import
+	ImportTaskRecurrence(ctx context.Context, arg ImportTaskRecurrenceParams) error
 	// Increments the ticket counter for a project after assigning a ticket number
 	IncrementTicketNumber(ctx context.Context, projectID int64) error
 	// Initializes the ticket number counter for a new project starting at 1
 	InitializeProjectCounter(ctx context.Context, projectID int64) error
 	// Creates a task-label association
 	InsertTaskLabel(ctx context.Context, arg InsertTaskLabelParams) error
+	// Files the activity log of a task under the project it moved to
+	MoveTaskEventsToProject(ctx context.Context, arg MoveTaskEventsToProjectParams) error
 	// Moves a task to a different column and updates its position
 	MoveTaskToColumn(ctx context.Context, arg MoveTaskToColumnParams) error
+	// Moves a project's tasks from one priority to another
+	ReassignProjectTasksPriority(ctx context.Context, arg ReassignProjectTasksPriorityParams) error
+	// Moves a project's tasks from one type to another
+	ReassignProjectTasksType(ctx context.Context, arg ReassignProjectTasksTypeParams) error
 	// Removes a specific label from a task
 	RemoveLabelFromTask(ctx context.Context, arg RemoveLabelFromTaskParams) error
 	// Removes a parent-child relationship between two tasks
 	RemoveSubtask(ctx context.Context, arg RemoveSubtaskParams) error
+	// Re-creates a deleted checklist item under its original ID and timestamps
+	RestoreChecklistItem(ctx context.Context, arg RestoreChecklistItemParams) error
+	// Re-creates a deleted column under its original ID
+	RestoreColumn(ctx context.Context, arg RestoreColumnParams) error
+	// Re-creates a deleted comment under its original ID and timestamps
+	RestoreComment(ctx context.Context, arg RestoreCommentParams) error
+	// Re-creates a deleted task under its original ID, ticket number and timestamps
+	RestoreTask(ctx context.Context, arg RestoreTaskParams) error
+	// Ranks tasks in a project against an FTS5 query over title, description and comments
+	// Title matches weigh the most, comment matches the least
+	// Archived tasks are included, so finished work stays findable
+	SearchTasks(ctx context.Context, arg SearchTasksParams) ([]SearchTasksRow, error)
+	// Ticks or unticks a checklist item
+	SetChecklistItemDone(ctx context.Context, arg SetChecklistItemDoneParams) error
+	// Sets the next ticket number of a project
+	SetNextTicketNumber(ctx context.Context, arg SetNextTicketNumberParams) error
 	// Updates a task's position within its current column
 	SetTaskPosition(ctx context.Context, arg SetTaskPositionParams) error
 	// Sets task position to -1 temporarily during reordering operations
 	SetTaskPositionTemporary(ctx context.Context, id int64) error
+	// Records the most recently generated instance of a rule
+	SetTaskRecurrenceInstance(ctx context.Context, arg SetTaskRecurrenceInstanceParams) error
+	// Gives a task a new ticket number, used when it moves to another project
+	SetTaskTicketNumber(ctx context.Context, arg SetTaskTicketNumberParams) error
+	// Puts an archived task back on the board
+	UnarchiveTask(ctx context.Context, id int64) error
 	// Sets whether a column holds completed tasks
 	UpdateColumnHoldsCompletedTasks(ctx context.Context, arg UpdateColumnHoldsCompletedTasksParams) error
 	// Sets whether a column holds in-progress tasks
@@ -175,18 +327,36 @@ type Querier interface {
 	UpdateColumnNextID(ctx context.Context, arg UpdateColumnNextIDParams) error
 	// Updates the previous column pointer in the linked list
 	UpdateColumnPrevID(ctx context.Context, arg UpdateColumnPrevIDParams) error
+	// Sets a column's work-in-progress limit; NULL removes it
+	UpdateColumnWipLimit(ctx context.Context, arg UpdateColumnWipLimitParams) error
 	// Updates a comment's content
 	UpdateComment(ctx context.Context, arg UpdateCommentParams) error
 	// Updates a label's name and color
 	UpdateLabel(ctx context.Context, arg UpdateLabelParams) error
+	// Updates a priority
+	UpdatePriority(ctx context.Context, arg UpdatePriorityParams) error
 	// Updates a project's name and description
 	UpdateProject(ctx context.Context, arg UpdateProjectParams) error
+	// Sets or clears (NULL) the ticket key of a project
+	UpdateProjectTicketKey(ctx context.Context, arg UpdateProjectTicketKeyParams) error
+	// Updates a relation type
+	UpdateRelationType(ctx context.Context, arg UpdateRelationTypeParams) error
 	// Updates a task's title and description
 	UpdateTask(ctx context.Context, arg UpdateTaskParams) error
+	// Sets a task's due and start dates (NULL clears them)
+	UpdateTaskDates(ctx context.Context, arg UpdateTaskDatesParams) error
 	// Updates a task's priority level
 	UpdateTaskPriority(ctx context.Context, arg UpdateTaskPriorityParams) error
 	// Updates a task's type classification
 	UpdateTaskType(ctx context.Context, arg UpdateTaskTypeParams) error
+	// Updates a type
+	UpdateType(ctx context.Context, arg UpdateTypeParams) error
+	// Saves a project template, replacing any template with the same name
+	UpsertProjectTemplate(ctx context.Context, arg UpsertProjectTemplateParams) (ProjectTemplate, error)
+	// Attaches a rule to a template task, replacing any rule it already has
+	UpsertTaskRecurrence(ctx context.Context, arg UpsertTaskRecurrenceParams) (TaskRecurrence, error)
+	// Saves a task template, replacing the project's template with the same name
+	UpsertTaskTemplate(ctx context.Context, arg UpsertTaskTemplateParams) (TaskTemplate, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -44,6 +44,66 @@ func (q *Queries) AddSubtaskWithRelationType(ctx context.Context, arg AddSubtask
 	return err
 }
 
+const archiveCompletedTasks = `-- name: ArchiveCompletedTasks :many
+update tasks
+set archived_at = current_timestamp, updated_at = current_timestamp
+where archived_at is null
+    and coalesce(
+        (select max(e.created_at) from task_events e
+         where e.task_id = tasks.id and e.event_type = 'moved'),
+        tasks.created_at
+    ) < datetime('now', ?1)
+    and column_id in (
+        select id from columns
+        where project_id = ?2 and holds_completed_tasks = 1
+    )
+returning id
+`
+
+type ArchiveCompletedTasksParams struct {
+	Age       interface{}
+	ProjectID int64
+}
+
+// Archives the tasks that have been in a project's completed column for
+// longer than the given age (a datetime modifier such as '-14 days'),
+// returning their IDs. A task's last move, or its creation if it never
+// moved, marks when it got there; later edits do not restart the clock.
+func (q *Queries) ArchiveCompletedTasks(ctx context.Context, arg ArchiveCompletedTasksParams) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, archiveCompletedTasks, arg.Age, arg.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const archiveTask = `-- name: ArchiveTask :exec
+update tasks
+set archived_at = current_timestamp, updated_at = current_timestamp
+where id = ?
+`
+
+// Hides a task from the board, keeping it and its history
+func (q *Queries) ArchiveTask(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, archiveTask, id)
+	return err
+}
+
 const createTask = `-- name: CreateTask :one
 insert into tasks (
     title,
@@ -52,7 +112,7 @@ insert into tasks (
     position,
     ticket_number)
 values (?, ?, ?, ?, ?)
-returning id, title, description, column_id, position, ticket_number, type_id, priority_id, created_at, updated_at
+returning id, title, description, column_id, position, ticket_number, type_id, priority_id, created_at, updated_at, due_at, start_at, archived_at
 `
 
 type CreateTaskParams struct {
@@ -84,6 +144,9 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (Task, e
 		&i.PriorityID,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.StartAt,
+		&i.ArchivedAt,
 	)
 	return i, err
 }
@@ -99,11 +162,24 @@ func (q *Queries) DeleteTask(ctx context.Context, id int64) error {
 	return err
 }
 
+const getActiveTaskCountByColumn = `-- name: GetActiveTaskCountByColumn :one
+select count(*)
+from tasks where column_id = ? and archived_at is null
+`
+
+// Returns the number of tasks in a column that are not archived
+func (q *Queries) GetActiveTaskCountByColumn(ctx context.Context, columnID int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getActiveTaskCountByColumn, columnID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getAllPriorities = `-- name: GetAllPriorities :many
-select id, description, color from priorities order by id
+select id, project_id, description, color, icon, sort_order from priorities order by id
 `
 
-// Retrieves all available priority levels
+// Retrieves every priority level, built-in and per-project
 func (q *Queries) GetAllPriorities(ctx context.Context) ([]Priority, error) {
 	rows, err := q.db.QueryContext(ctx, getAllPriorities)
 	if err != nil {
@@ -113,7 +189,14 @@ func (q *Queries) GetAllPriorities(ctx context.Context) ([]Priority, error) {
 	items := []Priority{}
 	for rows.Next() {
 		var i Priority
-		if err := rows.Scan(&i.ID, &i.Description, &i.Color); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.Description,
+			&i.Color,
+			&i.Icon,
+			&i.SortOrder,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -128,12 +211,12 @@ func (q *Queries) GetAllPriorities(ctx context.Context) ([]Priority, error) {
 }
 
 const getAllRelationTypes = `-- name: GetAllRelationTypes :many
-select id, p_to_c_label, c_to_p_label, color, is_blocking
+select id, p_to_c_label, c_to_p_label, color, is_blocking, project_id, name
 from relation_types
 order by id
 `
 
-// Retrieves all available relationship types for task links
+// Retrieves every relationship type for task links, built-in and per-project
 func (q *Queries) GetAllRelationTypes(ctx context.Context) ([]RelationType, error) {
 	rows, err := q.db.QueryContext(ctx, getAllRelationTypes)
 	if err != nil {
@@ -149,6 +232,8 @@ func (q *Queries) GetAllRelationTypes(ctx context.Context) ([]RelationType, erro
 			&i.CToPLabel,
 			&i.Color,
 			&i.IsBlocking,
+			&i.ProjectID,
+			&i.Name,
 		); err != nil {
 			return nil, err
 		}
@@ -164,10 +249,10 @@ func (q *Queries) GetAllRelationTypes(ctx context.Context) ([]RelationType, erro
 }
 
 const getAllTypes = `-- name: GetAllTypes :many
-select id, description from types order by id
+select id, project_id, description, color, icon, sort_order from types order by id
 `
 
-// Retrieves all available task types
+// Retrieves every task type, built-in and per-project
 func (q *Queries) GetAllTypes(ctx context.Context) ([]Type, error) {
 	rows, err := q.db.QueryContext(ctx, getAllTypes)
 	if err != nil {
@@ -177,7 +262,65 @@ func (q *Queries) GetAllTypes(ctx context.Context) ([]Type, error) {
 	items := []Type{}
 	for rows.Next() {
 		var i Type
-		if err := rows.Scan(&i.ID, &i.Description); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProjectID,
+			&i.Description,
+			&i.Color,
+			&i.Icon,
+			&i.SortOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getArchivedTasksByProject = `-- name: GetArchivedTasksByProject :many
+select
+    t.id,
+    t.ticket_number,
+    t.title,
+    c.name as column_name,
+    t.archived_at
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ? and t.archived_at is not null
+order by t.archived_at desc, t.id desc
+`
+
+type GetArchivedTasksByProjectRow struct {
+	ID           int64
+	TicketNumber sql.NullInt64
+	Title        string
+	ColumnName   string
+	ArchivedAt   sql.NullTime
+}
+
+// Retrieves the archived tasks of a project, most recently archived first
+func (q *Queries) GetArchivedTasksByProject(ctx context.Context, projectID int64) ([]GetArchivedTasksByProjectRow, error) {
+	rows, err := q.db.QueryContext(ctx, getArchivedTasksByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetArchivedTasksByProjectRow{}
+	for rows.Next() {
+		var i GetArchivedTasksByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TicketNumber,
+			&i.Title,
+			&i.ColumnName,
+			&i.ArchivedAt,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -191,6 +334,79 @@ func (q *Queries) GetAllTypes(ctx context.Context) ([]Type, error) {
 	return items, nil
 }
 
+const getBlockingDependents = `-- name: GetBlockingDependents :many
+with recursive dependents(id) as (
+    select ts.parent_id
+    from task_subtasks ts
+    inner join relation_types rt on ts.relation_type_id = rt.id
+    where ts.child_id = ? and rt.is_blocking = 1
+    union
+    select ts.parent_id
+    from task_subtasks ts
+    inner join relation_types rt on ts.relation_type_id = rt.id
+    inner join dependents d on ts.child_id = d.id
+    where rt.is_blocking = 1
+)
+select
+    t.id,
+    exists(
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked
+from dependents d
+inner join tasks t on d.id = t.id
+order by t.id
+`
+
+type GetBlockingDependentsRow struct {
+	ID        int64
+	IsBlocked int64
+}
+
+// Retrieves the tasks a task blocks, directly or through a blocker chain,
+// and whether each of them is currently blocked
+func (q *Queries) GetBlockingDependents(ctx context.Context, childID int64) ([]GetBlockingDependentsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBlockingDependents, childID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetBlockingDependentsRow{}
+	for rows.Next() {
+		var i GetBlockingDependentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.IsBlocked,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTaskBlocked = `-- name: GetTaskBlocked :one
+select exists(
+    select 1 from task_blockers tb where tb.task_id = t.id
+) as is_blocked
+from tasks t
+where t.id = ?
+`
+
+// Reports whether a task has an open task in its blocker chain
+func (q *Queries) GetTaskBlocked(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTaskBlocked, id)
+	var is_blocked int64
+	err := row.Scan(&is_blocked)
+	return is_blocked, err
+}
+
 const getChildTasks = `-- name: GetChildTasks :many
 select t.id, t.ticket_number, t.title, p.name,
 rt.id, rt.c_to_p_label, rt.color, rt.is_blocking
@@ -247,6 +463,47 @@ func (q *Queries) GetChildTasks(ctx context.Context, parentID int64) ([]GetChild
 	return items, nil
 }
 
+const getFullTask = `-- name: GetFullTask :one
+select
+    id,
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at
+from tasks
+where id = ?
+`
+
+// Retrieves every column of a task by ID
+func (q *Queries) GetFullTask(ctx context.Context, id int64) (Task, error) {
+	row := q.db.QueryRowContext(ctx, getFullTask, id)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ColumnID,
+		&i.Position,
+		&i.TicketNumber,
+		&i.TypeID,
+		&i.PriorityID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DueAt,
+		&i.StartAt,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const getInProgressTaskDetails = `-- name: GetInProgressTaskDetails :many
 select
     t.id,
@@ -266,10 +523,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -278,7 +533,7 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where proj.id = ? and c.holds_in_progress_tasks = 1
+where proj.id = ? and c.holds_in_progress_tasks = 1 and t.archived_at is null
 group by
     t.id,
     t.ticket_number,
@@ -369,7 +624,7 @@ select
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
-where proj.id = ? and c.holds_in_progress_tasks = 1
+where proj.id = ? and c.holds_in_progress_tasks = 1 and t.archived_at is null
 order by t.position
 `
 
@@ -539,8 +794,10 @@ const getReadyTaskSummariesByProject = `-- name: GetReadyTaskSummariesByProject
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -548,10 +805,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -559,12 +814,14 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ? and c.holds_ready_tasks = 1
+where c.project_id = ? and c.holds_ready_tasks = 1 and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
@@ -574,8 +831,10 @@ order by t.position
 type GetReadyTaskSummariesByProjectRow struct {
 	ID                  int64
 	Title               string
+	TicketNumber        sql.NullInt64
 	ColumnID            int64
 	Position            int64
+	DueAt               sql.NullTime
 	TypeDescription     sql.NullString
 	PriorityDescription sql.NullString
 	PriorityColor       sql.NullString
@@ -598,8 +857,10 @@ func (q *Queries) GetReadyTaskSummariesByProject(ctx context.Context, projectID
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
+			&i.TicketNumber,
 			&i.ColumnID,
 			&i.Position,
+			&i.DueAt,
 			&i.TypeDescription,
 			&i.PriorityDescription,
 			&i.PriorityColor,
@@ -663,7 +924,7 @@ func (q *Queries) GetTask(ctx context.Context, id int64) (GetTaskRow, error) {
 const getTaskAbove = `-- name: GetTaskAbove :one
 select id, position 
 from tasks
-where column_id = ? and position < ?
+where column_id = ? and position < ? and archived_at is null
 order by position desc limit 1
 `
 
@@ -688,7 +949,7 @@ func (q *Queries) GetTaskAbove(ctx context.Context, arg GetTaskAboveParams) (Get
 const getTaskBelow = `-- name: GetTaskBelow :one
 select id, position 
 from tasks
-where column_id = ? and position > ?
+where column_id = ? and position > ? and archived_at is null
 order by position asc limit 1
 `
 
@@ -733,16 +994,53 @@ select
     t.ticket_number,
     t.created_at,
     t.updated_at,
+    t.due_at,
+    t.start_at,
+    t.archived_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
     c.name as column_name,
     proj.name as project_name,
+    proj.id as project_id,
     exists(
-        select 1 from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
-    ) as is_blocked
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
@@ -760,16 +1058,22 @@ type GetTaskDetailRow struct {
 	TicketNumber        sql.NullInt64
 	CreatedAt           sql.NullTime
 	UpdatedAt           sql.NullTime
+	DueAt               sql.NullTime
+	StartAt             sql.NullTime
+	ArchivedAt          sql.NullTime
 	TypeDescription     sql.NullString
 	PriorityDescription sql.NullString
 	PriorityColor       sql.NullString
 	ColumnName          string
 	ProjectName         string
+	ProjectID           int64
 	IsBlocked           int64
+	ChildrenDone        int64
+	ChildrenTotal       int64
 }
 
 // Retrieves comprehensive task details including:
-// type, priority, column, project, and blocking status
+// type, priority, column, project, blocking status and child progress
 func (q *Queries) GetTaskDetail(ctx context.Context, id int64) (GetTaskDetailRow, error) {
 	row := q.db.QueryRowContext(ctx, getTaskDetail, id)
 	var i GetTaskDetailRow
@@ -782,16 +1086,42 @@ func (q *Queries) GetTaskDetail(ctx context.Context, id int64) (GetTaskDetailRow
 		&i.TicketNumber,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.DueAt,
+		&i.StartAt,
+		&i.ArchivedAt,
 		&i.TypeDescription,
 		&i.PriorityDescription,
 		&i.PriorityColor,
 		&i.ColumnName,
 		&i.ProjectName,
+		&i.ProjectID,
 		&i.IsBlocked,
+		&i.ChildrenDone,
+		&i.ChildrenTotal,
 	)
 	return i, err
 }
 
+const getTaskIDByTicketNumber = `-- name: GetTaskIDByTicketNumber :one
+select t.id
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ? and t.ticket_number = ?
+`
+
+type GetTaskIDByTicketNumberParams struct {
+	ProjectID    int64
+	TicketNumber sql.NullInt64
+}
+
+// Retrieves the ID of the task with the given ticket number in a project
+func (q *Queries) GetTaskIDByTicketNumber(ctx context.Context, arg GetTaskIDByTicketNumberParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, getTaskIDByTicketNumber, arg.ProjectID, arg.TicketNumber)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const getTaskLabels = `-- name: GetTaskLabels :many
 select l.id, l.name, l.color, l.project_id
 from labels l
@@ -829,6 +1159,41 @@ func (q *Queries) GetTaskLabels(ctx context.Context, taskID int64) ([]Label, err
 	return items, nil
 }
 
+const getTaskLinks = `-- name: GetTaskLinks :many
+select parent_id, child_id, relation_type_id
+from task_subtasks
+where parent_id = ?1 or child_id = ?1
+order by parent_id, child_id
+`
+
+// Retrieves every relation a task takes part in, as parent or as child
+func (q *Queries) GetTaskLinks(ctx context.Context, taskID int64) ([]TaskSubtask, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskLinks, taskID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskSubtask{}
+	for rows.Next() {
+		var i TaskSubtask
+		if err := rows.Scan(
+			&i.ParentID,
+			&i.ChildID,
+			&i.RelationTypeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTaskPosition = `-- name: GetTaskPosition :one
 select column_id, position
 from tasks
@@ -946,12 +1311,52 @@ func (q *Queries) GetTaskRelationsForProject(ctx context.Context, projectID int6
 	return items, nil
 }
 
+const getTaskSubtasksByProject = `-- name: GetTaskSubtasksByProject :many
+select ts.parent_id, ts.child_id, ts.relation_type_id
+from task_subtasks ts
+inner join tasks t_parent on ts.parent_id = t_parent.id
+inner join columns c_parent on t_parent.column_id = c_parent.id
+inner join tasks t_child on ts.child_id = t_child.id
+inner join columns c_child on t_child.column_id = c_child.id
+where c_parent.project_id = ? and c_child.project_id = c_parent.project_id
+order by ts.parent_id, ts.child_id
+`
+
+// Retrieves the relations between tasks of a project, for export
+func (q *Queries) GetTaskSubtasksByProject(ctx context.Context, projectID int64) ([]TaskSubtask, error) {
+	rows, err := q.db.QueryContext(ctx, getTaskSubtasksByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []TaskSubtask{}
+	for rows.Next() {
+		var i TaskSubtask
+		if err := rows.Scan(
+			&i.ParentID,
+			&i.ChildID,
+			&i.RelationTypeID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTaskSummariesByColumn = `-- name: GetTaskSummariesByColumn :many
 select
     t.id,
     t.title,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -963,12 +1368,13 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where t.column_id = ?
+where t.column_id = ? and t.archived_at is null
 group by
     t.id,
     t.title,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
@@ -980,6 +1386,7 @@ type GetTaskSummariesByColumnRow struct {
 	Title               string
 	ColumnID            int64
 	Position            int64
+	DueAt               sql.NullTime
 	TypeDescription     sql.NullString
 	PriorityDescription sql.NullString
 	PriorityColor       sql.NullString
@@ -1003,6 +1410,7 @@ func (q *Queries) GetTaskSummariesByColumn(ctx context.Context, columnID int64)
 			&i.Title,
 			&i.ColumnID,
 			&i.Position,
+			&i.DueAt,
 			&i.TypeDescription,
 			&i.PriorityDescription,
 			&i.PriorityColor,
@@ -1027,8 +1435,11 @@ const getTaskSummariesByProject = `-- name: GetTaskSummariesByProject :many
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
+    t.archived_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -1036,23 +1447,60 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
-    ) as is_blocked
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id and ci.done = 1) as checklist_done,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id) as checklist_total,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ?
+where c.project_id = ? and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
+    t.archived_at,
     ty.description,
     p.description,
     p.color
@@ -1062,8 +1510,11 @@ order by t.position
 type GetTaskSummariesByProjectRow struct {
 	ID                  int64
 	Title               string
+	TicketNumber        sql.NullInt64
 	ColumnID            int64
 	Position            int64
+	DueAt               sql.NullTime
+	ArchivedAt          sql.NullTime
 	TypeDescription     sql.NullString
 	PriorityDescription sql.NullString
 	PriorityColor       sql.NullString
@@ -1071,10 +1522,14 @@ type GetTaskSummariesByProjectRow struct {
 	LabelNames          string
 	LabelColors         string
 	IsBlocked           int64
+	ChecklistDone       int64
+	ChecklistTotal      int64
+	ChildrenDone        int64
+	ChildrenTotal       int64
 }
 
-// Retrieves task summaries with aggregated labels and blocking status
-// for all tasks in a project
+// Retrieves task summaries with aggregated labels, blocking status,
+// checklist progress and child progress for all tasks in a project
 func (q *Queries) GetTaskSummariesByProject(ctx context.Context, projectID int64) ([]GetTaskSummariesByProjectRow, error) {
 	rows, err := q.db.QueryContext(ctx, getTaskSummariesByProject, projectID)
 	if err != nil {
@@ -1087,8 +1542,11 @@ func (q *Queries) GetTaskSummariesByProject(ctx context.Context, projectID int64
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
+			&i.TicketNumber,
 			&i.ColumnID,
 			&i.Position,
+			&i.DueAt,
+			&i.ArchivedAt,
 			&i.TypeDescription,
 			&i.PriorityDescription,
 			&i.PriorityColor,
@@ -1096,6 +1554,10 @@ func (q *Queries) GetTaskSummariesByProject(ctx context.Context, projectID int64
 			&i.LabelNames,
 			&i.LabelColors,
 			&i.IsBlocked,
+			&i.ChecklistDone,
+			&i.ChecklistTotal,
+			&i.ChildrenDone,
+			&i.ChildrenTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -1114,8 +1576,10 @@ const getTaskSummariesByProjectFiltered = `-- name: GetTaskSummariesByProjectFil
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -1123,10 +1587,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -1134,12 +1596,14 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ? and t.title like ?
+where c.project_id = ? and t.title like ? and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
@@ -1154,8 +1618,10 @@ type GetTaskSummariesByProjectFilteredParams struct {
 type GetTaskSummariesByProjectFilteredRow struct {
 	ID                  int64
 	Title               string
+	TicketNumber        sql.NullInt64
 	ColumnID            int64
 	Position            int64
+	DueAt               sql.NullTime
 	TypeDescription     sql.NullString
 	PriorityDescription sql.NullString
 	PriorityColor       sql.NullString
@@ -1178,8 +1644,10 @@ func (q *Queries) GetTaskSummariesByProjectFiltered(ctx context.Context, arg Get
 		if err := rows.Scan(
 			&i.ID,
 			&i.Title,
+			&i.TicketNumber,
 			&i.ColumnID,
 			&i.Position,
+			&i.DueAt,
 			&i.TypeDescription,
 			&i.PriorityDescription,
 			&i.PriorityColor,
@@ -1201,6 +1669,129 @@ func (q *Queries) GetTaskSummariesByProjectFiltered(ctx context.Context, arg Get
 	return items, nil
 }
 
+const getTaskSummary = `-- name: GetTaskSummary :one
+select
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description as type_description,
+    p.description as priority_description,
+    p.color as priority_color,
+    cast(coalesce(group_concat(l.id, char(31)), '') as text) as label_ids,
+    cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
+    cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
+    exists(
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id and ci.done = 1) as checklist_done,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id) as checklist_total,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
+from tasks t
+left join types ty on t.type_id = ty.id
+left join priorities p on t.priority_id = p.id
+left join task_labels tl on t.id = tl.task_id
+left join labels l on tl.label_id = l.id
+where t.id = ?
+group by
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description,
+    p.description,
+    p.color
+`
+
+type GetTaskSummaryRow struct {
+	ID                  int64
+	Title               string
+	TicketNumber        sql.NullInt64
+	ColumnID            int64
+	Position            int64
+	DueAt               sql.NullTime
+	ArchivedAt          sql.NullTime
+	TypeDescription     sql.NullString
+	PriorityDescription sql.NullString
+	PriorityColor       sql.NullString
+	LabelIds            string
+	LabelNames          string
+	LabelColors         string
+	IsBlocked           int64
+	ChecklistDone       int64
+	ChecklistTotal      int64
+	ChildrenDone        int64
+	ChildrenTotal       int64
+}
+
+// Retrieves the summary of a single task, in the same shape as
+// GetTaskSummariesByProject (used to apply live updates incrementally)
+func (q *Queries) GetTaskSummary(ctx context.Context, id int64) (GetTaskSummaryRow, error) {
+	row := q.db.QueryRowContext(ctx, getTaskSummary, id)
+	var i GetTaskSummaryRow
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.TicketNumber,
+		&i.ColumnID,
+		&i.Position,
+		&i.DueAt,
+		&i.ArchivedAt,
+		&i.TypeDescription,
+		&i.PriorityDescription,
+		&i.PriorityColor,
+		&i.LabelIds,
+		&i.LabelNames,
+		&i.LabelColors,
+		&i.IsBlocked,
+		&i.ChecklistDone,
+		&i.ChecklistTotal,
+		&i.ChildrenDone,
+		&i.ChildrenTotal,
+	)
+	return i, err
+}
+
 const getTasksByColumn = `-- name: GetTasksByColumn :many
 select
     id,
@@ -1257,30 +1848,125 @@ func (q *Queries) GetTasksByColumn(ctx context.Context, columnID int64) ([]GetTa
 	return items, nil
 }
 
+const getTasksByProject = `-- name: GetTasksByProject :many
+select
+    t.id,
+    t.title,
+    t.description,
+    t.column_id,
+    t.position,
+    t.ticket_number,
+    t.type_id,
+    t.priority_id,
+    t.created_at,
+    t.updated_at,
+    t.due_at,
+    t.start_at,
+    t.archived_at
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by t.id
+`
+
+// Retrieves every task of a project with all columns, for export
+func (q *Queries) GetTasksByProject(ctx context.Context, projectID int64) ([]Task, error) {
+	rows, err := q.db.QueryContext(ctx, getTasksByProject, projectID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Task{}
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.ColumnID,
+			&i.Position,
+			&i.TicketNumber,
+			&i.TypeID,
+			&i.PriorityID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DueAt,
+			&i.StartAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTasksForTree = `-- name: GetTasksForTree :many
 select
     t.id,
     t.ticket_number,
     t.title,
     c.name as column_name,
-    proj.name as project_name
+    proj.name as project_name,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
-where proj.id = ?
+where proj.id = ? and t.archived_at is null
 order by t.ticket_number
 `
 
 type GetTasksForTreeRow struct {
-	ID           int64
-	TicketNumber sql.NullInt64
-	Title        string
-	ColumnName   string
-	ProjectName  string
+	ID            int64
+	TicketNumber  sql.NullInt64
+	Title         string
+	ColumnName    string
+	ProjectName   string
+	ChildrenDone  int64
+	ChildrenTotal int64
 }
 
-// Retrieves all tasks in a project with column
-// and project names for tree visualization
+// Retrieves all tasks in a project with column and project names
+// and child progress for tree visualization
 func (q *Queries) GetTasksForTree(ctx context.Context, id int64) ([]GetTasksForTreeRow, error) {
 	rows, err := q.db.QueryContext(ctx, getTasksForTree, id)
 	if err != nil {
@@ -1296,6 +1982,8 @@ func (q *Queries) GetTasksForTree(ctx context.Context, id int64) ([]GetTasksForT
 			&i.Title,
 			&i.ColumnName,
 			&i.ProjectName,
+			&i.ChildrenDone,
+			&i.ChildrenTotal,
 		); err != nil {
 			return nil, err
 		}
@@ -1310,6 +1998,74 @@ func (q *Queries) GetTasksForTree(ctx context.Context, id int64) ([]GetTasksForT
 	return items, nil
 }
 
+const importTask = `-- name: ImportTask :one
+insert into tasks (
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at)
+values (?, ?, ?, ?, ?, ?, ?, coalesce(?, current_timestamp), coalesce(?, current_timestamp), ?, ?, ?)
+returning id, title, description, column_id, position, ticket_number, type_id, priority_id, created_at, updated_at, due_at, start_at, archived_at
+`
+
+type ImportTaskParams struct {
+	Title        string
+	Description  sql.NullString
+	ColumnID     int64
+	Position     int64
+	TicketNumber sql.NullInt64
+	TypeID       int64
+	PriorityID   int64
+	CreatedAt    sql.NullTime
+	UpdatedAt    sql.NullTime
+	DueAt        sql.NullTime
+	StartAt      sql.NullTime
+	ArchivedAt   sql.NullTime
+}
+
+// Creates a task with every column given, keeping its ticket number and timestamps
+func (q *Queries) ImportTask(ctx context.Context, arg ImportTaskParams) (Task, error) {
+	row := q.db.QueryRowContext(ctx, importTask,
+		arg.Title,
+		arg.Description,
+		arg.ColumnID,
+		arg.Position,
+		arg.TicketNumber,
+		arg.TypeID,
+		arg.PriorityID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DueAt,
+		arg.StartAt,
+		arg.ArchivedAt,
+	)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.ColumnID,
+		&i.Position,
+		&i.TicketNumber,
+		&i.TypeID,
+		&i.PriorityID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DueAt,
+		&i.StartAt,
+		&i.ArchivedAt,
+	)
+	return i, err
+}
+
 const incrementTicketNumber = `-- name: IncrementTicketNumber :exec
 update project_counters
 set next_ticket_number = next_ticket_number + 1
@@ -1357,6 +2113,60 @@ func (q *Queries) RemoveSubtask(ctx context.Context, arg RemoveSubtaskParams) er
 	return err
 }
 
+const restoreTask = `-- name: RestoreTask :exec
+insert into tasks (
+    id,
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type RestoreTaskParams struct {
+	ID           int64
+	Title        string
+	Description  sql.NullString
+	ColumnID     int64
+	Position     int64
+	TicketNumber sql.NullInt64
+	TypeID       int64
+	PriorityID   int64
+	CreatedAt    sql.NullTime
+	UpdatedAt    sql.NullTime
+	DueAt        sql.NullTime
+	StartAt      sql.NullTime
+	ArchivedAt   sql.NullTime
+}
+
+// Re-creates a deleted task under its original ID, ticket number and timestamps
+func (q *Queries) RestoreTask(ctx context.Context, arg RestoreTaskParams) error {
+	_, err := q.db.ExecContext(ctx, restoreTask,
+		arg.ID,
+		arg.Title,
+		arg.Description,
+		arg.ColumnID,
+		arg.Position,
+		arg.TicketNumber,
+		arg.TypeID,
+		arg.PriorityID,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+		arg.DueAt,
+		arg.StartAt,
+		arg.ArchivedAt,
+	)
+	return err
+}
+
 const setTaskPosition = `-- name: SetTaskPosition :exec
 update tasks
 set position = ?,
@@ -1388,6 +2198,35 @@ func (q *Queries) SetTaskPositionTemporary(ctx context.Context, id int64) error
 	return err
 }
 
+const setTaskTicketNumber = `-- name: SetTaskTicketNumber :exec
+update tasks
+set ticket_number = ?, updated_at = current_timestamp
+where id = ?
+`
+
+type SetTaskTicketNumberParams struct {
+	TicketNumber sql.NullInt64
+	ID           int64
+}
+
+// Gives a task a new ticket number, used when it moves to another project
+func (q *Queries) SetTaskTicketNumber(ctx context.Context, arg SetTaskTicketNumberParams) error {
+	_, err := q.db.ExecContext(ctx, setTaskTicketNumber, arg.TicketNumber, arg.ID)
+	return err
+}
+
+const unarchiveTask = `-- name: UnarchiveTask :exec
+update tasks
+set archived_at = null, updated_at = current_timestamp
+where id = ?
+`
+
+// Puts an archived task back on the board
+func (q *Queries) UnarchiveTask(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, unarchiveTask, id)
+	return err
+}
+
 const updateTask = `-- name: UpdateTask :exec
 update tasks
 set title = ?, description = ?, updated_at = current_timestamp
@@ -1406,6 +2245,24 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) error {
 	return err
 }
 
+const updateTaskDates = `-- name: UpdateTaskDates :exec
+update tasks
+set due_at = ?, start_at = ?, updated_at = current_timestamp
+where id = ?
+`
+
+type UpdateTaskDatesParams struct {
+	DueAt   sql.NullTime
+	StartAt sql.NullTime
+	ID      int64
+}
+
+// Sets a task's due and start dates (NULL clears them)
+func (q *Queries) UpdateTaskDates(ctx context.Context, arg UpdateTaskDatesParams) error {
+	_, err := q.db.ExecContext(ctx, updateTaskDates, arg.DueAt, arg.StartAt, arg.ID)
+	return err
+}
+
 const updateTaskPriority = `-- name: UpdateTaskPriority :exec
 update tasks
 set priority_id = ?, updated_at = current_timestamp
@@ -30,7 +30,7 @@ func runMigrations(ctx context.Context, db *sql.DB) error {
 	return seedDefaultData(ctx, db)
 }
 
-// seedDefaultData seeds default project, columns, and labels if needed
+// seedDefaultData seeds default project, columns, labels, types and priorities if needed
 func seedDefaultData(ctx context.Context, db *sql.DB) error {
 	if err := seedDefaultProject(ctx, db); err != nil {
 		return err
@@ -44,6 +44,10 @@ func seedDefaultData(ctx context.Context, db *sql.DB) error {
 		return err
 	}
 
+	if err := seedProjectTaxonomy(ctx, db); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Add an append-only activity log for tasks, columns and labels
+-- Every mutating service method writes one or more rows here inside the same
+-- transaction as the change itself, so the log never disagrees with the data.
+--
+-- task_id is intentionally not a foreign key: history must survive task deletion.
+-- It is NULL for project-level events (column renamed, label deleted, ...).
+
+CREATE TABLE task_events (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    task_id INTEGER NULL,
+    event_type TEXT NOT NULL,
+    field TEXT NOT NULL DEFAULT '',
+    old_value TEXT NOT NULL DEFAULT '',
+    new_value TEXT NOT NULL DEFAULT '',
+    actor TEXT NOT NULL DEFAULT '',
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- History is always read per task (newest first) or per project
+CREATE INDEX idx_task_events_task ON task_events(task_id, created_at);
+CREATE INDEX idx_task_events_project ON task_events(project_id, created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_task_events_project;
+DROP INDEX IF EXISTS idx_task_events_task;
+DROP TABLE IF EXISTS task_events;
@@ -0,0 +1,83 @@
+-- +goose Up
+-- Add full-text search over task titles, descriptions and comments
+-- tasks_fts mirrors one row per task (rowid = tasks.id). The comments column
+-- holds every comment of the task concatenated, so a single MATCH covers all
+-- three sources and bm25() can rank them with per-column weights.
+--
+-- Triggers keep the index in sync; application code never writes to it.
+
+CREATE VIRTUAL TABLE tasks_fts USING fts5(
+    title,
+    description,
+    comments,
+    tokenize = 'unicode61 remove_diacritics 2'
+);
+
+-- Backfill existing tasks
+INSERT INTO tasks_fts (rowid, title, description, comments)
+SELECT
+    t.id,
+    t.title,
+    coalesce(t.description, ''),
+    coalesce((select group_concat(tc.content, char(10)) from task_comments tc where tc.task_id = t.id), '')
+FROM tasks t;
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_fts_after_insert AFTER INSERT ON tasks
+BEGIN
+    INSERT INTO tasks_fts (rowid, title, description, comments)
+    VALUES (new.id, new.title, coalesce(new.description, ''), '');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_fts_after_update AFTER UPDATE OF title, description ON tasks
+BEGIN
+    UPDATE tasks_fts
+    SET title = new.title, description = coalesce(new.description, '')
+    WHERE rowid = new.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER tasks_fts_after_delete AFTER DELETE ON tasks
+BEGIN
+    DELETE FROM tasks_fts WHERE rowid = old.id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_comments_fts_after_insert AFTER INSERT ON task_comments
+BEGIN
+    UPDATE tasks_fts
+    SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = new.task_id), '')
+    WHERE rowid = new.task_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_comments_fts_after_update AFTER UPDATE OF content ON task_comments
+BEGIN
+    UPDATE tasks_fts
+    SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = new.task_id), '')
+    WHERE rowid = new.task_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER task_comments_fts_after_delete AFTER DELETE ON task_comments
+BEGIN
+    UPDATE tasks_fts
+    SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = old.task_id), '')
+    WHERE rowid = old.task_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS task_comments_fts_after_delete;
+DROP TRIGGER IF EXISTS task_comments_fts_after_update;
+DROP TRIGGER IF EXISTS task_comments_fts_after_insert;
+DROP TRIGGER IF EXISTS tasks_fts_after_delete;
+DROP TRIGGER IF EXISTS tasks_fts_after_update;
+DROP TRIGGER IF EXISTS tasks_fts_after_insert;
+DROP TABLE IF EXISTS tasks_fts;
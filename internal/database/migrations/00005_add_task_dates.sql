@@ -0,0 +1,11 @@
+-- +goose Up
+-- Add optional due and start dates to tasks. Both are NULL when unset.
+ALTER TABLE tasks ADD COLUMN due_at DATETIME;
+ALTER TABLE tasks ADD COLUMN start_at DATETIME;
+
+CREATE INDEX idx_tasks_due_at ON tasks(due_at) WHERE due_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_due_at;
+ALTER TABLE tasks DROP COLUMN start_at;
+ALTER TABLE tasks DROP COLUMN due_at;
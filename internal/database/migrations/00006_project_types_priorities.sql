@@ -0,0 +1,104 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- Task types and priorities become per-project entities with a colour, icon
+-- and sort order. Rows without a project are the built-in set that is copied
+-- into every project on startup (see database.CreateDefaultTaxonomy).
+--
+-- description loses its global UNIQUE constraint, so both tables are rebuilt.
+-- Foreign keys must be off while the referenced tables are replaced, which
+-- cannot be changed inside a transaction, hence NO TRANSACTION above.
+PRAGMA foreign_keys = OFF;
+
+BEGIN;
+
+CREATE TABLE types_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NULL,
+    description TEXT NOT NULL,
+    color TEXT NOT NULL DEFAULT '#6B7280',
+    icon TEXT NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    UNIQUE(project_id, description)
+);
+
+INSERT INTO types_new (id, project_id, description, color, sort_order)
+SELECT id, NULL, description,
+    CASE description
+        WHEN 'task' THEN '#3B82F6'
+        WHEN 'feature' THEN '#A855F7'
+        WHEN 'bug' THEN '#EF4444'
+        ELSE '#6B7280'
+    END,
+    id
+FROM types;
+
+DROP TABLE types;
+ALTER TABLE types_new RENAME TO types;
+
+CREATE TABLE priorities_new (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NULL,
+    description TEXT NOT NULL,
+    color TEXT NOT NULL,
+    icon TEXT NOT NULL DEFAULT '',
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+    UNIQUE(project_id, description)
+);
+
+INSERT INTO priorities_new (id, project_id, description, color, sort_order)
+SELECT id, NULL, description, color, id
+FROM priorities;
+
+DROP TABLE priorities;
+ALTER TABLE priorities_new RENAME TO priorities;
+
+CREATE INDEX idx_types_project ON types(project_id);
+CREATE INDEX idx_priorities_project ON priorities(project_id);
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
+
+-- +goose Down
+-- Per-project rows are folded back onto the built-in set by name. Tasks using
+-- a custom type or priority fall back to task/medium.
+PRAGMA foreign_keys = OFF;
+
+BEGIN;
+
+UPDATE tasks SET type_id = COALESCE(
+    (SELECT b.id FROM types b, types c
+     WHERE c.id = tasks.type_id AND b.project_id IS NULL AND b.description = c.description),
+    1)
+WHERE type_id NOT IN (SELECT id FROM types WHERE project_id IS NULL);
+
+UPDATE tasks SET priority_id = COALESCE(
+    (SELECT b.id FROM priorities b, priorities c
+     WHERE c.id = tasks.priority_id AND b.project_id IS NULL AND b.description = c.description),
+    3)
+WHERE priority_id NOT IN (SELECT id FROM priorities WHERE project_id IS NULL);
+
+CREATE TABLE types_old (
+    id INTEGER PRIMARY KEY,
+    description TEXT NOT NULL UNIQUE
+);
+INSERT INTO types_old (id, description)
+SELECT id, description FROM types WHERE project_id IS NULL;
+DROP TABLE types;
+ALTER TABLE types_old RENAME TO types;
+
+CREATE TABLE priorities_old (
+    id INTEGER PRIMARY KEY,
+    description TEXT NOT NULL UNIQUE,
+    color TEXT NOT NULL
+);
+INSERT INTO priorities_old (id, description, color)
+SELECT id, description, color FROM priorities WHERE project_id IS NULL;
+DROP TABLE priorities;
+ALTER TABLE priorities_old RENAME TO priorities;
+
+COMMIT;
+
+PRAGMA foreign_keys = ON;
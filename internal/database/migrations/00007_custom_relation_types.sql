@@ -0,0 +1,19 @@
+-- +goose Up
+-- Relation types can be defined per project next to the three built-in ones.
+-- Built-in rows keep a NULL project_id; every row gets a short name that
+-- `paso task link --type` accepts.
+ALTER TABLE relation_types ADD COLUMN project_id INTEGER REFERENCES projects(id) ON DELETE CASCADE;
+ALTER TABLE relation_types ADD COLUMN name TEXT NOT NULL DEFAULT '';
+
+UPDATE relation_types SET name = 'parent' WHERE id = 1;
+UPDATE relation_types SET name = 'blocker' WHERE id = 2;
+UPDATE relation_types SET name = 'related' WHERE id = 3;
+
+CREATE UNIQUE INDEX idx_relation_types_project_name ON relation_types(project_id, name);
+
+-- +goose Down
+DELETE FROM task_subtasks WHERE relation_type_id IN (SELECT id FROM relation_types WHERE project_id IS NOT NULL);
+DELETE FROM relation_types WHERE project_id IS NOT NULL;
+DROP INDEX IF EXISTS idx_relation_types_project_name;
+ALTER TABLE relation_types DROP COLUMN name;
+ALTER TABLE relation_types DROP COLUMN project_id;
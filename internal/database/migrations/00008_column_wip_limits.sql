@@ -0,0 +1,6 @@
+-- +goose Up
+-- Optional work-in-progress limit per column. NULL means no limit.
+ALTER TABLE columns ADD COLUMN wip_limit INTEGER;
+
+-- +goose Down
+ALTER TABLE columns DROP COLUMN wip_limit;
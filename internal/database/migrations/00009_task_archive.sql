@@ -0,0 +1,10 @@
+-- +goose Up
+-- Archived tasks keep their column and history but are hidden from the board.
+-- archived_at is NULL for active tasks.
+ALTER TABLE tasks ADD COLUMN archived_at DATETIME;
+
+CREATE INDEX idx_tasks_archived_at ON tasks(archived_at) WHERE archived_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_archived_at;
+ALTER TABLE tasks DROP COLUMN archived_at;
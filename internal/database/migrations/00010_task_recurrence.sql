@@ -0,0 +1,20 @@
+-- +goose Up
+-- Repeat rules of recurring tasks. The task a rule is attached to is the
+-- template every instance is copied from. last_instance_id is the most
+-- recently generated instance (the template itself until the first run);
+-- occurrences counts the runs and guards against two processes generating
+-- the same occurrence.
+CREATE TABLE task_recurrences (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL UNIQUE,
+    rule TEXT NOT NULL,
+    next_run_at DATETIME NOT NULL,
+    last_instance_id INTEGER,
+    occurrences INTEGER NOT NULL DEFAULT 0,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+    FOREIGN KEY (last_instance_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_recurrences;
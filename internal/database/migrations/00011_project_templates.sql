@@ -0,0 +1,13 @@
+-- +goose Up
+-- Named project templates saved with paso project save-template. The
+-- definition is the JSON encoding of models.ProjectTemplate.
+CREATE TABLE project_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE,
+    definition TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS project_templates;
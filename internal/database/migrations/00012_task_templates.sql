@@ -0,0 +1,16 @@
+-- +goose Up
+-- Named task templates of a project. The definition is the JSON encoding of
+-- models.TaskTemplate.
+CREATE TABLE task_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    project_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    definition TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, name),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_templates;
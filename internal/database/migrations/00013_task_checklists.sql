@@ -0,0 +1,19 @@
+-- +goose Up
+-- Checklist items of a task: small ordered sub-steps that are ticked off
+-- without becoming tasks of their own.
+CREATE TABLE task_checklist_items (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    task_id INTEGER NOT NULL,
+    content TEXT NOT NULL CHECK(length(content) <= 500),
+    done BOOLEAN NOT NULL DEFAULT 0,
+    position INTEGER NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_checklist_items_task ON task_checklist_items(task_id, position);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_task_checklist_items_task;
+DROP TABLE IF EXISTS task_checklist_items;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- An optional short key per project (e.g. API) that prefixes its ticket
+-- numbers as API-42. Projects without one keep a NULL key; keys are stored
+-- upper case and are unique across projects.
+ALTER TABLE projects ADD COLUMN ticket_key TEXT NULL;
+
+CREATE UNIQUE INDEX idx_projects_ticket_key ON projects(ticket_key);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_projects_ticket_key;
+ALTER TABLE projects DROP COLUMN ticket_key;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- task_blockers lists, for every task, the open tasks in its blocker chain.
+-- A blocker in a completed column no longer blocks, and the chain stops
+-- there: what blocks a finished task does not block the tasks it blocked.
+-- Queries check "is blocked" with exists(... where tb.task_id = t.id).
+
+-- +goose StatementBegin
+CREATE VIEW task_blockers AS
+WITH RECURSIVE chain(task_id, blocker_id) AS (
+    SELECT ts.parent_id, ts.child_id
+    FROM task_subtasks ts
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0
+    UNION
+    SELECT ch.task_id, ts.child_id
+    FROM chain ch
+    INNER JOIN task_subtasks ts ON ts.parent_id = ch.blocker_id
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0
+)
+SELECT task_id, blocker_id FROM chain;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP VIEW IF EXISTS task_blockers;
@@ -0,0 +1,55 @@
+-- +goose Up
+-- Archived tasks no longer block: they are off the board, so nothing would
+-- show why their dependents stay blocked. Like a completed blocker, an
+-- archived one also ends the chain.
+
+-- +goose StatementBegin
+DROP VIEW IF EXISTS task_blockers;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE VIEW task_blockers AS
+WITH RECURSIVE chain(task_id, blocker_id) AS (
+    SELECT ts.parent_id, ts.child_id
+    FROM task_subtasks ts
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0 AND bt.archived_at IS NULL
+    UNION
+    SELECT ch.task_id, ts.child_id
+    FROM chain ch
+    INNER JOIN task_subtasks ts ON ts.parent_id = ch.blocker_id
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0 AND bt.archived_at IS NULL
+)
+SELECT task_id, blocker_id FROM chain;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW IF EXISTS task_blockers;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE VIEW task_blockers AS
+WITH RECURSIVE chain(task_id, blocker_id) AS (
+    SELECT ts.parent_id, ts.child_id
+    FROM task_subtasks ts
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0
+    UNION
+    SELECT ch.task_id, ts.child_id
+    FROM chain ch
+    INNER JOIN task_subtasks ts ON ts.parent_id = ch.blocker_id
+    INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+    INNER JOIN tasks bt ON ts.child_id = bt.id
+    INNER JOIN columns bc ON bt.column_id = bc.id
+    WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0
+)
+SELECT task_id, blocker_id FROM chain;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- name: CreateChecklistItem :one
+-- Appends an item to the end of a task's checklist
+insert into task_checklist_items (task_id, content, position)
+values (
+    sqlc.arg(task_id),
+    sqlc.arg(content),
+    (select coalesce(max(position), 0) + 1 from task_checklist_items where task_id = sqlc.arg(task_id))
+)
+returning id, task_id, content, done, position, created_at, updated_at;
+
+-- name: GetChecklistItem :one
+-- Retrieves a single checklist item by ID
+select id, task_id, content, done, position, created_at, updated_at
+from task_checklist_items
+where id = ?;
+
+-- name: GetChecklistByTask :many
+-- Retrieves the checklist of a task in order
+select id, task_id, content, done, position, created_at, updated_at
+from task_checklist_items
+where task_id = ?
+order by position, id;
+
+-- name: GetChecklistItemsByProject :many
+-- Retrieves the checklists of the tasks of a project, for export
+select
+    ci.id,
+    ci.task_id,
+    ci.content,
+    ci.done,
+    ci.position,
+    ci.created_at,
+    ci.updated_at
+from task_checklist_items ci
+inner join tasks t on ci.task_id = t.id
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by ci.task_id, ci.position, ci.id;
+
+-- name: ImportChecklistItem :exec
+-- Creates a checklist item with its state and position, for import
+insert into task_checklist_items (task_id, content, done, position)
+values (?, ?, ?, ?);
+
+-- name: SetChecklistItemDone :exec
+-- Ticks or unticks a checklist item
+update task_checklist_items
+set done = ?, updated_at = current_timestamp
+where id = ?;
+
+-- name: DeleteChecklistItem :exec
+-- Deletes a checklist item by ID
+delete from task_checklist_items where id = ?;
+
+-- name: RestoreChecklistItem :exec
+-- Re-creates a deleted checklist item under its original ID and timestamps
+insert into task_checklist_items (id, task_id, content, done, position, created_at, updated_at)
+values (?, ?, ?, ?, ?, ?, ?);
@@ -23,7 +23,8 @@ select
     next_id,
     holds_ready_tasks,
     holds_completed_tasks,
-    holds_in_progress_tasks
+    holds_in_progress_tasks,
+    wip_limit
 from columns
 where id = ?;
 
@@ -37,7 +38,8 @@ select
     next_id,
     holds_ready_tasks,
     holds_completed_tasks,
-    holds_in_progress_tasks
+    holds_in_progress_tasks,
+    wip_limit
 from columns
 where project_id = ?;
 
@@ -61,6 +63,12 @@ update columns
 set name = ?
 where id = ?;
 
+-- name: UpdateColumnWipLimit :exec
+-- Sets a column's work-in-progress limit; NULL removes it
+update columns
+set wip_limit = ?
+where id = ?;
+
 -- name: UpdateColumnNextID :exec
 -- Updates the next column pointer in the linked list
 update columns
@@ -87,6 +95,21 @@ where id = ?;
 delete from columns
 where id = ?;
 
+-- name: RestoreColumn :exec
+-- Re-creates a deleted column under its original ID
+insert into columns (
+    id,
+    name,
+    project_id,
+    prev_id,
+    next_id,
+    holds_ready_tasks,
+    holds_completed_tasks,
+    holds_in_progress_tasks,
+    wip_limit
+)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
 -- name: DeleteTasksByColumn :exec
 -- Deletes all tasks within a specific column
 delete from tasks
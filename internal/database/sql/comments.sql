@@ -36,3 +36,29 @@ delete from task_comments where id = ?;
 -- name: GetCommentCountByTask :one
 -- Returns the number of comments for a task
 select count(*) from task_comments where task_id = ?;
+
+-- name: GetCommentsByProject :many
+-- Retrieves every comment on the tasks of a project, for export
+select
+    tc.id,
+    tc.task_id,
+    tc.content,
+    tc.author,
+    tc.created_at,
+    tc.updated_at
+from task_comments tc
+inner join tasks t on tc.task_id = t.id
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by tc.task_id, tc.created_at, tc.id;
+
+-- name: ImportComment :one
+-- Creates a comment keeping its timestamps
+insert into task_comments (task_id, content, author, created_at, updated_at)
+values (?, ?, ?, coalesce(?, current_timestamp), coalesce(?, current_timestamp))
+returning id, task_id, content, author, created_at, updated_at;
+
+-- name: RestoreComment :exec
+-- Re-creates a deleted comment under its original ID and timestamps
+insert into task_comments (id, task_id, content, author, created_at, updated_at)
+values (?, ?, ?, ?, ?, ?);
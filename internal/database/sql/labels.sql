@@ -52,3 +52,15 @@ delete from task_labels where task_id = ?;
 -- name: InsertTaskLabel :exec
 -- Creates a task-label association
 insert into task_labels (task_id, label_id) values (?, ?);
+
+-- name: GetTaskIDsByLabel :many
+-- Retrieves the IDs of all tasks a label is attached to
+select task_id from task_labels where label_id = ?;
+
+-- name: GetTaskLabelsByProject :many
+-- Retrieves every task/label association of a project, for export
+select tl.task_id, tl.label_id
+from task_labels tl
+inner join labels l on tl.label_id = l.id
+where l.project_id = ?
+order by tl.task_id, tl.label_id;
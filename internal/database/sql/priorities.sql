@@ -0,0 +1,48 @@
+-- name: CountPrioritiesByProject :one
+-- Counts the priorities a project owns (built-in rows are not counted)
+select count(*) from priorities where project_id = ?;
+
+-- name: CountTasksWithPriority :one
+-- Counts the tasks using a priority
+select count(*) from tasks where priority_id = ?;
+
+-- name: CreatePriority :one
+-- Creates a priority for a project
+insert into priorities (project_id, description, color, icon, sort_order)
+values (?, ?, ?, ?, ?)
+returning id, project_id, description, color, icon, sort_order;
+
+-- name: DeletePriority :exec
+-- Deletes a priority
+delete from priorities where id = ?;
+
+-- name: GetBuiltinPriorities :many
+-- Retrieves the built-in priorities that new projects start with
+select id, project_id, description, color, icon, sort_order
+from priorities
+where project_id is null
+order by sort_order, id;
+
+-- name: GetPrioritiesByProject :many
+-- Retrieves the priorities of a project in sort order, or the built-in priorities
+-- while the project has none of its own
+select id, project_id, description, color, icon, sort_order
+from priorities
+where project_id = ?
+   or (project_id is null and not exists (select 1 from priorities o where o.project_id = ?))
+order by sort_order, id;
+
+-- name: GetPriorityByID :one
+-- Retrieves a single priority by ID
+select id, project_id, description, color, icon, sort_order from priorities where id = ?;
+
+-- name: ReassignProjectTasksPriority :exec
+-- Moves a project's tasks from one priority to another
+update tasks set priority_id = ?
+where priority_id = ? and column_id in (select id from columns where project_id = ?);
+
+-- name: UpdatePriority :exec
+-- Updates a priority
+update priorities
+set description = ?, color = ?, icon = ?, sort_order = ?
+where id = ?;
@@ -0,0 +1,20 @@
+-- name: DeleteProjectTemplate :execrows
+-- Deletes a saved project template by name
+delete from project_templates where name = ?;
+
+-- name: GetAllProjectTemplates :many
+-- Retrieves every saved project template ordered by name
+select id, name, definition, created_at, updated_at from project_templates order by name;
+
+-- name: GetProjectTemplate :one
+-- Retrieves a saved project template by name
+select id, name, definition, created_at, updated_at from project_templates where name = ?;
+
+-- name: UpsertProjectTemplate :one
+-- Saves a project template, replacing any template with the same name
+insert into project_templates (name, definition)
+values (?, ?)
+on conflict(name) do update set
+    definition = excluded.definition,
+    updated_at = current_timestamp
+returning id, name, definition, created_at, updated_at;
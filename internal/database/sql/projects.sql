@@ -11,12 +11,24 @@ select
     name,
     description,
     created_at,
-    updated_at
+    updated_at,
+    ticket_key
 from projects where id = ?;
 
+-- name: GetProjectByTicketKey :one
+-- Retrieves the project whose ticket key is given (keys are stored upper case)
+select
+    id,
+    name,
+    description,
+    created_at,
+    updated_at,
+    ticket_key
+from projects where ticket_key = ?;
+
 -- name: GetAllProjects :many
 -- Retrieves all projects ordered by ID
-select id, name, description, created_at, updated_at from projects order by id;
+select id, name, description, created_at, updated_at, ticket_key from projects order by id;
 
 -- name: UpdateProject :exec
 -- Updates a project's name and description
@@ -24,6 +36,11 @@ update projects set name = ?,
 description = ?,
 updated_at = current_timestamp where id = ?;
 
+-- name: UpdateProjectTicketKey :exec
+-- Sets or clears (NULL) the ticket key of a project
+update projects set ticket_key = ?,
+updated_at = current_timestamp where id = ?;
+
 -- name: DeleteProject :exec
 -- Permanently deletes a project by ID
 delete from projects where id = ?;
@@ -52,3 +69,9 @@ where column_id in (select id from columns where project_id = ?);
 -- Deletes all columns belonging to a project
 delete from columns
 where project_id = ?;
+
+-- name: SetNextTicketNumber :exec
+-- Sets the next ticket number of a project
+update project_counters
+set next_ticket_number = ?
+where project_id = ?;
@@ -0,0 +1,36 @@
+-- name: CountTaskRelationsWithType :one
+-- Counts the task links using a relation type
+select count(*) from task_subtasks where relation_type_id = ?;
+
+-- name: CreateRelationType :one
+-- Creates a relation type for a project
+insert into relation_types (p_to_c_label, c_to_p_label, color, is_blocking, project_id, name)
+values (?, ?, ?, ?, ?, ?)
+returning id, p_to_c_label, c_to_p_label, color, is_blocking, project_id, name;
+
+-- name: DeleteRelationType :exec
+-- Deletes a relation type
+delete from relation_types where id = ?;
+
+-- name: DeleteTaskRelationsWithType :exec
+-- Removes every task link of a relation type
+delete from task_subtasks where relation_type_id = ?;
+
+-- name: GetRelationTypeByID :one
+-- Retrieves a single relation type by ID
+select id, p_to_c_label, c_to_p_label, color, is_blocking, project_id, name
+from relation_types
+where id = ?;
+
+-- name: GetRelationTypesByProject :many
+-- Retrieves the built-in relation types followed by the project's own
+select id, p_to_c_label, c_to_p_label, color, is_blocking, project_id, name
+from relation_types
+where project_id is null or project_id = ?
+order by project_id is not null, id;
+
+-- name: UpdateRelationType :exec
+-- Updates a relation type
+update relation_types
+set name = ?, p_to_c_label = ?, c_to_p_label = ?, color = ?, is_blocking = ?
+where id = ?;
@@ -0,0 +1,19 @@
+-- name: SearchTasks :many
+-- Ranks tasks in a project against an FTS5 query over title, description and comments
+-- Title matches weigh the most, comment matches the least
+-- Archived tasks are included, so finished work stays findable
+select
+    t.id,
+    t.ticket_number,
+    t.title,
+    t.column_id,
+    c.name as column_name,
+    t.archived_at,
+    cast(snippet(tasks_fts, -1, '**', '**', '…', 12) as text) as snippet,
+    cast(bm25(tasks_fts, 10.0, 4.0, 1.0) as real) as rank
+from tasks_fts
+inner join tasks t on tasks_fts.rowid = t.id
+inner join columns c on t.column_id = c.id
+where tasks_fts match sqlc.arg(query) and c.project_id = sqlc.arg(project_id)
+order by rank, t.id
+limit sqlc.arg(max_results);
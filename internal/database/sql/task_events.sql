@@ -0,0 +1,31 @@
+-- name: CreateTaskEvent :exec
+-- Appends an entry to the task activity log
+insert into task_events (
+    project_id,
+    task_id,
+    event_type,
+    field,
+    old_value,
+    new_value,
+    actor)
+values (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetTaskEventsByTask :many
+-- Retrieves the activity log for a task, newest first
+select
+    id,
+    project_id,
+    task_id,
+    event_type,
+    field,
+    old_value,
+    new_value,
+    actor,
+    created_at
+from task_events
+where task_id = ?
+order by created_at desc, id desc;
+
+-- name: MoveTaskEventsToProject :exec
+-- Files the activity log of a task under the project it moved to
+update task_events set project_id = ? where task_id = ?;
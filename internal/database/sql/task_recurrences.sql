@@ -0,0 +1,87 @@
+-- name: UpsertTaskRecurrence :one
+-- Attaches a rule to a template task, replacing any rule it already has
+insert into task_recurrences (
+    task_id,
+    rule,
+    next_run_at,
+    last_instance_id)
+values (?, ?, ?, ?)
+on conflict (task_id) do update set
+    rule = excluded.rule,
+    next_run_at = excluded.next_run_at
+returning id, task_id, rule, next_run_at, last_instance_id, occurrences, created_at;
+
+-- name: GetTaskRecurrence :one
+-- Retrieves the rule attached to a template task
+select
+    id,
+    task_id,
+    rule,
+    next_run_at,
+    last_instance_id,
+    occurrences,
+    created_at
+from task_recurrences
+where task_id = ?;
+
+-- name: GetTaskRecurrencesByProject :many
+-- Lists the recurring tasks of a project with their templates
+select
+    r.id,
+    r.task_id,
+    r.rule,
+    r.next_run_at,
+    r.last_instance_id,
+    r.occurrences,
+    r.created_at,
+    t.title,
+    t.ticket_number
+from task_recurrences r
+inner join tasks t on r.task_id = t.id
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by r.id;
+
+-- name: ImportTaskRecurrence :exec
+-- Attaches a rule to a template task keeping its schedule and run count, for import
+insert into task_recurrences (
+    task_id,
+    rule,
+    next_run_at,
+    last_instance_id,
+    occurrences)
+values (?, ?, ?, ?, ?);
+
+-- name: GetRecurrenceSchedule :many
+-- Lists every rule with whether its last instance has been completed
+select
+    r.id,
+    r.task_id,
+    r.rule,
+    r.next_run_at,
+    r.last_instance_id,
+    r.occurrences,
+    coalesce(ic.holds_completed_tasks, 0) as last_instance_completed
+from task_recurrences r
+left join tasks i on r.last_instance_id = i.id
+left join columns ic on i.column_id = ic.id
+order by r.id;
+
+-- name: ClaimTaskRecurrence :execrows
+-- Moves a rule past the occurrence about to be generated. Affects no rows
+-- when another process has generated it first.
+update task_recurrences
+set next_run_at = ?,
+    occurrences = occurrences + 1
+where id = ? and occurrences = ?;
+
+-- name: SetTaskRecurrenceInstance :exec
+-- Records the most recently generated instance of a rule
+update task_recurrences
+set last_instance_id = ?
+where id = ?;
+
+-- name: DeleteTaskRecurrence :exec
+-- Detaches the rule from a template task
+delete from task_recurrences
+where task_id = ?;
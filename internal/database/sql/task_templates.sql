@@ -0,0 +1,25 @@
+-- name: DeleteTaskTemplate :execrows
+-- Deletes a task template of a project
+delete from task_templates where project_id = ? and name = ?;
+
+-- name: GetTaskTemplate :one
+-- Retrieves a task template of a project by name
+select id, project_id, name, definition, created_at, updated_at
+from task_templates
+where project_id = ? and name = ?;
+
+-- name: GetTaskTemplatesByProject :many
+-- Retrieves the task templates of a project ordered by name
+select id, project_id, name, definition, created_at, updated_at
+from task_templates
+where project_id = ?
+order by name;
+
+-- name: UpsertTaskTemplate :one
+-- Saves a task template, replacing the project's template with the same name
+insert into task_templates (project_id, name, definition)
+values (?, ?, ?)
+on conflict(project_id, name) do update set
+    definition = excluded.definition,
+    updated_at = current_timestamp
+returning id, project_id, name, definition, created_at, updated_at;
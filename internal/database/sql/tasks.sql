@@ -22,6 +22,25 @@ select
 from tasks
 where id = ?;
 
+-- name: GetFullTask :one
+-- Retrieves every column of a task by ID
+select
+    id,
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at
+from tasks
+where id = ?;
+
 -- name: GetTasksByColumn :many
 -- Retrieves all tasks in a column, ordered by position
 select
@@ -41,12 +60,23 @@ order by position;
 select count(*)
 from tasks where column_id = ?;
 
+-- name: GetActiveTaskCountByColumn :one
+-- Returns the number of tasks in a column that are not archived
+select count(*)
+from tasks where column_id = ? and archived_at is null;
+
 -- name: UpdateTask :exec
 -- Updates a task's title and description
 update tasks
 set title = ?, description = ?, updated_at = current_timestamp
 where id = ?;
 
+-- name: UpdateTaskDates :exec
+-- Sets a task's due and start dates (NULL clears them)
+update tasks
+set due_at = ?, start_at = ?, updated_at = current_timestamp
+where id = ?;
+
 -- name: UpdateTaskPriority :exec
 -- Updates a task's priority level
 update tasks
@@ -59,6 +89,50 @@ update tasks
 set type_id = ?, updated_at = current_timestamp
 where id = ?;
 
+-- name: ArchiveTask :exec
+-- Hides a task from the board, keeping it and its history
+update tasks
+set archived_at = current_timestamp, updated_at = current_timestamp
+where id = ?;
+
+-- name: UnarchiveTask :exec
+-- Puts an archived task back on the board
+update tasks
+set archived_at = null, updated_at = current_timestamp
+where id = ?;
+
+-- name: ArchiveCompletedTasks :many
+-- Archives the tasks that have been in a project's completed column for
+-- longer than the given age (a datetime modifier such as '-14 days'),
+-- returning their IDs. A task's last move, or its creation if it never
+-- moved, marks when it got there; later edits do not restart the clock.
+update tasks
+set archived_at = current_timestamp, updated_at = current_timestamp
+where archived_at is null
+    and coalesce(
+        (select max(e.created_at) from task_events e
+         where e.task_id = tasks.id and e.event_type = 'moved'),
+        tasks.created_at
+    ) < datetime('now', sqlc.arg(age))
+    and column_id in (
+        select id from columns
+        where project_id = sqlc.arg(project_id) and holds_completed_tasks = 1
+    )
+returning id;
+
+-- name: GetArchivedTasksByProject :many
+-- Retrieves the archived tasks of a project, most recently archived first
+select
+    t.id,
+    t.ticket_number,
+    t.title,
+    c.name as column_name,
+    t.archived_at
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ? and t.archived_at is not null
+order by t.archived_at desc, t.id desc;
+
 -- name: DeleteTask :exec
 -- Permanently deletes a task by ID
 delete from tasks
@@ -66,7 +140,7 @@ where id = ?;
 
 -- name: GetTaskDetail :one
 -- Retrieves comprehensive task details including:
--- type, priority, column, project, and blocking status
+-- type, priority, column, project, blocking status and child progress
 select
     t.id,
     t.title,
@@ -76,16 +150,53 @@ select
     t.ticket_number,
     t.created_at,
     t.updated_at,
+    t.due_at,
+    t.start_at,
+    t.archived_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
     c.name as column_name,
     proj.name as project_name,
+    proj.id as project_id,
     exists(
-        select 1 from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
-    ) as is_blocked
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
@@ -108,6 +219,7 @@ select
     t.title,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -119,25 +231,29 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where t.column_id = ?
+where t.column_id = ? and t.archived_at is null
 group by
     t.id,
     t.title,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
 order by t.position;
 
 -- name: GetTaskSummariesByProject :many
--- Retrieves task summaries with aggregated labels and blocking status
--- for all tasks in a project
+-- Retrieves task summaries with aggregated labels, blocking status,
+-- checklist progress and child progress for all tasks in a project
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
+    t.archived_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -145,35 +261,149 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
-    ) as is_blocked
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id and ci.done = 1) as checklist_done,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id) as checklist_total,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ?
+where c.project_id = ? and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
+    t.archived_at,
     ty.description,
     p.description,
     p.color
 order by t.position;
 
+-- name: GetTaskSummary :one
+-- Retrieves the summary of a single task, in the same shape as
+-- GetTaskSummariesByProject (used to apply live updates incrementally)
+select
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description as type_description,
+    p.description as priority_description,
+    p.color as priority_color,
+    cast(coalesce(group_concat(l.id, char(31)), '') as text) as label_ids,
+    cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
+    cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
+    exists(
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id and ci.done = 1) as checklist_done,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id) as checklist_total,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
+from tasks t
+left join types ty on t.type_id = ty.id
+left join priorities p on t.priority_id = p.id
+left join task_labels tl on t.id = tl.task_id
+left join labels l on tl.label_id = l.id
+where t.id = ?
+group by
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description,
+    p.description,
+    p.color;
+
 -- name: GetReadyTaskSummariesByProject :many
 -- Retrieves task summaries for ready tasks (tasks in columns marked as holds_ready_tasks)
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -181,10 +411,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -192,12 +420,14 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ? and c.holds_ready_tasks = 1
+where c.project_id = ? and c.holds_ready_tasks = 1 and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
@@ -215,7 +445,7 @@ select
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
-where proj.id = ? and c.holds_in_progress_tasks = 1
+where proj.id = ? and c.holds_in_progress_tasks = 1 and t.archived_at is null
 order by t.position;
 
 -- name: GetInProgressTaskDetails :many
@@ -238,10 +468,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -250,7 +478,7 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where proj.id = ? and c.holds_in_progress_tasks = 1
+where proj.id = ? and c.holds_in_progress_tasks = 1 and t.archived_at is null
 group by
     t.id,
     t.ticket_number,
@@ -272,8 +500,10 @@ order by t.position;
 select
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description as type_description,
     p.description as priority_description,
     p.color as priority_color,
@@ -281,10 +511,8 @@ select
     cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
     cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
     exists(
-        select 1
-        from task_subtasks ts
-        inner join relation_types rt on ts.relation_type_id = rt.id
-        where ts.parent_id = t.id and rt.is_blocking = 1
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
     ) as is_blocked
 from tasks t
 inner join columns c on t.column_id = c.id
@@ -292,12 +520,14 @@ left join types ty on t.type_id = ty.id
 left join priorities p on t.priority_id = p.id
 left join task_labels tl on t.id = tl.task_id
 left join labels l on tl.label_id = l.id
-where c.project_id = ? and t.title like ?
+where c.project_id = ? and t.title like ? and t.archived_at is null
 group by
     t.id,
     t.title,
+    t.ticket_number,
     t.column_id,
     t.position,
+    t.due_at,
     ty.description,
     p.description,
     p.color
@@ -344,14 +574,14 @@ where id = ?;
 -- Retrieves the task immediately above the given position in a column
 select id, position 
 from tasks
-where column_id = ? and position < ?
+where column_id = ? and position < ? and archived_at is null
 order by position desc limit 1;
 
 -- name: GetTaskBelow :one
 -- Retrieves the task immediately below the given position in a column
 select id, position 
 from tasks
-where column_id = ? and position > ?
+where column_id = ? and position > ? and archived_at is null
 order by position asc limit 1;
 
 -- name: GetProjectIDFromTask :one
@@ -361,6 +591,13 @@ from tasks t
 inner join columns c on t.column_id = c.id
 where t.id = ?;
 
+-- name: GetTaskIDByTicketNumber :one
+-- Retrieves the ID of the task with the given ticket number in a project
+select t.id
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ? and t.ticket_number = ?;
+
 -- name: GetProjectIDFromColumn :one
 -- Retrieves the project ID for a given column
 select project_id
@@ -377,6 +614,12 @@ update project_counters
 set next_ticket_number = next_ticket_number + 1
 where project_id = ?;
 
+-- name: SetTaskTicketNumber :exec
+-- Gives a task a new ticket number, used when it moves to another project
+update tasks
+set ticket_number = ?, updated_at = current_timestamp
+where id = ?;
+
 -- name: GetParentTasks :many
 -- Retrieves all parent tasks for a given child task with relationship details
 select t.id, t.ticket_number, t.title, p.name,
@@ -401,6 +644,39 @@ inner join projects p on c.project_id = p.id
 where ts.parent_id = ?
 order by p.name, t.ticket_number;
 
+-- name: GetBlockingDependents :many
+-- Retrieves the tasks a task blocks, directly or through a blocker chain,
+-- and whether each of them is currently blocked
+with recursive dependents(id) as (
+    select ts.parent_id
+    from task_subtasks ts
+    inner join relation_types rt on ts.relation_type_id = rt.id
+    where ts.child_id = ? and rt.is_blocking = 1
+    union
+    select ts.parent_id
+    from task_subtasks ts
+    inner join relation_types rt on ts.relation_type_id = rt.id
+    inner join dependents d on ts.child_id = d.id
+    where rt.is_blocking = 1
+)
+select
+    t.id,
+    exists(
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked
+from dependents d
+inner join tasks t on d.id = t.id
+order by t.id;
+
+-- name: GetTaskBlocked :one
+-- Reports whether a task has an open task in its blocker chain
+select exists(
+    select 1 from task_blockers tb where tb.task_id = t.id
+) as is_blocked
+from tasks t
+where t.id = ?;
+
 -- name: GetTaskReferencesForProject :many
 -- Retrieves basic task references for all tasks in a project
 select t.id, t.ticket_number, t.title, p.name
@@ -426,32 +702,66 @@ values (?, ?, ?);
 delete from task_subtasks where parent_id = ? and child_id = ?;
 
 -- name: GetAllRelationTypes :many
--- Retrieves all available relationship types for task links
-select id, p_to_c_label, c_to_p_label, color, is_blocking
+-- Retrieves every relationship type for task links, built-in and per-project
+select id, p_to_c_label, c_to_p_label, color, is_blocking, project_id, name
 from relation_types
 order by id;
 
 -- name: GetAllPriorities :many
--- Retrieves all available priority levels
-select id, description, color from priorities order by id;
+-- Retrieves every priority level, built-in and per-project
+select id, project_id, description, color, icon, sort_order from priorities order by id;
 
 -- name: GetAllTypes :many
--- Retrieves all available task types
-select id, description from types order by id;
+-- Retrieves every task type, built-in and per-project
+select id, project_id, description, color, icon, sort_order from types order by id;
 
 -- name: GetTasksForTree :many
--- Retrieves all tasks in a project with column
--- and project names for tree visualization
+-- Retrieves all tasks in a project with column and project names
+-- and child progress for tree visualization
 select
     t.id,
     t.ticket_number,
     t.title,
     c.name as column_name,
-    proj.name as project_name
+    proj.name as project_name,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
 from tasks t
 inner join columns c on t.column_id = c.id
 inner join projects proj on c.project_id = proj.id
-where proj.id = ?
+where proj.id = ? and t.archived_at is null
 order by t.ticket_number;
 
 -- name: GetTaskRelationsForProject :many
@@ -468,3 +778,78 @@ inner join relation_types rt on ts.relation_type_id = rt.id
 inner join tasks t_parent on ts.parent_id = t_parent.id
 inner join columns c on t_parent.column_id = c.id
 where c.project_id = ?;
+
+-- name: GetTasksByProject :many
+-- Retrieves every task of a project with all columns, for export
+select
+    t.id,
+    t.title,
+    t.description,
+    t.column_id,
+    t.position,
+    t.ticket_number,
+    t.type_id,
+    t.priority_id,
+    t.created_at,
+    t.updated_at,
+    t.due_at,
+    t.start_at,
+    t.archived_at
+from tasks t
+inner join columns c on t.column_id = c.id
+where c.project_id = ?
+order by t.id;
+
+-- name: GetTaskSubtasksByProject :many
+-- Retrieves the relations between tasks of a project, for export
+select ts.parent_id, ts.child_id, ts.relation_type_id
+from task_subtasks ts
+inner join tasks t_parent on ts.parent_id = t_parent.id
+inner join columns c_parent on t_parent.column_id = c_parent.id
+inner join tasks t_child on ts.child_id = t_child.id
+inner join columns c_child on t_child.column_id = c_child.id
+where c_parent.project_id = ? and c_child.project_id = c_parent.project_id
+order by ts.parent_id, ts.child_id;
+
+-- name: GetTaskLinks :many
+-- Retrieves every relation a task takes part in, as parent or as child
+select parent_id, child_id, relation_type_id
+from task_subtasks
+where parent_id = sqlc.arg(task_id) or child_id = sqlc.arg(task_id)
+order by parent_id, child_id;
+
+-- name: ImportTask :one
+-- Creates a task with every column given, keeping its ticket number and timestamps
+insert into tasks (
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at)
+values (?, ?, ?, ?, ?, ?, ?, coalesce(?, current_timestamp), coalesce(?, current_timestamp), ?, ?, ?)
+returning id, title, description, column_id, position, ticket_number, type_id, priority_id, created_at, updated_at, due_at, start_at, archived_at;
+
+-- name: RestoreTask :exec
+-- Re-creates a deleted task under its original ID, ticket number and timestamps
+insert into tasks (
+    id,
+    title,
+    description,
+    column_id,
+    position,
+    ticket_number,
+    type_id,
+    priority_id,
+    created_at,
+    updated_at,
+    due_at,
+    start_at,
+    archived_at)
+values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
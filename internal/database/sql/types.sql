@@ -0,0 +1,48 @@
+-- name: CountTasksWithType :one
+-- Counts the tasks using a type
+select count(*) from tasks where type_id = ?;
+
+-- name: CountTypesByProject :one
+-- Counts the types a project owns (built-in rows are not counted)
+select count(*) from types where project_id = ?;
+
+-- name: CreateType :one
+-- Creates a type for a project
+insert into types (project_id, description, color, icon, sort_order)
+values (?, ?, ?, ?, ?)
+returning id, project_id, description, color, icon, sort_order;
+
+-- name: DeleteType :exec
+-- Deletes a type
+delete from types where id = ?;
+
+-- name: GetBuiltinTypes :many
+-- Retrieves the built-in types that new projects start with
+select id, project_id, description, color, icon, sort_order
+from types
+where project_id is null
+order by sort_order, id;
+
+-- name: GetTypeByID :one
+-- Retrieves a single type by ID
+select id, project_id, description, color, icon, sort_order from types where id = ?;
+
+-- name: GetTypesByProject :many
+-- Retrieves the types of a project in sort order, or the built-in types
+-- while the project has none of its own
+select id, project_id, description, color, icon, sort_order
+from types
+where project_id = ?
+   or (project_id is null and not exists (select 1 from types o where o.project_id = ?))
+order by sort_order, id;
+
+-- name: ReassignProjectTasksType :exec
+-- Moves a project's tasks from one type to another
+update tasks set type_id = ?
+where type_id = ? and column_id in (select id from columns where project_id = ?);
+
+-- name: UpdateType :exec
+-- Updates a type
+update types
+set description = ?, color = ?, icon = ?, sort_order = ?
+where id = ?;
@@ -0,0 +1,44 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/user"
+)
+
+// TaskEventRecord describes a single entry in the task activity log.
+// TaskID is 0 for project-level events such as a column rename.
+type TaskEventRecord struct {
+	ProjectID int64
+	TaskID    int64
+	EventType string
+	Field     string
+	OldValue  string
+	NewValue  string
+}
+
+// RecordTaskEvent appends an entry to the task activity log.
+// It should be called with transaction-scoped queries so the log entry is
+// committed or rolled back together with the change it describes.
+func RecordTaskEvent(ctx context.Context, q generated.Querier, rec TaskEventRecord) error {
+	var taskID sql.NullInt64
+	if rec.TaskID > 0 {
+		taskID = sql.NullInt64{Int64: rec.TaskID, Valid: true}
+	}
+
+	if err := q.CreateTaskEvent(ctx, generated.CreateTaskEventParams{
+		ProjectID: rec.ProjectID,
+		TaskID:    taskID,
+		EventType: rec.EventType,
+		Field:     rec.Field,
+		OldValue:  rec.OldValue,
+		NewValue:  rec.NewValue,
+		Actor:     user.GetActor(),
+	}); err != nil {
+		return fmt.Errorf("failed to record task event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package database
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/filter"
+)
+
+// taskSummariesFilteredQuery mirrors GetTaskSummariesByProject with an extra
+// condition slot. It is built at runtime because sqlc cannot generate
+// queries with a dynamic WHERE clause. The slot also carries the archived
+// condition, since is:archived lifts it.
+const taskSummariesFilteredQuery = `
+select
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description as type_description,
+    p.description as priority_description,
+    p.color as priority_color,
+    cast(coalesce(group_concat(l.id, char(31)), '') as text) as label_ids,
+    cast(coalesce(group_concat(l.name, char(31)), '') as text) as label_names,
+    cast(coalesce(group_concat(l.color, char(31)), '') as text) as label_colors,
+    exists(
+        -- Blocked while an open task is in its blocker chain
+        select 1 from task_blockers tb where tb.task_id = t.id
+    ) as is_blocked,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id and ci.done = 1) as checklist_done,
+    (select count(*) from task_checklist_items ci where ci.task_id = t.id) as checklist_total,
+    (
+        -- Descendants through parent-child links (relation type 1), each
+        -- counted once
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        inner join tasks dt on d.id = dt.id
+        inner join columns dc on dt.column_id = dc.id
+        where d.id != t.id and dc.holds_completed_tasks = 1
+    ) as children_done,
+    (
+        with recursive descendants(id) as (
+            select ts.child_id
+            from task_subtasks ts
+            where ts.parent_id = t.id and ts.relation_type_id = 1
+            union
+            select ts.child_id
+            from task_subtasks ts
+            inner join descendants d on ts.parent_id = d.id
+            where ts.relation_type_id = 1
+        )
+        select count(*)
+        from descendants d
+        where d.id != t.id
+    ) as children_total
+from tasks t
+inner join columns c on t.column_id = c.id
+left join types ty on t.type_id = ty.id
+left join priorities p on t.priority_id = p.id
+left join task_labels tl on t.id = tl.task_id
+left join labels l on tl.label_id = l.id
+where c.project_id = ? and (%s)
+group by
+    t.id,
+    t.title,
+    t.ticket_number,
+    t.column_id,
+    t.position,
+    t.due_at,
+    t.archived_at,
+    ty.description,
+    p.description,
+    p.color
+order by t.column_id, t.position`
+
+// GetTaskSummariesByFilter retrieves task summaries for a project that match
+// a parsed filter query. Rows use the same shape as GetTaskSummariesByProject
+// so they can share converters. Archived tasks are left out unless the query
+// asks for them with is:archived.
+func GetTaskSummariesByFilter(ctx context.Context, db generated.DBTX, projectID int64, q *filter.Query) ([]generated.GetTaskSummariesByProjectRow, error) {
+	where, filterArgs := q.SQL()
+	if !q.IncludesArchived() {
+		where = "t.archived_at is null and (" + where + ")"
+	}
+	args := append([]any{projectID}, filterArgs...)
+
+	rows, err := db.QueryContext(ctx, fmt.Sprintf(taskSummariesFilteredQuery, where), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query filtered tasks: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	items := []generated.GetTaskSummariesByProjectRow{}
+	for rows.Next() {
+		var i generated.GetTaskSummariesByProjectRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.TicketNumber,
+			&i.ColumnID,
+			&i.Position,
+			&i.DueAt,
+			&i.ArchivedAt,
+			&i.TypeDescription,
+			&i.PriorityDescription,
+			&i.PriorityColor,
+			&i.LabelIds,
+			&i.LabelNames,
+			&i.LabelColors,
+			&i.IsBlocked,
+			&i.ChecklistDone,
+			&i.ChecklistTotal,
+			&i.ChildrenDone,
+			&i.ChildrenTotal,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan filtered task: %w", err)
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate filtered tasks: %w", err)
+	}
+	return items, nil
+}
@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+)
+
+// CreateDefaultTaxonomy gives a project its own copy of the built-in task
+// types and priorities, using the provided querier (works with both db and tx).
+// It returns the built-in→project ID mappings so callers can remap tasks.
+func CreateDefaultTaxonomy(ctx context.Context, q generated.Querier, projectID int64) (typeIDs, priorityIDs map[int64]int64, err error) {
+	pid := sql.NullInt64{Int64: projectID, Valid: true}
+
+	builtinTypes, err := q.GetBuiltinTypes(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get built-in types: %w", err)
+	}
+	typeIDs = make(map[int64]int64, len(builtinTypes))
+	for _, t := range builtinTypes {
+		created, err := q.CreateType(ctx, generated.CreateTypeParams{
+			ProjectID:   pid,
+			Description: t.Description,
+			Color:       t.Color,
+			Icon:        t.Icon,
+			SortOrder:   t.SortOrder,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create type %q: %w", t.Description, err)
+		}
+		typeIDs[t.ID] = created.ID
+	}
+
+	builtinPriorities, err := q.GetBuiltinPriorities(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get built-in priorities: %w", err)
+	}
+	priorityIDs = make(map[int64]int64, len(builtinPriorities))
+	for _, p := range builtinPriorities {
+		created, err := q.CreatePriority(ctx, generated.CreatePriorityParams{
+			ProjectID:   pid,
+			Description: p.Description,
+			Color:       p.Color,
+			Icon:        p.Icon,
+			SortOrder:   p.SortOrder,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create priority %q: %w", p.Description, err)
+		}
+		priorityIDs[p.ID] = created.ID
+	}
+
+	return typeIDs, priorityIDs, nil
+}
+
+// seedProjectTaxonomy gives every project without types of its own a copy of
+// the built-in types and priorities, and moves its existing tasks onto them
+func seedProjectTaxonomy(ctx context.Context, db *sql.DB) error {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id FROM projects p WHERE NOT EXISTS (SELECT 1 FROM types t WHERE t.project_id = p.id)`)
+	if err != nil {
+		return err
+	}
+	var projectIDs []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		projectIDs = append(projectIDs, id)
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, projectID := range projectIDs {
+		err := WithTx(ctx, db, func(tx *sql.Tx) error {
+			return EnsureProjectTaxonomy(ctx, generated.New(tx), projectID)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to seed types and priorities for project %d: %w", projectID, err)
+		}
+	}
+
+	return nil
+}
+
+// EnsureProjectTaxonomy gives a project that has no types of its own a copy
+// of the built-in types and priorities, and moves its tasks onto the copies.
+// It does nothing for a project that already has them. Run it in a transaction.
+func EnsureProjectTaxonomy(ctx context.Context, q generated.Querier, projectID int64) error {
+	count, err := q.CountTypesByProject(ctx, sql.NullInt64{Int64: projectID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to count types: %w", err)
+	}
+	if count > 0 {
+		return nil
+	}
+
+	typeIDs, priorityIDs, err := CreateDefaultTaxonomy(ctx, q, projectID)
+	if err != nil {
+		return err
+	}
+
+	for from, to := range typeIDs {
+		if err := q.ReassignProjectTasksType(ctx, generated.ReassignProjectTasksTypeParams{
+			TypeID:    to,
+			TypeID_2:  from,
+			ProjectID: projectID,
+		}); err != nil {
+			return fmt.Errorf("failed to remap task types: %w", err)
+		}
+	}
+	for from, to := range priorityIDs {
+		if err := q.ReassignProjectTasksPriority(ctx, generated.ReassignProjectTasksPriorityParams{
+			PriorityID:   to,
+			PriorityID_2: from,
+			ProjectID:    projectID,
+		}); err != nil {
+			return fmt.Errorf("failed to remap task priorities: %w", err)
+		}
+	}
+	return nil
+}
+
+// DefaultTypeID returns the type new tasks get when none is given: the first
+// in sort order. types must be ordered as GetTypesByProject returns them.
+func DefaultTypeID(types []generated.Type) int64 {
+	if len(types) == 0 {
+		return 0
+	}
+	return types[0].ID
+}
+
+// DefaultPriorityID returns the priority new tasks get when none is given: the
+// middle of the project's scale, so medium on the built-in five levels.
+// priorities must be ordered as GetPrioritiesByProject returns them.
+func DefaultPriorityID(priorities []generated.Priority) int64 {
+	if len(priorities) == 0 {
+		return 0
+	}
+	return priorities[len(priorities)/2].ID
+}
@@ -0,0 +1,204 @@
+// Package dates parses and formats the due and start dates of tasks.
+//
+// Dates are entered in natural forms (today, tomorrow, +3d, friday,
+// 2026-11-01) and resolved against the local clock. A bare day resolves to
+// local midnight and is shown without a time.
+package dates
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDate is wrapped by all parse errors
+var ErrInvalidDate = errors.New("invalid date")
+
+// dayLayouts are the absolute day forms accepted by Parse
+var dayLayouts = []string{"2006-01-02", "2006/01/02"}
+
+// timeLayouts are the absolute forms with a time of day accepted by Parse
+var timeLayouts = []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02T15:04"}
+
+// Parse resolves a date relative to now. Accepted forms:
+//
+//	today, tomorrow, yesterday
+//	+3d, +2w, +12h, -1d   (offsets; days and weeks land on midnight)
+//	monday ... sunday     (the next such day, never today; mon..sun also work)
+//	2026-11-01            (a day)
+//	2026-11-01 15:04      (a day and local time, or RFC 3339)
+func Parse(s string, now time.Time) (time.Time, error) {
+	value := strings.ToLower(strings.TrimSpace(s))
+	today := startOfDay(now)
+
+	switch value {
+	case "":
+		return time.Time{}, fmt.Errorf("%w: empty date", ErrInvalidDate)
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+
+	if value[0] == '+' || value[0] == '-' {
+		n, unit, err := splitOffset(value[1:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %q: %v", ErrInvalidDate, s, err)
+		}
+		if value[0] == '-' {
+			n = -n
+		}
+		switch unit {
+		case 'h':
+			return now.Add(time.Duration(n) * time.Hour), nil
+		case 'd':
+			return today.AddDate(0, 0, n), nil
+		case 'w':
+			return today.AddDate(0, 0, 7*n), nil
+		}
+	}
+
+	if weekday, ok := parseWeekday(value); ok {
+		days := (int(weekday) - int(today.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days), nil
+	}
+
+	for _, layout := range dayLayouts {
+		if t, err := time.ParseInLocation(layout, value, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, strings.ToUpper(value), now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %q (use e.g. today, tomorrow, +3d, friday or 2026-11-01)", ErrInvalidDate, s)
+}
+
+// ParseWindow parses a look-ahead window such as 7d, 2w or 12h
+func ParseWindow(s string) (time.Duration, error) {
+	n, unit, err := splitOffset(strings.ToLower(strings.TrimSpace(strings.TrimPrefix(s, "+"))))
+	if err != nil {
+		return 0, fmt.Errorf("%w: window %q: %v", ErrInvalidDate, s, err)
+	}
+	switch unit {
+	case 'h':
+		return time.Duration(n) * time.Hour, nil
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	}
+	return time.Duration(n) * 24 * time.Hour, nil
+}
+
+// splitOffset splits "3d" into 3 and 'd'
+func splitOffset(s string) (int, byte, error) {
+	if len(s) < 2 {
+		return 0, 0, errors.New("want a number followed by h, d or w")
+	}
+	unit := s[len(s)-1]
+	if unit != 'h' && unit != 'd' && unit != 'w' {
+		return 0, 0, fmt.Errorf("unknown unit %q (want h, d or w)", unit)
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n < 0 {
+		return 0, 0, errors.New("want a number followed by h, d or w")
+	}
+	return n, unit, nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if s == name || s == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// HasTime reports whether t carries a time of day, i.e. is not local midnight
+func HasTime(t time.Time) bool {
+	t = t.Local()
+	return !t.Equal(startOfDay(t))
+}
+
+// Format renders a date as 2026-11-01, or 2026-11-01 15:04 when it has a time
+func Format(t time.Time) string {
+	t = t.Local()
+	if HasTime(t) {
+		return t.Format("2006-01-02 15:04")
+	}
+	return t.Format("2006-01-02")
+}
+
+// State classifies a due date relative to now
+type State int
+
+const (
+	StateNone    State = iota // No due date
+	StateLater                // Due after the soon window
+	StateSoon                 // Due within SoonWindow
+	StateToday                // Due later today
+	StateOverdue              // Past due
+)
+
+// SoonWindow is how far ahead a due date counts as soon
+const SoonWindow = 3 * 24 * time.Hour
+
+// DueState classifies a due date. A bare day is overdue once the day is
+// over; a due date with a time is overdue once that time has passed.
+func DueState(due *time.Time, now time.Time) State {
+	if due == nil {
+		return StateNone
+	}
+	deadline := due.Local()
+	if !HasTime(deadline) {
+		deadline = deadline.AddDate(0, 0, 1)
+	}
+
+	switch {
+	case !now.Before(deadline):
+		return StateOverdue
+	case startOfDay(due.Local()).Equal(startOfDay(now.Local())):
+		return StateToday
+	case deadline.Sub(now) <= SoonWindow:
+		return StateSoon
+	}
+	return StateLater
+}
+
+// Relative renders a due date compactly for badges: "today", "tomorrow",
+// "in 3d", "2d late", or the date itself when it is further away
+func Relative(due time.Time, now time.Time) string {
+	days := int(startOfDay(due.Local()).Sub(startOfDay(now.Local())).Hours() / 24)
+	switch {
+	case days == 0:
+		if HasTime(due) {
+			return due.Local().Format("15:04")
+		}
+		return "today"
+	case days == 1:
+		return "tomorrow"
+	case days == -1:
+		return "yesterday"
+	case days < 0:
+		return fmt.Sprintf("%dd late", -days)
+	case days < 7:
+		return fmt.Sprintf("in %dd", days)
+	}
+	return due.Local().Format("Jan 2")
+}
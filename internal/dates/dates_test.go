@@ -0,0 +1,99 @@
+package dates
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// now is Wednesday 2026-10-14 15:30 UTC
+var now = time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"today", day(2026, 10, 14)},
+		{"Tomorrow", day(2026, 10, 15)},
+		{"yesterday", day(2026, 10, 13)},
+		{"+3d", day(2026, 10, 17)},
+		{"+2w", day(2026, 10, 28)},
+		{"-1d", day(2026, 10, 13)},
+		{"+12h", now.Add(12 * time.Hour)},
+		{"friday", day(2026, 10, 16)},
+		{"wed", day(2026, 10, 21)},
+		{"2026-11-01", day(2026, 11, 1)},
+		{"2026-11-01 09:15", time.Date(2026, 11, 1, 9, 15, 0, 0, time.UTC)},
+		{"2026-11-01T09:15:00Z", time.Date(2026, 11, 1, 9, 15, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input, now)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, input := range []string{"", "soon", "+3", "+3y", "2026-13-01", "+d"} {
+		t.Run(input, func(t *testing.T) {
+			if _, err := Parse(input, now); !errors.Is(err, ErrInvalidDate) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidDate", input, err)
+			}
+		})
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := map[string]time.Duration{
+		"7d":  7 * 24 * time.Hour,
+		"+2w": 14 * 24 * time.Hour,
+		"12h": 12 * time.Hour,
+	}
+	for input, want := range tests {
+		if got, err := ParseWindow(input); err != nil || got != want {
+			t.Errorf("ParseWindow(%q) = %v, %v; want %v", input, got, err, want)
+		}
+	}
+	if _, err := ParseWindow("week"); err == nil {
+		t.Error("ParseWindow(\"week\") should fail")
+	}
+}
+
+func TestDueState(t *testing.T) {
+	at := func(t time.Time) *time.Time { return &t }
+	local := now.Local()
+	today := startOfDay(local)
+
+	tests := []struct {
+		name string
+		due  *time.Time
+		want State
+	}{
+		{"none", nil, StateNone},
+		{"yesterday", at(today.AddDate(0, 0, -1)), StateOverdue},
+		{"earlier today with a time", at(local.Add(-time.Minute)), StateOverdue},
+		{"today", at(today), StateToday},
+		{"tomorrow", at(today.AddDate(0, 0, 1)), StateSoon},
+		{"next month", at(today.AddDate(0, 1, 0)), StateLater},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DueState(tt.due, local); got != tt.want {
+				t.Errorf("DueState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
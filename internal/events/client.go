@@ -20,6 +20,8 @@ type NotifyFunc func(level, message string)
 // It handles event sending, receiving, batching, reconnection, and subscriptions.
 type Client struct {
 	socketPath string
+	addr       string // TCP address of a remote daemon; overrides socketPath
+	token      string // Shared token for authenticating with a remote daemon
 	conn       net.Conn
 	encoder    *json.Encoder
 	decoder    *json.Decoder
@@ -37,7 +39,9 @@ type Client struct {
 	// Subscription state
 	currentProjectID int
 
-	// Event tracking
+	// Event tracking: position in the daemon's event stream, sent in a
+	// resume message after reconnecting
+	lastEpoch    int64
 	lastSequence int64
 
 	// Context for graceful shutdown
@@ -61,6 +65,8 @@ type Client struct {
 // NewClient creates a new event client but does not connect.
 // The socket path should be the full path to the Unix domain socket.
 // The debounce duration controls event batching (default 100ms if not configured via env var).
+// If PASO_DAEMON_ADDR is set, the client connects to that TCP address instead
+// and authenticates with PASO_DAEMON_TOKEN.
 func NewClient(socketPath string) (*Client, error) {
 	// Read debounce duration from environment variable
 	debounceMs := 100 // Default: 100ms
@@ -74,6 +80,8 @@ func NewClient(socketPath string) (*Client, error) {
 
 	return &Client{
 		socketPath:             socketPath,
+		addr:                   os.Getenv("PASO_DAEMON_ADDR"),
+		token:                  os.Getenv("PASO_DAEMON_TOKEN"),
 		eventQueue:             make(chan Event, 100),
 		debounce:               time.Duration(debounceMs) * time.Millisecond,
 		maxRetries:             5,
@@ -164,9 +172,15 @@ func (c *Client) Connect(ctx context.Context) error {
 		c.eventQueue = make(chan Event, 100)
 	}
 
-	// Dial the Unix domain socket
+	// Dial the Unix domain socket, or the remote daemon if configured
 	dialer := net.Dialer{}
-	conn, err := dialer.DialContext(ctx, "unix", c.socketPath)
+	var conn net.Conn
+	var err error
+	if c.addr != "" {
+		conn, err = dialer.DialContext(ctx, "tcp", c.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "unix", c.socketPath)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to dial daemon socket: %w", err)
 	}
@@ -175,6 +189,15 @@ func (c *Client) Connect(ctx context.Context) error {
 	c.encoder = json.NewEncoder(conn)
 	c.decoder = json.NewDecoder(conn)
 
+	if c.addr != "" {
+		if err := c.authenticate(); err != nil {
+			if closeErr := conn.Close(); closeErr != nil {
+				slog.Debug("error closing connection", "error", closeErr)
+			}
+			return err
+		}
+	}
+
 	// Send initial subscription for all projects (ProjectID = 0)
 	msg := Message{
 		Version: ProtocolVersion,
@@ -278,9 +301,13 @@ func (c *Client) sendEventWithRetry(event Event) error {
 	return fmt.Errorf("event queue full (all %d retry attempts exhausted)", maxRetries)
 }
 
+// maxBatchedEvents is the number of typed events a single debounce window may
+// forward individually. Larger bursts are coalesced into one db_changed event,
+// since a full reload is cheaper than applying hundreds of small updates.
+const maxBatchedEvents = 50
+
 // startBatcher runs in a goroutine and batches events from the queue.
-// It sends a single event every debounce duration if any events are pending.
-// If events from multiple projects are batched together, sends projectID 0 (all projects).
+// Every debounce duration it forwards the pending events (see coalesceEvents).
 func (c *Client) startBatcher() {
 	defer func() {
 		close(c.batcherDone)
@@ -292,29 +319,20 @@ func (c *Client) startBatcher() {
 	ticker := time.NewTicker(c.debounce)
 	defer ticker.Stop()
 
-	var pending bool
-	var projectID int
-	var hasMultipleProjects bool
+	var pending []Event
 
 	// Helper to flush pending events
 	flushPending := func() {
-		if pending {
-			batchProjectID := projectID
-			if hasMultipleProjects {
-				batchProjectID = 0
-			}
-
-			if err := c.sendToSocket(Event{
-				Type:      EventDatabaseChanged,
-				ProjectID: batchProjectID,
-				Timestamp: time.Now(),
-			}); err != nil {
+		for _, event := range coalesceEvents(pending) {
+			event.Timestamp = time.Now()
+			if err := c.sendToSocket(event); err != nil {
 				if !isConnectionError(err) {
 					slog.Error("failed to send batched event", "error", err)
 				}
+				break
 			}
-			pending = false
 		}
+		pending = pending[:0]
 	}
 
 	for {
@@ -330,16 +348,7 @@ func (c *Client) startBatcher() {
 				flushPending()
 				return
 			}
-
-			// Mark that we have pending events to send
-			if !pending {
-				pending = true
-				projectID = event.ProjectID
-				hasMultipleProjects = false
-			} else if projectID != event.ProjectID && event.ProjectID != 0 {
-				// Different project detected - use projectID 0 (all projects)
-				hasMultipleProjects = true
-			}
+			pending = append(pending, event)
 
 			// Continue draining the queue to batch multiple events together
 			// This loop drains any other events queued during this batch window
@@ -350,10 +359,7 @@ func (c *Client) startBatcher() {
 					if !ok {
 						break drainLoop
 					}
-					// Check if we have events from different projects
-					if projectID != evt.ProjectID && evt.ProjectID != 0 {
-						hasMultipleProjects = true
-					}
+					pending = append(pending, evt)
 				default:
 					break drainLoop
 				}
@@ -365,6 +371,39 @@ func (c *Client) startBatcher() {
 	}
 }
 
+// coalesceEvents decides what to send for the events queued in one debounce
+// window. Typed events are forwarded in order so receivers can apply them
+// incrementally. If the window contains an untyped db_changed event, or more
+// than maxBatchedEvents events, a single db_changed is sent instead; its
+// project ID is 0 (all projects) when the events span several projects, and
+// it keeps the tasks the coalesced events unblocked.
+func coalesceEvents(pending []Event) []Event {
+	if len(pending) == 0 {
+		return nil
+	}
+
+	projectID := pending[0].ProjectID
+	coalesce := len(pending) > maxBatchedEvents
+	var unblocked []int
+	for _, event := range pending {
+		if !event.IsTyped() {
+			coalesce = true
+		}
+		if event.ProjectID != projectID && event.ProjectID != 0 {
+			projectID = 0
+		}
+		unblocked = append(unblocked, event.Unblocked...)
+		if event.Type == EventTaskUnblocked {
+			unblocked = append(unblocked, event.TaskID)
+		}
+	}
+
+	if !coalesce {
+		return pending
+	}
+	return []Event{{Type: EventDatabaseChanged, ProjectID: projectID, Unblocked: unblocked}}
+}
+
 // sendToSocket sends an event to the daemon socket.
 func (c *Client) sendToSocket(event Event) error {
 	c.mu.Lock()
@@ -476,22 +515,39 @@ func (c *Client) readEvents(ctx context.Context, eventChan chan Event) error {
 			return fmt.Errorf("failed to decode message: %w", err)
 		}
 
-		// Check protocol version - log warning if mismatch
-		if msg.Version != 0 && msg.Version != ProtocolVersion {
+		// Older daemons speak version 1 and only send db_changed, which the
+		// receiver handles as a full reload; only newer versions are unexpected
+		if msg.Version > ProtocolVersion {
 			slog.Warn("protocol version mismatch", "received", msg.Version, "expected", ProtocolVersion)
 		}
 
 		switch msg.Type {
 		case "event":
 			if msg.Event != nil {
-				// Check for event ordering (basic duplicate detection)
-				if msg.Event.SequenceID > c.lastSequence {
-					c.lastSequence = msg.Event.SequenceID
-					select {
-					case eventChan <- *msg.Event:
-					case <-ctx.Done():
-						return ctx.Err()
-					}
+				if err := c.deliver(ctx, eventChan, *msg.Event); err != nil {
+					return err
+				}
+			}
+
+		case "replay":
+			// Events missed while disconnected, oldest first
+			for _, event := range msg.Events {
+				if err := c.deliver(ctx, eventChan, event); err != nil {
+					return err
+				}
+			}
+
+		case "resync":
+			// The missed events are gone; everything must be reloaded
+			if msg.Event != nil {
+				slog.Info("missed events no longer available, resyncing", "sequence", msg.Event.SequenceID)
+				c.notify("info", "Missed updates while disconnected, reloading")
+				c.lastEpoch = msg.Event.Epoch
+				c.lastSequence = msg.Event.SequenceID
+				select {
+				case eventChan <- Event{Type: EventDatabaseChanged, ProjectID: 0, SequenceID: msg.Event.SequenceID}:
+				case <-ctx.Done():
+					return ctx.Err()
 				}
 			}
 
@@ -507,6 +563,24 @@ func (c *Client) readEvents(ctx context.Context, eventChan chan Event) error {
 	}
 }
 
+// deliver forwards an event to the listener unless it was already delivered.
+// Sequence numbers restart when the daemon does, so an event from a new
+// epoch is always accepted.
+func (c *Client) deliver(ctx context.Context, eventChan chan Event, event Event) error {
+	if event.Epoch == c.lastEpoch && event.SequenceID <= c.lastSequence {
+		return nil // duplicate
+	}
+	c.lastEpoch = event.Epoch
+	c.lastSequence = event.SequenceID
+
+	select {
+	case eventChan <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // isConnectionError checks if an error is a network connection error
 func isConnectionError(err error) bool {
 	if err == nil {
@@ -547,6 +621,12 @@ func (c *Client) reconnect(ctx context.Context) bool {
 						slog.Error("failed to restore subscription after reconnect", "project_id", c.currentProjectID, "error", err)
 					}
 				}
+				// Ask the daemon for the events missed while disconnected
+				if c.lastSequence > 0 {
+					if err := c.resume(); err != nil {
+						slog.Error("failed to resume after reconnect", "last_sequence", c.lastSequence, "error", err)
+					}
+				}
 				return true
 			}
 
@@ -596,6 +676,69 @@ func (c *Client) Subscribe(projectID int) error {
 	return err
 }
 
+// authenticate performs the token handshake with a remote daemon.
+// Callers must hold c.mu.
+func (c *Client) authenticate() error {
+	msg := Message{
+		Version: ProtocolVersion,
+		Type:    "auth",
+		Auth:    &AuthMessage{Token: c.token},
+	}
+	if err := c.encoder.Encode(msg); err != nil {
+		return fmt.Errorf("failed to send auth: %w", err)
+	}
+
+	if err := c.conn.SetReadDeadline(time.Now().Add(c.writeDeadline)); err != nil {
+		return fmt.Errorf("connection error: %w", err)
+	}
+	var reply Message
+	err := c.decoder.Decode(&reply)
+	if clearErr := c.conn.SetReadDeadline(time.Time{}); clearErr != nil {
+		slog.Debug("failed to clear read deadline after auth", "error", clearErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read auth reply: %w", err)
+	}
+
+	if reply.Type != "auth_ok" {
+		return ErrAuthRejected
+	}
+	return nil
+}
+
+// resume asks the daemon to replay the events after the last one received
+func (c *Client) resume() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.conn == nil {
+		return fmt.Errorf("not connected to daemon")
+	}
+
+	msg := Message{
+		Version: ProtocolVersion,
+		Type:    "resume",
+		Resume: &ResumeMessage{
+			Epoch:        c.lastEpoch,
+			LastSequence: c.lastSequence,
+		},
+	}
+
+	// Set a write deadline for the resume message
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.writeDeadline)); err != nil {
+		return fmt.Errorf("connection error: %w", err)
+	}
+
+	err := c.encoder.Encode(msg)
+
+	// Clear the deadline after writing
+	if clearErr := c.conn.SetWriteDeadline(time.Time{}); clearErr != nil {
+		slog.Debug("failed to clear write deadline after resume", "error", clearErr)
+	}
+
+	return err
+}
+
 // Close closes the connection to the daemon and stops all goroutines.
 func (c *Client) Close() error {
 	if c == nil {
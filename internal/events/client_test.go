@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -660,6 +661,48 @@ func TestSendEvent_Batching(t *testing.T) {
 	}
 }
 
+func TestCoalesceEvents(t *testing.T) {
+	typed := []Event{
+		{Type: EventTaskCreated, ProjectID: 1, TaskID: 10},
+		{Type: EventTaskMoved, ProjectID: 1, TaskID: 10},
+	}
+
+	// Typed events are forwarded in order
+	got := coalesceEvents(typed)
+	if len(got) != 2 || got[0].Type != EventTaskCreated || got[1].Type != EventTaskMoved {
+		t.Errorf("coalesceEvents(typed) = %+v, want both events in order", got)
+	}
+
+	// A v1 event in the window forces a single reload
+	got = coalesceEvents(append(typed, Event{Type: EventDatabaseChanged, ProjectID: 1}))
+	if len(got) != 1 || got[0].Type != EventDatabaseChanged || got[0].ProjectID != 1 {
+		t.Errorf("coalesceEvents(mixed) = %+v, want one db_changed for project 1", got)
+	}
+
+	// Bursts collapse into one reload for all affected projects
+	var burst []Event
+	for i := 0; i <= maxBatchedEvents; i++ {
+		burst = append(burst, Event{Type: EventTaskUpdated, ProjectID: 1 + i%2, TaskID: i})
+	}
+	got = coalesceEvents(burst)
+	if len(got) != 1 || got[0].Type != EventDatabaseChanged || got[0].ProjectID != 0 {
+		t.Errorf("coalesceEvents(burst) = %+v, want one db_changed for all projects", got)
+	}
+
+	// Unblocked tasks are still named after coalescing
+	got = coalesceEvents([]Event{
+		{Type: EventTaskUnblocked, ProjectID: 1, TaskID: 10},
+		{Type: EventDatabaseChanged, ProjectID: 1, Unblocked: []int{11}},
+	})
+	if len(got) != 1 || !slices.Equal(got[0].Unblocked, []int{10, 11}) {
+		t.Errorf("coalesceEvents(unblocked) = %+v, want one db_changed naming tasks 10 and 11", got)
+	}
+
+	if got := coalesceEvents(nil); got != nil {
+		t.Errorf("coalesceEvents(nil) = %+v, want nil", got)
+	}
+}
+
 // ============================================================================
 // Close Tests
 // ============================================================================
@@ -2587,6 +2630,93 @@ verify:
 	t.Logf("✓ Client tracks sequence numbers correctly: lastSequence=%d", finalSeq)
 }
 
+// TestClient_ReplayAndResync tests that replayed events are delivered like
+// live ones and that a resync turns into a full reload.
+func TestClient_ReplayAndResync(t *testing.T) {
+	t.Parallel()
+
+	socketPath := filepath.Join(t.TempDir(), "test.sock")
+	listener, err := (&net.ListenConfig{}).Listen(context.Background(), "unix", socketPath)
+	if err != nil {
+		t.Fatalf("Failed to create mock daemon listener: %v", err)
+	}
+	defer func() { _ = listener.Close() }()
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		var subscribeMsg Message
+		if err := json.NewDecoder(conn).Decode(&subscribeMsg); err != nil {
+			return
+		}
+
+		encoder := json.NewEncoder(conn)
+		messages := []Message{
+			{Type: "event", Event: &Event{Type: EventTaskCreated, ProjectID: 1, Epoch: 1, SequenceID: 5}},
+			// The replay overlaps with what the client already has
+			{Type: "replay", Events: []Event{
+				{Type: EventTaskCreated, ProjectID: 1, Epoch: 1, SequenceID: 5},
+				{Type: EventTaskMoved, ProjectID: 1, Epoch: 1, SequenceID: 6},
+			}},
+			// Daemon restarted; its sequence numbers start over
+			{Type: "resync", Event: &Event{Type: EventDatabaseChanged, Epoch: 2, SequenceID: 10}},
+			{Type: "event", Event: &Event{Type: EventTaskUpdated, ProjectID: 1, Epoch: 2, SequenceID: 3}},
+			{Type: "event", Event: &Event{Type: EventTaskUpdated, ProjectID: 1, Epoch: 2, SequenceID: 11}},
+		}
+		for _, msg := range messages {
+			msg.Version = ProtocolVersion
+			_ = encoder.Encode(msg)
+		}
+
+		time.Sleep(2 * time.Second)
+	}()
+
+	client, err := NewClient(socketPath)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer func() { _ = client.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Second)
+	defer cancel()
+
+	if err := client.Connect(ctx); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+
+	eventChan, err := client.Listen(ctx)
+	if err != nil {
+		t.Fatalf("Failed to start listening: %v", err)
+	}
+
+	want := []struct {
+		eventType EventType
+		sequence  int64
+	}{
+		{EventTaskCreated, 5},
+		{EventTaskMoved, 6},
+		{EventDatabaseChanged, 10},
+		{EventTaskUpdated, 11},
+	}
+	for i, w := range want {
+		select {
+		case event := <-eventChan:
+			if event.Type != w.eventType || event.SequenceID != w.sequence {
+				t.Errorf("event %d = %s/%d, want %s/%d", i, event.Type, event.SequenceID, w.eventType, w.sequence)
+			}
+			if event.Type == EventDatabaseChanged && event.ProjectID != 0 {
+				t.Errorf("resync reload has ProjectID %d, want 0 (all projects)", event.ProjectID)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timeout waiting for event %d", i)
+		}
+	}
+}
+
 // TestClient_EventReceiverHandlesMissingSequenceNumbers tests that the receiver
 // handles events with missing (zero) sequence numbers gracefully.
 func TestClient_EventReceiverHandlesMissingSequenceNumbers(t *testing.T) {
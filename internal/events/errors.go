@@ -14,8 +14,12 @@ const (
 	ErrSocketPermission
 	ErrDaemonNotRunning
 	ErrConnectionRefused
+	ErrAuthFailed
 )
 
+// ErrAuthRejected is returned by Connect when a remote daemon rejects the token
+var ErrAuthRejected = errors.New("daemon rejected the token")
+
 // DaemonError represents a structured daemon error with context.
 type DaemonError struct {
 	Code    ErrorCode
@@ -37,6 +41,14 @@ func ClassifyDaemonError(err error) *DaemonError {
 		return nil
 	}
 
+	if errors.Is(err, ErrAuthRejected) {
+		return &DaemonError{
+			Code:    ErrAuthFailed,
+			Message: "Authentication failed",
+			Hint:    "Check PASO_DAEMON_TOKEN matches the daemon's token",
+		}
+	}
+
 	if os.IsNotExist(err) {
 		return &DaemonError{
 			Code:    ErrSocketNotFound,
@@ -2,8 +2,15 @@ package events
 
 import "time"
 
-// ProtocolVersion is the current wire protocol version
-const ProtocolVersion = 1
+// ProtocolVersion is the current wire protocol version.
+//
+// Version 2 adds typed events (task.created, task.moved, ...) that carry the
+// IDs of the changed entities. Version 1 peers only understand db_changed;
+// the daemon downgrades typed events for them (see Event.ForVersion).
+const ProtocolVersion = 2
+
+// MinProtocolVersion is the oldest protocol version still accepted
+const MinProtocolVersion = 1
 
 // EventType indicates what kind of change occurred
 type EventType string
@@ -14,12 +21,76 @@ const (
 	EventPong            EventType = "pong"
 )
 
+// Typed events (protocol v2)
+const (
+	EventTaskCreated    EventType = "task.created"
+	EventTaskUpdated    EventType = "task.updated"
+	EventTaskMoved      EventType = "task.moved"
+	EventTaskDeleted    EventType = "task.deleted"
+	EventTaskLinked     EventType = "task.linked"
+	EventTaskUnlinked   EventType = "task.unlinked"
+	EventTaskUnblocked  EventType = "task.unblocked"
+	EventTaskArchived   EventType = "task.archived"
+	EventTaskUnarchived EventType = "task.unarchived"
+	EventColumnCreated  EventType = "column.created"
+	EventColumnRenamed  EventType = "column.renamed"
+	EventColumnUpdated  EventType = "column.updated"
+	EventColumnDeleted  EventType = "column.deleted"
+	EventLabelCreated   EventType = "label.created"
+	EventLabelUpdated   EventType = "label.updated"
+	EventLabelDeleted   EventType = "label.deleted"
+	EventLabelAttached  EventType = "label.attached"
+	EventLabelDetached  EventType = "label.detached"
+	EventCommentAdded   EventType = "comment.added"
+	EventCommentUpdated EventType = "comment.updated"
+	EventCommentDeleted EventType = "comment.deleted"
+	EventProjectCreated EventType = "project.created"
+	EventProjectUpdated EventType = "project.updated"
+	EventProjectDeleted EventType = "project.deleted"
+)
+
 // Event represents a database change notification
 type Event struct {
 	Type       EventType
 	ProjectID  int       // For filtering - which project was modified
 	Timestamp  time.Time // When the event occurred
 	SequenceID int64     // Monotonically increasing sequence number for ordering
+	Epoch      int64     `json:",omitempty"` // Daemon instance that assigned SequenceID
+
+	// Payload of typed events (protocol v2). Only the IDs relevant to the
+	// event type are set.
+	TaskID        int      `json:",omitempty"`
+	RelatedTaskID int      `json:",omitempty"` // Other end of task.linked/task.unlinked, resolved blocker of task.unblocked
+	ColumnID      int      `json:",omitempty"`
+	LabelID       int      `json:",omitempty"`
+	CommentID     int      `json:",omitempty"`
+	Fields        []string `json:",omitempty"` // Changed fields, e.g. "title", "priority"
+	Unblocked     []int    `json:",omitempty"` // Tasks a db_changed event from a bulk change unblocked
+}
+
+// IsTyped reports whether the event is a protocol v2 typed event
+func (e Event) IsTyped() bool {
+	switch e.Type {
+	case EventDatabaseChanged, EventPing, EventPong, "":
+		return false
+	}
+	return true
+}
+
+// ForVersion returns the event as a peer speaking the given protocol version
+// understands it. Typed events become db_changed for version 1 peers, keeping
+// the project and sequence number so filtering and ordering still work.
+func (e Event) ForVersion(version int) Event {
+	if version >= 2 || !e.IsTyped() {
+		return e
+	}
+	return Event{
+		Type:       EventDatabaseChanged,
+		ProjectID:  e.ProjectID,
+		Timestamp:  e.Timestamp,
+		SequenceID: e.SequenceID,
+		Epoch:      e.Epoch,
+	}
 }
 
 // SubscribeMessage is sent by clients to subscribe to specific project updates
@@ -27,12 +98,39 @@ type SubscribeMessage struct {
 	ProjectID int // 0 = all projects, >0 = specific project
 }
 
+// ResumeMessage is sent by clients after reconnecting to catch up on the
+// events they missed. The daemon answers with a "replay" message holding the
+// missed events, or a "resync" message when they are no longer buffered
+// (or were assigned by a different daemon instance).
+type ResumeMessage struct {
+	Epoch        int64 // Epoch of the last event the client received
+	LastSequence int64 // SequenceID of the last event the client received
+}
+
+// AuthMessage is the first message a client sends over TCP. The daemon
+// answers "auth_ok", or "auth_failed" and closes the connection.
+type AuthMessage struct {
+	Token string // Shared token configured on the daemon (PASO_DAEMON_TOKEN)
+}
+
 // Message wraps events and control messages for wire protocol
 type Message struct {
 	Version   int               // Protocol version (use ProtocolVersion constant)
-	Type      string            // "event", "subscribe", "ping", "pong"
+	Type      string            // "auth", "auth_ok", "auth_failed", "event", "subscribe", "resume", "replay", "resync", "ping", "pong"
 	Event     *Event            `json:",omitempty"`
+	Events    []Event           `json:",omitempty"` // Missed events, oldest first ("replay")
 	Subscribe *SubscribeMessage `json:",omitempty"`
+	Resume    *ResumeMessage    `json:",omitempty"`
+	Auth      *AuthMessage      `json:",omitempty"`
+}
+
+// NegotiateVersion returns the protocol version to speak with a peer that
+// sent the given version. Version 0 (field missing) is treated as version 1.
+func NegotiateVersion(peerVersion int) int {
+	if peerVersion < MinProtocolVersion {
+		return MinProtocolVersion
+	}
+	return min(peerVersion, ProtocolVersion)
 }
 
 // NotificationMsg is sent from the events client to the TUI to display user-facing messages
@@ -10,8 +10,56 @@ import (
 // ============================================================================
 
 func TestProtocolVersion(t *testing.T) {
-	if ProtocolVersion != 1 {
-		t.Errorf("Expected ProtocolVersion to be 1, got %d", ProtocolVersion)
+	if ProtocolVersion != 2 {
+		t.Errorf("Expected ProtocolVersion to be 2, got %d", ProtocolVersion)
+	}
+}
+
+func TestNegotiateVersion(t *testing.T) {
+	tests := []struct {
+		peer int
+		want int
+	}{
+		{0, 1}, // v1 clients that omit the field
+		{1, 1},
+		{2, 2},
+		{3, ProtocolVersion}, // newer peers fall back to ours
+	}
+
+	for _, tt := range tests {
+		if got := NegotiateVersion(tt.peer); got != tt.want {
+			t.Errorf("NegotiateVersion(%d) = %d, want %d", tt.peer, got, tt.want)
+		}
+	}
+}
+
+func TestEvent_ForVersion(t *testing.T) {
+	typed := Event{
+		Type:       EventTaskUpdated,
+		ProjectID:  3,
+		SequenceID: 7,
+		TaskID:     42,
+		Fields:     []string{"title"},
+	}
+
+	v1 := typed.ForVersion(1)
+	if v1.Type != EventDatabaseChanged {
+		t.Errorf("ForVersion(1).Type = %s, want %s", v1.Type, EventDatabaseChanged)
+	}
+	if v1.ProjectID != 3 || v1.SequenceID != 7 {
+		t.Errorf("ForVersion(1) lost project or sequence: %+v", v1)
+	}
+	if v1.TaskID != 0 || v1.Fields != nil {
+		t.Errorf("ForVersion(1) kept the v2 payload: %+v", v1)
+	}
+
+	if v2 := typed.ForVersion(2); v2.Type != EventTaskUpdated || v2.TaskID != 42 {
+		t.Errorf("ForVersion(2) = %+v, want the event unchanged", v2)
+	}
+
+	ping := Event{Type: EventPing}
+	if got := ping.ForVersion(1); got.Type != EventPing {
+		t.Errorf("ForVersion(1) on ping = %s, want ping", got.Type)
 	}
 }
 
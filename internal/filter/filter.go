@@ -0,0 +1,316 @@
+// Package filter implements the task filter query language used by
+// `paso task list --filter`, `paso task ready`/`blocked` and the TUI search bar.
+//
+// A query is a whitespace-separated list of terms that must all match:
+//
+//	priority>=high label:backend is:blocked type:bug column:"In Progress" updated:<7d
+//
+// Supported fields:
+//
+//	priority   trivial, low, medium, high, critical (all comparison operators)
+//	type       task type name
+//	label      label name
+//	column     column name
+//	is         blocked, ready, done, in-progress, archived
+//	created    date (2006-01-02) or age (12h, 7d, 2w)
+//	updated    date (2006-01-02) or age (12h, 7d, 2w)
+//	epic       ticket number of a parent task: the task and all its descendants
+//
+// Operators are written after the field, with or without a colon:
+// "priority>=high" and "priority:>=high" are equivalent. A bare colon means
+// equality. For ages the comparison is on the age itself, so "updated:<7d"
+// means "updated less than seven days ago".
+//
+// Terms prefixed with '-' are negated. Words without a field are matched
+// against titles, descriptions and comments using the full-text index.
+// Values containing spaces must be double-quoted.
+//
+// Archived tasks only match queries that mention is:archived.
+package filter
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidFilter is returned (wrapped) for any malformed filter query
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Op is a comparison operator
+type Op string
+
+// Supported comparison operators
+const (
+	OpEq Op = "="
+	OpNe Op = "!="
+	OpGt Op = ">"
+	OpGe Op = ">="
+	OpLt Op = "<"
+	OpLe Op = "<="
+)
+
+// Field names recognized by the parser
+const (
+	FieldText     = "" // free text, matched with full-text search
+	FieldPriority = "priority"
+	FieldType     = "type"
+	FieldLabel    = "label"
+	FieldColumn   = "column"
+	FieldIs       = "is"
+	FieldCreated  = "created"
+	FieldUpdated  = "updated"
+	FieldEpic     = "epic"
+)
+
+// States accepted by the "is" field
+const (
+	StateBlocked    = "blocked"
+	StateReady      = "ready"
+	StateDone       = "done"
+	StateInProgress = "in-progress"
+	StateArchived   = "archived"
+)
+
+// Term is a single condition of a query
+type Term struct {
+	Field  string
+	Op     Op
+	Value  string
+	Negate bool
+}
+
+// Query is a parsed filter: the conjunction of its terms
+type Query struct {
+	Terms []Term
+}
+
+// IsEmpty reports whether the query has no terms (matches everything)
+func (q *Query) IsEmpty() bool {
+	return q == nil || len(q.Terms) == 0
+}
+
+// IncludesArchived reports whether the query names the archived state, in
+// which case archived tasks are matched like any other. Otherwise callers
+// leave them out.
+func (q *Query) IncludesArchived() bool {
+	if q == nil {
+		return false
+	}
+	for _, term := range q.Terms {
+		if term.Field == FieldIs && term.Value == StateArchived {
+			return true
+		}
+	}
+	return false
+}
+
+// Text returns the free-text words of the query that are not negated.
+// Callers use them to highlight matches.
+func (q *Query) Text() []string {
+	if q == nil {
+		return nil
+	}
+	var words []string
+	for _, term := range q.Terms {
+		if term.Field == FieldText && !term.Negate {
+			words = append(words, term.Value)
+		}
+	}
+	return words
+}
+
+// fieldOps lists the operators each field accepts
+var fieldOps = map[string][]Op{
+	FieldPriority: {OpEq, OpNe, OpGt, OpGe, OpLt, OpLe},
+	FieldType:     {OpEq, OpNe},
+	FieldLabel:    {OpEq, OpNe},
+	FieldColumn:   {OpEq, OpNe},
+	FieldIs:       {OpEq, OpNe},
+	FieldCreated:  {OpEq, OpGt, OpGe, OpLt, OpLe},
+	FieldUpdated:  {OpEq, OpGt, OpGe, OpLt, OpLe},
+	FieldEpic:     {OpEq, OpNe},
+}
+
+// stateAliases maps accepted spellings of "is" values to their canonical form
+var stateAliases = map[string]string{
+	"blocked":     StateBlocked,
+	"ready":       StateReady,
+	"done":        StateDone,
+	"completed":   StateDone,
+	"in-progress": StateInProgress,
+	"in_progress": StateInProgress,
+	"inprogress":  StateInProgress,
+	"archived":    StateArchived,
+}
+
+// operators in match order: two-character operators first
+var operators = []Op{OpGe, OpLe, OpNe, OpGt, OpLt, OpEq}
+
+var agePattern = regexp.MustCompile(`^(\d+)([hdw])$`)
+
+// Parse parses a filter query. An empty or whitespace-only input yields an
+// empty query. Errors wrap ErrInvalidFilter.
+func Parse(input string) (*Query, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Query{Terms: make([]Term, 0, len(tokens))}
+	for _, tok := range tokens {
+		term, err := parseTerm(tok)
+		if err != nil {
+			return nil, err
+		}
+		q.Terms = append(q.Terms, term)
+	}
+	return q, nil
+}
+
+// token is a whitespace-delimited chunk of input with quotes removed.
+// quotedFrom is the index in text where the first quoted section started,
+// or -1 if the token contained no quotes.
+type token struct {
+	text       string
+	quotedFrom int
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	var cur strings.Builder
+	quotedFrom := -1
+	inQuotes := false
+	inToken := false
+
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, token{text: cur.String(), quotedFrom: quotedFrom})
+		}
+		cur.Reset()
+		quotedFrom = -1
+		inToken = false
+	}
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			if !inQuotes && quotedFrom < 0 {
+				quotedFrom = cur.Len()
+			}
+			inQuotes = !inQuotes
+			inToken = true
+		case !inQuotes && (r == ' ' || r == '\t' || r == '\n'):
+			flush()
+		default:
+			cur.WriteRune(r)
+			inToken = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("%w: unterminated quote", ErrInvalidFilter)
+	}
+	flush()
+
+	return tokens, nil
+}
+
+func parseTerm(tok token) (Term, error) {
+	s := tok.text
+	quotedFrom := tok.quotedFrom
+
+	var term Term
+	if len(s) > 1 && s[0] == '-' && quotedFrom != 0 {
+		term.Negate = true
+		s = s[1:]
+		if quotedFrom > 0 {
+			quotedFrom--
+		}
+	}
+
+	// The field name is the leading run of letters, only if it is unquoted
+	// and directly followed by ':' or an operator
+	i := 0
+	for i < len(s) && isFieldChar(s[i]) {
+		i++
+	}
+	if i == 0 || i == len(s) || (quotedFrom >= 0 && quotedFrom < i) || !strings.ContainsRune(":<>=!", rune(s[i])) {
+		if s == "" {
+			return Term{}, fmt.Errorf("%w: empty term", ErrInvalidFilter)
+		}
+		term.Field = FieldText
+		term.Op = OpEq
+		term.Value = s
+		return term, nil
+	}
+
+	term.Field = strings.ToLower(s[:i])
+	allowed, ok := fieldOps[term.Field]
+	if !ok {
+		return Term{}, fmt.Errorf("%w: unknown field %q", ErrInvalidFilter, s[:i])
+	}
+
+	rest := s[i:]
+	hasColon := strings.HasPrefix(rest, ":")
+	rest = strings.TrimPrefix(rest, ":")
+	term.Op = ""
+	for _, op := range operators {
+		if strings.HasPrefix(rest, string(op)) {
+			term.Op = op
+			rest = rest[len(op):]
+			break
+		}
+	}
+	if term.Op == "" {
+		if !hasColon {
+			return Term{}, fmt.Errorf("%w: bad operator in %q", ErrInvalidFilter, tok.text)
+		}
+		term.Op = OpEq
+	}
+	if !containsOp(allowed, term.Op) {
+		return Term{}, fmt.Errorf("%w: operator %s not supported for %s", ErrInvalidFilter, term.Op, term.Field)
+	}
+
+	term.Value = rest
+	if term.Value == "" {
+		return Term{}, fmt.Errorf("%w: missing value for %s", ErrInvalidFilter, term.Field)
+	}
+
+	switch term.Field {
+	case FieldIs:
+		state, ok := stateAliases[strings.ToLower(term.Value)]
+		if !ok {
+			return Term{}, fmt.Errorf("%w: unknown state %q (want blocked, ready, done, in-progress or archived)", ErrInvalidFilter, term.Value)
+		}
+		term.Value = state
+	case FieldCreated, FieldUpdated:
+		if !agePattern.MatchString(term.Value) {
+			if _, err := time.Parse(time.DateOnly, term.Value); err != nil {
+				return Term{}, fmt.Errorf("%w: %s wants a date like 2006-01-02 or an age like 7d, got %q", ErrInvalidFilter, term.Field, term.Value)
+			}
+		}
+	case FieldEpic:
+		term.Value = strings.TrimPrefix(term.Value, "#")
+		if n, err := strconv.Atoi(term.Value); err != nil || n <= 0 {
+			return Term{}, fmt.Errorf("%w: epic wants a ticket number like 12, got %q", ErrInvalidFilter, rest)
+		}
+	}
+
+	return term, nil
+}
+
+func isFieldChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+func containsOp(ops []Op, op Op) bool {
+	for _, o := range ops {
+		if o == op {
+			return true
+		}
+	}
+	return false
+}
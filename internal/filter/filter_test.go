@@ -0,0 +1,224 @@
+package filter
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Term
+	}{
+		{
+			name:  "empty",
+			input: "   ",
+			want:  []Term{},
+		},
+		{
+			name:  "free text",
+			input: "billing export",
+			want: []Term{
+				{Field: FieldText, Op: OpEq, Value: "billing"},
+				{Field: FieldText, Op: OpEq, Value: "export"},
+			},
+		},
+		{
+			name:  "comparison without colon",
+			input: "priority>=high",
+			want:  []Term{{Field: FieldPriority, Op: OpGe, Value: "high"}},
+		},
+		{
+			name:  "comparison with colon",
+			input: "priority:<medium",
+			want:  []Term{{Field: FieldPriority, Op: OpLt, Value: "medium"}},
+		},
+		{
+			name:  "quoted value",
+			input: `column:"In Progress"`,
+			want:  []Term{{Field: FieldColumn, Op: OpEq, Value: "In Progress"}},
+		},
+		{
+			name:  "negation",
+			input: "-label:frontend",
+			want:  []Term{{Field: FieldLabel, Op: OpEq, Value: "frontend", Negate: true}},
+		},
+		{
+			name:  "state alias",
+			input: "is:completed",
+			want:  []Term{{Field: FieldIs, Op: OpEq, Value: StateDone}},
+		},
+		{
+			name:  "field names are case-insensitive",
+			input: "Type:bug",
+			want:  []Term{{Field: FieldType, Op: OpEq, Value: "bug"}},
+		},
+		{
+			name:  "quoted text containing a colon is free text",
+			input: `"label:frontend"`,
+			want:  []Term{{Field: FieldText, Op: OpEq, Value: "label:frontend"}},
+		},
+		{
+			name:  "age and date",
+			input: "updated:<7d created>=2025-01-31",
+			want: []Term{
+				{Field: FieldUpdated, Op: OpLt, Value: "7d"},
+				{Field: FieldCreated, Op: OpGe, Value: "2025-01-31"},
+			},
+		},
+		{
+			name:  "epic ticket number",
+			input: "epic:#12",
+			want:  []Term{{Field: FieldEpic, Op: OpEq, Value: "12"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(q.Terms, tt.want) {
+				t.Errorf("Parse(%q) = %+v, want %+v", tt.input, q.Terms, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []string{
+		"owner:me",
+		"priority:",
+		"label>backend",
+		"is:sleeping",
+		"updated:<yesterday",
+		`column:"In Progress`,
+		"created!=7d",
+		"epic:billing",
+		"epic>3",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			_, err := Parse(input)
+			if !errors.Is(err, ErrInvalidFilter) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidFilter", input, err)
+			}
+		})
+	}
+}
+
+func TestQuery_Text(t *testing.T) {
+	q, err := Parse("billing -draft priority>=high export")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []string{"billing", "export"}
+	if got := q.Text(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Text() = %v, want %v", got, want)
+	}
+}
+
+func TestQuery_IncludesArchived(t *testing.T) {
+	tests := map[string]bool{
+		"":                    false,
+		"is:done label:ops":   false,
+		"is:archived":         true,
+		"-is:archived":        true,
+		"billing is:ARCHIVED": true,
+	}
+	for input, want := range tests {
+		q, err := Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", input, err)
+		}
+		if got := q.IncludesArchived(); got != want {
+			t.Errorf("IncludesArchived(%q) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestQuery_SQL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		contains []string
+		args     []any
+	}{
+		{
+			name:     "empty query matches everything",
+			input:    "",
+			contains: []string{"1 = 1"},
+		},
+		{
+			name:     "priority comparison",
+			input:    "priority>=high",
+			contains: []string{"p.sort_order >= (\n        select fp.sort_order from priorities fp"},
+			args:     []any{"high"},
+		},
+		{
+			name:     "priority inequality is a negated equality",
+			input:    "priority!=low",
+			contains: []string{"not (p.sort_order = (\n        select fp.sort_order from priorities fp"},
+			args:     []any{"low"},
+		},
+		{
+			name:     "negated label",
+			input:    "-label:frontend",
+			contains: []string{"not (exists (", "lower(fl.name) = lower(?)"},
+			args:     []any{"frontend"},
+		},
+		{
+			name:     "age is inverted into a timestamp comparison",
+			input:    "updated:<2w",
+			contains: []string{"t.updated_at > datetime('now', ?)"},
+			args:     []any{"-14 days"},
+		},
+		{
+			name:     "date",
+			input:    "created<=2025-01-31",
+			contains: []string{"date(t.created_at) <= ?"},
+			args:     []any{"2025-01-31"},
+		},
+		{
+			name:     "archived state",
+			input:    "is:archived",
+			contains: []string{"t.archived_at is not null"},
+		},
+		{
+			name:     "epic matches the parent and its descendants",
+			input:    "epic:12",
+			contains: []string{"t.id in (\n        with recursive fepic(id) as (", "fet.ticket_number = ?"},
+			args:     []any{12},
+		},
+		{
+			name:     "terms are joined with and",
+			input:    "is:done migrate",
+			contains: []string{"c.holds_completed_tasks = 1 and t.id in (select rowid from tasks_fts"},
+			args:     []any{`"migrate"*`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			where, args := q.SQL()
+			for _, want := range tt.contains {
+				if !strings.Contains(where, want) {
+					t.Errorf("SQL() = %q, want it to contain %q", where, want)
+				}
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("SQL() args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
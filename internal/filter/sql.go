@@ -0,0 +1,166 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SQL renders the query as a boolean SQL expression and its positional
+// arguments. An empty query renders as "1 = 1".
+//
+// The expression expects the tasks table aliased as t, columns as c, types as
+// ty and priorities as p, as in the task summary queries. Priorities compare
+// by their position in the task's project's scale, not by ID.
+func (q *Query) SQL() (string, []any) {
+	if q.IsEmpty() {
+		return "1 = 1", nil
+	}
+
+	clauses := make([]string, 0, len(q.Terms))
+	var args []any
+	for _, term := range q.Terms {
+		clause, termArgs := term.sql()
+		clauses = append(clauses, clause)
+		args = append(args, termArgs...)
+	}
+	return strings.Join(clauses, " and "), args
+}
+
+// blockedSQL matches tasks with an unresolved blocker (see the task_blockers
+// view)
+const blockedSQL = `exists (select 1 from task_blockers ftb where ftb.task_id = t.id)`
+
+// epicSQL matches the task with the given ticket number in the task's project
+// and every task below it through parent-child links (relation type 1);
+// blockers and related tasks are not part of the epic
+const epicSQL = `t.id in (
+        with recursive fepic(id) as (
+            select fet.id
+            from tasks fet
+            inner join columns fec on fet.column_id = fec.id
+            where fec.project_id = c.project_id and fet.ticket_number = ?
+            union
+            select fsub.child_id
+            from task_subtasks fsub
+            inner join fepic fe on fsub.parent_id = fe.id
+            where fsub.relation_type_id = 1
+        )
+        select id from fepic
+    )`
+
+func (term Term) sql() (string, []any) {
+	var clause string
+	var args []any
+	negate := term.Negate
+
+	switch term.Field {
+	case FieldText:
+		clause = "t.id in (select rowid from tasks_fts where tasks_fts match ?)"
+		args = []any{MatchExpression([]string{term.Value})}
+
+	case FieldPriority:
+		op := term.Op
+		if op == OpNe {
+			op, negate = OpEq, !negate
+		}
+		clause = fmt.Sprintf(`p.sort_order %s (
+        select fp.sort_order from priorities fp
+        where fp.project_id is p.project_id and lower(fp.description) = lower(?)
+    )`, op)
+		args = []any{term.Value}
+
+	case FieldType:
+		clause = "lower(ty.description) = lower(?)"
+		args = []any{term.Value}
+
+	case FieldLabel:
+		clause = `exists (
+        select 1
+        from task_labels ftl
+        inner join labels fl on ftl.label_id = fl.id
+        where ftl.task_id = t.id and lower(fl.name) = lower(?)
+    )`
+		args = []any{term.Value}
+
+	case FieldColumn:
+		clause = "lower(c.name) = lower(?)"
+		args = []any{term.Value}
+
+	case FieldIs:
+		switch term.Value {
+		case StateBlocked:
+			clause = blockedSQL
+		case StateReady:
+			clause = "(c.holds_ready_tasks = 1 and not " + blockedSQL + ")"
+		case StateDone:
+			clause = "c.holds_completed_tasks = 1"
+		case StateInProgress:
+			clause = "c.holds_in_progress_tasks = 1"
+		case StateArchived:
+			clause = "t.archived_at is not null"
+		}
+
+	case FieldCreated, FieldUpdated:
+		clause, args = timeSQL("t."+term.Field+"_at", term.Op, term.Value)
+
+	case FieldEpic:
+		ticketNumber, _ := strconv.Atoi(term.Value)
+		clause = epicSQL
+		args = []any{ticketNumber}
+	}
+
+	// Ops other than priority comparisons are either = or !=
+	if term.Op == OpNe && term.Field != FieldPriority {
+		negate = !negate
+	}
+	if negate {
+		clause = "not (" + clause + ")"
+	}
+	return clause, args
+}
+
+// timeSQL compares a timestamp column against a date or an age
+func timeSQL(column string, op Op, value string) (string, []any) {
+	if m := agePattern.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		modifier := ""
+		switch m[2] {
+		case "h":
+			modifier = fmt.Sprintf("-%d hours", n)
+		case "d":
+			modifier = fmt.Sprintf("-%d days", n)
+		case "w":
+			modifier = fmt.Sprintf("-%d days", n*7)
+		}
+
+		// Comparing ages inverts the comparison on timestamps:
+		// younger than 7d means the timestamp is after now-7d.
+		// A bare "updated:7d" means "within the last 7 days".
+		var tsOp Op
+		switch op {
+		case OpLt:
+			tsOp = OpGt
+		case OpLe, OpEq:
+			tsOp = OpGe
+		case OpGt:
+			tsOp = OpLt
+		case OpGe:
+			tsOp = OpLe
+		}
+		return fmt.Sprintf("%s %s datetime('now', ?)", column, tsOp), []any{modifier}
+	}
+
+	return fmt.Sprintf("date(%s) %s ?", column, op), []any{value}
+}
+
+// MatchExpression converts plain words into an FTS5 MATCH expression.
+// Each word is quoted so characters such as '-' or ':' are never parsed as
+// operators, and suffixed with '*' for prefix matching.
+func MatchExpression(words []string) string {
+	parts := make([]string, 0, len(words))
+	for _, word := range words {
+		parts = append(parts, `"`+strings.ReplaceAll(word, `"`, `""`)+`"*`)
+	}
+	return strings.Join(parts, " ")
+}
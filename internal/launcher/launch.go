@@ -105,6 +105,10 @@ func Launch() error {
 	}
 
 	application := app.New(db, appOpts...)
+
+	// Bring recurring tasks up to date before the board is loaded
+	application.GenerateRecurringTasks(initCtx)
+
 	tuiApp := core.New(ctx, application, cfg, eventClient)
 	p := tea.NewProgram(tuiApp, tea.WithContext(ctx))
 
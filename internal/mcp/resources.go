@@ -0,0 +1,127 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const mimeJSON = "application/json"
+
+// resourceTemplates describes the parameterised resources
+var resourceTemplates = []map[string]any{
+	{
+		"uriTemplate": "paso://projects/{project_id}/tree",
+		"name":        "project-tree",
+		"title":       "Project tree",
+		"description": "A project's columns and its tasks arranged by parent/child and blocking relations",
+		"mimeType":    mimeJSON,
+	},
+	{
+		"uriTemplate": "paso://tasks/{task_id}",
+		"name":        "task",
+		"title":       "Task detail",
+		"description": "A task with its description, labels, relations and comments",
+		"mimeType":    mimeJSON,
+	},
+}
+
+// projectTree is the content of a paso://projects/{id}/tree resource
+type projectTree struct {
+	Project any
+	Columns any
+	Tasks   any
+}
+
+// listResources lists the tree resource of every project
+func (s *Server) listResources(ctx context.Context) (any, error) {
+	projects, err := s.app.ProjectService.GetAllProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	resources := make([]map[string]any, 0, len(projects))
+	for _, project := range projects {
+		resources = append(resources, map[string]any{
+			"uri":         fmt.Sprintf("paso://projects/%d/tree", project.ID),
+			"name":        project.Name,
+			"title":       project.Name + " task tree",
+			"description": project.Description,
+			"mimeType":    mimeJSON,
+		})
+	}
+	return map[string]any{"resources": resources}, nil
+}
+
+// readResource resolves a paso:// URI
+func (s *Server) readResource(ctx context.Context, params json.RawMessage) (any, error) {
+	var p struct {
+		URI string `json:"uri"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	content, err := s.resolve(ctx, p.URI)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource: %w", err)
+	}
+
+	return map[string]any{
+		"contents": []map[string]any{{
+			"uri":      p.URI,
+			"mimeType": mimeJSON,
+			"text":     string(data),
+		}},
+	}, nil
+}
+
+func (s *Server) resolve(ctx context.Context, uri string) (any, error) {
+	notFound := &rpcError{Code: codeNotFound, Message: "resource not found: " + uri}
+
+	path, ok := strings.CutPrefix(uri, "paso://")
+	if !ok {
+		return nil, notFound
+	}
+	parts := strings.Split(path, "/")
+
+	switch {
+	case len(parts) == 3 && parts[0] == "projects" && parts[2] == "tree":
+		projectID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, notFound
+		}
+		project, err := s.app.ProjectService.GetProjectByID(ctx, projectID)
+		if err != nil {
+			return nil, notFound
+		}
+		columns, err := s.app.ColumnService.GetColumnsByProject(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch columns: %w", err)
+		}
+		tree, err := s.app.TaskService.GetTaskTreeByProject(ctx, projectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch task tree: %w", err)
+		}
+		return projectTree{Project: project, Columns: columns, Tasks: tree}, nil
+
+	case len(parts) == 2 && parts[0] == "tasks":
+		taskID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, notFound
+		}
+		task, err := s.app.TaskService.GetTaskDetail(ctx, taskID)
+		if err != nil {
+			return nil, notFound
+		}
+		return task, nil
+	}
+
+	return nil, notFound
+}
@@ -0,0 +1,212 @@
+// Package mcp implements a Model Context Protocol server over stdio, so
+// agents can work with paso through typed tools and resources instead of
+// running CLI commands and parsing their output.
+//
+// Messages are JSON-RPC 2.0, one per line. Tools and resources are backed
+// directly by the services in app.App, so changes publish live-update events
+// like the CLI does.
+package mcp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/thenoetrevino/paso/internal/app"
+)
+
+// protocolVersion is the newest MCP revision this server implements
+const protocolVersion = "2025-06-18"
+
+// supportedVersions are the MCP revisions a client may ask for
+var supportedVersions = []string{"2024-11-05", "2025-03-26", protocolVersion}
+
+// maxMessageBytes bounds a single JSON-RPC message
+const maxMessageBytes = 4 << 20
+
+// JSON-RPC error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+	codeNotFound       = -32002 // MCP: resource not found
+)
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return e.Message
+}
+
+// Server serves MCP requests for an App
+type Server struct {
+	app     *app.App
+	version string // Paso version reported to clients
+	tools   []tool
+
+	mu  sync.Mutex // Serializes writes to out
+	out io.Writer
+}
+
+// NewServer creates an MCP server for the given app
+func NewServer(a *app.App, version string) *Server {
+	s := &Server{app: a, version: version}
+	s.tools = s.toolset()
+	return s
+}
+
+// Serve reads requests from r and writes responses to w until r is
+// exhausted or ctx is cancelled
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.out = w
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxMessageBytes)
+
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var req request
+		if err := json.Unmarshal(line, &req); err != nil {
+			s.write(response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "parse error: " + err.Error()}})
+			continue
+		}
+
+		result, err := s.dispatch(ctx, req)
+
+		// Notifications have no ID and get no response
+		if len(req.ID) == 0 {
+			if err != nil {
+				slog.Warn("mcp notification failed", "method", req.Method, "error", err)
+			}
+			continue
+		}
+
+		resp := response{JSONRPC: "2.0", ID: req.ID}
+		if err != nil {
+			var rpcErr *rpcError
+			if !errors.As(err, &rpcErr) {
+				rpcErr = &rpcError{Code: codeInternalError, Message: err.Error()}
+			}
+			resp.Error = rpcErr
+		} else {
+			resp.Result = result
+		}
+		s.write(resp)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read request: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) write(resp response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := json.NewEncoder(s.out).Encode(resp); err != nil {
+		slog.Error("failed to writing mcp response", "error", err)
+	}
+}
+
+// dispatch routes a request to its method handler
+func (s *Server) dispatch(ctx context.Context, req request) (any, error) {
+	if req.JSONRPC != "2.0" {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: `jsonrpc must be "2.0"`}
+	}
+
+	switch req.Method {
+	case "initialize":
+		return s.initialize(req.Params)
+	case "notifications/initialized", "notifications/cancelled":
+		return nil, nil
+	case "ping":
+		return struct{}{}, nil
+	case "tools/list":
+		return map[string]any{"tools": s.tools}, nil
+	case "tools/call":
+		return s.callTool(ctx, req.Params)
+	case "resources/list":
+		return s.listResources(ctx)
+	case "resources/templates/list":
+		return map[string]any{"resourceTemplates": resourceTemplates}, nil
+	case "resources/read":
+		return s.readResource(ctx, req.Params)
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + req.Method}
+	}
+}
+
+func (s *Server) initialize(params json.RawMessage) (any, error) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	// Speak the client's revision if we can, otherwise offer ours
+	version := protocolVersion
+	if slices.Contains(supportedVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools":     map[string]any{},
+			"resources": map[string]any{},
+		},
+		"serverInfo": map[string]any{
+			"name":    "paso",
+			"version": s.version,
+		},
+		"instructions": "Paso is a kanban board. Use list_projects to find a project, list_ready_tasks to pick work, " +
+			"move_task to track progress and add_comment to record decisions. " +
+			"Read paso://projects/{project_id}/tree for the dependency tree.",
+	}, nil
+}
+
+// decodeParams decodes request params into v. Missing params leave v unchanged.
+func decodeParams(params json.RawMessage, v any) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/app"
+	projectservice "github.com/thenoetrevino/paso/internal/services/project"
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+type testResponse struct {
+	ID     int
+	Result json.RawMessage
+	Error  *rpcError
+}
+
+// session runs the requests through a fresh server and returns the responses
+func session(t *testing.T, a *app.App, requests ...string) []testResponse {
+	t.Helper()
+
+	var out bytes.Buffer
+	srv := NewServer(a, "test")
+	if err := srv.Serve(context.Background(), strings.NewReader(strings.Join(requests, "\n")), &out); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	var responses []testResponse
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp testResponse
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("invalid response %q: %v", line, err)
+		}
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+func call(id int, method, params string) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":%q,"params":%s}`, id, method, params)
+}
+
+func toolCall(id int, name, args string) string {
+	return call(id, "tools/call", fmt.Sprintf(`{"name":%q,"arguments":%s}`, name, args))
+}
+
+// toolText returns the text content and error flag of a tools/call result
+func toolText(t *testing.T, resp testResponse) (string, bool) {
+	t.Helper()
+	if resp.Error != nil {
+		t.Fatalf("response %d error = %+v", resp.ID, resp.Error)
+	}
+	var result struct {
+		Content []struct{ Text string }
+		IsError bool
+	}
+	if err := json.Unmarshal(resp.Result, &result); err != nil || len(result.Content) != 1 {
+		t.Fatalf("response %d: malformed tool result %s", resp.ID, resp.Result)
+	}
+	return result.Content[0].Text, result.IsError
+}
+
+func setupApp(t *testing.T) (*app.App, int) {
+	t.Helper()
+	a := app.New(testutil.SetupTestDB(t))
+	project, err := a.ProjectService.CreateProject(context.Background(), projectservice.CreateProjectRequest{Name: "Agents"})
+	if err != nil {
+		t.Fatalf("CreateProject() error = %v", err)
+	}
+	return a, project.ID
+}
+
+func TestInitializeAndList(t *testing.T) {
+	a, _ := setupApp(t)
+
+	responses := session(t, a,
+		call(1, "initialize", `{"protocolVersion":"2025-03-26","capabilities":{},"clientInfo":{"name":"test","version":"1"}}`),
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		call(2, "tools/list", `{}`),
+		call(3, "resources/list", `{}`),
+		call(4, "bogus/method", `{}`),
+	)
+	if len(responses) != 4 {
+		t.Fatalf("got %d responses, want 4 (notifications get none)", len(responses))
+	}
+
+	var init struct {
+		ProtocolVersion string
+		ServerInfo      struct{ Name string }
+	}
+	if err := json.Unmarshal(responses[0].Result, &init); err != nil {
+		t.Fatalf("invalid initialize result: %v", err)
+	}
+	if init.ProtocolVersion != "2025-03-26" || init.ServerInfo.Name != "paso" {
+		t.Errorf("initialize = %+v, want the client's protocol version and server name paso", init)
+	}
+
+	var tools struct{ Tools []struct{ Name string } }
+	if err := json.Unmarshal(responses[1].Result, &tools); err != nil {
+		t.Fatalf("invalid tools/list result: %v", err)
+	}
+	var names []string
+	for _, tool := range tools.Tools {
+		names = append(names, tool.Name)
+	}
+	for _, want := range []string{"create_task", "update_task", "move_task", "link_tasks", "list_ready_tasks", "list_blocked_tasks", "add_comment"} {
+		if !strings.Contains(strings.Join(names, ","), want) {
+			t.Errorf("tools/list = %v, missing %s", names, want)
+		}
+	}
+
+	if !strings.Contains(string(responses[2].Result), "paso://projects/") {
+		t.Errorf("resources/list = %s, want the project tree", responses[2].Result)
+	}
+
+	if responses[3].Error == nil || responses[3].Error.Code != codeMethodNotFound {
+		t.Errorf("unknown method error = %+v, want code %d", responses[3].Error, codeMethodNotFound)
+	}
+}
+
+func TestTaskTools(t *testing.T) {
+	a, projectID := setupApp(t)
+
+	responses := session(t, a,
+		toolCall(1, "create_task", fmt.Sprintf(`{"project_id":%d,"title":"Write migration","priority":"high"}`, projectID)),
+		toolCall(2, "create_task", fmt.Sprintf(`{"project_id":%d,"title":"Ship release","column":"in progress"}`, projectID)),
+		toolCall(3, "link_tasks", `{"parent_id":2,"child_id":1,"relation":"blocker"}`),
+		toolCall(4, "list_blocked_tasks", fmt.Sprintf(`{"project_id":%d}`, projectID)),
+		toolCall(5, "update_task", `{"task_id":1,"title":"Write schema migration"}`),
+		toolCall(6, "move_task", `{"task_id":1,"to":"done"}`),
+		toolCall(7, "list_tasks", fmt.Sprintf(`{"project_id":%d,"filter":"priority>=high"}`, projectID)),
+		toolCall(8, "add_comment", `{"task_id":2,"message":"Waiting on QA","author":"agent"}`),
+		call(9, "resources/read", `{"uri":"paso://tasks/2"}`),
+	)
+	if len(responses) != 9 {
+		t.Fatalf("got %d responses, want 9", len(responses))
+	}
+	for _, resp := range responses[:8] {
+		if text, isError := toolText(t, resp); isError {
+			t.Fatalf("tool call %d failed: %s", resp.ID, text)
+		}
+	}
+
+	if text, _ := toolText(t, responses[3]); !strings.Contains(text, "Ship release") {
+		t.Errorf("blocked tasks = %s, want Ship release", text)
+	}
+	if text, _ := toolText(t, responses[4]); !strings.Contains(text, "Write schema migration") {
+		t.Errorf("update_task = %s, want the new title", text)
+	}
+	if text, _ := toolText(t, responses[5]); !strings.Contains(text, `"ColumnName": "Done"`) {
+		t.Errorf("move_task = %s, want the task in Done", text)
+	}
+	if text, _ := toolText(t, responses[6]); !strings.Contains(text, "Write schema migration") || strings.Contains(text, "Ship release") {
+		t.Errorf("filtered tasks = %s, want only the high priority task", text)
+	}
+	if !strings.Contains(string(responses[8].Result), "Waiting on QA") {
+		t.Errorf("task resource = %s, want the comment", responses[8].Result)
+	}
+}
+
+func TestToolErrors(t *testing.T) {
+	a, projectID := setupApp(t)
+
+	responses := session(t, a,
+		toolCall(1, "create_task", fmt.Sprintf(`{"project_id":%d,"title":"x","priority":"urgent"}`, projectID)),
+		toolCall(2, "get_task", `{"task_id":404}`),
+		toolCall(3, "create_task", `{"project_id":1,"title":"x","colour":"red"}`),
+		toolCall(4, "no_such_tool", `{}`),
+		call(5, "resources/read", `{"uri":"paso://tasks/404"}`),
+	)
+
+	// Tool failures are results the model can read, not protocol errors
+	for _, resp := range responses[:3] {
+		if text, isError := toolText(t, resp); !isError {
+			t.Errorf("tool call %d = %s, want isError", resp.ID, text)
+		}
+	}
+	if responses[3].Error == nil || responses[3].Error.Code != codeInvalidParams {
+		t.Errorf("unknown tool error = %+v, want code %d", responses[3].Error, codeInvalidParams)
+	}
+	if responses[4].Error == nil || responses[4].Error.Code != codeNotFound {
+		t.Errorf("missing resource error = %+v, want code %d", responses[4].Error, codeNotFound)
+	}
+}
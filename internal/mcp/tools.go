@@ -0,0 +1,514 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/cli"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	userutil "github.com/thenoetrevino/paso/internal/user"
+)
+
+// tool is an MCP tool and the function that runs it
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+
+	run func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+// objectSchema builds a JSON Schema for a tool's arguments
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func intProp(description string) map[string]any {
+	return map[string]any{"type": "integer", "description": description}
+}
+
+func stringProp(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+func enumProp(description string, values ...string) map[string]any {
+	return map[string]any{"type": "string", "description": description, "enum": values}
+}
+
+var (
+	// Types and priorities are per-project, so they can't be an enum
+	typeProp     = stringProp("Task type, one of the project's types (omit for the project's default)")
+	priorityProp = stringProp("Task priority, one of the project's priorities (omit for the project's default)")
+)
+
+// toolset returns the tools the server exposes
+func (s *Server) toolset() []tool {
+	return []tool{
+		{
+			Name:        "list_projects",
+			Description: "List all projects.",
+			InputSchema: objectSchema(map[string]any{}),
+			run:         s.listProjects,
+		},
+		{
+			Name:        "list_tasks",
+			Description: "List a project's tasks, optionally narrowed by a filter query such as 'priority>=high label:backend -is:done'.",
+			InputSchema: objectSchema(map[string]any{
+				"project_id": intProp("Project ID"),
+				"filter":     stringProp("Filter query (same syntax as paso task list --filter)"),
+			}, "project_id"),
+			run: s.listTasks,
+		},
+		{
+			Name:        "list_ready_tasks",
+			Description: "List tasks in the project's ready column that are not blocked, i.e. tasks that can be picked up now.",
+			InputSchema: objectSchema(map[string]any{
+				"project_id": intProp("Project ID"),
+			}, "project_id"),
+			run: s.listReadyTasks,
+		},
+		{
+			Name:        "list_blocked_tasks",
+			Description: "List tasks that are blocked by unfinished tasks.",
+			InputSchema: objectSchema(map[string]any{
+				"project_id": intProp("Project ID"),
+			}, "project_id"),
+			run: s.listBlockedTasks,
+		},
+		{
+			Name:        "get_task",
+			Description: "Get a task with its description, labels, relations and comments.",
+			InputSchema: objectSchema(map[string]any{
+				"task_id": intProp("Task ID"),
+			}, "task_id"),
+			run: s.getTask,
+		},
+		{
+			Name:        "create_task",
+			Description: "Create a task. It goes in the project's first column unless a column is given.",
+			InputSchema: objectSchema(map[string]any{
+				"project_id":  intProp("Project ID"),
+				"title":       stringProp("Task title"),
+				"description": stringProp("Task description (markdown)"),
+				"type":        typeProp,
+				"priority":    priorityProp,
+				"column":      stringProp("Column name"),
+				"parent_id":   intProp("Parent task ID"),
+				"blocked_by":  intProp("ID of a task that blocks this one"),
+			}, "project_id", "title"),
+			run: s.createTask,
+		},
+		{
+			Name:        "update_task",
+			Description: "Update a task's title, description, type or priority. Omitted fields are left unchanged.",
+			InputSchema: objectSchema(map[string]any{
+				"task_id":     intProp("Task ID"),
+				"title":       stringProp("New title"),
+				"description": stringProp("New description"),
+				"type":        typeProp,
+				"priority":    priorityProp,
+			}, "task_id"),
+			run: s.updateTask,
+		},
+		{
+			Name:        "move_task",
+			Description: "Move a task. Give either 'to' (a column role or direction) or 'column' (a column name).",
+			InputSchema: objectSchema(map[string]any{
+				"task_id": intProp("Task ID"),
+				"to":      enumProp("Destination", "next", "prev", "ready", "in-progress", "done"),
+				"column":  stringProp("Destination column name"),
+			}, "task_id"),
+			run: s.moveTask,
+		},
+		{
+			Name:        "link_tasks",
+			Description: "Relate two tasks. With relation 'blocker', or a blocking project relation type, the parent is blocked until the child is done.",
+			InputSchema: objectSchema(map[string]any{
+				"parent_id": intProp("Parent task ID"),
+				"child_id":  intProp("Child task ID"),
+				"relation":  stringProp("Relation type: parent-child (default), blocker, related or a project relation type name"),
+			}, "parent_id", "child_id"),
+			run: s.linkTasks,
+		},
+		{
+			Name:        "add_comment",
+			Description: "Add a comment to a task, e.g. to record a decision or progress note.",
+			InputSchema: objectSchema(map[string]any{
+				"task_id": intProp("Task ID"),
+				"message": stringProp("Comment text"),
+				"author":  stringProp("Comment author (defaults to the current user)"),
+			}, "task_id", "message"),
+			run: s.addComment,
+		},
+	}
+}
+
+// callTool runs a tool. Failures of the tool itself are reported in the
+// result with isError set, so the model can see and correct them; only
+// unknown tools are protocol errors.
+func (s *Server) callTool(ctx context.Context, params json.RawMessage) (any, error) {
+	var p struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := decodeParams(params, &p); err != nil {
+		return nil, err
+	}
+
+	for _, t := range s.tools {
+		if t.Name != p.Name {
+			continue
+		}
+
+		result, err := t.run(ctx, p.Arguments)
+		if err != nil {
+			return toolResult(err.Error(), true), nil
+		}
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tool result: %w", err)
+		}
+		return toolResult(string(data), false), nil
+	}
+
+	return nil, &rpcError{Code: codeInvalidParams, Message: "unknown tool: " + p.Name}
+}
+
+func toolResult(text string, isError bool) map[string]any {
+	return map[string]any{
+		"content": []map[string]any{{"type": "text", "text": text}},
+		"isError": isError,
+	}
+}
+
+// decodeArgs decodes tool arguments, rejecting unknown ones
+func decodeArgs(args json.RawMessage, v any) error {
+	if len(args) == 0 {
+		args = json.RawMessage("{}")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(args))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+type projectArgs struct {
+	ProjectID int    `json:"project_id"`
+	Filter    string `json:"filter"`
+}
+
+type taskArgs struct {
+	TaskID int `json:"task_id"`
+}
+
+func (s *Server) listProjects(ctx context.Context, args json.RawMessage) (any, error) {
+	if err := decodeArgs(args, &struct{}{}); err != nil {
+		return nil, err
+	}
+	return s.app.ProjectService.GetAllProjects(ctx)
+}
+
+func (s *Server) filteredTasks(ctx context.Context, args json.RawMessage, implicit string) (any, error) {
+	var a projectArgs
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	if _, err := s.app.ProjectService.GetProjectByID(ctx, a.ProjectID); err != nil {
+		return nil, fmt.Errorf("project %d not found", a.ProjectID)
+	}
+	return s.app.TaskService.GetTaskSummariesByFilter(ctx, a.ProjectID, implicit+" "+a.Filter)
+}
+
+func (s *Server) listTasks(ctx context.Context, args json.RawMessage) (any, error) {
+	return s.filteredTasks(ctx, args, "")
+}
+
+func (s *Server) listReadyTasks(ctx context.Context, args json.RawMessage) (any, error) {
+	return s.filteredTasks(ctx, args, "is:ready")
+}
+
+func (s *Server) listBlockedTasks(ctx context.Context, args json.RawMessage) (any, error) {
+	return s.filteredTasks(ctx, args, "is:blocked")
+}
+
+func (s *Server) getTask(ctx context.Context, args json.RawMessage) (any, error) {
+	var a taskArgs
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	task, err := s.app.TaskService.GetTaskDetail(ctx, a.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("task %d not found", a.TaskID)
+	}
+	return task, nil
+}
+
+func (s *Server) createTask(ctx context.Context, args json.RawMessage) (any, error) {
+	var a struct {
+		ProjectID   int    `json:"project_id"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Type        string `json:"type"`
+		Priority    string `json:"priority"`
+		Column      string `json:"column"`
+		ParentID    int    `json:"parent_id"`
+		BlockedBy   int    `json:"blocked_by"`
+	}
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	if _, err := s.app.ProjectService.GetProjectByID(ctx, a.ProjectID); err != nil {
+		return nil, fmt.Errorf("project %d not found", a.ProjectID)
+	}
+	columns, err := s.app.ColumnService.GetColumnsByProject(ctx, a.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch columns: %w", err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("project %d has no columns", a.ProjectID)
+	}
+	column := columns[0]
+	if a.Column != "" {
+		if column, err = cli.FindColumnByName(columns, a.Column); err != nil {
+			return nil, fmt.Errorf("column '%s' not found (available: %s)", a.Column, cli.FormatAvailableColumns(columns))
+		}
+	}
+
+	typeID, priorityID, err := s.parseTypeAndPriority(ctx, a.ProjectID, a.Type, a.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	req := taskservice.CreateTaskRequest{
+		Title:       a.Title,
+		Description: a.Description,
+		ColumnID:    column.ID,
+		Position:    models.DefaultTaskPosition,
+		PriorityID:  priorityID,
+		TypeID:      typeID,
+	}
+	if a.ParentID > 0 {
+		req.ParentIDs = []int{a.ParentID}
+	}
+	if a.BlockedBy > 0 {
+		req.BlockedByIDs = []int{a.BlockedBy}
+	}
+
+	task, err := s.app.TaskService.CreateTask(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("task creation error: %w", err)
+	}
+	return s.app.TaskService.GetTaskDetail(ctx, task.ID)
+}
+
+func (s *Server) updateTask(ctx context.Context, args json.RawMessage) (any, error) {
+	var a struct {
+		TaskID      int     `json:"task_id"`
+		Title       *string `json:"title"`
+		Description *string `json:"description"`
+		Type        *string `json:"type"`
+		Priority    *string `json:"priority"`
+	}
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	if a.Title == nil && a.Description == nil && a.Type == nil && a.Priority == nil {
+		return nil, fmt.Errorf("nothing to update: give at least one of title, description, type or priority")
+	}
+
+	req := taskservice.UpdateTaskRequest{
+		TaskID:      a.TaskID,
+		Title:       a.Title,
+		Description: a.Description,
+	}
+
+	existing, err := s.app.TaskService.GetTaskDetail(ctx, a.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("task %d not found", a.TaskID)
+	}
+	if a.Type != nil || a.Priority != nil {
+		var typeName, priorityName string
+		if a.Type != nil {
+			if *a.Type == "" {
+				return nil, fmt.Errorf("type cannot be empty")
+			}
+			typeName = *a.Type
+		}
+		if a.Priority != nil {
+			if *a.Priority == "" {
+				return nil, fmt.Errorf("priority cannot be empty")
+			}
+			priorityName = *a.Priority
+		}
+		typeID, priorityID, err := s.parseTypeAndPriority(ctx, existing.ProjectID, typeName, priorityName)
+		if err != nil {
+			return nil, err
+		}
+		if a.Type != nil {
+			req.TypeID = &typeID
+		}
+		if a.Priority != nil {
+			req.PriorityID = &priorityID
+		}
+	}
+	if err := s.app.TaskService.UpdateTask(ctx, req); err != nil {
+		return nil, fmt.Errorf("update error: %w", err)
+	}
+	return s.app.TaskService.GetTaskDetail(ctx, a.TaskID)
+}
+
+// parseTypeAndPriority resolves type and priority names against a project's
+// own. Empty names resolve to 0, the project's defaults.
+func (s *Server) parseTypeAndPriority(ctx context.Context, projectID int, typeName, priorityName string) (typeID, priorityID int, err error) {
+	if typeName != "" {
+		types, err := s.app.TypeService.GetTypesByProject(ctx, projectID)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to fetch types: %w", err)
+		}
+		if typeID, err = cli.ParseTaskType(types, typeName); err != nil {
+			return 0, 0, err
+		}
+	}
+	if priorityName != "" {
+		priorities, err := s.app.PriorityService.GetPrioritiesByProject(ctx, projectID)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to fetch priorities: %w", err)
+		}
+		if priorityID, err = cli.ParsePriority(priorities, priorityName); err != nil {
+			return 0, 0, err
+		}
+	}
+	return typeID, priorityID, nil
+}
+
+func (s *Server) moveTask(ctx context.Context, args json.RawMessage) (any, error) {
+	var a struct {
+		TaskID int    `json:"task_id"`
+		To     string `json:"to"`
+		Column string `json:"column"`
+	}
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	if (a.To == "") == (a.Column == "") {
+		return nil, fmt.Errorf("give exactly one of 'to' or 'column'")
+	}
+
+	task, err := s.app.TaskService.GetTaskSummary(ctx, a.TaskID)
+	if err != nil {
+		return nil, fmt.Errorf("task %d not found", a.TaskID)
+	}
+
+	tasks := s.app.TaskService
+	switch a.To {
+	case "":
+		column, err := s.app.ColumnService.GetColumnByID(ctx, task.ColumnID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch column: %w", err)
+		}
+		columns, err := s.app.ColumnService.GetColumnsByProject(ctx, column.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch columns: %w", err)
+		}
+		target, err := cli.FindColumnByName(columns, a.Column)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s' not found (available: %s)", a.Column, cli.FormatAvailableColumns(columns))
+		}
+		err = tasks.MoveTaskToColumn(ctx, a.TaskID, target.ID)
+	case "next":
+		err = tasks.MoveTaskToNextColumn(ctx, a.TaskID)
+	case "prev":
+		err = tasks.MoveTaskToPrevColumn(ctx, a.TaskID)
+	case "ready":
+		err = tasks.MoveTaskToReadyColumn(ctx, a.TaskID)
+	case "in-progress":
+		err = tasks.MoveTaskToInProgressColumn(ctx, a.TaskID)
+	case "done":
+		err = tasks.MoveTaskToCompletedColumn(ctx, a.TaskID)
+	default:
+		return nil, fmt.Errorf("unknown destination '%s' (must be: next, prev, ready, in-progress, done)", a.To)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("move error: %w", err)
+	}
+	return tasks.GetTaskDetail(ctx, a.TaskID)
+}
+
+func (s *Server) linkTasks(ctx context.Context, args json.RawMessage) (any, error) {
+	var a struct {
+		ParentID int    `json:"parent_id"`
+		ChildID  int    `json:"child_id"`
+		Relation string `json:"relation"`
+	}
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+
+	relationTypeID := models.RelationTypeParentChild
+	switch a.Relation {
+	case "", "parent-child":
+	case "blocker":
+		relationTypeID = models.RelationTypeBlocking
+	case "related":
+		relationTypeID = models.RelationTypeRelated
+	default:
+		parent, err := s.app.TaskService.GetTaskDetail(ctx, a.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("task %d not found: %w", a.ParentID, err)
+		}
+		relationTypes, err := s.app.RelationTypeService.GetRelationTypesByProject(ctx, parent.ProjectID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch relation types: %w", err)
+		}
+		relationType, err := cli.ParseRelationType(relationTypes, a.Relation)
+		if err != nil {
+			return nil, err
+		}
+		relationTypeID = relationType.ID
+	}
+
+	if err := s.app.TaskService.AddChildRelation(ctx, a.ParentID, a.ChildID, relationTypeID); err != nil {
+		return nil, fmt.Errorf("link error: %w", err)
+	}
+	return s.app.TaskService.GetTaskDetail(ctx, a.ParentID)
+}
+
+func (s *Server) addComment(ctx context.Context, args json.RawMessage) (any, error) {
+	var a struct {
+		TaskID  int    `json:"task_id"`
+		Message string `json:"message"`
+		Author  string `json:"author"`
+	}
+	if err := decodeArgs(args, &a); err != nil {
+		return nil, err
+	}
+	if a.Author == "" {
+		a.Author = userutil.GetCurrentUsername()
+	}
+
+	if _, err := s.app.TaskService.GetTaskSummary(ctx, a.TaskID); err != nil {
+		return nil, fmt.Errorf("task %d not found", a.TaskID)
+	}
+	comment, err := s.app.TaskService.CreateComment(ctx, taskservice.CreateCommentRequest{
+		TaskID:  a.TaskID,
+		Message: a.Message,
+		Author:  a.Author,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comment error: %w", err)
+	}
+	return comment, nil
+}
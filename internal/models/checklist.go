@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// ChecklistItem is one step of a task's checklist
+type ChecklistItem struct {
+	ID        int
+	TaskID    int
+	Text      string
+	Done      bool
+	Position  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -12,4 +12,5 @@ type Column struct {
 	HoldsReadyTasks      bool   // Whether tasks in this column are considered "ready" for work
 	HoldsCompletedTasks  bool   // Whether tasks in this column are considered "completed"
 	HoldsInProgressTasks bool   // Whether tasks in this column are considered "in progress"
+	WIPLimit             int    // Maximum number of tasks in the column (0 for no limit)
 }
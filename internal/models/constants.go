@@ -48,6 +48,3 @@ const DefaultTaskPosition = 9999
 
 // DefaultRelationTypeID is the default relation type ID (Parent/Child relationship)
 const DefaultRelationTypeID = RelationTypeParentChild
-
-// MaxRelationTypes is the maximum number of relation types
-const MaxRelationTypes = 3
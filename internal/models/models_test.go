@@ -79,3 +79,25 @@ func TestRelationType_NonBlocking(t *testing.T) {
 		t.Error("Expected IsBlocking to be false")
 	}
 }
+
+func TestTaskEvent_Summary(t *testing.T) {
+	tests := []struct {
+		name  string
+		event TaskEvent
+		want  string
+	}{
+		{"no field", TaskEvent{EventType: TaskEventCreated, NewValue: "Write docs"}, "created: Write docs"},
+		{"bare", TaskEvent{EventType: TaskEventDeleted}, "deleted"},
+		{"change", TaskEvent{EventType: TaskEventMoved, Field: "column", OldValue: "Todo", NewValue: "Done"}, "moved column: Todo → Done"},
+		{"added", TaskEvent{EventType: TaskEventLabelAttached, Field: "label", NewValue: "bug"}, "label_attached label: bug"},
+		{"removed", TaskEvent{EventType: TaskEventLabelDetached, Field: "label", OldValue: "bug"}, "label_detached label: bug"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.Summary(); got != tt.want {
+				t.Errorf("Summary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
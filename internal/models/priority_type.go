@@ -1,14 +1,22 @@
 package models
 
-// Priority represents a task priority level
+// Priority represents a task priority level. Each project has its own scale;
+// a higher SortOrder is a higher priority.
 type Priority struct {
 	ID          int
+	ProjectID   int // 0 for the built-in levels new projects start with
 	Description string
 	Color       string
+	Icon        string
+	SortOrder   int
 }
 
-// Type represents a task type
+// Type represents a task type. Each project has its own set.
 type Type struct {
 	ID          int
+	ProjectID   int // 0 for the built-in types new projects start with
 	Description string
+	Color       string
+	Icon        string
+	SortOrder   int
 }
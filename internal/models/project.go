@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Project represents a container for kanban columns and tasks
 // Projects are the top-level organizational unit in Paso
@@ -8,6 +11,73 @@ type Project struct {
 	ID          int
 	Name        string
 	Description string
+	TicketKey   string // Prefix of the project's ticket references (e.g. API in API-42), empty when unset
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
+
+// TicketRef formats a ticket number for display: KEY-N when the project has a
+// ticket key and #N otherwise
+func TicketRef(key string, number int) string {
+	if key == "" {
+		return fmt.Sprintf("#%d", number)
+	}
+	return fmt.Sprintf("%s-%d", key, number)
+}
+
+// ProjectTemplate describes the board a new project starts with. Templates
+// are saved in the database by `paso project save-template` or defined under
+// project_templates in the config file, and refer to columns, labels, types
+// and priorities by name.
+type ProjectTemplate struct {
+	Name          string                 `json:"name" yaml:"name"`
+	Description   string                 `json:"description,omitempty" yaml:"description,omitempty"`
+	Columns       []TemplateColumn       `json:"columns" yaml:"columns"` // In board order
+	Labels        []TemplateLabel        `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Types         []TemplateCategory     `json:"types,omitempty" yaml:"types,omitempty"`           // Built-in types when empty
+	Priorities    []TemplateCategory     `json:"priorities,omitempty" yaml:"priorities,omitempty"` // Lowest first; built-in priorities when empty
+	RelationTypes []TemplateRelationType `json:"relation_types,omitempty" yaml:"relation_types,omitempty"`
+	Tasks         []TemplateTask         `json:"tasks,omitempty" yaml:"tasks,omitempty"` // Starter tasks
+}
+
+// TemplateColumn is a column of a project template
+type TemplateColumn struct {
+	Name       string `json:"name" yaml:"name"`
+	Ready      bool   `json:"ready,omitempty" yaml:"ready,omitempty"`
+	InProgress bool   `json:"in_progress,omitempty" yaml:"in_progress,omitempty"`
+	Completed  bool   `json:"completed,omitempty" yaml:"completed,omitempty"`
+	WIPLimit   int    `json:"wip_limit,omitempty" yaml:"wip_limit,omitempty"`
+}
+
+// TemplateLabel is a label of a project template
+type TemplateLabel struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+}
+
+// TemplateCategory is a task type or priority of a project template
+type TemplateCategory struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+	Icon  string `json:"icon,omitempty" yaml:"icon,omitempty"`
+}
+
+// TemplateRelationType is a relation type of a project template
+type TemplateRelationType struct {
+	Name     string `json:"name" yaml:"name"`
+	Forward  string `json:"forward" yaml:"forward"` // Label shown on the parent's side
+	Reverse  string `json:"reverse" yaml:"reverse"` // Label shown on the child's side
+	Color    string `json:"color" yaml:"color"`
+	Blocking bool   `json:"blocking,omitempty" yaml:"blocking,omitempty"`
+}
+
+// TemplateTask is a starter task of a project template. Column, Type,
+// Priority and Labels are names; an empty Column means the ready column.
+type TemplateTask struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Column      string   `json:"column,omitempty" yaml:"column,omitempty"`
+	Type        string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Priority    string   `json:"priority,omitempty" yaml:"priority,omitempty"`
+	Labels      []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
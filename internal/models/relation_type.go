@@ -3,8 +3,16 @@ package models
 // RelationType represents a type of relationship between tasks
 type RelationType struct {
 	ID         int
+	ProjectID  int    // Owning project, 0 for the built-in parent/blocker/related types
+	Name       string // Short name used by `paso task link --type` (e.g., "blocker", "verifies")
 	PToCLabel  string // Label from parent's perspective (e.g., "Blocked By", "Parent")
 	CToPLabel  string // Label from child's perspective (e.g., "Blocker", "Child")
 	Color      string // Hex color code
 	IsBlocking bool   // Special flag for blocking relationships
 }
+
+// IsBuiltin reports whether the relation type is one of the built-in types
+// shared by every project
+func (r *RelationType) IsBuiltin() bool {
+	return r.ProjectID == 0
+}
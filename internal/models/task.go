@@ -11,6 +11,9 @@ type Task struct {
 	PriorityID  int
 	ColumnID    int
 	Position    int
+	DueAt       *time.Time // nil when the task has no due date
+	StartAt     *time.Time // nil when the task has no start date
+	ArchivedAt  *time.Time // nil unless the task is archived
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
@@ -33,13 +36,20 @@ type TaskReference struct {
 type TaskSummary struct {
 	ID                  int
 	Title               string
+	TicketNumber        int
 	Labels              []*Label
 	TypeDescription     string
 	PriorityDescription string
 	PriorityColor       string
 	ColumnID            int
 	Position            int
-	IsBlocked           bool // True if any child task has is_blocking=true
+	IsBlocked           bool       // True while a task in the blocker chain is not completed
+	DueAt               *time.Time // nil when the task has no due date
+	ArchivedAt          *time.Time // nil unless the task is archived
+	ChecklistDone       int        // Checklist items ticked off
+	ChecklistTotal      int        // Checklist items in all, 0 without a checklist
+	ChildrenDone        int        // Descendant tasks in a completed column
+	ChildrenTotal       int        // Descendant tasks in all, 0 without children
 }
 
 // TaskDetail is a DTO for the full ticket view
@@ -52,6 +62,7 @@ type TaskDetail struct {
 	ParentTasks         []*TaskReference // Tasks that depend on this task
 	ChildTasks          []*TaskReference // Tasks this task depends on
 	Comments            []*Comment       // Comments on this task
+	Checklist           []*ChecklistItem // Checklist items in order
 	TypeDescription     string
 	PriorityDescription string
 	PriorityColor       string
@@ -60,11 +71,45 @@ type TaskDetail struct {
 	Position            int
 	TicketNumber        int    // For display "PROJ-12"
 	ProjectName         string // Project name for display
-	IsBlocked           bool   // True if any child task has is_blocking=true
+	ProjectID           int
+	IsBlocked           bool // True while a task in the blocker chain is not completed
+	ChildrenDone        int  // Descendant tasks in a completed column
+	ChildrenTotal       int  // Descendant tasks in all, 0 without children
+	DueAt               *time.Time
+	StartAt             *time.Time
+	ArchivedAt          *time.Time // nil unless the task is archived
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
 
+// ArchivedTask is a task hidden from the board, as listed by the archive browser
+type ArchivedTask struct {
+	ID           int
+	TicketNumber int
+	Title        string
+	ColumnName   string // Column the task was archived from
+	ArchivedAt   time.Time
+}
+
+// TaskSnapshot is everything deleting a task removes: the task itself, its
+// ticket number, labels, links, comments and checklist. It lets a deleted
+// task be restored under its original ID.
+type TaskSnapshot struct {
+	Task         *Task
+	TicketNumber int
+	LabelIDs     []int
+	Links        []TaskLink
+	Comments     []*Comment
+	Checklist    []*ChecklistItem
+}
+
+// TaskLink is a raw parent-child relation between two tasks
+type TaskLink struct {
+	ParentID       int
+	ChildID        int
+	RelationTypeID int
+}
+
 // TaskTreeNode represents a task in a tree structure for hierarchical display
 type TaskTreeNode struct {
 	ID             int
@@ -76,9 +121,19 @@ type TaskTreeNode struct {
 	RelationColor  string // Hex color for the relation
 	IsBlocking     bool   // Whether this node's relationship to parent is blocking
 	InBlockingPath bool   // Whether this node is part of a path that leads to a blocker
+	ChildrenDone   int    // Descendant tasks in a completed column
+	ChildrenTotal  int    // Descendant tasks in all, 0 without children
 	Children       []*TaskTreeNode
 }
 
+// ProgressPercent returns done as a whole percentage of total, 0 when total is 0
+func ProgressPercent(done, total int) int {
+	if total <= 0 {
+		return 0
+	}
+	return done * 100 / total
+}
+
 // TaskRelation represents a parent-child relationship between tasks
 type TaskRelation struct {
 	ParentID      int
@@ -87,3 +142,54 @@ type TaskRelation struct {
 	RelationColor string
 	IsBlocking    bool
 }
+
+// TaskSearchResult is a single ranked hit from full-text search
+// Snippet is an excerpt of the best matching field with matches wrapped in "**"
+type TaskSearchResult struct {
+	ID           int
+	TicketNumber int
+	Title        string
+	ColumnID     int
+	ColumnName   string
+	Archived     bool
+	Snippet      string
+	Rank         float64 // bm25 score, lower is more relevant
+}
+
+// TaskRecurrence is the repeat rule attached to a template task.
+// Each run copies the template into the project's ready column.
+type TaskRecurrence struct {
+	ID             int
+	TaskID         int    // The template task
+	Title          string // Title of the template (set when listing a project's rules)
+	TicketNumber   int    // Ticket number of the template (set when listing a project's rules)
+	Rule           string // Canonical RRULE, e.g. FREQ=WEEKLY;BYDAY=MO
+	Description    string // The rule for people, e.g. "every week on Mon"
+	NextRunAt      time.Time
+	LastInstanceID int // The template until the first run, 0 once the instance is deleted
+	Occurrences    int // Instances generated so far
+	CreatedAt      time.Time
+}
+
+// TaskTemplate is a named blueprint for a task and its child tasks within a
+// project. Titles and descriptions may contain {{variable}} placeholders,
+// filled in when a task is created from the template. Type, priority and
+// labels are names in the template's project; empty ones mean the defaults.
+type TaskTemplate struct {
+	Name        string              `json:"name" yaml:"name"`
+	Title       string              `json:"title" yaml:"title"`
+	Description string              `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string              `json:"type,omitempty" yaml:"type,omitempty"`
+	Priority    string              `json:"priority,omitempty" yaml:"priority,omitempty"`
+	Labels      []string            `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Children    []TaskTemplateChild `json:"children,omitempty" yaml:"children,omitempty"`
+}
+
+// TaskTemplateChild is a child task created along with a task template
+type TaskTemplateChild struct {
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string   `json:"type,omitempty" yaml:"type,omitempty"`
+	Priority    string   `json:"priority,omitempty" yaml:"priority,omitempty"`
+	Labels      []string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
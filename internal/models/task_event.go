@@ -0,0 +1,84 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// TaskEvent is a single entry in the task activity log.
+// Field, OldValue and NewValue are only set for events that change a value
+// (e.g. a title edit or a column move).
+type TaskEvent struct {
+	ID        int
+	ProjectID int
+	TaskID    int // 0 for project-level events (column/label changes)
+	EventType string
+	Field     string
+	OldValue  string
+	NewValue  string
+	Actor     string
+	CreatedAt time.Time
+}
+
+// Summary renders the event as a short one-line description,
+// e.g. "moved column: Todo → Done".
+func (e *TaskEvent) Summary() string {
+	switch {
+	case e.Field == "":
+		if e.NewValue != "" {
+			return fmt.Sprintf("%s: %s", e.EventType, e.NewValue)
+		}
+		if e.OldValue != "" {
+			return fmt.Sprintf("%s: %s", e.EventType, e.OldValue)
+		}
+		return e.EventType
+	case e.OldValue == "":
+		return fmt.Sprintf("%s %s: %s", e.EventType, e.Field, e.NewValue)
+	case e.NewValue == "":
+		return fmt.Sprintf("%s %s: %s", e.EventType, e.Field, e.OldValue)
+	default:
+		return fmt.Sprintf("%s %s: %s → %s", e.EventType, e.Field, e.OldValue, e.NewValue)
+	}
+}
+
+// ============================================================================
+// TASK EVENT TYPES
+// ============================================================================
+
+// Task-level event types
+const (
+	TaskEventCreated           = "created"
+	TaskEventUpdated           = "updated"
+	TaskEventMoved             = "moved"
+	TaskEventReordered         = "reordered"
+	TaskEventDeleted           = "deleted"
+	TaskEventRestored          = "restored"
+	TaskEventArchived          = "archived"
+	TaskEventUnarchived        = "unarchived"
+	TaskEventLabelAttached     = "label_attached"
+	TaskEventLabelDetached     = "label_detached"
+	TaskEventRelationAdded     = "relation_added"
+	TaskEventRelationRemoved   = "relation_removed"
+	TaskEventCommentAdded      = "comment_added"
+	TaskEventCommentUpdated    = "comment_updated"
+	TaskEventCommentDeleted    = "comment_deleted"
+	TaskEventChecklistAdded    = "checklist_added"
+	TaskEventChecklistChecked  = "checklist_checked"
+	TaskEventChecklistReopened = "checklist_reopened"
+	TaskEventChecklistRemoved  = "checklist_removed"
+	TaskEventRecurrenceSet     = "recurrence_set"
+	TaskEventRecurrenceRemoved = "recurrence_removed"
+)
+
+// Project-level event types (recorded without a task ID)
+const (
+	TaskEventColumnCreated      = "column_created"
+	TaskEventColumnRenamed      = "column_renamed"
+	TaskEventColumnDeleted      = "column_deleted"
+	TaskEventColumnRestored     = "column_restored"
+	TaskEventColumnStateChanged = "column_state_changed"
+	TaskEventColumnWIPLimitSet  = "column_wip_limit_set"
+	TaskEventLabelCreated       = "label_created"
+	TaskEventLabelUpdated       = "label_updated"
+	TaskEventLabelDeleted       = "label_deleted"
+)
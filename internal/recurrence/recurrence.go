@@ -0,0 +1,470 @@
+// Package recurrence parses and evaluates the repeat rules of recurring tasks.
+//
+// Rules are a subset of iCalendar RRULE (FREQ, INTERVAL, BYDAY and
+// BYMONTHDAY) and can also be written in short forms such as daily,
+// weekdays, "weekly on mon,thu" or "monthly on the 1st". Occurrences fall
+// on local midnight.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidRule is wrapped by all parse errors
+var ErrInvalidRule = errors.New("invalid recurrence rule")
+
+// Frequency is the unit a rule repeats in
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// maxInterval bounds INTERVAL so a typo cannot push the next run centuries out
+const maxInterval = 366
+
+// Rule describes when a recurring task repeats
+type Rule struct {
+	Freq     Frequency
+	Interval int            // Every Interval days, weeks or months (1 when unset)
+	Weekdays []time.Weekday // Weekly: the days to repeat on. Monthly: the single weekday of Week
+	MonthDay int            // Monthly: the day of the month, -1 for the last day
+	Week     int            // Monthly: the nth Weekdays[0] of the month (1-4, -1 for the last)
+}
+
+// weekdayCodes are the RRULE names of the days of the week
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday,
+	"FR": time.Friday, "SA": time.Saturday, "SU": time.Sunday,
+}
+
+var workWeek = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// Parse reads a rule. Accepted forms:
+//
+//	FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH   (RRULE, with or without "RRULE:")
+//	daily, weekly, monthly, weekdays
+//	every 3 days, every 2 weeks, every month
+//	weekly on mon,thu                    (also: on weekdays, on weekends)
+//	monthly on the 15th                  (also: on 1, on the last day)
+//	monthly on the 2nd tue               (also: on the last fri)
+func Parse(s string) (Rule, error) {
+	value := strings.TrimSpace(s)
+	if value == "" {
+		return Rule{}, fmt.Errorf("%w: empty rule", ErrInvalidRule)
+	}
+
+	var (
+		rule Rule
+		err  error
+	)
+	if strings.Contains(strings.ToUpper(value), "FREQ=") {
+		rule, err = parseRRule(value)
+	} else {
+		rule, err = parseShort(strings.ToLower(value))
+	}
+	if err != nil {
+		return Rule{}, fmt.Errorf("%w: %q: %v", ErrInvalidRule, s, err)
+	}
+	if err := rule.validate(); err != nil {
+		return Rule{}, fmt.Errorf("%w: %q: %v", ErrInvalidRule, s, err)
+	}
+	rule.normalize()
+	return rule, nil
+}
+
+func parseRRule(s string) (Rule, error) {
+	s = strings.TrimPrefix(strings.ToUpper(s), "RRULE:")
+	rule := Rule{Interval: 1}
+
+	for _, part := range strings.Split(s, ";") {
+		if part == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return rule, fmt.Errorf("%q is not KEY=VALUE", part)
+		}
+		switch key {
+		case "FREQ":
+			rule.Freq = Frequency(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return rule, fmt.Errorf("INTERVAL %q is not a number", value)
+			}
+			rule.Interval = n
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				week, day, err := parseByDay(code)
+				if err != nil {
+					return rule, err
+				}
+				if week != 0 {
+					rule.Week = week
+				}
+				rule.Weekdays = append(rule.Weekdays, day)
+			}
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return rule, fmt.Errorf("BYMONTHDAY %q is not a number", value)
+			}
+			rule.MonthDay = n
+		default:
+			return rule, fmt.Errorf("unsupported part %s (use FREQ, INTERVAL, BYDAY or BYMONTHDAY)", key)
+		}
+	}
+	return rule, nil
+}
+
+// parseByDay splits a BYDAY entry such as TU or 2TU into week and day
+func parseByDay(code string) (int, time.Weekday, error) {
+	if len(code) < 2 {
+		return 0, 0, fmt.Errorf("unknown day %q", code)
+	}
+	day, ok := weekdayCodes[code[len(code)-2:]]
+	if !ok {
+		return 0, 0, fmt.Errorf("unknown day %q", code)
+	}
+	if len(code) == 2 {
+		return 0, day, nil
+	}
+	week, err := strconv.Atoi(code[:len(code)-2])
+	if err != nil || week == 0 {
+		return 0, 0, fmt.Errorf("unknown day %q", code)
+	}
+	return week, day, nil
+}
+
+func parseShort(s string) (Rule, error) {
+	head, spec, hasSpec := strings.Cut(s, " on ")
+	head = strings.TrimSpace(head)
+	rule := Rule{Interval: 1}
+
+	switch head {
+	case "daily", "every day":
+		rule.Freq = Daily
+	case "weekly", "every week":
+		rule.Freq = Weekly
+	case "monthly", "every month":
+		rule.Freq = Monthly
+	case "weekdays", "every weekday":
+		if hasSpec {
+			return rule, errors.New("weekdays takes no days")
+		}
+		return Rule{Freq: Weekly, Interval: 1, Weekdays: workWeek}, nil
+	default:
+		fields := strings.Fields(head)
+		if len(fields) != 3 || fields[0] != "every" {
+			return rule, errors.New("use e.g. daily, weekdays, weekly on mon, monthly on the 1st or an RRULE")
+		}
+		n, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return rule, fmt.Errorf("%q is not a number", fields[1])
+		}
+		rule.Interval = n
+		switch strings.TrimSuffix(fields[2], "s") {
+		case "day":
+			rule.Freq = Daily
+		case "week":
+			rule.Freq = Weekly
+		case "month":
+			rule.Freq = Monthly
+		default:
+			return rule, fmt.Errorf("unknown unit %q (use days, weeks or months)", fields[2])
+		}
+	}
+
+	if !hasSpec {
+		return rule, nil
+	}
+	spec = strings.TrimSpace(spec)
+
+	switch rule.Freq {
+	case Weekly:
+		days, err := parseDayList(spec)
+		if err != nil {
+			return rule, err
+		}
+		rule.Weekdays = days
+	case Monthly:
+		if err := parseMonthSpec(&rule, spec); err != nil {
+			return rule, err
+		}
+	default:
+		return rule, errors.New("daily rules take no days")
+	}
+	return rule, nil
+}
+
+// parseDayList reads "mon,thu", "mon and thu", "weekdays" or "weekends"
+func parseDayList(s string) ([]time.Weekday, error) {
+	switch s {
+	case "weekdays":
+		return workWeek, nil
+	case "weekends":
+		return []time.Weekday{time.Saturday, time.Sunday}, nil
+	}
+
+	var days []time.Weekday
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if name == "and" {
+			continue
+		}
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q", name)
+		}
+		days = append(days, day)
+	}
+	if len(days) == 0 {
+		return nil, errors.New("no days given")
+	}
+	return days, nil
+}
+
+// parseMonthSpec reads "15", "the 15th", "the last day", "the 2nd tue" or
+// "the last fri"
+func parseMonthSpec(rule *Rule, s string) error {
+	fields := strings.Fields(strings.TrimPrefix(s, "the "))
+	if len(fields) == 0 || len(fields) > 2 {
+		return fmt.Errorf("unknown day of month %q", s)
+	}
+
+	n := -1
+	if fields[0] != "last" {
+		var err error
+		n, err = strconv.Atoi(strings.TrimRight(fields[0], "stndrh"))
+		if err != nil {
+			return fmt.Errorf("unknown day of month %q", s)
+		}
+	}
+
+	if len(fields) == 1 || fields[1] == "day" {
+		rule.MonthDay = n
+		return nil
+	}
+
+	day, ok := parseWeekday(fields[1])
+	if !ok {
+		return fmt.Errorf("unknown day %q", fields[1])
+	}
+	rule.Week = n
+	rule.Weekdays = []time.Weekday{day}
+	return nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if s == name || s == name[:3] {
+			return day, true
+		}
+	}
+	return 0, false
+}
+
+func (r Rule) validate() error {
+	if r.Interval < 1 || r.Interval > maxInterval {
+		return fmt.Errorf("interval must be between 1 and %d", maxInterval)
+	}
+
+	switch r.Freq {
+	case Daily:
+		if len(r.Weekdays) > 0 || r.MonthDay != 0 {
+			return errors.New("daily rules take no days")
+		}
+	case Weekly:
+		if r.Week != 0 || r.MonthDay != 0 {
+			return errors.New("weekly rules take plain days of the week")
+		}
+	case Monthly:
+		switch {
+		case r.MonthDay != 0 && len(r.Weekdays) > 0:
+			return errors.New("use either a day of the month or a weekday, not both")
+		case r.MonthDay != 0 && (r.MonthDay < -1 || r.MonthDay > 31):
+			return errors.New("day of month must be between 1 and 31, or last")
+		case len(r.Weekdays) > 1:
+			return errors.New("monthly rules take a single weekday, e.g. the 2nd tue")
+		case len(r.Weekdays) == 1 && (r.Week < -1 || r.Week == 0 || r.Week > 4):
+			return errors.New("the week of the month must be 1st to 4th, or last")
+		}
+	case "":
+		return errors.New("missing FREQ")
+	default:
+		return fmt.Errorf("unsupported frequency %s (use DAILY, WEEKLY or MONTHLY)", r.Freq)
+	}
+	return nil
+}
+
+// normalize sorts the weekdays Monday first and drops duplicates
+func (r *Rule) normalize() {
+	r.Weekdays = slices.Clone(r.Weekdays)
+	slices.SortFunc(r.Weekdays, func(a, b time.Weekday) int {
+		return mondayIndex(a) - mondayIndex(b)
+	})
+	r.Weekdays = slices.Compact(r.Weekdays)
+}
+
+// String renders the rule as an RRULE, e.g. FREQ=WEEKLY;BYDAY=MO,TH.
+// Parse reads it back unchanged.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		codes := make([]string, len(r.Weekdays))
+		for i, day := range r.Weekdays {
+			codes[i] = dayCode(day)
+			if r.Week != 0 {
+				codes[i] = strconv.Itoa(r.Week) + codes[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.MonthDay != 0 {
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", r.MonthDay))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Describe renders the rule for people, e.g. "every week on Mon, Thu"
+func (r Rule) Describe() string {
+	units := map[Frequency]string{Daily: "day", Weekly: "week", Monthly: "month"}
+	every := "every " + units[r.Freq]
+	if r.Interval > 1 {
+		every = fmt.Sprintf("every %d %ss", r.Interval, units[r.Freq])
+	}
+
+	switch {
+	case r.Freq == Weekly && r.Interval <= 1 && slices.Equal(r.Weekdays, workWeek):
+		return "every weekday"
+	case r.Freq == Weekly && len(r.Weekdays) > 0:
+		names := make([]string, len(r.Weekdays))
+		for i, day := range r.Weekdays {
+			names[i] = day.String()[:3]
+		}
+		return every + " on " + strings.Join(names, ", ")
+	case r.Freq == Monthly && r.MonthDay == -1:
+		return every + " on the last day"
+	case r.Freq == Monthly && r.MonthDay > 0:
+		return every + " on the " + ordinal(r.MonthDay)
+	case r.Freq == Monthly && len(r.Weekdays) == 1:
+		week := "last"
+		if r.Week > 0 {
+			week = ordinal(r.Week)
+		}
+		return fmt.Sprintf("%s on the %s %s", every, week, r.Weekdays[0])
+	}
+	return every
+}
+
+// Anchor returns the rule pinned to the day of the month of start when it
+// is a monthly rule without a day. Next keeps the day of the previous
+// occurrence for such rules, which drifts once a short month has clamped it
+// (Jan 31, Feb 28, Mar 28, ...); an anchored rule returns to the 31st.
+// Other rules are returned unchanged.
+func (r Rule) Anchor(start time.Time) Rule {
+	if r.Freq == Monthly && r.MonthDay == 0 && len(r.Weekdays) == 0 {
+		r.MonthDay = start.Day()
+	}
+	return r
+}
+
+// Next returns the first occurrence after the day of prev. prev is taken to
+// be an occurrence itself: rules with an interval count on from it, and
+// rules without days keep its weekday or day of the month (see Anchor).
+func (r Rule) Next(prev time.Time) time.Time {
+	prev = startOfDay(prev)
+	interval := max(r.Interval, 1)
+
+	switch r.Freq {
+	case Weekly:
+		if len(r.Weekdays) == 0 {
+			return prev.AddDate(0, 0, 7*interval)
+		}
+		// The rest of prev's week (weeks start on Monday), then the first
+		// matching day interval weeks on
+		monday := prev.AddDate(0, 0, -mondayIndex(prev.Weekday()))
+		for d := prev.AddDate(0, 0, 1); d.Before(monday.AddDate(0, 0, 7)); d = d.AddDate(0, 0, 1) {
+			if slices.Contains(r.Weekdays, d.Weekday()) {
+				return d
+			}
+		}
+		monday = monday.AddDate(0, 0, 7*interval)
+		for i := range 7 {
+			if d := monday.AddDate(0, 0, i); slices.Contains(r.Weekdays, d.Weekday()) {
+				return d
+			}
+		}
+	case Monthly:
+		month := time.Date(prev.Year(), prev.Month(), 1, 0, 0, 0, 0, prev.Location())
+		if d := r.dayInMonth(month, prev.Day()); d.After(prev) {
+			return d
+		}
+		return r.dayInMonth(month.AddDate(0, interval, 0), prev.Day())
+	}
+	return prev.AddDate(0, 0, interval)
+}
+
+// dayInMonth returns the rule's day in the month starting at first. Days
+// past the end of a short month fall on its last day.
+func (r Rule) dayInMonth(first time.Time, fallbackDay int) time.Time {
+	last := first.AddDate(0, 1, -1)
+
+	if len(r.Weekdays) == 1 {
+		day := r.Weekdays[0]
+		if r.Week < 0 {
+			offset := (int(last.Weekday()) - int(day) + 7) % 7
+			return last.AddDate(0, 0, -offset)
+		}
+		offset := (int(day) - int(first.Weekday()) + 7) % 7
+		return first.AddDate(0, 0, offset+7*(r.Week-1))
+	}
+
+	n := r.MonthDay
+	if n == 0 {
+		n = fallbackDay
+	}
+	if n < 0 || n > last.Day() {
+		return last
+	}
+	return first.AddDate(0, 0, n-1)
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+// mondayIndex numbers the days of the week from Monday (0) to Sunday (6)
+func mondayIndex(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+func dayCode(day time.Weekday) string {
+	return strings.ToUpper(day.String()[:2])
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
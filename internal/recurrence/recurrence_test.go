@@ -0,0 +1,156 @@
+package recurrence
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input    string
+		rrule    string
+		describe string
+	}{
+		{"daily", "FREQ=DAILY", "every day"},
+		{"every 3 days", "FREQ=DAILY;INTERVAL=3", "every 3 days"},
+		{"Weekdays", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", "every weekday"},
+		{"weekly on weekdays", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", "every weekday"},
+		{"weekly", "FREQ=WEEKLY", "every week"},
+		{"weekly on thu, mon", "FREQ=WEEKLY;BYDAY=MO,TH", "every week on Mon, Thu"},
+		{"every 2 weeks on friday", "FREQ=WEEKLY;INTERVAL=2;BYDAY=FR", "every 2 weeks on Fri"},
+		{"monthly", "FREQ=MONTHLY", "every month"},
+		{"monthly on the 1st", "FREQ=MONTHLY;BYMONTHDAY=1", "every month on the 1st"},
+		{"monthly on 22", "FREQ=MONTHLY;BYMONTHDAY=22", "every month on the 22nd"},
+		{"monthly on the last day", "FREQ=MONTHLY;BYMONTHDAY=-1", "every month on the last day"},
+		{"monthly on the 2nd tue", "FREQ=MONTHLY;BYDAY=2TU", "every month on the 2nd Tuesday"},
+		{"every 3 months on the last fri", "FREQ=MONTHLY;INTERVAL=3;BYDAY=-1FR", "every 3 months on the last Friday"},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TH,MO", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH", "every 2 weeks on Mon, Thu"},
+		{"freq=monthly;bymonthday=15", "FREQ=MONTHLY;BYMONTHDAY=15", "every month on the 15th"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rule, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got := rule.String(); got != tt.rrule {
+				t.Errorf("Parse(%q).String() = %q, want %q", tt.input, got, tt.rrule)
+			}
+			if got := rule.Describe(); got != tt.describe {
+				t.Errorf("Parse(%q).Describe() = %q, want %q", tt.input, got, tt.describe)
+			}
+
+			again, err := Parse(rule.String())
+			if err != nil || again.String() != rule.String() {
+				t.Errorf("Parse(%q) did not round-trip: %q, %v", rule.String(), again.String(), err)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	inputs := []string{
+		"",
+		"sometimes",
+		"yearly",
+		"every 0 days",
+		"every 2 fortnights",
+		"daily on mon",
+		"weekly on funday",
+		"monthly on the 32nd",
+		"monthly on the 5th tue",
+		"FREQ=YEARLY",
+		"FREQ=WEEKLY;BYDAY=2MO",
+		"FREQ=MONTHLY;BYDAY=MO",
+		"FREQ=MONTHLY;BYMONTHDAY=1;BYDAY=1MO",
+		"FREQ=DAILY;COUNT=3",
+		"INTERVAL=2",
+	}
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := Parse(input); !errors.Is(err, ErrInvalidRule) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidRule", input, err)
+			}
+		})
+	}
+}
+
+func TestAnchor(t *testing.T) {
+	rule, err := Parse("monthly")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	anchored := rule.Anchor(day(2027, 1, 31))
+	if got := anchored.String(); got != "FREQ=MONTHLY;BYMONTHDAY=31" {
+		t.Errorf("Anchor().String() = %q, want FREQ=MONTHLY;BYMONTHDAY=31", got)
+	}
+
+	// The day survives a short month instead of drifting to the 28th
+	occurrence := day(2027, 1, 31)
+	for _, want := range []time.Time{day(2027, 2, 28), day(2027, 3, 31), day(2027, 4, 30)} {
+		occurrence = anchored.Next(occurrence)
+		if !occurrence.Equal(want) {
+			t.Errorf("Next() = %s, want %s", occurrence.Format("2006-01-02"), want.Format("2006-01-02"))
+		}
+	}
+
+	// Rules with a day are left alone
+	for _, s := range []string{"monthly on the 1st", "monthly on the 2nd tue", "weekly", "daily"} {
+		rule, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", s, err)
+		}
+		if got := rule.Anchor(day(2027, 1, 31)).String(); got != rule.String() {
+			t.Errorf("Anchor() of %q = %q, want %q", s, got, rule.String())
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	// 2026-10-14 is a Wednesday
+	tests := []struct {
+		rule string
+		prev time.Time
+		want time.Time
+	}{
+		{"daily", day(2026, 10, 14), day(2026, 10, 15)},
+		{"every 3 days", day(2026, 10, 30), day(2026, 11, 2)},
+		{"weekly", day(2026, 10, 14), day(2026, 10, 21)},
+		{"weekdays", day(2026, 10, 16), day(2026, 10, 19)},
+		{"weekly on mon,thu", day(2026, 10, 14), day(2026, 10, 15)},
+		{"weekly on mon,thu", day(2026, 10, 15), day(2026, 10, 19)},
+		{"every 2 weeks on mon,thu", day(2026, 10, 15), day(2026, 10, 26)},
+		{"every 2 weeks on mon,thu", day(2026, 10, 26), day(2026, 10, 29)},
+		{"monthly on the 1st", day(2026, 10, 14), day(2026, 11, 1)},
+		{"monthly on the 1st", day(2026, 11, 1), day(2026, 12, 1)},
+		{"monthly on the 20th", day(2026, 10, 14), day(2026, 10, 20)},
+		{"monthly on 31", day(2027, 1, 31), day(2027, 2, 28)},
+		{"monthly on 31", day(2027, 2, 28), day(2027, 3, 31)},
+		{"monthly on the last day", day(2026, 10, 31), day(2026, 11, 30)},
+		{"monthly on the 2nd tue", day(2026, 10, 14), day(2026, 11, 10)},
+		{"monthly on the 2nd tue", day(2026, 10, 1), day(2026, 10, 13)},
+		{"monthly on the last fri", day(2026, 10, 14), day(2026, 10, 30)},
+		{"every 3 months on the 1st", day(2026, 10, 1), day(2027, 1, 1)},
+		{"monthly", day(2026, 10, 14), day(2026, 11, 14)},
+		{"daily", time.Date(2026, 10, 14, 15, 30, 0, 0, time.UTC), day(2026, 10, 15)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rule+" after "+tt.prev.Format("2006-01-02"), func(t *testing.T) {
+			rule, err := Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.rule, err)
+			}
+			if got := rule.Next(tt.prev); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.prev.Format("2006-01-02"), got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+			}
+		})
+	}
+}
@@ -9,10 +9,12 @@ var (
 	ErrNameTooLong      = errors.New("name cannot exceed 50 characters")
 	ErrInvalidColumnID  = errors.New("invalid column ID")
 	ErrInvalidProjectID = errors.New("invalid project ID")
+	ErrInvalidWIPLimit  = errors.New("WIP limit cannot be negative")
 
 	// Business logic errors
 	ErrColumnNotFound         = errors.New("column not found")
 	ErrColumnHasTasks         = errors.New("cannot delete column with tasks")
+	ErrColumnExists           = errors.New("column already exists")
 	ErrCompletedColumnExists  = errors.New("a completed column already exists for this project")
 	ErrReadyColumnExists      = errors.New("a ready column already exists for this project")
 	ErrInProgressColumnExists = errors.New("an in-progress column already exists for this project")
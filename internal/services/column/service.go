@@ -3,7 +3,9 @@ package column
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/thenoetrevino/paso/internal/converters"
 	"github.com/thenoetrevino/paso/internal/database"
@@ -24,7 +26,9 @@ type Service interface {
 	SetHoldsReadyTasks(ctx context.Context, columnID int) (*models.Column, error)
 	SetHoldsCompletedTasks(ctx context.Context, columnID int, force bool) (*models.Column, error)
 	SetHoldsInProgressTasks(ctx context.Context, columnID int) (*models.Column, error)
+	SetWIPLimit(ctx context.Context, columnID, limit int) (*models.Column, error)
 	DeleteColumn(ctx context.Context, id int) error
+	RestoreColumn(ctx context.Context, column *models.Column) error
 }
 
 // CreateColumnRequest encapsulates data for creating a column
@@ -177,7 +181,12 @@ func (s *service) CreateColumn(ctx context.Context, req CreateColumnRequest) (*m
 			}
 		}
 
-		return nil
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: int64(req.ProjectID),
+			EventType: models.TaskEventColumnCreated,
+			Field:     "name",
+			NewValue:  req.Name,
+		})
 	})
 
 	if err != nil {
@@ -185,7 +194,7 @@ func (s *service) CreateColumn(ctx context.Context, req CreateColumnRequest) (*m
 	}
 
 	// Publish event after successful commit
-	s.publishColumnEvent(ctx, int(column.ID), int(column.ProjectID))
+	s.publishColumnEvent(events.EventColumnCreated, int(column.ID), int(column.ProjectID))
 
 	return converters.ColumnToModel(column), nil
 }
@@ -209,16 +218,31 @@ func (s *service) UpdateColumnName(ctx context.Context, id int, name string) err
 		return fmt.Errorf("failed to get column: %w", err)
 	}
 
-	// Update column
-	if err := s.queries.UpdateColumnName(ctx, generated.UpdateColumnNameParams{
-		Name: name,
-		ID:   int64(id),
-	}); err != nil {
-		return fmt.Errorf("failed to update column: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Update column
+		if err := qtx.UpdateColumnName(ctx, generated.UpdateColumnNameParams{
+			Name: name,
+			ID:   int64(id),
+		}); err != nil {
+			return fmt.Errorf("failed to update column: %w", err)
+		}
+
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: column.ProjectID,
+			EventType: models.TaskEventColumnRenamed,
+			Field:     "name",
+			OldValue:  column.Name,
+			NewValue:  name,
+		})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Publish event
-	s.publishColumnEvent(ctx, id, int(column.ProjectID))
+	s.publishColumnEvent(events.EventColumnRenamed, id, int(column.ProjectID))
 
 	return nil
 }
@@ -235,6 +259,20 @@ const (
 	stateInProgress
 )
 
+// String returns the name used for the state in the activity log
+func (t specialColumnStateType) String() string {
+	switch t {
+	case stateReady:
+		return "ready"
+	case stateCompleted:
+		return "completed"
+	case stateInProgress:
+		return "in_progress"
+	default:
+		return "unknown"
+	}
+}
+
 // setSpecialColumnState is a parametrized helper function that sets a column's special state.
 // It handles the common pattern of:
 // 1. Validating the column ID
@@ -284,7 +322,12 @@ func (s *service) setSpecialColumnState(ctx context.Context, columnID int, state
 			return err
 		}
 
-		return nil
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: column.ProjectID,
+			EventType: models.TaskEventColumnStateChanged,
+			Field:     stateType.String(),
+			NewValue:  column.Name,
+		})
 	})
 
 	if err != nil {
@@ -292,7 +335,7 @@ func (s *service) setSpecialColumnState(ctx context.Context, columnID int, state
 	}
 
 	// Publish event
-	s.publishColumnEvent(ctx, columnID, int(column.ProjectID))
+	s.publishColumnEvent(events.EventColumnUpdated, columnID, int(column.ProjectID))
 
 	// Return updated column
 	return s.GetColumnByID(ctx, columnID)
@@ -402,6 +445,65 @@ func (s *service) SetHoldsInProgressTasks(ctx context.Context, columnID int) (*m
 	return s.setSpecialColumnState(ctx, columnID, stateInProgress, false)
 }
 
+// SetWIPLimit sets the maximum number of tasks a column may hold; 0 removes
+// the limit. Tasks already over a new limit stay where they are.
+func (s *service) SetWIPLimit(ctx context.Context, columnID, limit int) (*models.Column, error) {
+	if columnID <= 0 {
+		return nil, ErrInvalidColumnID
+	}
+	if limit < 0 {
+		return nil, ErrInvalidWIPLimit
+	}
+
+	column, err := s.queries.GetColumnByID(ctx, int64(columnID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrColumnNotFound
+		}
+		return nil, fmt.Errorf("failed to get column: %w", err)
+	}
+
+	oldLimit := column.WipLimit
+	column.WipLimit = sql.NullInt64{Int64: int64(limit), Valid: limit > 0}
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := qtx.UpdateColumnWipLimit(ctx, generated.UpdateColumnWipLimitParams{
+			WipLimit: column.WipLimit,
+			ID:       column.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to update WIP limit: %w", err)
+		}
+
+		if oldLimit == column.WipLimit {
+			return nil
+		}
+		// Field names the column, as a limit means nothing without it
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: column.ProjectID,
+			EventType: models.TaskEventColumnWIPLimitSet,
+			Field:     column.Name,
+			OldValue:  wipLimitValue(oldLimit),
+			NewValue:  wipLimitValue(column.WipLimit),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishColumnEvent(events.EventColumnUpdated, columnID, int(column.ProjectID))
+
+	return converters.ColumnFromIDRowToModel(column), nil
+}
+
+// wipLimitValue renders a WIP limit for the activity log
+func wipLimitValue(limit sql.NullInt64) string {
+	if !limit.Valid {
+		return "none"
+	}
+	return strconv.FormatInt(limit.Int64, 10)
+}
+
 // DeleteColumn deletes a column (business rule: must not have tasks)
 func (s *service) DeleteColumn(ctx context.Context, id int) error {
 	if id <= 0 {
@@ -459,12 +561,22 @@ func (s *service) DeleteColumn(ctx context.Context, id int) error {
 			}
 		}
 
+		column, err := qtx.GetColumnByID(ctx, int64(id))
+		if err != nil {
+			return fmt.Errorf("failed to get column: %w", err)
+		}
+
 		// Delete column
 		if err := qtx.DeleteColumn(ctx, int64(id)); err != nil {
 			return fmt.Errorf("failed to delete column: %w", err)
 		}
 
-		return nil
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: int64(projectID),
+			EventType: models.TaskEventColumnDeleted,
+			Field:     "name",
+			OldValue:  column.Name,
+		})
 	})
 
 	if err != nil {
@@ -472,11 +584,141 @@ func (s *service) DeleteColumn(ctx context.Context, id int) error {
 	}
 
 	// Publish event after successful deletion
-	s.publishColumnEvent(ctx, id, projectID)
+	s.publishColumnEvent(events.EventColumnDeleted, id, projectID)
+
+	return nil
+}
+
+// RestoreColumn re-creates a deleted column under its original ID. It goes
+// back after its old previous column, or before its old next column if the
+// previous one is gone too, and at the end of the board otherwise.
+func (s *service) RestoreColumn(ctx context.Context, column *models.Column) error {
+	if column == nil || column.ID <= 0 {
+		return ErrInvalidColumnID
+	}
+	if column.ProjectID <= 0 {
+		return ErrInvalidProjectID
+	}
 
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if _, err := qtx.GetColumnLinkedListInfo(ctx, int64(column.ID)); err == nil {
+			return ErrColumnExists
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to check column: %w", err)
+		}
+
+		prevID, nextID, err := restoreNeighbours(ctx, qtx, column)
+		if err != nil {
+			return err
+		}
+
+		if column.HoldsReadyTasks {
+			if err := qtx.ClearReadyColumnByProject(ctx, int64(column.ProjectID)); err != nil {
+				return fmt.Errorf("failed to clear existing ready column: %w", err)
+			}
+		}
+		if column.HoldsInProgressTasks {
+			if err := qtx.ClearInProgressColumnByProject(ctx, int64(column.ProjectID)); err != nil {
+				return fmt.Errorf("failed to clear existing in-progress column: %w", err)
+			}
+		}
+		if column.HoldsCompletedTasks {
+			existing, err := qtx.GetCompletedColumnByProject(ctx, int64(column.ProjectID))
+			if err == nil {
+				return fmt.Errorf("%w: column '%s' (ID: %d)", ErrCompletedColumnExists, existing.Name, existing.ID)
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to check for existing completed column: %w", err)
+			}
+		}
+
+		if err := qtx.RestoreColumn(ctx, generated.RestoreColumnParams{
+			ID:                   int64(column.ID),
+			Name:                 column.Name,
+			ProjectID:            int64(column.ProjectID),
+			PrevID:               prevID,
+			NextID:               nextID,
+			HoldsReadyTasks:      column.HoldsReadyTasks,
+			HoldsCompletedTasks:  column.HoldsCompletedTasks,
+			HoldsInProgressTasks: column.HoldsInProgressTasks,
+			WipLimit:             sql.NullInt64{Int64: int64(column.WIPLimit), Valid: column.WIPLimit > 0},
+		}); err != nil {
+			return fmt.Errorf("failed to restore column: %w", err)
+		}
+
+		if prevID != nil {
+			if err := qtx.UpdateColumnNextID(ctx, generated.UpdateColumnNextIDParams{
+				NextID: int64(column.ID),
+				ID:     prevID.(int64),
+			}); err != nil {
+				return fmt.Errorf("failed to update prev column: %w", err)
+			}
+		}
+		if nextID != nil {
+			if err := qtx.UpdateColumnPrevID(ctx, generated.UpdateColumnPrevIDParams{
+				PrevID: int64(column.ID),
+				ID:     nextID.(int64),
+			}); err != nil {
+				return fmt.Errorf("failed to update next column: %w", err)
+			}
+		}
+
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: int64(column.ProjectID),
+			EventType: models.TaskEventColumnRestored,
+			Field:     "name",
+			NewValue:  column.Name,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishColumnEvent(events.EventColumnCreated, column.ID, column.ProjectID)
 	return nil
 }
 
+// restoreNeighbours finds the columns a restored column goes between,
+// preferring its old neighbours when they still exist in the project
+func restoreNeighbours(ctx context.Context, qtx generated.Querier, column *models.Column) (prevID, nextID interface{}, err error) {
+	if column.PrevID != nil {
+		info, err := qtx.GetColumnLinkedListInfo(ctx, int64(*column.PrevID))
+		if err == nil && int(info.ProjectID) == column.ProjectID {
+			if next := database.AnyToIntPtr(info.NextID); next != nil {
+				return int64(*column.PrevID), int64(*next), nil
+			}
+			return int64(*column.PrevID), nil, nil
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, fmt.Errorf("failed to get previous column: %w", err)
+		}
+	}
+
+	if column.NextID != nil {
+		info, err := qtx.GetColumnLinkedListInfo(ctx, int64(*column.NextID))
+		if err == nil && int(info.ProjectID) == column.ProjectID {
+			if prev := database.AnyToIntPtr(info.PrevID); prev != nil {
+				return int64(*prev), int64(*column.NextID), nil
+			}
+			return nil, int64(*column.NextID), nil
+		}
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, fmt.Errorf("failed to get next column: %w", err)
+		}
+	}
+
+	tailID, err := qtx.GetTailColumnForProject(ctx, int64(column.ProjectID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, fmt.Errorf("failed to get tail column: %w", err)
+	}
+	if tailID != 0 {
+		return tailID, nil, nil
+	}
+	return nil, nil, nil
+}
+
 // validateCreateColumn validates a CreateColumnRequest
 func (s *service) validateCreateColumn(req CreateColumnRequest) error {
 	if req.Name == "" {
@@ -494,8 +736,8 @@ func (s *service) validateCreateColumn(req CreateColumnRequest) error {
 	return nil
 }
 
-// publishColumnEvent publishes a column event with retry logic
-func (s *service) publishColumnEvent(ctx context.Context, columnID, projectID int) {
+// publishColumnEvent publishes a typed column event with retry logic
+func (s *service) publishColumnEvent(eventType events.EventType, columnID, projectID int) {
 	if s.eventClient == nil {
 		return
 	}
@@ -503,7 +745,8 @@ func (s *service) publishColumnEvent(ctx context.Context, columnID, projectID in
 	// Publish with retry (3 attempts with exponential backoff)
 	// Non-blocking: errors are logged but don't affect the operation
 	_ = events.PublishWithRetry(s.eventClient, events.Event{
-		Type:      events.EventDatabaseChanged,
+		Type:      eventType,
 		ProjectID: projectID,
+		ColumnID:  columnID,
 	}, 3)
 }
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/testutil"
 )
 
@@ -2460,3 +2461,137 @@ func TestCreateColumn_OnlyOneCompletedPerProject(t *testing.T) {
 		t.Errorf("Expected UNIQUE constraint violation, got %v", err)
 	}
 }
+
+func TestSetWIPLimit(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	svc := NewService(db, nil)
+
+	column, err := svc.CreateColumn(context.Background(), CreateColumnRequest{Name: "In Progress", ProjectID: projectID})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	updated, err := svc.SetWIPLimit(context.Background(), column.ID, 3)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if updated.WIPLimit != 3 {
+		t.Errorf("Expected WIP limit 3, got %d", updated.WIPLimit)
+	}
+
+	fetched, err := svc.GetColumnByID(context.Background(), column.ID)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if fetched.WIPLimit != 3 {
+		t.Errorf("Expected stored WIP limit 3, got %d", fetched.WIPLimit)
+	}
+
+	// Zero removes the limit
+	cleared, err := svc.SetWIPLimit(context.Background(), column.ID, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cleared.WIPLimit != 0 {
+		t.Errorf("Expected no WIP limit, got %d", cleared.WIPLimit)
+	}
+
+	// Each change is in the activity log; setting the same limit again is not
+	if _, err := svc.SetWIPLimit(context.Background(), column.ID, 0); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	rows, err := db.QueryContext(context.Background(),
+		"SELECT field, old_value, new_value FROM task_events WHERE project_id = ? AND event_type = ? ORDER BY id",
+		projectID, models.TaskEventColumnWIPLimitSet)
+	if err != nil {
+		t.Fatalf("Failed to query events: %v", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var changes []string
+	for rows.Next() {
+		var field, oldValue, newValue string
+		if err := rows.Scan(&field, &oldValue, &newValue); err != nil {
+			t.Fatalf("Failed to scan event: %v", err)
+		}
+		changes = append(changes, field+": "+oldValue+" → "+newValue)
+	}
+	want := []string{"In Progress: none → 3", "In Progress: 3 → none"}
+	if strings.Join(changes, "; ") != strings.Join(want, "; ") {
+		t.Errorf("Expected WIP limit events %q, got %q", want, changes)
+	}
+}
+
+func TestSetWIPLimit_Invalid(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	svc := NewService(db, nil)
+
+	if _, err := svc.SetWIPLimit(context.Background(), 1, -1); err != ErrInvalidWIPLimit {
+		t.Errorf("Expected ErrInvalidWIPLimit, got %v", err)
+	}
+	if _, err := svc.SetWIPLimit(context.Background(), 999, 2); err != ErrColumnNotFound {
+		t.Errorf("Expected ErrColumnNotFound, got %v", err)
+	}
+}
+
+func TestRestoreColumn(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	var ids []int
+	for _, name := range []string{"To Do", "Review", "Done"} {
+		col, err := svc.CreateColumn(ctx, CreateColumnRequest{Name: name, ProjectID: projectID})
+		if err != nil {
+			t.Fatalf("Failed to create column: %v", err)
+		}
+		ids = append(ids, col.ID)
+	}
+	if _, err := svc.SetWIPLimit(ctx, ids[1], 2); err != nil {
+		t.Fatalf("Failed to set WIP limit: %v", err)
+	}
+
+	deleted, err := svc.GetColumnByID(ctx, ids[1])
+	if err != nil {
+		t.Fatalf("Failed to get column: %v", err)
+	}
+	if err := svc.DeleteColumn(ctx, ids[1]); err != nil {
+		t.Fatalf("Failed to delete column: %v", err)
+	}
+
+	if err := svc.RestoreColumn(ctx, deleted); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if err := svc.RestoreColumn(ctx, deleted); err != ErrColumnExists {
+		t.Errorf("Expected ErrColumnExists on a second restore, got %v", err)
+	}
+
+	columns, err := svc.GetColumnsByProject(ctx, projectID)
+	if err != nil {
+		t.Fatalf("Failed to get columns: %v", err)
+	}
+	if len(columns) != 3 {
+		t.Fatalf("Expected 3 columns, got %d", len(columns))
+	}
+	for i, col := range columns {
+		if col.ID != ids[i] {
+			t.Errorf("Column %d: expected ID %d, got %d (%s)", i, ids[i], col.ID, col.Name)
+		}
+	}
+	if columns[1].Name != "Review" || columns[1].WIPLimit != 2 {
+		t.Errorf("Expected Review with WIP limit 2 back in place, got %+v", columns[1])
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
 	"github.com/thenoetrevino/paso/internal/database/generated"
 	"github.com/thenoetrevino/paso/internal/events"
 	"github.com/thenoetrevino/paso/internal/models"
@@ -89,11 +90,27 @@ func (s *service) CreateLabel(ctx context.Context, req CreateLabelRequest) (*mod
 		return nil, err
 	}
 
-	// Create label
-	label, err := s.queries.CreateLabel(ctx, generated.CreateLabelParams{
-		Name:      req.Name,
-		Color:     req.Color,
-		ProjectID: int64(req.ProjectID),
+	var label generated.Label
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Create label
+		var labelErr error
+		label, labelErr = qtx.CreateLabel(ctx, generated.CreateLabelParams{
+			Name:      req.Name,
+			Color:     req.Color,
+			ProjectID: int64(req.ProjectID),
+		})
+		if labelErr != nil {
+			return labelErr
+		}
+
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: label.ProjectID,
+			EventType: models.TaskEventLabelCreated,
+			Field:     "name",
+			NewValue:  label.Name,
+		})
 	})
 	if err != nil {
 		// Check for unique constraint violation
@@ -104,7 +121,7 @@ func (s *service) CreateLabel(ctx context.Context, req CreateLabelRequest) (*mod
 	}
 
 	// Publish event
-	s.publishLabelEvent(ctx, int(label.ID), int(label.ProjectID))
+	s.publishLabelEvent(events.EventLabelCreated, int(label.ID), int(label.ProjectID))
 
 	return converters.LabelToModel(label), nil
 }
@@ -147,17 +164,45 @@ func (s *service) UpdateLabel(ctx context.Context, req UpdateLabelRequest) error
 		color = *req.Color
 	}
 
-	// Update label
-	if err := s.queries.UpdateLabel(ctx, generated.UpdateLabelParams{
-		ID:    int64(req.ID),
-		Name:  name,
-		Color: color,
-	}); err != nil {
-		return fmt.Errorf("failed to update label: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Update label
+		if err := qtx.UpdateLabel(ctx, generated.UpdateLabelParams{
+			ID:    int64(req.ID),
+			Name:  name,
+			Color: color,
+		}); err != nil {
+			return fmt.Errorf("failed to update label: %w", err)
+		}
+
+		// Record each changed field in the activity log
+		changes := []struct{ field, oldValue, newValue string }{
+			{"name", existing.Name, name},
+			{"color", existing.Color, color},
+		}
+		for _, c := range changes {
+			if c.oldValue == c.newValue {
+				continue
+			}
+			if err := database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+				ProjectID: existing.ProjectID,
+				EventType: models.TaskEventLabelUpdated,
+				Field:     c.field,
+				OldValue:  c.oldValue,
+				NewValue:  c.newValue,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Publish event
-	s.publishLabelEvent(ctx, req.ID, int(existing.ProjectID))
+	s.publishLabelEvent(events.EventLabelUpdated, req.ID, int(existing.ProjectID))
 
 	return nil
 }
@@ -180,13 +225,44 @@ func (s *service) DeleteLabel(ctx context.Context, id int) error {
 
 	projectID := int(existing.ProjectID)
 
-	// Delete label
-	if err := s.queries.DeleteLabel(ctx, int64(id)); err != nil {
-		return fmt.Errorf("failed to delete label: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Deleting a label implicitly detaches it; log that on each affected task
+		taskIDs, err := qtx.GetTaskIDsByLabel(ctx, int64(id))
+		if err != nil {
+			return fmt.Errorf("failed to get tasks for label: %w", err)
+		}
+		for _, taskID := range taskIDs {
+			if err := database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+				ProjectID: existing.ProjectID,
+				TaskID:    taskID,
+				EventType: models.TaskEventLabelDetached,
+				Field:     "label",
+				OldValue:  existing.Name,
+			}); err != nil {
+				return err
+			}
+		}
+
+		// Delete label
+		if err := qtx.DeleteLabel(ctx, int64(id)); err != nil {
+			return fmt.Errorf("failed to delete label: %w", err)
+		}
+
+		return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+			ProjectID: existing.ProjectID,
+			EventType: models.TaskEventLabelDeleted,
+			Field:     "name",
+			OldValue:  existing.Name,
+		})
+	})
+	if err != nil {
+		return err
 	}
 
 	// Publish event
-	s.publishLabelEvent(ctx, id, projectID)
+	s.publishLabelEvent(events.EventLabelDeleted, id, projectID)
 
 	return nil
 }
@@ -208,8 +284,8 @@ func (s *service) validateCreateLabel(req CreateLabelRequest) error {
 	return nil
 }
 
-// publishLabelEvent publishes a label event with retry logic
-func (s *service) publishLabelEvent(ctx context.Context, labelID, projectID int) {
+// publishLabelEvent publishes a typed label event with retry logic
+func (s *service) publishLabelEvent(eventType events.EventType, labelID, projectID int) {
 	if s.eventClient == nil {
 		return
 	}
@@ -217,8 +293,9 @@ func (s *service) publishLabelEvent(ctx context.Context, labelID, projectID int)
 	// Publish with retry (3 attempts with exponential backoff)
 	// Non-blocking: errors are logged but don't affect the operation
 	_ = events.PublishWithRetry(s.eventClient, events.Event{
-		Type:      events.EventDatabaseChanged,
+		Type:      eventType,
 		ProjectID: projectID,
+		LabelID:   labelID,
 	}, 3)
 }
 
@@ -0,0 +1,22 @@
+package priority
+
+import "errors"
+
+// Priority-related errors
+var (
+	// Validation errors
+	ErrEmptyName         = errors.New("name cannot be empty")
+	ErrNameTooLong       = errors.New("name cannot exceed 30 characters")
+	ErrInvalidColor      = errors.New("invalid color format (must be hex color like #FFFFFF)")
+	ErrIconTooLong       = errors.New("icon cannot exceed 4 characters")
+	ErrInvalidPriorityID = errors.New("invalid priority ID")
+	ErrInvalidProjectID  = errors.New("invalid project ID")
+
+	// Business logic errors
+	ErrPriorityNotFound = errors.New("priority not found")
+	ErrPriorityExists   = errors.New("a priority with this name already exists in the project")
+	ErrBuiltinPriority  = errors.New("built-in priorities cannot be changed")
+	ErrPriorityInUse    = errors.New("priority is used by tasks; reassign them to another priority first")
+	ErrLastPriority     = errors.New("a project must keep at least one priority")
+	ErrInvalidReassign  = errors.New("tasks can only be reassigned to another priority of the same project")
+)
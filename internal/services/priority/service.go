@@ -0,0 +1,300 @@
+package priority
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// Hex color regex pattern
+var hexColorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Service defines all task priority operations. Every project has its own
+// scale, copied from the built-in trivial…critical levels when the project is
+// created. A higher SortOrder is a higher priority.
+type Service interface {
+	// Read operations
+	GetPrioritiesByProject(ctx context.Context, projectID int) ([]*models.Priority, error)
+
+	// Write operations
+	CreatePriority(ctx context.Context, req CreatePriorityRequest) (*models.Priority, error)
+	UpdatePriority(ctx context.Context, req UpdatePriorityRequest) error
+	DeletePriority(ctx context.Context, req DeletePriorityRequest) error
+}
+
+// CreatePriorityRequest encapsulates data for creating a priority
+type CreatePriorityRequest struct {
+	ProjectID int
+	Name      string
+	Color     string // Hex color like #FF5733
+	Icon      string
+	SortOrder *int // nil appends above the project's highest priority
+}
+
+// UpdatePriorityRequest encapsulates data for updating a priority
+type UpdatePriorityRequest struct {
+	ID        int
+	Name      *string
+	Color     *string
+	Icon      *string
+	SortOrder *int
+}
+
+// DeletePriorityRequest encapsulates data for deleting a priority
+type DeletePriorityRequest struct {
+	ID         int
+	ReassignTo int // Priority the deleted priority's tasks move to; required while tasks use it
+}
+
+// service implements Service interface using SQLC directly
+type service struct {
+	db          *sql.DB
+	queries     generated.Querier
+	eventClient events.EventPublisher
+}
+
+// NewService creates a new priority service
+func NewService(db *sql.DB, eventClient events.EventPublisher) Service {
+	return &service{
+		db:          db,
+		queries:     generated.New(db),
+		eventClient: eventClient,
+	}
+}
+
+// GetPrioritiesByProject retrieves a project's priorities, lowest first
+func (s *service) GetPrioritiesByProject(ctx context.Context, projectID int) ([]*models.Priority, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	pid := sql.NullInt64{Int64: int64(projectID), Valid: true}
+	priorities, err := s.queries.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, err
+	}
+	return converters.PrioritiesToModels(priorities), nil
+}
+
+// CreatePriority adds a priority level to a project
+func (s *service) CreatePriority(ctx context.Context, req CreatePriorityRequest) (*models.Priority, error) {
+	if req.ProjectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if err := validateFields(&req.Name, &req.Color, &req.Icon); err != nil {
+		return nil, err
+	}
+
+	var created generated.Priority
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := database.EnsureProjectTaxonomy(ctx, qtx, int64(req.ProjectID)); err != nil {
+			return err
+		}
+
+		var sortOrder int64
+		if req.SortOrder != nil {
+			sortOrder = int64(*req.SortOrder)
+		} else {
+			pid := sql.NullInt64{Int64: int64(req.ProjectID), Valid: true}
+			existing, err := qtx.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+			if err != nil {
+				return fmt.Errorf("failed to get priorities: %w", err)
+			}
+			if len(existing) > 0 {
+				sortOrder = existing[len(existing)-1].SortOrder
+			}
+			sortOrder++
+		}
+
+		var err error
+		created, err = qtx.CreatePriority(ctx, generated.CreatePriorityParams{
+			ProjectID:   sql.NullInt64{Int64: int64(req.ProjectID), Valid: true},
+			Description: req.Name,
+			Color:       req.Color,
+			Icon:        req.Icon,
+			SortOrder:   sortOrder,
+		})
+		return err
+	})
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, ErrPriorityExists
+		}
+		return nil, fmt.Errorf("failed to create priority: %w", err)
+	}
+
+	s.publishPrioritiesChanged(req.ProjectID)
+
+	return converters.PriorityToModel(created), nil
+}
+
+// UpdatePriority changes a priority's name, colour, icon or position
+func (s *service) UpdatePriority(ctx context.Context, req UpdatePriorityRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidPriorityID
+	}
+
+	existing, err := s.getProjectPriority(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	name, color, icon := existing.Description, existing.Color, existing.Icon
+	if req.Name != nil {
+		name = *req.Name
+	}
+	if req.Color != nil {
+		color = *req.Color
+	}
+	if req.Icon != nil {
+		icon = *req.Icon
+	}
+	if err := validateFields(&name, &color, &icon); err != nil {
+		return err
+	}
+	sortOrder := existing.SortOrder
+	if req.SortOrder != nil {
+		sortOrder = int64(*req.SortOrder)
+	}
+
+	if err := s.queries.UpdatePriority(ctx, generated.UpdatePriorityParams{
+		Description: name,
+		Color:       color,
+		Icon:        icon,
+		SortOrder:   sortOrder,
+		ID:          existing.ID,
+	}); err != nil {
+		if isUniqueConstraintError(err) {
+			return ErrPriorityExists
+		}
+		return fmt.Errorf("failed to update priority: %w", err)
+	}
+
+	s.publishPrioritiesChanged(int(existing.ProjectID.Int64))
+
+	return nil
+}
+
+// DeletePriority removes a priority. Tasks using it are moved to
+// req.ReassignTo; a priority still in use without a reassignment target, or a
+// project's last priority, cannot be deleted.
+func (s *service) DeletePriority(ctx context.Context, req DeletePriorityRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidPriorityID
+	}
+
+	existing, err := s.getProjectPriority(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	projectID := existing.ProjectID
+
+	count, err := s.queries.CountPrioritiesByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to count priorities: %w", err)
+	}
+	if count <= 1 {
+		return ErrLastPriority
+	}
+
+	if req.ReassignTo > 0 {
+		target, err := s.queries.GetPriorityByID(ctx, int64(req.ReassignTo))
+		if err != nil || target.ProjectID != projectID || target.ID == existing.ID {
+			return ErrInvalidReassign
+		}
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		used, err := qtx.CountTasksWithPriority(ctx, existing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count tasks: %w", err)
+		}
+		if used > 0 {
+			if req.ReassignTo <= 0 {
+				return ErrPriorityInUse
+			}
+			if err := qtx.ReassignProjectTasksPriority(ctx, generated.ReassignProjectTasksPriorityParams{
+				PriorityID:   int64(req.ReassignTo),
+				PriorityID_2: existing.ID,
+				ProjectID:    projectID.Int64,
+			}); err != nil {
+				return fmt.Errorf("failed to reassign tasks: %w", err)
+			}
+		}
+
+		return qtx.DeletePriority(ctx, existing.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishPrioritiesChanged(int(projectID.Int64))
+
+	return nil
+}
+
+// getProjectPriority loads a priority that belongs to a project
+func (s *service) getProjectPriority(ctx context.Context, id int) (generated.Priority, error) {
+	existing, err := s.queries.GetPriorityByID(ctx, int64(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return existing, ErrPriorityNotFound
+	}
+	if err != nil {
+		return existing, fmt.Errorf("failed to get priority: %w", err)
+	}
+	if !existing.ProjectID.Valid {
+		return existing, ErrBuiltinPriority
+	}
+	return existing, nil
+}
+
+// validateFields trims and validates a priority's name, colour and icon
+func validateFields(name, color, icon *string) error {
+	*name = strings.TrimSpace(*name)
+	if *name == "" {
+		return ErrEmptyName
+	}
+	if utf8.RuneCountInString(*name) > 30 {
+		return ErrNameTooLong
+	}
+	if !hexColorRegex.MatchString(*color) {
+		return ErrInvalidColor
+	}
+	if utf8.RuneCountInString(*icon) > 4 {
+		return ErrIconTooLong
+	}
+	return nil
+}
+
+// publishPrioritiesChanged tells clients to reload a project's priorities
+func (s *service) publishPrioritiesChanged(projectID int) {
+	if s.eventClient == nil {
+		return
+	}
+
+	// Publish with retry (3 attempts with exponential backoff)
+	// Non-blocking: errors are logged but don't affect the operation
+	_ = events.PublishWithRetry(s.eventClient, events.Event{
+		Type:      events.EventProjectUpdated,
+		ProjectID: projectID,
+		Fields:    []string{"priorities"},
+	}, 3)
+}
+
+// isUniqueConstraintError checks if an error is a SQLite unique constraint violation
+func isUniqueConstraintError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
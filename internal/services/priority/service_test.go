@@ -0,0 +1,80 @@
+package priority
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+func TestPriorities_ThreeLevelScale(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "Research")
+
+	// Add the new scale, then fold the built-in levels into it
+	scale := []string{"later", "soon", "now"}
+	created := make(map[string]int, len(scale))
+	for i, name := range scale {
+		order := 10 + i
+		p, err := svc.CreatePriority(ctx, CreatePriorityRequest{ProjectID: projectID, Name: name, Color: "#3B82F6", SortOrder: &order})
+		if err != nil {
+			t.Fatalf("CreatePriority(%s) error = %v", name, err)
+		}
+		created[name] = p.ID
+	}
+
+	priorities, err := svc.GetPrioritiesByProject(ctx, projectID)
+	if err != nil {
+		t.Fatalf("GetPrioritiesByProject() error = %v", err)
+	}
+	for _, p := range priorities {
+		if _, ok := created[p.Description]; ok {
+			continue
+		}
+		if err := svc.DeletePriority(ctx, DeletePriorityRequest{ID: p.ID, ReassignTo: created["soon"]}); err != nil {
+			t.Fatalf("DeletePriority(%s) error = %v", p.Description, err)
+		}
+	}
+
+	priorities, err = svc.GetPrioritiesByProject(ctx, projectID)
+	if err != nil {
+		t.Fatalf("GetPrioritiesByProject() error = %v", err)
+	}
+	if len(priorities) != 3 {
+		t.Fatalf("got %d priorities, want 3", len(priorities))
+	}
+	for i, name := range scale {
+		if priorities[i].Description != name {
+			t.Errorf("priorities[%d] = %s, want %s", i, priorities[i].Description, name)
+		}
+	}
+}
+
+func TestUpdatePriority_Validation(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "Research")
+
+	p, err := svc.CreatePriority(ctx, CreatePriorityRequest{ProjectID: projectID, Name: "now", Color: "#EF4444"})
+	if err != nil {
+		t.Fatalf("CreatePriority() error = %v", err)
+	}
+
+	bad, empty, dup := "red", "", "high"
+	if err := svc.UpdatePriority(ctx, UpdatePriorityRequest{ID: p.ID, Color: &bad}); !errors.Is(err, ErrInvalidColor) {
+		t.Errorf("UpdatePriority(color) error = %v, want ErrInvalidColor", err)
+	}
+	if err := svc.UpdatePriority(ctx, UpdatePriorityRequest{ID: p.ID, Name: &empty}); !errors.Is(err, ErrEmptyName) {
+		t.Errorf("UpdatePriority(name) error = %v, want ErrEmptyName", err)
+	}
+	if err := svc.UpdatePriority(ctx, UpdatePriorityRequest{ID: p.ID, Name: &dup}); !errors.Is(err, ErrPriorityExists) {
+		t.Errorf("UpdatePriority(duplicate) error = %v, want ErrPriorityExists", err)
+	}
+	if err := svc.UpdatePriority(ctx, UpdatePriorityRequest{ID: 9999, Name: &dup}); !errors.Is(err, ErrPriorityNotFound) {
+		t.Errorf("UpdatePriority(missing) error = %v, want ErrPriorityNotFound", err)
+	}
+}
@@ -8,9 +8,15 @@ var (
 	ErrEmptyName        = errors.New("name cannot be empty")
 	ErrNameTooLong      = errors.New("name cannot exceed 50 characters")
 	ErrInvalidProjectID = errors.New("invalid project ID")
+	ErrInvalidExport    = errors.New("invalid project export")
+	ErrInvalidTemplate  = errors.New("invalid project template")
+	ErrInvalidTicketKey = errors.New("ticket key must be a letter followed by up to 9 letters or digits")
 
 	// Business logic errors
 	ErrProjectNotFound   = errors.New("project not found")
 	ErrProjectHasColumns = errors.New("cannot delete project with columns")
 	ErrProjectHasTasks   = errors.New("cannot delete project with tasks")
+	ErrTemplateNotFound  = errors.New("project template not found")
+	ErrTemplateExists    = errors.New("project template already exists")
+	ErrTicketKeyTaken    = errors.New("ticket key is already used by another project")
 )
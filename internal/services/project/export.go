@@ -0,0 +1,940 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/recurrence"
+)
+
+// ExportVersion is the version of the export format written by ExportProject.
+// ImportProject rejects exports with a newer version.
+const ExportVersion = 1
+
+// Export is a self-contained copy of a project, as written by
+// paso project export. IDs are the ones in the exporting database and are
+// only used to link entries to each other; ImportProject assigns new ones.
+type Export struct {
+	Version    int                `json:"version" yaml:"version"`
+	ExportedAt time.Time          `json:"exported_at" yaml:"exported_at"`
+	Project    ExportedProject    `json:"project" yaml:"project"`
+	Columns    []ExportedColumn   `json:"columns" yaml:"columns"` // In board order (head of the linked list first)
+	Labels     []ExportedLabel    `json:"labels" yaml:"labels"`
+	Types      []ExportedType     `json:"types,omitempty" yaml:"types,omitempty"`           // In sort order
+	Priorities []ExportedPriority `json:"priorities,omitempty" yaml:"priorities,omitempty"` // In sort order, lowest first
+	Tasks      []ExportedTask     `json:"tasks" yaml:"tasks"`
+	Relations  []ExportedRelation `json:"relations" yaml:"relations"`
+
+	// RelationTypes holds the project's own relation types; the built-in
+	// ones are not exported
+	RelationTypes []ExportedRelationType `json:"relation_types,omitempty" yaml:"relation_types,omitempty"`
+
+	// TaskTemplates holds the project's task templates, ordered by name
+	TaskTemplates []models.TaskTemplate `json:"task_templates,omitempty" yaml:"task_templates,omitempty"`
+}
+
+// ExportedProject holds the project's own fields
+type ExportedProject struct {
+	Name             string `json:"name" yaml:"name"`
+	Description      string `json:"description,omitempty" yaml:"description,omitempty"`
+	TicketKey        string `json:"ticket_key,omitempty" yaml:"ticket_key,omitempty"`
+	NextTicketNumber int    `json:"next_ticket_number" yaml:"next_ticket_number"`
+}
+
+// ExportedColumn is a column of the project
+type ExportedColumn struct {
+	ID                   int    `json:"id" yaml:"id"`
+	Name                 string `json:"name" yaml:"name"`
+	HoldsReadyTasks      bool   `json:"holds_ready_tasks,omitempty" yaml:"holds_ready_tasks,omitempty"`
+	HoldsInProgressTasks bool   `json:"holds_in_progress_tasks,omitempty" yaml:"holds_in_progress_tasks,omitempty"`
+	HoldsCompletedTasks  bool   `json:"holds_completed_tasks,omitempty" yaml:"holds_completed_tasks,omitempty"`
+	WIPLimit             int    `json:"wip_limit,omitempty" yaml:"wip_limit,omitempty"`
+}
+
+// ExportedLabel is a label of the project
+type ExportedLabel struct {
+	ID    int    `json:"id" yaml:"id"`
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+}
+
+// ExportedType is a task type of the project
+type ExportedType struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+	Icon  string `json:"icon,omitempty" yaml:"icon,omitempty"`
+}
+
+// ExportedPriority is a priority level of the project
+type ExportedPriority struct {
+	Name  string `json:"name" yaml:"name"`
+	Color string `json:"color" yaml:"color"`
+	Icon  string `json:"icon,omitempty" yaml:"icon,omitempty"`
+}
+
+// ExportedTask is a task with its labels, comments and checklist
+type ExportedTask struct {
+	ID           int                     `json:"id" yaml:"id"`
+	TicketNumber int                     `json:"ticket_number,omitempty" yaml:"ticket_number,omitempty"`
+	Title        string                  `json:"title" yaml:"title"`
+	Description  string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	ColumnID     int                     `json:"column_id" yaml:"column_id"`
+	Position     int                     `json:"position" yaml:"position"`
+	Type         string                  `json:"type" yaml:"type"`
+	Priority     string                  `json:"priority" yaml:"priority"`
+	LabelIDs     []int                   `json:"label_ids,omitempty" yaml:"label_ids,omitempty"`
+	DueAt        *time.Time              `json:"due_at,omitempty" yaml:"due_at,omitempty"`
+	StartAt      *time.Time              `json:"start_at,omitempty" yaml:"start_at,omitempty"`
+	ArchivedAt   *time.Time              `json:"archived_at,omitempty" yaml:"archived_at,omitempty"`
+	CreatedAt    time.Time               `json:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time               `json:"updated_at" yaml:"updated_at"`
+	Comments     []ExportedComment       `json:"comments,omitempty" yaml:"comments,omitempty"`
+	Checklist    []ExportedChecklistItem `json:"checklist,omitempty" yaml:"checklist,omitempty"` // In checklist order
+	Recurrence   *ExportedRecurrence     `json:"recurrence,omitempty" yaml:"recurrence,omitempty"`
+}
+
+// ExportedComment is a comment on a task
+type ExportedComment struct {
+	Author    string    `json:"author" yaml:"author"`
+	Message   string    `json:"message" yaml:"message"`
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" yaml:"updated_at"`
+}
+
+// ExportedChecklistItem is an item of a task's checklist
+type ExportedChecklistItem struct {
+	Text     string `json:"text" yaml:"text"`
+	Done     bool   `json:"done,omitempty" yaml:"done,omitempty"`
+	Position int    `json:"position" yaml:"position"`
+}
+
+// ExportedRecurrence is the repeat rule of a recurring task. LastInstanceID is
+// the task generated last, 0 once it has been deleted or left the project.
+type ExportedRecurrence struct {
+	Rule           string    `json:"rule" yaml:"rule"`
+	NextRunAt      time.Time `json:"next_run_at" yaml:"next_run_at"`
+	LastInstanceID int       `json:"last_instance_id,omitempty" yaml:"last_instance_id,omitempty"`
+	Occurrences    int       `json:"occurrences,omitempty" yaml:"occurrences,omitempty"`
+}
+
+// ExportedRelation relates two tasks of the project. RelationTypeID is one of
+// the built-in relation types or the ID of an exported relation type.
+type ExportedRelation struct {
+	ParentID       int `json:"parent_id" yaml:"parent_id"`
+	ChildID        int `json:"child_id" yaml:"child_id"`
+	RelationTypeID int `json:"relation_type_id" yaml:"relation_type_id"`
+}
+
+// ExportedRelationType is a relation type defined by the project
+type ExportedRelationType struct {
+	ID       int    `json:"id" yaml:"id"`
+	Name     string `json:"name" yaml:"name"`
+	Forward  string `json:"forward" yaml:"forward"` // Label shown on the parent's side
+	Reverse  string `json:"reverse" yaml:"reverse"` // Label shown on the child's side
+	Color    string `json:"color" yaml:"color"`
+	Blocking bool   `json:"blocking,omitempty" yaml:"blocking,omitempty"`
+}
+
+// ExportProject returns a copy of a project with its columns, labels, types,
+// priorities, tasks, relations, comments, checklists, recurrence rules, task
+// templates and ticket counter
+func (s *service) ExportProject(ctx context.Context, projectID int) (*Export, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	project, err := s.queries.GetProjectByID(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	nextTicket, err := s.queries.GetNextTicketNumber(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ticket counter: %w", err)
+	}
+
+	export := &Export{
+		Version:    ExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Project: ExportedProject{
+			Name:             project.Name,
+			Description:      database.NullStringToString(project.Description),
+			TicketKey:        database.NullStringToString(project.TicketKey),
+			NextTicketNumber: int(nextTicket.Int64),
+		},
+		Columns:    []ExportedColumn{},
+		Labels:     []ExportedLabel{},
+		Types:      []ExportedType{},
+		Priorities: []ExportedPriority{},
+		Tasks:      []ExportedTask{},
+		Relations:  []ExportedRelation{},
+	}
+
+	columns, err := s.queries.GetColumnsByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get columns: %w", err)
+	}
+	for _, c := range columnsInBoardOrder(columns) {
+		export.Columns = append(export.Columns, ExportedColumn{
+			ID:                   int(c.ID),
+			Name:                 c.Name,
+			HoldsReadyTasks:      c.HoldsReadyTasks,
+			HoldsInProgressTasks: c.HoldsInProgressTasks,
+			HoldsCompletedTasks:  c.HoldsCompletedTasks,
+			WIPLimit:             int(c.WipLimit.Int64),
+		})
+	}
+
+	labels, err := s.queries.GetLabelsByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+	for _, l := range labels {
+		export.Labels = append(export.Labels, ExportedLabel{ID: int(l.ID), Name: l.Name, Color: l.Color})
+	}
+
+	pid := sql.NullInt64{Int64: int64(projectID), Valid: true}
+	types, err := s.queries.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get types: %w", err)
+	}
+	for _, t := range types {
+		export.Types = append(export.Types, ExportedType{Name: t.Description, Color: t.Color, Icon: t.Icon})
+	}
+
+	priorities, err := s.queries.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get priorities: %w", err)
+	}
+	for _, p := range priorities {
+		export.Priorities = append(export.Priorities, ExportedPriority{Name: p.Description, Color: p.Color, Icon: p.Icon})
+	}
+
+	relationTypes, err := s.queries.GetRelationTypesByProject(ctx, pid)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relation types: %w", err)
+	}
+	for _, rt := range relationTypes {
+		if !rt.ProjectID.Valid {
+			continue
+		}
+		export.RelationTypes = append(export.RelationTypes, ExportedRelationType{
+			ID:       int(rt.ID),
+			Name:     rt.Name,
+			Forward:  rt.PToCLabel,
+			Reverse:  rt.CToPLabel,
+			Color:    rt.Color,
+			Blocking: rt.IsBlocking,
+		})
+	}
+
+	typeNames, priorityNames, err := s.lookupNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.queries.GetTasksByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	taskIndex := make(map[int64]int, len(tasks))
+	for _, t := range tasks {
+		taskIndex[t.ID] = len(export.Tasks)
+		export.Tasks = append(export.Tasks, ExportedTask{
+			ID:           int(t.ID),
+			TicketNumber: int(t.TicketNumber.Int64),
+			Title:        t.Title,
+			Description:  database.NullStringToString(t.Description),
+			ColumnID:     int(t.ColumnID),
+			Position:     int(t.Position),
+			Type:         typeNames[t.TypeID],
+			Priority:     priorityNames[t.PriorityID],
+			CreatedAt:    database.NullTimeToTime(t.CreatedAt),
+			UpdatedAt:    database.NullTimeToTime(t.UpdatedAt),
+			DueAt:        converters.NullTimeToPtr(t.DueAt),
+			StartAt:      converters.NullTimeToPtr(t.StartAt),
+			ArchivedAt:   converters.NullTimeToPtr(t.ArchivedAt),
+		})
+	}
+
+	taskLabels, err := s.queries.GetTaskLabelsByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task labels: %w", err)
+	}
+	for _, tl := range taskLabels {
+		if i, ok := taskIndex[tl.TaskID]; ok {
+			export.Tasks[i].LabelIDs = append(export.Tasks[i].LabelIDs, int(tl.LabelID))
+		}
+	}
+
+	comments, err := s.queries.GetCommentsByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+	for _, c := range comments {
+		if i, ok := taskIndex[c.TaskID]; ok {
+			export.Tasks[i].Comments = append(export.Tasks[i].Comments, ExportedComment{
+				Author:    c.Author,
+				Message:   c.Content,
+				CreatedAt: database.NullTimeToTime(c.CreatedAt),
+				UpdatedAt: database.NullTimeToTime(c.UpdatedAt),
+			})
+		}
+	}
+
+	checklist, err := s.queries.GetChecklistItemsByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklists: %w", err)
+	}
+	for _, item := range checklist {
+		if i, ok := taskIndex[item.TaskID]; ok {
+			export.Tasks[i].Checklist = append(export.Tasks[i].Checklist, ExportedChecklistItem{
+				Text:     item.Content,
+				Done:     item.Done,
+				Position: int(item.Position),
+			})
+		}
+	}
+
+	recurrences, err := s.queries.GetTaskRecurrencesByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurrence rules: %w", err)
+	}
+	for _, r := range recurrences {
+		i, ok := taskIndex[r.TaskID]
+		if !ok {
+			continue
+		}
+		var lastInstanceID int
+		if _, inProject := taskIndex[r.LastInstanceID.Int64]; inProject {
+			lastInstanceID = int(r.LastInstanceID.Int64)
+		}
+		export.Tasks[i].Recurrence = &ExportedRecurrence{
+			Rule:           r.Rule,
+			NextRunAt:      r.NextRunAt,
+			LastInstanceID: lastInstanceID,
+			Occurrences:    int(r.Occurrences),
+		}
+	}
+
+	templates, err := s.queries.GetTaskTemplatesByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task templates: %w", err)
+	}
+	for _, row := range templates {
+		var template models.TaskTemplate
+		if err := json.Unmarshal([]byte(row.Definition), &template); err != nil {
+			return nil, fmt.Errorf("failed to decode task template '%s': %w", row.Name, err)
+		}
+		template.Name = row.Name
+		export.TaskTemplates = append(export.TaskTemplates, template)
+	}
+
+	relations, err := s.queries.GetTaskSubtasksByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relations: %w", err)
+	}
+	for _, r := range relations {
+		export.Relations = append(export.Relations, ExportedRelation{
+			ParentID:       int(r.ParentID),
+			ChildID:        int(r.ChildID),
+			RelationTypeID: int(r.RelationTypeID),
+		})
+	}
+
+	return export, nil
+}
+
+// ImportProject creates a new project from an export. Columns, labels, types,
+// priorities and tasks get new IDs, the column list, relations and recurrence
+// rules are rebuilt against them, and ticket numbers, timestamps and the
+// schedules of recurring tasks are kept. The ticket key is
+// kept unless another project already uses it, as when a project is imported
+// next to the one it was exported from. Everything is created in a
+// single transaction, so a bad export leaves the database untouched.
+func (s *service) ImportProject(ctx context.Context, export *Export) (*models.Project, error) {
+	if err := validateExport(export); err != nil {
+		return nil, err
+	}
+
+	// Exports written before types and priorities were per-project carry
+	// none; those projects get the built-in set
+	if len(export.Types) == 0 || len(export.Priorities) == 0 {
+		if err := s.fillBuiltinTaxonomy(ctx, export); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateTaxonomy(export); err != nil {
+		return nil, err
+	}
+	key, err := normalizeTicketKey(export.Project.TicketKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	if err := checkTicketKeyFree(ctx, s.queries, key, 0); errors.Is(err, ErrTicketKeyTaken) {
+		key = ""
+	} else if err != nil {
+		return nil, err
+	}
+
+	var project generated.Project
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		var err error
+		project, err = qtx.CreateProjectRecord(ctx, generated.CreateProjectRecordParams{
+			Name:        export.Project.Name,
+			Description: sql.NullString{String: export.Project.Description, Valid: export.Project.Description != ""},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create project: %w", err)
+		}
+		if key != "" {
+			project.TicketKey = sql.NullString{String: key, Valid: true}
+			if err := qtx.UpdateProjectTicketKey(ctx, generated.UpdateProjectTicketKeyParams{
+				TicketKey: project.TicketKey,
+				ID:        project.ID,
+			}); err != nil {
+				return fmt.Errorf("failed to set ticket key: %w", err)
+			}
+		}
+		if err := qtx.InitializeProjectCounter(ctx, project.ID); err != nil {
+			return fmt.Errorf("failed to initialize project counter: %w", err)
+		}
+
+		// Columns are created in board order, then linked
+		columnIDs := make(map[int]int64, len(export.Columns))
+		var prevID any
+		for _, c := range export.Columns {
+			column, err := qtx.CreateColumn(ctx, generated.CreateColumnParams{
+				Name:                 c.Name,
+				ProjectID:            project.ID,
+				PrevID:               prevID,
+				HoldsReadyTasks:      c.HoldsReadyTasks,
+				HoldsCompletedTasks:  c.HoldsCompletedTasks,
+				HoldsInProgressTasks: c.HoldsInProgressTasks,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create column '%s': %w", c.Name, err)
+			}
+			if c.WIPLimit > 0 {
+				if err := qtx.UpdateColumnWipLimit(ctx, generated.UpdateColumnWipLimitParams{
+					WipLimit: sql.NullInt64{Int64: int64(c.WIPLimit), Valid: true},
+					ID:       column.ID,
+				}); err != nil {
+					return fmt.Errorf("failed to set WIP limit on column '%s': %w", c.Name, err)
+				}
+			}
+			if prevID != nil {
+				if err := qtx.UpdateColumnNextID(ctx, generated.UpdateColumnNextIDParams{
+					ID:     prevID.(int64),
+					NextID: column.ID,
+				}); err != nil {
+					return fmt.Errorf("failed to link column '%s': %w", c.Name, err)
+				}
+			}
+			columnIDs[c.ID] = column.ID
+			prevID = column.ID
+		}
+
+		typeIDs := make(map[string]int64, len(export.Types))
+		var defaultType int64
+		for i, t := range export.Types {
+			created, err := qtx.CreateType(ctx, generated.CreateTypeParams{
+				ProjectID:   sql.NullInt64{Int64: project.ID, Valid: true},
+				Description: t.Name,
+				Color:       t.Color,
+				Icon:        t.Icon,
+				SortOrder:   int64(i + 1),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create type '%s': %w", t.Name, err)
+			}
+			typeIDs[t.Name] = created.ID
+			if i == 0 {
+				defaultType = created.ID
+			}
+		}
+
+		priorityIDs := make(map[string]int64, len(export.Priorities))
+		var defaultPriority int64
+		for i, p := range export.Priorities {
+			created, err := qtx.CreatePriority(ctx, generated.CreatePriorityParams{
+				ProjectID:   sql.NullInt64{Int64: project.ID, Valid: true},
+				Description: p.Name,
+				Color:       p.Color,
+				Icon:        p.Icon,
+				SortOrder:   int64(i + 1),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create priority '%s': %w", p.Name, err)
+			}
+			priorityIDs[p.Name] = created.ID
+			if i == len(export.Priorities)/2 {
+				defaultPriority = created.ID
+			}
+		}
+
+		relationTypeIDs := map[int]int64{
+			models.RelationTypeParentChild: models.RelationTypeParentChild,
+			models.RelationTypeBlocking:    models.RelationTypeBlocking,
+			models.RelationTypeRelated:     models.RelationTypeRelated,
+		}
+		for _, rt := range export.RelationTypes {
+			created, err := qtx.CreateRelationType(ctx, generated.CreateRelationTypeParams{
+				PToCLabel:  rt.Forward,
+				CToPLabel:  rt.Reverse,
+				Color:      rt.Color,
+				IsBlocking: rt.Blocking,
+				ProjectID:  sql.NullInt64{Int64: project.ID, Valid: true},
+				Name:       rt.Name,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create relation type '%s': %w", rt.Name, err)
+			}
+			relationTypeIDs[rt.ID] = created.ID
+		}
+
+		labelIDs := make(map[int]int64, len(export.Labels))
+		for _, l := range export.Labels {
+			label, err := qtx.CreateLabel(ctx, generated.CreateLabelParams{
+				Name:      l.Name,
+				Color:     l.Color,
+				ProjectID: project.ID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create label '%s': %w", l.Name, err)
+			}
+			labelIDs[l.ID] = label.ID
+		}
+
+		// Tasks without a ticket number get one after the highest imported
+		nextTicket := max(export.Project.NextTicketNumber, 1)
+		for _, t := range export.Tasks {
+			nextTicket = max(nextTicket, t.TicketNumber+1)
+		}
+
+		taskIDs := make(map[int]int64, len(export.Tasks))
+		for _, t := range export.Tasks {
+			ticket := t.TicketNumber
+			if ticket <= 0 {
+				ticket = nextTicket
+				nextTicket++
+			}
+
+			typeID, ok := typeIDs[t.Type]
+			if !ok {
+				typeID = defaultType
+			}
+			priorityID, ok := priorityIDs[t.Priority]
+			if !ok {
+				priorityID = defaultPriority
+			}
+
+			task, err := qtx.ImportTask(ctx, generated.ImportTaskParams{
+				Title:        t.Title,
+				Description:  sql.NullString{String: t.Description, Valid: t.Description != ""},
+				ColumnID:     columnIDs[t.ColumnID],
+				Position:     int64(t.Position),
+				TicketNumber: sql.NullInt64{Int64: int64(ticket), Valid: true},
+				TypeID:       typeID,
+				PriorityID:   priorityID,
+				CreatedAt:    sql.NullTime{Time: t.CreatedAt, Valid: !t.CreatedAt.IsZero()},
+				UpdatedAt:    sql.NullTime{Time: t.UpdatedAt, Valid: !t.UpdatedAt.IsZero()},
+				DueAt:        converters.PtrToNullTime(t.DueAt),
+				StartAt:      converters.PtrToNullTime(t.StartAt),
+				ArchivedAt:   converters.PtrToNullTime(t.ArchivedAt),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create task '%s': %w", t.Title, err)
+			}
+			taskIDs[t.ID] = task.ID
+
+			if err := database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+				ProjectID: project.ID,
+				TaskID:    task.ID,
+				EventType: models.TaskEventCreated,
+				NewValue:  t.Title,
+			}); err != nil {
+				return err
+			}
+
+			for _, labelID := range t.LabelIDs {
+				if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+					TaskID:  task.ID,
+					LabelID: labelIDs[labelID],
+				}); err != nil {
+					return fmt.Errorf("failed to attach label to task '%s': %w", t.Title, err)
+				}
+			}
+
+			for _, c := range t.Comments {
+				if _, err := qtx.ImportComment(ctx, generated.ImportCommentParams{
+					TaskID:    task.ID,
+					Content:   c.Message,
+					Author:    c.Author,
+					CreatedAt: sql.NullTime{Time: c.CreatedAt, Valid: !c.CreatedAt.IsZero()},
+					UpdatedAt: sql.NullTime{Time: c.UpdatedAt, Valid: !c.UpdatedAt.IsZero()},
+				}); err != nil {
+					return fmt.Errorf("failed to create comment on task '%s': %w", t.Title, err)
+				}
+			}
+
+			for _, item := range t.Checklist {
+				if err := qtx.ImportChecklistItem(ctx, generated.ImportChecklistItemParams{
+					TaskID:   task.ID,
+					Content:  item.Text,
+					Done:     item.Done,
+					Position: int64(item.Position),
+				}); err != nil {
+					return fmt.Errorf("failed to create checklist item on task '%s': %w", t.Title, err)
+				}
+			}
+		}
+
+		for _, r := range export.Relations {
+			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+				ParentID:       taskIDs[r.ParentID],
+				ChildID:        taskIDs[r.ChildID],
+				RelationTypeID: relationTypeIDs[r.RelationTypeID],
+			}); err != nil {
+				return fmt.Errorf("failed to relate tasks %d and %d: %w", r.ParentID, r.ChildID, err)
+			}
+		}
+
+		for _, t := range export.Tasks {
+			r := t.Recurrence
+			if r == nil {
+				continue
+			}
+			lastInstanceID, ok := taskIDs[r.LastInstanceID]
+			if err := qtx.ImportTaskRecurrence(ctx, generated.ImportTaskRecurrenceParams{
+				TaskID:         taskIDs[t.ID],
+				Rule:           r.Rule,
+				NextRunAt:      r.NextRunAt,
+				LastInstanceID: sql.NullInt64{Int64: lastInstanceID, Valid: ok},
+				Occurrences:    int64(r.Occurrences),
+			}); err != nil {
+				return fmt.Errorf("failed to create recurrence rule of task '%s': %w", t.Title, err)
+			}
+		}
+
+		for _, template := range export.TaskTemplates {
+			definition, err := json.Marshal(template)
+			if err != nil {
+				return fmt.Errorf("failed to encode task template '%s': %w", template.Name, err)
+			}
+			if _, err := qtx.UpsertTaskTemplate(ctx, generated.UpsertTaskTemplateParams{
+				ProjectID:  project.ID,
+				Name:       template.Name,
+				Definition: string(definition),
+			}); err != nil {
+				return fmt.Errorf("failed to create task template '%s': %w", template.Name, err)
+			}
+		}
+
+		if err := qtx.SetNextTicketNumber(ctx, generated.SetNextTicketNumberParams{
+			NextTicketNumber: sql.NullInt64{Int64: int64(nextTicket), Valid: true},
+			ProjectID:        project.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to set ticket counter: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishProjectEvent(events.EventProjectCreated, int(project.ID))
+
+	return toProjectModel(project), nil
+}
+
+// validateExport checks that an export is complete and consistent before
+// anything is written
+func validateExport(export *Export) error {
+	if export == nil {
+		return fmt.Errorf("%w: empty export", ErrInvalidExport)
+	}
+	if export.Version > ExportVersion {
+		return fmt.Errorf("%w: version %d is newer than supported version %d", ErrInvalidExport, export.Version, ExportVersion)
+	}
+	if export.Project.Name == "" {
+		return ErrEmptyName
+	}
+	if len(export.Project.Name) > 100 {
+		return ErrNameTooLong
+	}
+
+	columns := make(map[int]bool, len(export.Columns))
+	var ready, inProgress, completed int
+	for _, c := range export.Columns {
+		if columns[c.ID] {
+			return fmt.Errorf("%w: duplicate column id %d", ErrInvalidExport, c.ID)
+		}
+		if c.Name == "" {
+			return fmt.Errorf("%w: column %d has no name", ErrInvalidExport, c.ID)
+		}
+		if c.WIPLimit < 0 {
+			return fmt.Errorf("%w: column %d has a negative WIP limit", ErrInvalidExport, c.ID)
+		}
+		columns[c.ID] = true
+		if c.HoldsReadyTasks {
+			ready++
+		}
+		if c.HoldsInProgressTasks {
+			inProgress++
+		}
+		if c.HoldsCompletedTasks {
+			completed++
+		}
+	}
+	if ready > 1 || inProgress > 1 || completed > 1 {
+		return fmt.Errorf("%w: at most one column may hold ready, in-progress or completed tasks", ErrInvalidExport)
+	}
+
+	labels := make(map[int]bool, len(export.Labels))
+	for _, l := range export.Labels {
+		if labels[l.ID] {
+			return fmt.Errorf("%w: duplicate label id %d", ErrInvalidExport, l.ID)
+		}
+		labels[l.ID] = true
+	}
+
+	relationTypes := map[int]bool{
+		models.RelationTypeParentChild: true,
+		models.RelationTypeBlocking:    true,
+		models.RelationTypeRelated:     true,
+	}
+	relationTypeNames := make(map[string]bool, len(export.RelationTypes))
+	for _, rt := range export.RelationTypes {
+		if relationTypes[rt.ID] {
+			return fmt.Errorf("%w: duplicate relation type id %d", ErrInvalidExport, rt.ID)
+		}
+		if rt.Name == "" || rt.Forward == "" || rt.Reverse == "" {
+			return fmt.Errorf("%w: relation type %d needs a name and both labels", ErrInvalidExport, rt.ID)
+		}
+		if relationTypeNames[rt.Name] {
+			return fmt.Errorf("%w: duplicate relation type '%s'", ErrInvalidExport, rt.Name)
+		}
+		relationTypes[rt.ID] = true
+		relationTypeNames[rt.Name] = true
+	}
+
+	tasks := make(map[int]bool, len(export.Tasks))
+	for _, t := range export.Tasks {
+		if tasks[t.ID] {
+			return fmt.Errorf("%w: duplicate task id %d", ErrInvalidExport, t.ID)
+		}
+		tasks[t.ID] = true
+		if t.Title == "" {
+			return fmt.Errorf("%w: task %d has no title", ErrInvalidExport, t.ID)
+		}
+		if !columns[t.ColumnID] {
+			return fmt.Errorf("%w: task %d is in unknown column %d", ErrInvalidExport, t.ID, t.ColumnID)
+		}
+		for _, labelID := range t.LabelIDs {
+			if !labels[labelID] {
+				return fmt.Errorf("%w: task %d has unknown label %d", ErrInvalidExport, t.ID, labelID)
+			}
+		}
+		for _, item := range t.Checklist {
+			if item.Text == "" || len(item.Text) > 500 {
+				return fmt.Errorf("%w: task %d has an empty or too long checklist item", ErrInvalidExport, t.ID)
+			}
+		}
+	}
+
+	for _, t := range export.Tasks {
+		r := t.Recurrence
+		if r == nil {
+			continue
+		}
+		if _, err := recurrence.Parse(r.Rule); err != nil {
+			return fmt.Errorf("%w: task %d: %w", ErrInvalidExport, t.ID, err)
+		}
+		if r.NextRunAt.IsZero() || r.Occurrences < 0 {
+			return fmt.Errorf("%w: task %d has an incomplete recurrence schedule", ErrInvalidExport, t.ID)
+		}
+		if r.LastInstanceID != 0 && !tasks[r.LastInstanceID] {
+			return fmt.Errorf("%w: recurrence of task %d refers to unknown task %d", ErrInvalidExport, t.ID, r.LastInstanceID)
+		}
+	}
+
+	labelNames := make(map[string]bool, len(export.Labels))
+	for _, l := range export.Labels {
+		labelNames[l.Name] = true
+	}
+	templates := make(map[string]bool, len(export.TaskTemplates))
+	for _, tmpl := range export.TaskTemplates {
+		if strings.TrimSpace(tmpl.Name) == "" || templates[tmpl.Name] {
+			return fmt.Errorf("%w: empty or duplicate task template '%s'", ErrInvalidExport, tmpl.Name)
+		}
+		templates[tmpl.Name] = true
+		if strings.TrimSpace(tmpl.Title) == "" {
+			return fmt.Errorf("%w: task template '%s' has no title", ErrInvalidExport, tmpl.Name)
+		}
+		labels := slices.Clone(tmpl.Labels)
+		for _, c := range tmpl.Children {
+			if strings.TrimSpace(c.Title) == "" {
+				return fmt.Errorf("%w: a child task of template '%s' has no title", ErrInvalidExport, tmpl.Name)
+			}
+			labels = append(labels, c.Labels...)
+		}
+		for _, name := range labels {
+			if !labelNames[name] {
+				return fmt.Errorf("%w: task template '%s' has unknown label '%s'", ErrInvalidExport, tmpl.Name, name)
+			}
+		}
+	}
+
+	for _, r := range export.Relations {
+		if !tasks[r.ParentID] || !tasks[r.ChildID] {
+			return fmt.Errorf("%w: relation %d -> %d refers to an unknown task", ErrInvalidExport, r.ParentID, r.ChildID)
+		}
+		if r.ParentID == r.ChildID {
+			return fmt.Errorf("%w: task %d is related to itself", ErrInvalidExport, r.ParentID)
+		}
+		if !relationTypes[r.RelationTypeID] {
+			return fmt.Errorf("%w: relation %d -> %d has unknown type %d", ErrInvalidExport, r.ParentID, r.ChildID, r.RelationTypeID)
+		}
+	}
+
+	return nil
+}
+
+// fillBuiltinTaxonomy gives an export without types or priorities the
+// built-in ones
+func (s *service) fillBuiltinTaxonomy(ctx context.Context, export *Export) error {
+	if len(export.Types) == 0 {
+		types, err := s.queries.GetBuiltinTypes(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get types: %w", err)
+		}
+		for _, t := range types {
+			export.Types = append(export.Types, ExportedType{Name: t.Description, Color: t.Color, Icon: t.Icon})
+		}
+	}
+	if len(export.Priorities) == 0 {
+		priorities, err := s.queries.GetBuiltinPriorities(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get priorities: %w", err)
+		}
+		for _, p := range priorities {
+			export.Priorities = append(export.Priorities, ExportedPriority{Name: p.Description, Color: p.Color, Icon: p.Icon})
+		}
+	}
+	return nil
+}
+
+// validateTaxonomy checks that type and priority names are unique and that
+// every task and task template uses one of them
+func validateTaxonomy(export *Export) error {
+	types := make(map[string]bool, len(export.Types))
+	for _, t := range export.Types {
+		if t.Name == "" || types[t.Name] {
+			return fmt.Errorf("%w: empty or duplicate type '%s'", ErrInvalidExport, t.Name)
+		}
+		types[t.Name] = true
+	}
+	priorities := make(map[string]bool, len(export.Priorities))
+	for _, p := range export.Priorities {
+		if p.Name == "" || priorities[p.Name] {
+			return fmt.Errorf("%w: empty or duplicate priority '%s'", ErrInvalidExport, p.Name)
+		}
+		priorities[p.Name] = true
+	}
+	for _, t := range export.Tasks {
+		if t.Type != "" && !types[t.Type] {
+			return fmt.Errorf("%w: task %d has unknown type '%s'", ErrInvalidExport, t.ID, t.Type)
+		}
+		if t.Priority != "" && !priorities[t.Priority] {
+			return fmt.Errorf("%w: task %d has unknown priority '%s'", ErrInvalidExport, t.ID, t.Priority)
+		}
+	}
+	for _, tmpl := range export.TaskTemplates {
+		children := append([]models.TaskTemplateChild{{Type: tmpl.Type, Priority: tmpl.Priority}}, tmpl.Children...)
+		for _, c := range children {
+			if c.Type != "" && !types[c.Type] {
+				return fmt.Errorf("%w: task template '%s' has unknown type '%s'", ErrInvalidExport, tmpl.Name, c.Type)
+			}
+			if c.Priority != "" && !priorities[c.Priority] {
+				return fmt.Errorf("%w: task template '%s' has unknown priority '%s'", ErrInvalidExport, tmpl.Name, c.Priority)
+			}
+		}
+	}
+	return nil
+}
+
+// lookupNames maps type and priority IDs to the names used in exports
+func (s *service) lookupNames(ctx context.Context) (types, priorities map[int64]string, err error) {
+	allTypes, err := s.queries.GetAllTypes(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get types: %w", err)
+	}
+	types = make(map[int64]string, len(allTypes))
+	for _, t := range allTypes {
+		types[t.ID] = t.Description
+	}
+
+	allPriorities, err := s.queries.GetAllPriorities(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get priorities: %w", err)
+	}
+	priorities = make(map[int64]string, len(allPriorities))
+	for _, p := range allPriorities {
+		priorities[p.ID] = p.Description
+	}
+
+	return types, priorities, nil
+}
+
+// columnsInBoardOrder walks the column linked list from its head. Columns
+// the walk doesn't reach (a broken list) are appended in ID order so an
+// export never loses them.
+func columnsInBoardOrder(columns []generated.GetColumnsByProjectRow) []generated.GetColumnsByProjectRow {
+	byID := make(map[int64]generated.GetColumnsByProjectRow, len(columns))
+	var head *generated.GetColumnsByProjectRow
+	for i, c := range columns {
+		byID[c.ID] = c
+		if head == nil && database.AnyToIntPtr(c.PrevID) == nil {
+			head = &columns[i]
+		}
+	}
+
+	ordered := make([]generated.GetColumnsByProjectRow, 0, len(columns))
+	seen := make(map[int64]bool, len(columns))
+	for c := head; c != nil && !seen[c.ID]; {
+		ordered = append(ordered, *c)
+		seen[c.ID] = true
+
+		next := database.AnyToIntPtr(c.NextID)
+		if next == nil {
+			break
+		}
+		nextColumn, ok := byID[int64(*next)]
+		if !ok {
+			break
+		}
+		c = &nextColumn
+	}
+
+	for _, c := range columns {
+		if !seen[c.ID] {
+			ordered = append(ordered, c)
+		}
+	}
+	return ordered
+}
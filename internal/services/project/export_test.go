@@ -0,0 +1,258 @@
+package project
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+// seedExportProject builds a project whose column IDs are not in board
+// order, with labels, comments, a checklist, relations, a recurrence rule, a
+// task template and a used-up ticket counter
+func seedExportProject(t *testing.T, db *sql.DB) int {
+	t.Helper()
+	ctx := context.Background()
+
+	exec := func(query string, args ...any) int {
+		t.Helper()
+		result, err := db.ExecContext(ctx, query, args...)
+		require.NoError(t, err, query)
+		id, _ := result.LastInsertId()
+		return int(id)
+	}
+
+	projectID := exec("INSERT INTO projects (name, description) VALUES ('Billing', 'Invoices and payments')")
+	exec("INSERT INTO project_counters (project_id, next_ticket_number) VALUES (?, 8)", projectID)
+
+	// Board order: Backlog -> Doing -> Shipped, created as Shipped, Backlog, Doing
+	shipped := exec("INSERT INTO columns (project_id, name, holds_completed_tasks) VALUES (?, 'Shipped', 1)", projectID)
+	backlog := exec("INSERT INTO columns (project_id, name, holds_ready_tasks) VALUES (?, 'Backlog', 1)", projectID)
+	doing := exec("INSERT INTO columns (project_id, name, holds_in_progress_tasks, wip_limit) VALUES (?, 'Doing', 1, 3)", projectID)
+	exec("UPDATE columns SET next_id = ? WHERE id = ?", doing, backlog)
+	exec("UPDATE columns SET prev_id = ?, next_id = ? WHERE id = ?", backlog, shipped, doing)
+	exec("UPDATE columns SET prev_id = ? WHERE id = ?", doing, shipped)
+
+	urgent := exec("INSERT INTO labels (project_id, name, color) VALUES (?, 'urgent', '#FF0000')", projectID)
+
+	invoice := exec(`INSERT INTO tasks (column_id, title, description, position, ticket_number, type_id, priority_id, created_at)
+		VALUES (?, 'Send invoices', 'Monthly run', 0, 3, 2, 4, '2025-01-02 03:04:05')`, doing)
+	refund := exec(`INSERT INTO tasks (column_id, title, position, ticket_number, type_id, priority_id)
+		VALUES (?, 'Refund flow', 0, 7, 1, 3)`, backlog)
+
+	exec("INSERT INTO task_labels (task_id, label_id) VALUES (?, ?)", invoice, urgent)
+	exec("INSERT INTO task_subtasks (parent_id, child_id, relation_type_id) VALUES (?, ?, 2)", refund, invoice)
+	verifies := exec(`INSERT INTO relation_types (p_to_c_label, c_to_p_label, color, is_blocking, project_id, name)
+		VALUES ('Verified By', 'Verifies', '#10B981', 0, ?, 'verifies')`, projectID)
+	exec("INSERT INTO task_subtasks (parent_id, child_id, relation_type_id) VALUES (?, ?, ?)", invoice, refund, verifies)
+	exec("INSERT INTO task_comments (task_id, content, author) VALUES (?, 'Waiting on finance', 'sam')", invoice)
+	exec("INSERT INTO task_checklist_items (task_id, content, done, position) VALUES (?, 'Export ledger', 1, 1), (?, 'Email customers', 0, 2)", invoice, invoice)
+	exec(`INSERT INTO task_recurrences (task_id, rule, next_run_at, last_instance_id, occurrences)
+		VALUES (?, 'FREQ=MONTHLY;BYMONTHDAY=1', '2025-02-01 09:00:00', ?, 2)`, invoice, refund)
+	exec(`INSERT INTO task_templates (project_id, name, definition)
+		VALUES (?, 'refund', '{"name":"refund","title":"Refund {{customer}}","type":"bug","labels":["urgent"],"children":[{"title":"Notify {{customer}}"}]}')`, projectID)
+
+	return projectID
+}
+
+func TestExportProject(t *testing.T) {
+	t.Parallel()
+
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	projectID := seedExportProject(t, db)
+
+	export, err := svc.ExportProject(context.Background(), projectID)
+	require.NoError(t, err)
+
+	assert.Equal(t, ExportVersion, export.Version)
+	assert.Equal(t, ExportedProject{Name: "Billing", Description: "Invoices and payments", NextTicketNumber: 8}, export.Project)
+
+	var columnNames []string
+	for _, c := range export.Columns {
+		columnNames = append(columnNames, c.Name)
+	}
+	assert.Equal(t, 3, export.Columns[1].WIPLimit)
+	assert.Equal(t, []string{"Backlog", "Doing", "Shipped"}, columnNames, "columns are exported in linked-list order")
+
+	require.Len(t, export.Tasks, 2)
+	invoice := export.Tasks[0]
+	assert.Equal(t, "Send invoices", invoice.Title)
+	assert.Equal(t, "feature", invoice.Type)
+	assert.Equal(t, "high", invoice.Priority)
+	assert.Equal(t, 3, invoice.TicketNumber)
+	assert.Equal(t, []int{export.Labels[0].ID}, invoice.LabelIDs)
+	require.Len(t, invoice.Comments, 1)
+	assert.Equal(t, "Waiting on finance", invoice.Comments[0].Message)
+	assert.Equal(t, 2025, invoice.CreatedAt.Year())
+	assert.Equal(t, []ExportedChecklistItem{
+		{Text: "Export ledger", Done: true, Position: 1},
+		{Text: "Email customers", Position: 2},
+	}, invoice.Checklist)
+
+	require.NotNil(t, invoice.Recurrence)
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=1", invoice.Recurrence.Rule)
+	assert.Equal(t, export.Tasks[1].ID, invoice.Recurrence.LastInstanceID)
+	assert.Equal(t, 2, invoice.Recurrence.Occurrences)
+	assert.Equal(t, 2025, invoice.Recurrence.NextRunAt.Year())
+	assert.Nil(t, export.Tasks[1].Recurrence)
+
+	require.Len(t, export.TaskTemplates, 1)
+	assert.Equal(t, models.TaskTemplate{
+		Name:     "refund",
+		Title:    "Refund {{customer}}",
+		Type:     "bug",
+		Labels:   []string{"urgent"},
+		Children: []models.TaskTemplateChild{{Title: "Notify {{customer}}"}},
+	}, export.TaskTemplates[0])
+
+	require.Len(t, export.RelationTypes, 1, "only the project's own relation types are exported")
+	verifies := export.RelationTypes[0]
+	assert.Equal(t, "verifies", verifies.Name)
+	assert.Equal(t, "Verified By", verifies.Forward)
+	assert.Equal(t, "Verifies", verifies.Reverse)
+	assert.ElementsMatch(t, []ExportedRelation{
+		{ParentID: export.Tasks[1].ID, ChildID: invoice.ID, RelationTypeID: 2},
+		{ParentID: invoice.ID, ChildID: export.Tasks[1].ID, RelationTypeID: verifies.ID},
+	}, export.Relations)
+}
+
+func TestImportProject_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	projectID := seedExportProject(t, db)
+
+	original, err := svc.ExportProject(ctx, projectID)
+	require.NoError(t, err)
+
+	// Round-trip through the file format like export/import on two machines
+	data, err := json.Marshal(original)
+	require.NoError(t, err)
+	var decoded Export
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	imported, err := svc.ImportProject(ctx, &decoded)
+	require.NoError(t, err)
+	assert.NotEqual(t, projectID, imported.ID)
+	assert.Equal(t, "Billing", imported.Name)
+
+	copied, err := svc.ExportProject(ctx, imported.ID)
+	require.NoError(t, err)
+
+	// Same content, new IDs
+	assert.Equal(t, original.Project, copied.Project)
+	require.Len(t, copied.Columns, len(original.Columns))
+	for i := range original.Columns {
+		assert.Equal(t, original.Columns[i].Name, copied.Columns[i].Name)
+		assert.Equal(t, original.Columns[i].HoldsReadyTasks, copied.Columns[i].HoldsReadyTasks)
+		assert.Equal(t, original.Columns[i].WIPLimit, copied.Columns[i].WIPLimit)
+		assert.NotEqual(t, original.Columns[i].ID, copied.Columns[i].ID)
+	}
+	require.Len(t, copied.Tasks, 2)
+	for i := range original.Tasks {
+		want, got := original.Tasks[i], copied.Tasks[i]
+		assert.Equal(t, want.Title, got.Title)
+		assert.Equal(t, want.TicketNumber, got.TicketNumber)
+		assert.Equal(t, want.Type, got.Type)
+		assert.Equal(t, want.Priority, got.Priority)
+		assert.Equal(t, want.CreatedAt, got.CreatedAt)
+		assert.Len(t, got.LabelIDs, len(want.LabelIDs))
+		assert.Len(t, got.Comments, len(want.Comments))
+		assert.Equal(t, want.Checklist, got.Checklist)
+	}
+	require.NotNil(t, copied.Tasks[0].Recurrence)
+	assert.Equal(t, ExportedRecurrence{
+		Rule:           original.Tasks[0].Recurrence.Rule,
+		NextRunAt:      original.Tasks[0].Recurrence.NextRunAt,
+		LastInstanceID: copied.Tasks[1].ID,
+		Occurrences:    2,
+	}, *copied.Tasks[0].Recurrence)
+	assert.Equal(t, original.TaskTemplates, copied.TaskTemplates)
+	require.Len(t, copied.RelationTypes, 1)
+	verifies := copied.RelationTypes[0]
+	assert.NotEqual(t, original.RelationTypes[0].ID, verifies.ID)
+	assert.Equal(t, "verifies", verifies.Name)
+	assert.ElementsMatch(t, []ExportedRelation{
+		{ParentID: copied.Tasks[1].ID, ChildID: copied.Tasks[0].ID, RelationTypeID: 2},
+		{ParentID: copied.Tasks[0].ID, ChildID: copied.Tasks[1].ID, RelationTypeID: verifies.ID},
+	}, copied.Relations)
+
+	// The rebuilt column list is walkable from its head
+	var headNext sql.NullInt64
+	require.NoError(t, db.QueryRowContext(ctx,
+		"SELECT next_id FROM columns WHERE project_id = ? AND prev_id IS NULL", imported.ID).Scan(&headNext))
+	assert.Equal(t, int64(copied.Columns[1].ID), headNext.Int64)
+}
+
+func TestImportProject_Invalid(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *Export {
+		return &Export{
+			Version: ExportVersion,
+			Project: ExportedProject{Name: "Demo"},
+			Columns: []ExportedColumn{{ID: 1, Name: "Todo"}},
+			Tasks:   []ExportedTask{{ID: 10, Title: "First", ColumnID: 1, Type: "task", Priority: "low"}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(e *Export)
+	}{
+		{"newer version", func(e *Export) { e.Version = ExportVersion + 1 }},
+		{"unknown column", func(e *Export) { e.Tasks[0].ColumnID = 2 }},
+		{"unknown label", func(e *Export) { e.Tasks[0].LabelIDs = []int{5} }},
+		{"unknown priority", func(e *Export) { e.Tasks[0].Priority = "urgent" }},
+		{"empty checklist item", func(e *Export) { e.Tasks[0].Checklist = []ExportedChecklistItem{{Position: 1}} }},
+		{"dangling relation", func(e *Export) {
+			e.Relations = []ExportedRelation{{ParentID: 10, ChildID: 11, RelationTypeID: 1}}
+		}},
+		{"unknown relation type", func(e *Export) {
+			e.Tasks = append(e.Tasks, ExportedTask{ID: 11, Title: "Second", ColumnID: 1, Type: "task", Priority: "low"})
+			e.Relations = []ExportedRelation{{ParentID: 10, ChildID: 11, RelationTypeID: 4}}
+		}},
+		{"bad recurrence rule", func(e *Export) {
+			e.Tasks[0].Recurrence = &ExportedRecurrence{Rule: "FREQ=FORTNIGHTLY", NextRunAt: time.Now()}
+		}},
+		{"unknown last instance", func(e *Export) {
+			e.Tasks[0].Recurrence = &ExportedRecurrence{Rule: "daily", NextRunAt: time.Now(), LastInstanceID: 11}
+		}},
+		{"task template without title", func(e *Export) {
+			e.TaskTemplates = []models.TaskTemplate{{Name: "empty"}}
+		}},
+		{"task template with unknown label", func(e *Export) {
+			e.TaskTemplates = []models.TaskTemplate{{Name: "bug", Title: "Bug", Labels: []string{"missing"}}}
+		}},
+		{"two ready columns", func(e *Export) {
+			e.Columns = []ExportedColumn{{ID: 1, Name: "A", HoldsReadyTasks: true}, {ID: 2, Name: "B", HoldsReadyTasks: true}}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := testutil.SetupTestDB(t)
+			svc := NewService(db, nil)
+
+			export := valid()
+			tt.mutate(export)
+			_, err := svc.ImportProject(context.Background(), export)
+			require.ErrorIs(t, err, ErrInvalidExport)
+
+			projects, err := svc.GetAllProjects(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, projects, "a rejected import must not create anything")
+		})
+	}
+}
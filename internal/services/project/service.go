@@ -3,7 +3,10 @@ package project
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/thenoetrevino/paso/internal/database"
 	"github.com/thenoetrevino/paso/internal/database/generated"
@@ -16,18 +19,35 @@ type Service interface {
 	// Read operations
 	GetAllProjects(ctx context.Context) ([]*models.Project, error)
 	GetProjectByID(ctx context.Context, id int) (*models.Project, error)
+	GetProjectByTicketKey(ctx context.Context, key string) (*models.Project, error)
 	GetTaskCount(ctx context.Context, projectID int) (int, error)
 
 	// Write operations
 	CreateProject(ctx context.Context, req CreateProjectRequest) (*models.Project, error)
 	UpdateProject(ctx context.Context, req UpdateProjectRequest) error
 	DeleteProject(ctx context.Context, id int, force bool) error
+
+	// Export and import
+	ExportProject(ctx context.Context, projectID int) (*Export, error)
+	ImportProject(ctx context.Context, export *Export) (*models.Project, error)
+
+	// Templates
+	ListTemplates(ctx context.Context) ([]*models.ProjectTemplate, error)
+	GetTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error)
+	SaveTemplate(ctx context.Context, req SaveTemplateRequest) (*models.ProjectTemplate, error)
+	DeleteTemplate(ctx context.Context, name string) error
 }
 
 // CreateProjectRequest encapsulates data for creating a project
 type CreateProjectRequest struct {
 	Name        string
 	Description string
+	TicketKey   string // Optional; stored upper case
+
+	// Template is the board the project starts with. Without one the project
+	// gets the Todo, In Progress and Done columns and the built-in types and
+	// priorities.
+	Template *models.ProjectTemplate
 }
 
 // UpdateProjectRequest encapsulates data for updating a project
@@ -35,6 +55,7 @@ type UpdateProjectRequest struct {
 	ID          int
 	Name        *string
 	Description *string
+	TicketKey   *string // An empty key clears it
 }
 
 // service implements Service interface using SQLC directly
@@ -74,6 +95,26 @@ func (s *service) GetProjectByID(ctx context.Context, id int) (*models.Project,
 	return toProjectModel(project), nil
 }
 
+// GetProjectByTicketKey retrieves the project with the given ticket key,
+// matched case-insensitively
+func (s *service) GetProjectByTicketKey(ctx context.Context, key string) (*models.Project, error) {
+	key, err := normalizeTicketKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if key == "" {
+		return nil, ErrInvalidTicketKey
+	}
+	project, err := s.queries.GetProjectByTicketKey(ctx, sql.NullString{String: key, Valid: true})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return toProjectModel(project), nil
+}
+
 // GetTaskCount returns the number of tasks in a project
 func (s *service) GetTaskCount(ctx context.Context, projectID int) (int, error) {
 	if projectID <= 0 {
@@ -92,11 +133,23 @@ func (s *service) CreateProject(ctx context.Context, req CreateProjectRequest) (
 	if err := s.validateCreateProject(req); err != nil {
 		return nil, err
 	}
+	key, err := normalizeTicketKey(req.TicketKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTicketKeyFree(ctx, s.queries, key, 0); err != nil {
+		return nil, err
+	}
+	req.TicketKey = key
+
+	if req.Template != nil {
+		return s.createFromTemplate(ctx, req)
+	}
 
 	var project generated.Project
 
 	// Use WithTx helper for transaction management
-	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
 		qtx := generated.New(tx)
 
 		// Create project record
@@ -108,6 +161,15 @@ func (s *service) CreateProject(ctx context.Context, req CreateProjectRequest) (
 		if projErr != nil {
 			return fmt.Errorf("failed to create project: %w", projErr)
 		}
+		if key != "" {
+			if err := qtx.UpdateProjectTicketKey(ctx, generated.UpdateProjectTicketKeyParams{
+				TicketKey: sql.NullString{String: key, Valid: true},
+				ID:        project.ID,
+			}); err != nil {
+				return fmt.Errorf("failed to set ticket key: %w", err)
+			}
+			project.TicketKey = sql.NullString{String: key, Valid: true}
+		}
 
 		// Initialize project counter (for task ticket numbers)
 		if err := qtx.InitializeProjectCounter(ctx, project.ID); err != nil {
@@ -119,6 +181,11 @@ func (s *service) CreateProject(ctx context.Context, req CreateProjectRequest) (
 			return fmt.Errorf("failed to create default columns: %w", err)
 		}
 
+		// Give the project its own copy of the built-in types and priorities
+		if _, _, err := database.CreateDefaultTaxonomy(ctx, qtx, project.ID); err != nil {
+			return fmt.Errorf("failed to create default types and priorities: %w", err)
+		}
+
 		return nil
 	})
 
@@ -127,7 +194,7 @@ func (s *service) CreateProject(ctx context.Context, req CreateProjectRequest) (
 	}
 
 	// Publish event after successful commit
-	s.publishProjectEvent(ctx, int(project.ID))
+	s.publishProjectEvent(events.EventProjectCreated, int(project.ID))
 
 	return toProjectModel(project), nil
 }
@@ -146,6 +213,16 @@ func (s *service) UpdateProject(ctx context.Context, req UpdateProjectRequest) e
 	if req.Name != nil && len(*req.Name) > 100 {
 		return ErrNameTooLong
 	}
+	var key string
+	if req.TicketKey != nil {
+		var err error
+		if key, err = normalizeTicketKey(*req.TicketKey); err != nil {
+			return err
+		}
+		if err := checkTicketKeyFree(ctx, s.queries, key, req.ID); err != nil {
+			return err
+		}
+	}
 
 	// Get existing project to fill in missing fields
 	existing, err := s.queries.GetProjectByID(ctx, int64(req.ID))
@@ -165,16 +242,32 @@ func (s *service) UpdateProject(ctx context.Context, req UpdateProjectRequest) e
 	}
 
 	// Update project
-	if err := s.queries.UpdateProject(ctx, generated.UpdateProjectParams{
-		ID:          int64(req.ID),
-		Name:        name,
-		Description: description,
-	}); err != nil {
-		return fmt.Errorf("failed to update project: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := qtx.UpdateProject(ctx, generated.UpdateProjectParams{
+			ID:          int64(req.ID),
+			Name:        name,
+			Description: description,
+		}); err != nil {
+			return fmt.Errorf("failed to update project: %w", err)
+		}
+		if req.TicketKey != nil {
+			if err := qtx.UpdateProjectTicketKey(ctx, generated.UpdateProjectTicketKeyParams{
+				TicketKey: sql.NullString{String: key, Valid: key != ""},
+				ID:        int64(req.ID),
+			}); err != nil {
+				return fmt.Errorf("failed to set ticket key: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	// Publish event
-	s.publishProjectEvent(ctx, req.ID)
+	s.publishProjectEvent(events.EventProjectUpdated, req.ID)
 
 	return nil
 }
@@ -228,7 +321,7 @@ func (s *service) DeleteProject(ctx context.Context, id int, force bool) error {
 	}
 
 	// Publish event after successful deletion
-	s.publishProjectEvent(ctx, id)
+	s.publishProjectEvent(events.EventProjectDeleted, id)
 
 	return nil
 }
@@ -244,8 +337,43 @@ func (s *service) validateCreateProject(req CreateProjectRequest) error {
 	return nil
 }
 
-// publishProjectEvent publishes a project event with retry logic
-func (s *service) publishProjectEvent(ctx context.Context, projectID int) {
+// ticketKeyPattern matches a normalized ticket key
+var ticketKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}$`)
+
+// normalizeTicketKey upper-cases a ticket key and checks its format. An empty
+// key is returned as is.
+func normalizeTicketKey(key string) (string, error) {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	if key == "" {
+		return "", nil
+	}
+	if !ticketKeyPattern.MatchString(key) {
+		return "", ErrInvalidTicketKey
+	}
+	return key, nil
+}
+
+// checkTicketKeyFree returns ErrTicketKeyTaken when a project other than
+// projectID already uses the key
+func checkTicketKeyFree(ctx context.Context, q generated.Querier, key string, projectID int) error {
+	if key == "" {
+		return nil
+	}
+	existing, err := q.GetProjectByTicketKey(ctx, sql.NullString{String: key, Valid: true})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check ticket key: %w", err)
+	}
+	if int(existing.ID) != projectID {
+		return ErrTicketKeyTaken
+	}
+	return nil
+}
+
+// publishProjectEvent publishes a typed project event with retry logic
+func (s *service) publishProjectEvent(eventType events.EventType, projectID int) {
 	if s.eventClient == nil {
 		return
 	}
@@ -253,7 +381,7 @@ func (s *service) publishProjectEvent(ctx context.Context, projectID int) {
 	// Publish with retry (3 attempts with exponential backoff)
 	// Non-blocking: errors are logged but don't affect the operation
 	_ = events.PublishWithRetry(s.eventClient, events.Event{
-		Type:      events.EventDatabaseChanged,
+		Type:      eventType,
 		ProjectID: projectID,
 	}, 3)
 }
@@ -265,6 +393,7 @@ func toProjectModel(p generated.Project) *models.Project {
 		ID:          int(p.ID),
 		Name:        p.Name,
 		Description: database.NullStringToString(p.Description),
+		TicketKey:   database.NullStringToString(p.TicketKey),
 		CreatedAt:   database.NullTimeToTime(p.CreatedAt),
 		UpdatedAt:   database.NullTimeToTime(p.UpdatedAt),
 	}
@@ -932,6 +932,51 @@ func TestGetAllProjects_AfterDelete(t *testing.T) {
 	}
 }
 
+func TestProjectTicketKey(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	api, err := svc.CreateProject(ctx, CreateProjectRequest{Name: "API", TicketKey: "api"})
+	require.NoError(t, err)
+	assert.Equal(t, "API", api.TicketKey, "key should be stored upper case")
+
+	found, err := svc.GetProjectByTicketKey(ctx, "Api")
+	require.NoError(t, err)
+	assert.Equal(t, api.ID, found.ID)
+
+	_, err = svc.GetProjectByTicketKey(ctx, "WEB")
+	assert.ErrorIs(t, err, ErrProjectNotFound)
+
+	_, err = svc.CreateProject(ctx, CreateProjectRequest{Name: "Bad", TicketKey: "1API"})
+	assert.ErrorIs(t, err, ErrInvalidTicketKey)
+
+	_, err = svc.CreateProject(ctx, CreateProjectRequest{Name: "Duplicate", TicketKey: "API"})
+	assert.ErrorIs(t, err, ErrTicketKeyTaken)
+
+	web, err := svc.CreateProject(ctx, CreateProjectRequest{Name: "Web"})
+	require.NoError(t, err)
+	assert.Empty(t, web.TicketKey)
+
+	err = svc.UpdateProject(ctx, UpdateProjectRequest{ID: web.ID, TicketKey: strPtr("api")})
+	assert.ErrorIs(t, err, ErrTicketKeyTaken)
+
+	// Re-setting a project's own key is not a conflict
+	require.NoError(t, svc.UpdateProject(ctx, UpdateProjectRequest{ID: api.ID, TicketKey: strPtr("API")}))
+
+	// An empty key removes it, freeing it for another project
+	require.NoError(t, svc.UpdateProject(ctx, UpdateProjectRequest{ID: api.ID, TicketKey: strPtr("")}))
+	require.NoError(t, svc.UpdateProject(ctx, UpdateProjectRequest{ID: web.ID, TicketKey: strPtr("api")}))
+
+	updated, err := svc.GetProjectByID(ctx, web.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "API", updated.TicketKey)
+}
+
 // Helper function to create string pointer
 func strPtr(s string) *string {
 	return &s
@@ -0,0 +1,388 @@
+package project
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// SaveTemplateRequest encapsulates data for saving a project as a template
+type SaveTemplateRequest struct {
+	ProjectID    int
+	Name         string
+	Description  string
+	IncludeTasks bool // Keep the project's unarchived tasks as starter tasks
+	Replace      bool // Overwrite a saved template with the same name
+}
+
+// ListTemplates returns the saved project templates ordered by name
+func (s *service) ListTemplates(ctx context.Context) ([]*models.ProjectTemplate, error) {
+	rows, err := s.queries.GetAllProjectTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+
+	templates := make([]*models.ProjectTemplate, 0, len(rows))
+	for _, row := range rows {
+		template, err := decodeTemplate(row)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// GetTemplate returns a saved project template by name
+func (s *service) GetTemplate(ctx context.Context, name string) (*models.ProjectTemplate, error) {
+	row, err := s.queries.GetProjectTemplate(ctx, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get template: %w", err)
+	}
+	return decodeTemplate(row)
+}
+
+// SaveTemplate captures the board of a project - its columns, labels, types,
+// priorities, relation types and optionally its tasks - as a named template
+func (s *service) SaveTemplate(ctx context.Context, req SaveTemplateRequest) (*models.ProjectTemplate, error) {
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, ErrEmptyName
+	}
+	if len(req.Name) > 100 {
+		return nil, ErrNameTooLong
+	}
+
+	export, err := s.ExportProject(ctx, req.ProjectID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrProjectNotFound
+		}
+		return nil, err
+	}
+
+	template := templateFromExport(export, req)
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+
+	if !req.Replace {
+		_, err := s.queries.GetProjectTemplate(ctx, req.Name)
+		if err == nil {
+			return nil, ErrTemplateExists
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get template: %w", err)
+		}
+	}
+
+	definition, err := json.Marshal(template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode template: %w", err)
+	}
+	if _, err := s.queries.UpsertProjectTemplate(ctx, generated.UpsertProjectTemplateParams{
+		Name:       template.Name,
+		Definition: string(definition),
+	}); err != nil {
+		return nil, fmt.Errorf("failed to save template: %w", err)
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate deletes a saved project template. Projects created from it
+// are not affected.
+func (s *service) DeleteTemplate(ctx context.Context, name string) error {
+	deleted, err := s.queries.DeleteProjectTemplate(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	if deleted == 0 {
+		return ErrTemplateNotFound
+	}
+	return nil
+}
+
+// createFromTemplate creates a project with the board of a template. The
+// template is turned into an export so the project is built the same way
+// as an imported one.
+func (s *service) createFromTemplate(ctx context.Context, req CreateProjectRequest) (*models.Project, error) {
+	if err := validateTemplate(req.Template); err != nil {
+		return nil, err
+	}
+	return s.ImportProject(ctx, templateToExport(req))
+}
+
+// decodeTemplate reads the definition of a saved template
+func decodeTemplate(row generated.ProjectTemplate) (*models.ProjectTemplate, error) {
+	var template models.ProjectTemplate
+	if err := json.Unmarshal([]byte(row.Definition), &template); err != nil {
+		return nil, fmt.Errorf("failed to decode template '%s': %w", row.Name, err)
+	}
+	template.Name = row.Name
+	return &template, nil
+}
+
+// validateTemplate checks that a template describes a usable board and that
+// its starter tasks only refer to names it defines
+func validateTemplate(t *models.ProjectTemplate) error {
+	if t == nil {
+		return fmt.Errorf("%w: empty template", ErrInvalidTemplate)
+	}
+	if len(t.Columns) == 0 {
+		return fmt.Errorf("%w: template '%s' has no columns", ErrInvalidTemplate, t.Name)
+	}
+
+	columns := make(map[string]bool, len(t.Columns))
+	var ready, inProgress, completed int
+	for _, c := range t.Columns {
+		if c.Name == "" {
+			return fmt.Errorf("%w: column without a name", ErrInvalidTemplate)
+		}
+		if columns[c.Name] {
+			return fmt.Errorf("%w: duplicate column '%s'", ErrInvalidTemplate, c.Name)
+		}
+		if c.WIPLimit < 0 {
+			return fmt.Errorf("%w: column '%s' has a negative WIP limit", ErrInvalidTemplate, c.Name)
+		}
+		columns[c.Name] = true
+		if c.Ready {
+			ready++
+		}
+		if c.InProgress {
+			inProgress++
+		}
+		if c.Completed {
+			completed++
+		}
+	}
+	if ready > 1 || inProgress > 1 || completed > 1 {
+		return fmt.Errorf("%w: at most one column may hold ready, in-progress or completed tasks", ErrInvalidTemplate)
+	}
+
+	labels, err := uniqueNames("label", len(t.Labels), func(i int) string { return t.Labels[i].Name })
+	if err != nil {
+		return err
+	}
+	types, err := uniqueNames("type", len(t.Types), func(i int) string { return t.Types[i].Name })
+	if err != nil {
+		return err
+	}
+	priorities, err := uniqueNames("priority", len(t.Priorities), func(i int) string { return t.Priorities[i].Name })
+	if err != nil {
+		return err
+	}
+	if _, err := uniqueNames("relation type", len(t.RelationTypes), func(i int) string { return t.RelationTypes[i].Name }); err != nil {
+		return err
+	}
+	for _, rt := range t.RelationTypes {
+		if rt.Forward == "" || rt.Reverse == "" {
+			return fmt.Errorf("%w: relation type '%s' needs both labels", ErrInvalidTemplate, rt.Name)
+		}
+	}
+
+	for _, task := range t.Tasks {
+		if task.Title == "" {
+			return fmt.Errorf("%w: starter task without a title", ErrInvalidTemplate)
+		}
+		if task.Column != "" && !columns[task.Column] {
+			return fmt.Errorf("%w: task '%s' is in unknown column '%s'", ErrInvalidTemplate, task.Title, task.Column)
+		}
+		// Without their own types and priorities, tasks are checked against
+		// the built-in ones when the project is created
+		if task.Type != "" && len(t.Types) > 0 && !types[task.Type] {
+			return fmt.Errorf("%w: task '%s' has unknown type '%s'", ErrInvalidTemplate, task.Title, task.Type)
+		}
+		if task.Priority != "" && len(t.Priorities) > 0 && !priorities[task.Priority] {
+			return fmt.Errorf("%w: task '%s' has unknown priority '%s'", ErrInvalidTemplate, task.Title, task.Priority)
+		}
+		for _, label := range task.Labels {
+			if !labels[label] {
+				return fmt.Errorf("%w: task '%s' has unknown label '%s'", ErrInvalidTemplate, task.Title, label)
+			}
+		}
+	}
+
+	return nil
+}
+
+// uniqueNames checks that n names are set and distinct and returns them as
+// a set
+func uniqueNames(kind string, n int, name func(int) string) (map[string]bool, error) {
+	names := make(map[string]bool, n)
+	for i := range n {
+		if name(i) == "" || names[name(i)] {
+			return nil, fmt.Errorf("%w: empty or duplicate %s '%s'", ErrInvalidTemplate, kind, name(i))
+		}
+		names[name(i)] = true
+	}
+	return names, nil
+}
+
+// templateToExport builds the export a project is created from. Columns,
+// labels and relation types are numbered in template order; relation types
+// are numbered past the built-in ones.
+func templateToExport(req CreateProjectRequest) *Export {
+	t := req.Template
+	export := &Export{
+		Version: ExportVersion,
+		Project: ExportedProject{
+			Name:             req.Name,
+			Description:      req.Description,
+			TicketKey:        req.TicketKey,
+			NextTicketNumber: 1,
+		},
+	}
+
+	columnIDs := make(map[string]int, len(t.Columns))
+	readyColumn := 1
+	for i, c := range t.Columns {
+		export.Columns = append(export.Columns, ExportedColumn{
+			ID:                   i + 1,
+			Name:                 c.Name,
+			HoldsReadyTasks:      c.Ready,
+			HoldsInProgressTasks: c.InProgress,
+			HoldsCompletedTasks:  c.Completed,
+			WIPLimit:             c.WIPLimit,
+		})
+		columnIDs[c.Name] = i + 1
+		if c.Ready {
+			readyColumn = i + 1
+		}
+	}
+
+	labelIDs := make(map[string]int, len(t.Labels))
+	for i, l := range t.Labels {
+		export.Labels = append(export.Labels, ExportedLabel{ID: i + 1, Name: l.Name, Color: l.Color})
+		labelIDs[l.Name] = i + 1
+	}
+
+	for _, c := range t.Types {
+		export.Types = append(export.Types, ExportedType{Name: c.Name, Color: c.Color, Icon: c.Icon})
+	}
+	for _, c := range t.Priorities {
+		export.Priorities = append(export.Priorities, ExportedPriority{Name: c.Name, Color: c.Color, Icon: c.Icon})
+	}
+	for i, rt := range t.RelationTypes {
+		export.RelationTypes = append(export.RelationTypes, ExportedRelationType{
+			ID:       models.RelationTypeRelated + i + 1,
+			Name:     rt.Name,
+			Forward:  rt.Forward,
+			Reverse:  rt.Reverse,
+			Color:    rt.Color,
+			Blocking: rt.Blocking,
+		})
+	}
+
+	positions := make(map[int]int, len(t.Columns))
+	for i, task := range t.Tasks {
+		columnID := readyColumn
+		if task.Column != "" {
+			columnID = columnIDs[task.Column]
+		}
+		positions[columnID]++
+
+		exported := ExportedTask{
+			ID:          i + 1,
+			Title:       task.Title,
+			Description: task.Description,
+			ColumnID:    columnID,
+			Position:    positions[columnID],
+			Type:        task.Type,
+			Priority:    task.Priority,
+		}
+		for _, label := range task.Labels {
+			exported.LabelIDs = append(exported.LabelIDs, labelIDs[label])
+		}
+		export.Tasks = append(export.Tasks, exported)
+	}
+
+	return export
+}
+
+// templateFromExport captures an exported project as a template. Starter
+// tasks keep their board order and leave archived tasks, ticket numbers,
+// dates, comments and relations behind.
+func templateFromExport(export *Export, req SaveTemplateRequest) *models.ProjectTemplate {
+	t := &models.ProjectTemplate{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	columnNames := make(map[int]string, len(export.Columns))
+	columnOrder := make(map[int]int, len(export.Columns))
+	for i, c := range export.Columns {
+		t.Columns = append(t.Columns, models.TemplateColumn{
+			Name:       c.Name,
+			Ready:      c.HoldsReadyTasks,
+			InProgress: c.HoldsInProgressTasks,
+			Completed:  c.HoldsCompletedTasks,
+			WIPLimit:   c.WIPLimit,
+		})
+		columnNames[c.ID] = c.Name
+		columnOrder[c.ID] = i
+	}
+
+	labelNames := make(map[int]string, len(export.Labels))
+	for _, l := range export.Labels {
+		t.Labels = append(t.Labels, models.TemplateLabel{Name: l.Name, Color: l.Color})
+		labelNames[l.ID] = l.Name
+	}
+
+	for _, c := range export.Types {
+		t.Types = append(t.Types, models.TemplateCategory{Name: c.Name, Color: c.Color, Icon: c.Icon})
+	}
+	for _, c := range export.Priorities {
+		t.Priorities = append(t.Priorities, models.TemplateCategory{Name: c.Name, Color: c.Color, Icon: c.Icon})
+	}
+	for _, rt := range export.RelationTypes {
+		t.RelationTypes = append(t.RelationTypes, models.TemplateRelationType{
+			Name:     rt.Name,
+			Forward:  rt.Forward,
+			Reverse:  rt.Reverse,
+			Color:    rt.Color,
+			Blocking: rt.Blocking,
+		})
+	}
+
+	if !req.IncludeTasks {
+		return t
+	}
+
+	tasks := slices.Clone(export.Tasks)
+	slices.SortStableFunc(tasks, func(a, b ExportedTask) int {
+		return cmp.Or(
+			cmp.Compare(columnOrder[a.ColumnID], columnOrder[b.ColumnID]),
+			cmp.Compare(a.Position, b.Position),
+		)
+	})
+	for _, task := range tasks {
+		if task.ArchivedAt != nil {
+			continue
+		}
+		starter := models.TemplateTask{
+			Title:       task.Title,
+			Description: task.Description,
+			Column:      columnNames[task.ColumnID],
+			Type:        task.Type,
+			Priority:    task.Priority,
+		}
+		for _, labelID := range task.LabelIDs {
+			starter.Labels = append(starter.Labels, labelNames[labelID])
+		}
+		t.Tasks = append(t.Tasks, starter)
+	}
+
+	return t
+}
@@ -0,0 +1,186 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+func TestSaveTemplate_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	projectID := seedExportProject(t, db)
+
+	saved, err := svc.SaveTemplate(ctx, SaveTemplateRequest{
+		ProjectID:    projectID,
+		Name:         "billing",
+		Description:  "Finance board",
+		IncludeTasks: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []models.TemplateColumn{
+		{Name: "Backlog", Ready: true},
+		{Name: "Doing", InProgress: true, WIPLimit: 3},
+		{Name: "Shipped", Completed: true},
+	}, saved.Columns)
+	require.Len(t, saved.Tasks, 2)
+	assert.Equal(t, "Refund flow", saved.Tasks[0].Title, "starter tasks follow board order")
+	assert.Equal(t, "Doing", saved.Tasks[1].Column)
+	assert.Equal(t, []string{"urgent"}, saved.Tasks[1].Labels)
+
+	template, err := svc.GetTemplate(ctx, "billing")
+	require.NoError(t, err)
+	assert.Equal(t, saved, template)
+
+	created, err := svc.CreateProject(ctx, CreateProjectRequest{Name: "Payroll", Template: template})
+	require.NoError(t, err)
+
+	copied, err := svc.ExportProject(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Payroll", copied.Project.Name)
+	require.Len(t, copied.Columns, 3)
+	assert.Equal(t, "Backlog", copied.Columns[0].Name)
+	assert.Equal(t, 3, copied.Columns[1].WIPLimit)
+	assert.True(t, copied.Columns[2].HoldsCompletedTasks)
+	require.Len(t, copied.RelationTypes, 1)
+	assert.Equal(t, "verifies", copied.RelationTypes[0].Name)
+
+	require.Len(t, copied.Tasks, 2)
+	ticketNumbers := []int{copied.Tasks[0].TicketNumber, copied.Tasks[1].TicketNumber}
+	assert.ElementsMatch(t, []int{1, 2}, ticketNumbers, "starter tasks are numbered from 1")
+	assert.Empty(t, copied.Relations, "relations between tasks are not part of a template")
+}
+
+func TestSaveTemplate_Exists(t *testing.T) {
+	t.Parallel()
+
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	projectID := seedExportProject(t, db)
+
+	_, err := svc.SaveTemplate(ctx, SaveTemplateRequest{ProjectID: projectID, Name: "billing"})
+	require.NoError(t, err)
+
+	_, err = svc.SaveTemplate(ctx, SaveTemplateRequest{ProjectID: projectID, Name: "billing", IncludeTasks: true})
+	require.ErrorIs(t, err, ErrTemplateExists)
+
+	_, err = svc.SaveTemplate(ctx, SaveTemplateRequest{ProjectID: projectID, Name: "billing", IncludeTasks: true, Replace: true})
+	require.NoError(t, err)
+
+	templates, err := svc.ListTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Len(t, templates[0].Tasks, 2)
+
+	_, err = svc.SaveTemplate(ctx, SaveTemplateRequest{ProjectID: 999, Name: "missing"})
+	require.ErrorIs(t, err, ErrProjectNotFound)
+
+	require.NoError(t, svc.DeleteTemplate(ctx, "billing"))
+	require.ErrorIs(t, svc.DeleteTemplate(ctx, "billing"), ErrTemplateNotFound)
+	_, err = svc.GetTemplate(ctx, "billing")
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+}
+
+func TestCreateProject_FromTemplate(t *testing.T) {
+	t.Parallel()
+
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	template := &models.ProjectTemplate{
+		Name: "triage",
+		Columns: []models.TemplateColumn{
+			{Name: "Inbox"},
+			{Name: "Triaged", Ready: true},
+			{Name: "Fixing", InProgress: true},
+			{Name: "Released", Completed: true},
+		},
+		Labels: []models.TemplateLabel{{Name: "regression", Color: "#FF0000"}},
+		Tasks: []models.TemplateTask{
+			{Title: "Write the triage checklist"},
+			{Title: "Sort the inbox", Column: "Inbox", Priority: "high", Labels: []string{"regression"}},
+		},
+	}
+
+	project, err := svc.CreateProject(ctx, CreateProjectRequest{Name: "Mobile bugs", Template: template})
+	require.NoError(t, err)
+
+	export, err := svc.ExportProject(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, export.Columns, 4)
+	assert.True(t, export.Columns[1].HoldsReadyTasks)
+	assert.NotEmpty(t, export.Types, "projects without template types get the built-in ones")
+
+	columnNames := make(map[int]string, len(export.Columns))
+	for _, c := range export.Columns {
+		columnNames[c.ID] = c.Name
+	}
+	require.Len(t, export.Tasks, 2)
+	for _, task := range export.Tasks {
+		switch task.Title {
+		case "Write the triage checklist":
+			assert.Equal(t, "Triaged", columnNames[task.ColumnID], "tasks without a column go to the ready column")
+		case "Sort the inbox":
+			assert.Equal(t, "Inbox", columnNames[task.ColumnID])
+			assert.Equal(t, "high", task.Priority)
+			assert.Len(t, task.LabelIDs, 1)
+		}
+	}
+}
+
+func TestCreateProject_InvalidTemplate(t *testing.T) {
+	t.Parallel()
+
+	valid := func() *models.ProjectTemplate {
+		return &models.ProjectTemplate{
+			Name:    "demo",
+			Columns: []models.TemplateColumn{{Name: "Todo"}, {Name: "Done", Completed: true}},
+			Tasks:   []models.TemplateTask{{Title: "First"}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(t *models.ProjectTemplate)
+	}{
+		{"no columns", func(t *models.ProjectTemplate) { t.Columns = nil }},
+		{"duplicate column", func(t *models.ProjectTemplate) { t.Columns[1].Name = "Todo" }},
+		{"two completed columns", func(t *models.ProjectTemplate) { t.Columns[0].Completed = true }},
+		{"unknown task column", func(t *models.ProjectTemplate) { t.Tasks[0].Column = "Doing" }},
+		{"unknown task label", func(t *models.ProjectTemplate) { t.Tasks[0].Labels = []string{"urgent"} }},
+		{"unknown task type", func(t *models.ProjectTemplate) {
+			t.Types = []models.TemplateCategory{{Name: "chore", Color: "#6B7280"}}
+			t.Tasks[0].Type = "bug"
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := testutil.SetupTestDB(t)
+			svc := NewService(db, nil)
+
+			template := valid()
+			tt.mutate(template)
+			_, err := svc.CreateProject(context.Background(), CreateProjectRequest{Name: "Demo", Template: template})
+			require.ErrorIs(t, err, ErrInvalidTemplate)
+
+			projects, err := svc.GetAllProjects(context.Background())
+			require.NoError(t, err)
+			assert.Empty(t, projects, "a rejected template must not create anything")
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package relationtype
+
+import "errors"
+
+// Relation type errors
+var (
+	// Validation errors
+	ErrEmptyName             = errors.New("name cannot be empty")
+	ErrNameTooLong           = errors.New("name cannot exceed 30 characters")
+	ErrInvalidName           = errors.New("name may only contain lowercase letters, digits and hyphens")
+	ErrEmptyLabel            = errors.New("label cannot be empty")
+	ErrLabelTooLong          = errors.New("label cannot exceed 30 characters")
+	ErrInvalidColor          = errors.New("invalid color format (must be hex color like #FFFFFF)")
+	ErrInvalidRelationTypeID = errors.New("invalid relation type ID")
+	ErrInvalidProjectID      = errors.New("invalid project ID")
+
+	// Business logic errors
+	ErrRelationTypeNotFound = errors.New("relation type not found")
+	ErrRelationTypeExists   = errors.New("a relation type with this name already exists in the project")
+	ErrBuiltinRelationType  = errors.New("built-in relation types cannot be changed")
+	ErrRelationTypeInUse    = errors.New("relation type is used by task links; remove them first")
+)
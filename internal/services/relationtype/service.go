@@ -0,0 +1,301 @@
+package relationtype
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+var (
+	// Hex color regex pattern
+	hexColorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+	// Names are typed on the command line, so keep them to one plain word
+	nameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+)
+
+// Service defines all relation type operations. The built-in parent, blocker
+// and related types are shared by every project; projects can add their own.
+type Service interface {
+	// Read operations
+	GetRelationTypesByProject(ctx context.Context, projectID int) ([]*models.RelationType, error)
+
+	// Write operations
+	CreateRelationType(ctx context.Context, req CreateRelationTypeRequest) (*models.RelationType, error)
+	UpdateRelationType(ctx context.Context, req UpdateRelationTypeRequest) error
+	DeleteRelationType(ctx context.Context, req DeleteRelationTypeRequest) error
+}
+
+// CreateRelationTypeRequest encapsulates data for creating a relation type
+type CreateRelationTypeRequest struct {
+	ProjectID  int
+	Name       string
+	PToCLabel  string // Label from the parent's side, e.g. "Verified By"
+	CToPLabel  string // Label from the child's side, e.g. "Verifies"; defaults to PToCLabel
+	Color      string // Hex color like #FF5733
+	IsBlocking bool
+}
+
+// UpdateRelationTypeRequest encapsulates data for updating a relation type
+type UpdateRelationTypeRequest struct {
+	ID         int
+	Name       *string
+	PToCLabel  *string
+	CToPLabel  *string
+	Color      *string
+	IsBlocking *bool
+}
+
+// DeleteRelationTypeRequest encapsulates data for deleting a relation type
+type DeleteRelationTypeRequest struct {
+	ID          int
+	RemoveLinks bool // Also remove task links of this type; required while any exist
+}
+
+// service implements Service interface using SQLC directly
+type service struct {
+	db          *sql.DB
+	queries     generated.Querier
+	eventClient events.EventPublisher
+}
+
+// NewService creates a new relation type service
+func NewService(db *sql.DB, eventClient events.EventPublisher) Service {
+	return &service{
+		db:          db,
+		queries:     generated.New(db),
+		eventClient: eventClient,
+	}
+}
+
+// GetRelationTypesByProject retrieves the built-in relation types followed by
+// the project's own
+func (s *service) GetRelationTypesByProject(ctx context.Context, projectID int) ([]*models.RelationType, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	relationTypes, err := s.queries.GetRelationTypesByProject(ctx, sql.NullInt64{Int64: int64(projectID), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	return converters.RelationTypesToModels(relationTypes), nil
+}
+
+// CreateRelationType adds a relation type to a project
+func (s *service) CreateRelationType(ctx context.Context, req CreateRelationTypeRequest) (*models.RelationType, error) {
+	if req.ProjectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if strings.TrimSpace(req.CToPLabel) == "" {
+		req.CToPLabel = req.PToCLabel
+	}
+	if err := validateFields(&req.Name, &req.PToCLabel, &req.CToPLabel, req.Color); err != nil {
+		return nil, err
+	}
+
+	// Project names must not shadow a built-in name, which the unique index
+	// cannot catch since built-in rows have no project
+	if err := s.checkNameAvailable(ctx, req.ProjectID, req.Name, 0); err != nil {
+		return nil, err
+	}
+
+	created, err := s.queries.CreateRelationType(ctx, generated.CreateRelationTypeParams{
+		PToCLabel:  req.PToCLabel,
+		CToPLabel:  req.CToPLabel,
+		Color:      req.Color,
+		IsBlocking: req.IsBlocking,
+		ProjectID:  sql.NullInt64{Int64: int64(req.ProjectID), Valid: true},
+		Name:       req.Name,
+	})
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, ErrRelationTypeExists
+		}
+		return nil, fmt.Errorf("failed to create relation type: %w", err)
+	}
+
+	s.publishRelationTypesChanged(req.ProjectID)
+
+	return converters.RelationTypeToModel(created), nil
+}
+
+// UpdateRelationType changes a relation type's name, labels, colour or
+// blocking flag
+func (s *service) UpdateRelationType(ctx context.Context, req UpdateRelationTypeRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidRelationTypeID
+	}
+
+	existing, err := s.getProjectRelationType(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	name, pToC, cToP, color, blocking := existing.Name, existing.PToCLabel, existing.CToPLabel, existing.Color, existing.IsBlocking
+	if req.Name != nil {
+		name = *req.Name
+	}
+	if req.PToCLabel != nil {
+		pToC = *req.PToCLabel
+	}
+	if req.CToPLabel != nil {
+		cToP = *req.CToPLabel
+	}
+	if req.Color != nil {
+		color = *req.Color
+	}
+	if req.IsBlocking != nil {
+		blocking = *req.IsBlocking
+	}
+	if err := validateFields(&name, &pToC, &cToP, color); err != nil {
+		return err
+	}
+	projectID := int(existing.ProjectID.Int64)
+	if err := s.checkNameAvailable(ctx, projectID, name, existing.ID); err != nil {
+		return err
+	}
+
+	if err := s.queries.UpdateRelationType(ctx, generated.UpdateRelationTypeParams{
+		Name:       name,
+		PToCLabel:  pToC,
+		CToPLabel:  cToP,
+		Color:      color,
+		IsBlocking: blocking,
+		ID:         existing.ID,
+	}); err != nil {
+		if isUniqueConstraintError(err) {
+			return ErrRelationTypeExists
+		}
+		return fmt.Errorf("failed to update relation type: %w", err)
+	}
+
+	s.publishRelationTypesChanged(projectID)
+
+	return nil
+}
+
+// DeleteRelationType removes a relation type. A type still used by task links
+// is only deleted, together with those links, when req.RemoveLinks is set.
+func (s *service) DeleteRelationType(ctx context.Context, req DeleteRelationTypeRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidRelationTypeID
+	}
+
+	existing, err := s.getProjectRelationType(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		used, err := qtx.CountTaskRelationsWithType(ctx, existing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count task links: %w", err)
+		}
+		if used > 0 {
+			if !req.RemoveLinks {
+				return ErrRelationTypeInUse
+			}
+			if err := qtx.DeleteTaskRelationsWithType(ctx, existing.ID); err != nil {
+				return fmt.Errorf("failed to remove task links: %w", err)
+			}
+		}
+
+		return qtx.DeleteRelationType(ctx, existing.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishRelationTypesChanged(int(existing.ProjectID.Int64))
+
+	return nil
+}
+
+// getProjectRelationType loads a relation type that belongs to a project
+func (s *service) getProjectRelationType(ctx context.Context, id int) (generated.RelationType, error) {
+	existing, err := s.queries.GetRelationTypeByID(ctx, int64(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return existing, ErrRelationTypeNotFound
+	}
+	if err != nil {
+		return existing, fmt.Errorf("failed to get relation type: %w", err)
+	}
+	if !existing.ProjectID.Valid {
+		return existing, ErrBuiltinRelationType
+	}
+	return existing, nil
+}
+
+// checkNameAvailable reports ErrRelationTypeExists when another relation type
+// visible to the project, built-in or its own, already uses name
+func (s *service) checkNameAvailable(ctx context.Context, projectID int, name string, exceptID int64) error {
+	relationTypes, err := s.queries.GetRelationTypesByProject(ctx, sql.NullInt64{Int64: int64(projectID), Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to get relation types: %w", err)
+	}
+	for _, rt := range relationTypes {
+		if rt.ID != exceptID && rt.Name == name {
+			return ErrRelationTypeExists
+		}
+	}
+	return nil
+}
+
+// validateFields normalises and validates a relation type's name, labels and colour
+func validateFields(name, pToCLabel, cToPLabel *string, color string) error {
+	*name = strings.ToLower(strings.TrimSpace(*name))
+	if *name == "" {
+		return ErrEmptyName
+	}
+	if utf8.RuneCountInString(*name) > 30 {
+		return ErrNameTooLong
+	}
+	if !nameRegex.MatchString(*name) {
+		return ErrInvalidName
+	}
+	for _, label := range []*string{pToCLabel, cToPLabel} {
+		*label = strings.TrimSpace(*label)
+		if *label == "" {
+			return ErrEmptyLabel
+		}
+		if utf8.RuneCountInString(*label) > 30 {
+			return ErrLabelTooLong
+		}
+	}
+	if !hexColorRegex.MatchString(color) {
+		return ErrInvalidColor
+	}
+	return nil
+}
+
+// publishRelationTypesChanged tells clients to reload a project's relation types
+func (s *service) publishRelationTypesChanged(projectID int) {
+	if s.eventClient == nil {
+		return
+	}
+
+	// Publish with retry (3 attempts with exponential backoff)
+	// Non-blocking: errors are logged but don't affect the operation
+	_ = events.PublishWithRetry(s.eventClient, events.Event{
+		Type:      events.EventProjectUpdated,
+		ProjectID: projectID,
+		Fields:    []string{"relation_types"},
+	}, 3)
+}
+
+// isUniqueConstraintError checks if an error is a SQLite unique constraint violation
+func isUniqueConstraintError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
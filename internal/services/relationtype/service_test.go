@@ -0,0 +1,129 @@
+package relationtype
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+func TestGetRelationTypesByProject_BuiltinsFirst(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "QA")
+	otherID := testutil.CreateTestProject(t, db, "Other")
+
+	if _, err := svc.CreateRelationType(ctx, CreateRelationTypeRequest{
+		ProjectID: otherID, Name: "duplicates", PToCLabel: "Duplicated By", CToPLabel: "Duplicates", Color: "#6B7280",
+	}); err != nil {
+		t.Fatalf("CreateRelationType() error = %v", err)
+	}
+	created, err := svc.CreateRelationType(ctx, CreateRelationTypeRequest{
+		ProjectID: projectID, Name: " Verifies ", PToCLabel: "Verified By", CToPLabel: "Verifies", Color: "#10B981",
+	})
+	if err != nil {
+		t.Fatalf("CreateRelationType() error = %v", err)
+	}
+	if created.Name != "verifies" || created.IsBlocking || created.IsBuiltin() {
+		t.Errorf("created = %+v, want a non-blocking project type named verifies", created)
+	}
+
+	relationTypes, err := svc.GetRelationTypesByProject(ctx, projectID)
+	if err != nil {
+		t.Fatalf("GetRelationTypesByProject() error = %v", err)
+	}
+	var got []string
+	for _, rt := range relationTypes {
+		got = append(got, rt.Name)
+	}
+	want := []string{"parent", "blocker", "related", "verifies"}
+	if len(got) != len(want) {
+		t.Fatalf("relation types = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("relation types = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCreateRelationType_Validation(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "QA")
+
+	tests := []struct {
+		name    string
+		req     CreateRelationTypeRequest
+		wantErr error
+	}{
+		{"empty name", CreateRelationTypeRequest{ProjectID: projectID, PToCLabel: "X", Color: "#000000"}, ErrEmptyName},
+		{"name with spaces", CreateRelationTypeRequest{ProjectID: projectID, Name: "is tested by", PToCLabel: "X", Color: "#000000"}, ErrInvalidName},
+		{"missing label", CreateRelationTypeRequest{ProjectID: projectID, Name: "tests", Color: "#000000"}, ErrEmptyLabel},
+		{"bad color", CreateRelationTypeRequest{ProjectID: projectID, Name: "tests", PToCLabel: "Tests", Color: "green"}, ErrInvalidColor},
+		{"shadows built-in", CreateRelationTypeRequest{ProjectID: projectID, Name: "Blocker", PToCLabel: "X", Color: "#000000"}, ErrRelationTypeExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.CreateRelationType(ctx, tt.req); !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateRelationType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The reverse label defaults to the forward one
+	created, err := svc.CreateRelationType(ctx, CreateRelationTypeRequest{ProjectID: projectID, Name: "follows", PToCLabel: "Follows", Color: "#000000"})
+	if err != nil {
+		t.Fatalf("CreateRelationType() error = %v", err)
+	}
+	if created.CToPLabel != "Follows" {
+		t.Errorf("CToPLabel = %q, want %q", created.CToPLabel, "Follows")
+	}
+}
+
+func TestUpdateAndDeleteRelationType(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "QA")
+	columnID := testutil.CreateTestColumn(t, db, projectID, "Todo")
+	parentID := testutil.CreateTestTask(t, db, columnID, "Feature")
+	childID := testutil.CreateTestTask(t, db, columnID, "Test plan")
+
+	if err := svc.UpdateRelationType(ctx, UpdateRelationTypeRequest{ID: 2}); !errors.Is(err, ErrBuiltinRelationType) {
+		t.Errorf("UpdateRelationType(built-in) error = %v, want %v", err, ErrBuiltinRelationType)
+	}
+
+	created, err := svc.CreateRelationType(ctx, CreateRelationTypeRequest{ProjectID: projectID, Name: "tests", PToCLabel: "Tested By", CToPLabel: "Tests", Color: "#000000"})
+	if err != nil {
+		t.Fatalf("CreateRelationType() error = %v", err)
+	}
+
+	blocking := true
+	if err := svc.UpdateRelationType(ctx, UpdateRelationTypeRequest{ID: created.ID, IsBlocking: &blocking}); err != nil {
+		t.Fatalf("UpdateRelationType() error = %v", err)
+	}
+
+	if _, err := db.ExecContext(ctx, `INSERT INTO task_subtasks (parent_id, child_id, relation_type_id) VALUES (?, ?, ?)`,
+		parentID, childID, created.ID); err != nil {
+		t.Fatalf("failed to link tasks: %v", err)
+	}
+
+	if err := svc.DeleteRelationType(ctx, DeleteRelationTypeRequest{ID: created.ID}); !errors.Is(err, ErrRelationTypeInUse) {
+		t.Errorf("DeleteRelationType() error = %v, want %v", err, ErrRelationTypeInUse)
+	}
+	if err := svc.DeleteRelationType(ctx, DeleteRelationTypeRequest{ID: created.ID, RemoveLinks: true}); err != nil {
+		t.Fatalf("DeleteRelationType(RemoveLinks) error = %v", err)
+	}
+
+	var links int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM task_subtasks`).Scan(&links); err != nil {
+		t.Fatal(err)
+	}
+	if links != 0 {
+		t.Errorf("links = %d, want 0 after deleting the relation type", links)
+	}
+}
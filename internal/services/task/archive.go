@@ -0,0 +1,166 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// ArchiveTask hides a task from the board. The task keeps its column,
+// relations and history and can be brought back with UnarchiveTask.
+func (s *service) ArchiveTask(ctx context.Context, taskID int) error {
+	if taskID <= 0 {
+		return ErrInvalidTaskID
+	}
+
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		task, err := getTaskForArchive(ctx, qtx, taskID)
+		if err != nil {
+			return err
+		}
+		if task.ArchivedAt.Valid {
+			return ErrTaskArchived
+		}
+
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, task.ID); err != nil {
+			return err
+		}
+		if err := qtx.ArchiveTask(ctx, task.ID); err != nil {
+			return fmt.Errorf("failed to archive task: %w", err)
+		}
+		if changes, err = snapshot.changes(ctx, qtx); err != nil {
+			return err
+		}
+		return recordTaskEvent(ctx, qtx, task.ID, models.TaskEventArchived, "", "", task.ColumnName)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskArchived, TaskID: taskID})
+	s.publishBlockChanges(ctx, taskID, changes)
+	return nil
+}
+
+// UnarchiveTask puts an archived task back on the board, in the column it
+// was archived from
+func (s *service) UnarchiveTask(ctx context.Context, taskID int) error {
+	if taskID <= 0 {
+		return ErrInvalidTaskID
+	}
+
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		task, err := getTaskForArchive(ctx, qtx, taskID)
+		if err != nil {
+			return err
+		}
+		if !task.ArchivedAt.Valid {
+			return ErrTaskNotArchived
+		}
+
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, task.ID); err != nil {
+			return err
+		}
+		if err := qtx.UnarchiveTask(ctx, task.ID); err != nil {
+			return fmt.Errorf("failed to unarchive task: %w", err)
+		}
+		if changes, err = snapshot.changes(ctx, qtx); err != nil {
+			return err
+		}
+		return recordTaskEvent(ctx, qtx, task.ID, models.TaskEventUnarchived, "", "", task.ColumnName)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUnarchived, TaskID: taskID})
+	s.publishBlockChanges(ctx, taskID, changes)
+	return nil
+}
+
+// ArchiveCompletedTasks archives every task that has been in the project's
+// completed column for at least olderThan, and returns the IDs of the
+// archived tasks. Editing a finished task does not keep it on the board.
+func (s *service) ArchiveCompletedTasks(ctx context.Context, projectID int, olderThan time.Duration) ([]int, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if olderThan <= 0 {
+		return nil, ErrInvalidArchiveAge
+	}
+
+	var archived []int
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		ids, err := qtx.ArchiveCompletedTasks(ctx, generated.ArchiveCompletedTasksParams{
+			Age:       fmt.Sprintf("-%d seconds", int64(olderThan.Seconds())),
+			ProjectID: int64(projectID),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to archive completed tasks: %w", err)
+		}
+
+		for _, id := range ids {
+			task, err := qtx.GetTaskDetail(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get archived task: %w", err)
+			}
+			if err := recordTaskEvent(ctx, qtx, id, models.TaskEventArchived, "", "", task.ColumnName); err != nil {
+				return err
+			}
+			archived = append(archived, int(id))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range archived {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskArchived, TaskID: id})
+	}
+	return archived, nil
+}
+
+// GetArchivedTasksByProject lists a project's archived tasks, most recently
+// archived first
+func (s *service) GetArchivedTasksByProject(ctx context.Context, projectID int) ([]*models.ArchivedTask, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	rows, err := s.queries.GetArchivedTasksByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get archived tasks: %w", err)
+	}
+	return converters.ArchivedTasksToModels(rows), nil
+}
+
+// getTaskForArchive loads the task being archived or unarchived
+func getTaskForArchive(ctx context.Context, qtx generated.Querier, taskID int) (generated.GetTaskDetailRow, error) {
+	task, err := qtx.GetTaskDetail(ctx, int64(taskID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return task, ErrTaskNotFound
+		}
+		return task, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
+}
@@ -0,0 +1,309 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// BulkUpdateRequest describes changes applied to several tasks of one
+// project at once. Nil and empty fields leave the tasks as they are.
+type BulkUpdateRequest struct {
+	ProjectID      int
+	TaskIDs        []int
+	ColumnID       *int
+	PriorityID     *int
+	TypeID         *int
+	AttachLabelIDs []int
+	DetachLabelIDs []int
+	Archive        bool
+	// DryRun works out the changes without keeping them
+	DryRun bool
+}
+
+// hasChanges reports whether the request changes anything
+func (r BulkUpdateRequest) hasChanges() bool {
+	return r.ColumnID != nil || r.PriorityID != nil || r.TypeID != nil ||
+		len(r.AttachLabelIDs) > 0 || len(r.DetachLabelIDs) > 0 || r.Archive
+}
+
+// BulkChange is one change made to a task by a bulk update. Field is one of
+// column, priority, type, label or archived; label changes have only a new
+// value when attaching and only an old value when detaching.
+type BulkChange struct {
+	Field    string
+	OldValue string `json:",omitempty"`
+	NewValue string `json:",omitempty"`
+}
+
+// BulkTaskResult lists what a bulk update changed on one task. Tasks that
+// already matched the request have no changes.
+type BulkTaskResult struct {
+	TaskID       int
+	TicketNumber int
+	Title        string
+	Changes      []BulkChange `json:",omitempty"`
+}
+
+// BulkUpdateResult describes the outcome of a bulk update, with one entry
+// per selected task in the order they were given
+type BulkUpdateResult struct {
+	Tasks   []*BulkTaskResult
+	Updated int // Tasks with at least one change
+}
+
+// errBulkDryRun rolls back the transaction of a dry run
+var errBulkDryRun = errors.New("bulk update dry run")
+
+// BulkUpdateTasks applies the same changes to every selected task in a single
+// transaction: if one task cannot be changed (it belongs to another project,
+// the target column is full, ...) nothing is. Every change is recorded in the
+// tasks' history, and one db_changed event for the project replaces the
+// per-task events so listeners reload once; it lists the tasks the update
+// unblocked so they can still be announced. A dry run returns the same result
+// without keeping the changes.
+func (s *service) BulkUpdateTasks(ctx context.Context, req BulkUpdateRequest) (*BulkUpdateResult, error) {
+	if req.ProjectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if len(req.TaskIDs) == 0 {
+		return nil, ErrNoTasksSelected
+	}
+	if !req.hasChanges() {
+		return nil, ErrNoBulkChanges
+	}
+	for _, id := range req.TaskIDs {
+		if id <= 0 {
+			return nil, ErrInvalidTaskID
+		}
+	}
+
+	result := &BulkUpdateResult{}
+	// Tasks the update unblocked, named in the event once the transaction commits
+	var unblocked []int
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		labels, err := bulkLabels(ctx, qtx, int64(req.ProjectID), req.AttachLabelIDs, req.DetachLabelIDs)
+		if err != nil {
+			return err
+		}
+		if req.ColumnID != nil {
+			column, err := qtx.GetColumnByID(ctx, int64(*req.ColumnID))
+			if err != nil || column.ProjectID != int64(req.ProjectID) {
+				return fmt.Errorf("%w: %d", ErrInvalidColumnID, *req.ColumnID)
+			}
+		}
+		if req.TypeID != nil || req.PriorityID != nil {
+			var typeID, priorityID int
+			if req.TypeID != nil {
+				typeID = *req.TypeID
+			}
+			if req.PriorityID != nil {
+				priorityID = *req.PriorityID
+			}
+			if _, _, err := resolveTypeAndPriority(ctx, qtx, int64(req.ProjectID), typeID, priorityID); err != nil {
+				return err
+			}
+		}
+
+		seen := make(map[int]bool, len(req.TaskIDs))
+		for _, id := range req.TaskIDs {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+
+			taskResult, changes, err := bulkUpdateTaskTx(ctx, qtx, req, int64(id), labels)
+			if err != nil {
+				return err
+			}
+			unblocked = append(unblocked, changes.unblocked...)
+			result.Tasks = append(result.Tasks, taskResult)
+			if len(taskResult.Changes) > 0 {
+				result.Updated++
+			}
+		}
+
+		if req.DryRun {
+			return errBulkDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errBulkDryRun) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Updated > 0 {
+		s.publishEvent(events.Event{Type: events.EventDatabaseChanged, ProjectID: req.ProjectID, Unblocked: unblocked})
+	}
+	return result, nil
+}
+
+// bulkLabels loads the labels a bulk update attaches or detaches, checking
+// that they belong to the project
+func bulkLabels(ctx context.Context, qtx generated.Querier, projectID int64, attach, detach []int) (map[int64]generated.Label, error) {
+	labels := make(map[int64]generated.Label, len(attach)+len(detach))
+	for _, id := range append(append([]int{}, attach...), detach...) {
+		if id <= 0 {
+			return nil, ErrInvalidLabelID
+		}
+		label, err := qtx.GetLabelByID(ctx, int64(id))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, fmt.Errorf("%w: %d", ErrInvalidLabelID, id)
+			}
+			return nil, fmt.Errorf("failed to get label: %w", err)
+		}
+		if label.ProjectID != projectID {
+			return nil, fmt.Errorf("%w: label %d belongs to another project", ErrInvalidLabelID, id)
+		}
+		labels[label.ID] = label
+	}
+	return labels, nil
+}
+
+// bulkUpdateTaskTx applies a bulk update to one task: type and priority
+// first, then labels, the move and finally archiving, so a task is archived
+// from the column it was moved to. It also returns the tasks the move and the
+// archiving unblocked or blocked again. qtx must be scoped to the caller's
+// transaction.
+func bulkUpdateTaskTx(ctx context.Context, qtx generated.Querier, req BulkUpdateRequest, taskID int64, labels map[int64]generated.Label) (*BulkTaskResult, blockChanges, error) {
+	var changes blockChanges
+
+	before, err := qtx.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, changes, fmt.Errorf("%w: %d", ErrTaskNotFound, taskID)
+		}
+		return nil, changes, fmt.Errorf("failed to get task: %w", err)
+	}
+	if before.ProjectID != int64(req.ProjectID) {
+		return nil, changes, fmt.Errorf("%w: task %d", ErrTaskNotInProject, taskID)
+	}
+
+	result := &BulkTaskResult{
+		TaskID:       int(taskID),
+		TicketNumber: int(before.TicketNumber.Int64),
+		Title:        before.Title,
+	}
+
+	if req.PriorityID != nil {
+		if err := qtx.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{
+			PriorityID: int64(*req.PriorityID),
+			ID:         taskID,
+		}); err != nil {
+			return nil, changes, fmt.Errorf("failed to update priority: %w", err)
+		}
+	}
+	if req.TypeID != nil {
+		if err := qtx.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{
+			TypeID: int64(*req.TypeID),
+			ID:     taskID,
+		}); err != nil {
+			return nil, changes, fmt.Errorf("failed to update type: %w", err)
+		}
+	}
+	if req.PriorityID != nil || req.TypeID != nil {
+		after, err := qtx.GetTaskDetail(ctx, taskID)
+		if err != nil {
+			return nil, changes, fmt.Errorf("failed to get updated task: %w", err)
+		}
+		if err := recordTaskDiff(ctx, qtx, before, after); err != nil {
+			return nil, changes, err
+		}
+		if before.PriorityDescription != after.PriorityDescription {
+			result.Changes = append(result.Changes, BulkChange{"priority", before.PriorityDescription.String, after.PriorityDescription.String})
+		}
+		if before.TypeDescription != after.TypeDescription {
+			result.Changes = append(result.Changes, BulkChange{"type", before.TypeDescription.String, after.TypeDescription.String})
+		}
+	}
+
+	if len(req.AttachLabelIDs) > 0 || len(req.DetachLabelIDs) > 0 {
+		current, err := qtx.GetTaskLabels(ctx, taskID)
+		if err != nil {
+			return nil, changes, fmt.Errorf("failed to get task labels: %w", err)
+		}
+		attached := make(map[int64]bool, len(current))
+		for _, l := range current {
+			attached[l.ID] = true
+		}
+
+		for _, id := range req.AttachLabelIDs {
+			label := labels[int64(id)]
+			if attached[label.ID] {
+				continue
+			}
+			if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{TaskID: taskID, LabelID: label.ID}); err != nil {
+				return nil, changes, fmt.Errorf("failed to attach label: %w", err)
+			}
+			if err := recordTaskEvent(ctx, qtx, taskID, models.TaskEventLabelAttached, "label", "", label.Name); err != nil {
+				return nil, changes, err
+			}
+			attached[label.ID] = true
+			result.Changes = append(result.Changes, BulkChange{Field: "label", NewValue: label.Name})
+		}
+		for _, id := range req.DetachLabelIDs {
+			label := labels[int64(id)]
+			if !attached[label.ID] {
+				continue
+			}
+			if err := qtx.RemoveLabelFromTask(ctx, generated.RemoveLabelFromTaskParams{TaskID: taskID, LabelID: label.ID}); err != nil {
+				return nil, changes, fmt.Errorf("failed to detach label: %w", err)
+			}
+			if err := recordTaskEvent(ctx, qtx, taskID, models.TaskEventLabelDetached, "label", label.Name, ""); err != nil {
+				return nil, changes, err
+			}
+			attached[label.ID] = false
+			result.Changes = append(result.Changes, BulkChange{Field: "label", OldValue: label.Name})
+		}
+	}
+
+	columnName := before.ColumnName
+	if req.ColumnID != nil && before.ColumnID != int64(*req.ColumnID) {
+		moveChanges, err := moveTaskToColumnTx(ctx, qtx, taskID, int64(*req.ColumnID))
+		if err != nil {
+			return nil, changes, err
+		}
+		changes.unblocked = append(changes.unblocked, moveChanges.unblocked...)
+		changes.reblocked = append(changes.reblocked, moveChanges.reblocked...)
+		moved, err := qtx.GetTaskDetail(ctx, taskID)
+		if err != nil {
+			return nil, changes, fmt.Errorf("failed to get moved task: %w", err)
+		}
+		columnName = moved.ColumnName
+		result.Changes = append(result.Changes, BulkChange{"column", before.ColumnName, columnName})
+	}
+
+	if req.Archive && !before.ArchivedAt.Valid {
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, taskID); err != nil {
+			return nil, changes, err
+		}
+		if err := qtx.ArchiveTask(ctx, taskID); err != nil {
+			return nil, changes, fmt.Errorf("failed to archive task: %w", err)
+		}
+		archiveChanges, err := snapshot.changes(ctx, qtx)
+		if err != nil {
+			return nil, changes, err
+		}
+		changes.unblocked = append(changes.unblocked, archiveChanges.unblocked...)
+		changes.reblocked = append(changes.reblocked, archiveChanges.reblocked...)
+		if err := recordTaskEvent(ctx, qtx, taskID, models.TaskEventArchived, "", "", columnName); err != nil {
+			return nil, changes, err
+		}
+		result.Changes = append(result.Changes, BulkChange{Field: "archived"})
+	}
+
+	return result, changes, nil
+}
@@ -0,0 +1,157 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// GetChecklist retrieves the checklist of a task in order
+func (s *service) GetChecklist(ctx context.Context, taskID int) ([]*models.ChecklistItem, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	rows, err := s.queries.GetChecklistByTask(ctx, int64(taskID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	return converters.ChecklistToModels(rows), nil
+}
+
+// AddChecklistItem appends an item to the end of a task's checklist
+func (s *service) AddChecklistItem(ctx context.Context, taskID int, text string) (*models.ChecklistItem, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+	text = strings.TrimSpace(text)
+	if err := validateChecklistText(text); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.queries.GetTask(ctx, int64(taskID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to verify task exists: %w", err)
+	}
+
+	var item generated.TaskChecklistItem
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		var itemErr error
+		item, itemErr = qtx.CreateChecklistItem(ctx, generated.CreateChecklistItemParams{
+			TaskID:  int64(taskID),
+			Content: text,
+		})
+		if itemErr != nil {
+			return fmt.Errorf("failed to create checklist item: %w", itemErr)
+		}
+
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventChecklistAdded, "checklist", "", text)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: taskID, Fields: []string{"checklist"}})
+	return converters.ChecklistItemToModel(item), nil
+}
+
+// ToggleChecklistItem ticks an open checklist item or reopens a ticked one
+func (s *service) ToggleChecklistItem(ctx context.Context, itemID int) (*models.ChecklistItem, error) {
+	if itemID <= 0 {
+		return nil, ErrInvalidChecklistItemID
+	}
+
+	item, err := s.getChecklistItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item.Done = !item.Done
+	eventType := models.TaskEventChecklistChecked
+	if !item.Done {
+		eventType = models.TaskEventChecklistReopened
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := qtx.SetChecklistItemDone(ctx, generated.SetChecklistItemDoneParams{
+			Done: item.Done,
+			ID:   item.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to update checklist item: %w", err)
+		}
+
+		return recordTaskEvent(ctx, qtx, item.TaskID, eventType, "checklist", "", item.Content)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: int(item.TaskID), Fields: []string{"checklist"}})
+	return converters.ChecklistItemToModel(item), nil
+}
+
+// RemoveChecklistItem deletes an item from a task's checklist
+func (s *service) RemoveChecklistItem(ctx context.Context, itemID int) error {
+	if itemID <= 0 {
+		return ErrInvalidChecklistItemID
+	}
+
+	item, err := s.getChecklistItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := qtx.DeleteChecklistItem(ctx, item.ID); err != nil {
+			return fmt.Errorf("failed to delete checklist item: %w", err)
+		}
+
+		return recordTaskEvent(ctx, qtx, item.TaskID, models.TaskEventChecklistRemoved, "checklist", item.Content, "")
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: int(item.TaskID), Fields: []string{"checklist"}})
+	return nil
+}
+
+// getChecklistItem retrieves a checklist item, mapping a missing row to
+// ErrChecklistItemNotFound
+func (s *service) getChecklistItem(ctx context.Context, itemID int) (generated.TaskChecklistItem, error) {
+	item, err := s.queries.GetChecklistItem(ctx, int64(itemID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return item, ErrChecklistItemNotFound
+		}
+		return item, fmt.Errorf("failed to get checklist item: %w", err)
+	}
+	return item, nil
+}
+
+// validateChecklistText validates the text of a checklist item
+func validateChecklistText(text string) error {
+	if text == "" {
+		return ErrEmptyChecklistItem
+	}
+	if len(text) > 500 {
+		return ErrChecklistItemTooLong
+	}
+	return nil
+}
@@ -5,15 +5,18 @@ import "errors"
 // Task-related errors
 var (
 	// Validation errors
-	ErrEmptyTitle       = errors.New("task title cannot be empty")
-	ErrTitleTooLong     = errors.New("task title cannot exceed 255 characters")
-	ErrInvalidTaskID    = errors.New("invalid task ID")
-	ErrInvalidColumnID  = errors.New("invalid column ID")
-	ErrInvalidProjectID = errors.New("invalid project ID")
-	ErrInvalidLabelID   = errors.New("invalid label ID")
-	ErrInvalidPriority  = errors.New("invalid priority ID")
-	ErrInvalidType      = errors.New("invalid type ID")
-	ErrInvalidPosition  = errors.New("invalid position: must be >= 0")
+	ErrEmptyTitle          = errors.New("task title cannot be empty")
+	ErrTitleTooLong        = errors.New("task title cannot exceed 255 characters")
+	ErrInvalidTaskID       = errors.New("invalid task ID")
+	ErrInvalidColumnID     = errors.New("invalid column ID")
+	ErrInvalidProjectID    = errors.New("invalid project ID")
+	ErrInvalidLabelID      = errors.New("invalid label ID")
+	ErrInvalidPriority     = errors.New("invalid priority ID")
+	ErrInvalidType         = errors.New("invalid type ID")
+	ErrInvalidRelationType = errors.New("invalid relation type ID")
+	ErrInvalidPosition     = errors.New("invalid position: must be >= 0")
+	ErrInvalidTicketNumber = errors.New("invalid ticket number")
+	ErrStartAfterDue       = errors.New("start date cannot be after the due date")
 
 	// Business logic errors
 	ErrTaskNotFound              = errors.New("task not found")
@@ -21,12 +24,38 @@ var (
 	ErrDuplicateRelation         = errors.New("relationship already exists")
 	ErrSelfRelation              = errors.New("circular dependency: task cannot have a relationship with itself")
 	ErrTaskAlreadyInTargetColumn = errors.New("task is already in target column")
+	ErrWIPLimitExceeded          = errors.New("column is at its WIP limit")
+	ErrTaskArchived              = errors.New("task is already archived")
+	ErrTaskNotArchived           = errors.New("task is not archived")
+	ErrInvalidArchiveAge         = errors.New("archive age must be positive")
+	ErrTaskExists                = errors.New("task already exists")
+	ErrRecurrenceNotFound        = errors.New("task has no recurrence rule")
+	ErrTaskAlreadyInProject      = errors.New("task is already in target project")
+	ErrCrossProjectRelations     = errors.New("task has relations that would cross projects")
+	ErrInvalidRelationPolicy     = errors.New("invalid relation policy")
+	ErrTaskNotInProject          = errors.New("task does not belong to the project")
+
+	// Bulk update errors
+	ErrNoTasksSelected = errors.New("no tasks selected")
+	ErrNoBulkChanges   = errors.New("no changes given")
+
+	// Task template errors
+	ErrInvalidTaskTemplate  = errors.New("invalid task template")
+	ErrTaskTemplateNotFound = errors.New("task template not found")
+	ErrTaskTemplateExists   = errors.New("task template already exists")
+	ErrMissingTemplateVar   = errors.New("missing template variable")
 
 	// Comment validation errors
 	ErrEmptyCommentMessage   = errors.New("comment message cannot be empty")
 	ErrCommentMessageTooLong = errors.New("comment message cannot exceed 1000 characters")
 	ErrInvalidCommentID      = errors.New("invalid comment ID")
 	ErrCommentNotFound       = errors.New("comment not found")
+
+	// Checklist errors
+	ErrEmptyChecklistItem     = errors.New("checklist item cannot be empty")
+	ErrChecklistItemTooLong   = errors.New("checklist item cannot exceed 500 characters")
+	ErrInvalidChecklistItemID = errors.New("invalid checklist item ID")
+	ErrChecklistItemNotFound  = errors.New("checklist item not found")
 )
 
 // Movement-related errors
@@ -0,0 +1,116 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/dates"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// GetTaskHistory retrieves the activity log for a task, newest first.
+// History is kept after a task is deleted, so this does not require the task to exist.
+func (s *service) GetTaskHistory(ctx context.Context, taskID int) ([]*models.TaskEvent, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	rows, err := s.queries.GetTaskEventsByTask(ctx, sql.NullInt64{Int64: int64(taskID), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task history: %w", err)
+	}
+
+	return converters.TaskEventsToModels(rows), nil
+}
+
+// recordTaskEvent appends an activity log entry for a task.
+// qtx must be scoped to the transaction performing the change.
+func recordTaskEvent(ctx context.Context, qtx generated.Querier, taskID int64, eventType, field, oldValue, newValue string) error {
+	projectID, err := qtx.GetProjectIDFromTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get project ID for task event: %w", err)
+	}
+
+	return database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+		ProjectID: projectID,
+		TaskID:    taskID,
+		EventType: eventType,
+		Field:     field,
+		OldValue:  oldValue,
+		NewValue:  newValue,
+	})
+}
+
+// recordTaskDiff compares two snapshots of the same task and records an
+// "updated" event for every user-visible field that changed.
+func recordTaskDiff(ctx context.Context, qtx generated.Querier, before, after generated.GetTaskDetailRow) error {
+	changes := []struct {
+		field    string
+		oldValue string
+		newValue string
+	}{
+		{"title", before.Title, after.Title},
+		{"description", before.Description.String, after.Description.String},
+		{"type", before.TypeDescription.String, after.TypeDescription.String},
+		{"priority", before.PriorityDescription.String, after.PriorityDescription.String},
+		{"due", formatNullDate(before.DueAt), formatNullDate(after.DueAt)},
+		{"start", formatNullDate(before.StartAt), formatNullDate(after.StartAt)},
+	}
+
+	for _, c := range changes {
+		if c.oldValue == c.newValue {
+			continue
+		}
+		if err := recordTaskEvent(ctx, qtx, after.ID, models.TaskEventUpdated, c.field, c.oldValue, c.newValue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatNullDate formats an optional date for log values, "" when unset
+func formatNullDate(t sql.NullTime) string {
+	if !t.Valid {
+		return ""
+	}
+	return dates.Format(t.Time)
+}
+
+// recordRelationEvent records a relation change on both tasks involved, so
+// each task's history shows the link from its own point of view.
+func recordRelationEvent(ctx context.Context, qtx generated.Querier, eventType string, parentID, childID int64) error {
+	parentRef, err := taskEventRef(ctx, qtx, parentID)
+	if err != nil {
+		return err
+	}
+	childRef, err := taskEventRef(ctx, qtx, childID)
+	if err != nil {
+		return err
+	}
+
+	oldParent, newParent := "", parentRef
+	oldChild, newChild := "", childRef
+	if eventType == models.TaskEventRelationRemoved {
+		oldParent, newParent = parentRef, ""
+		oldChild, newChild = childRef, ""
+	}
+
+	if err := recordTaskEvent(ctx, qtx, childID, eventType, "parent", oldParent, newParent); err != nil {
+		return err
+	}
+	return recordTaskEvent(ctx, qtx, parentID, eventType, "child", oldChild, newChild)
+}
+
+// taskEventRef formats a task as "#<ticket> <title>" for use in log values
+func taskEventRef(ctx context.Context, qtx generated.Querier, taskID int64) (string, error) {
+	detail, err := qtx.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get related task: %w", err)
+	}
+	return fmt.Sprintf("#%d %s", detail.TicketNumber.Int64, detail.Title), nil
+}
@@ -0,0 +1,449 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// RelationPolicy says what happens to a task's relations with tasks outside
+// the project it moves to
+type RelationPolicy string
+
+const (
+	// RelationsUnset refuses the move when a relation would cross projects
+	RelationsUnset RelationPolicy = ""
+	// RelationsKeep keeps relations as cross-project links
+	RelationsKeep RelationPolicy = "keep"
+	// RelationsDrop removes relations that would cross projects
+	RelationsDrop RelationPolicy = "drop"
+)
+
+// ParseRelationPolicy parses the value of a --relations flag
+func ParseRelationPolicy(s string) (RelationPolicy, error) {
+	switch policy := RelationPolicy(s); policy {
+	case RelationsUnset, RelationsKeep, RelationsDrop:
+		return policy, nil
+	}
+	return RelationsUnset, fmt.Errorf("%w: %q (use keep or drop)", ErrInvalidRelationPolicy, s)
+}
+
+// MoveToProjectRequest describes moving a task to another project
+type MoveToProjectRequest struct {
+	TaskID    int
+	ProjectID int
+	// ColumnID is the target column; 0 picks the project's ready column, or
+	// its first column when it has none
+	ColumnID  int
+	Relations RelationPolicy
+}
+
+// MoveToProjectResult describes what a move to another project changed
+type MoveToProjectResult struct {
+	OldTicketNumber  int
+	TicketNumber     int
+	ColumnID         int
+	CreatedLabels    []string // Labels that did not exist in the target project yet
+	DroppedRelations int
+}
+
+// MoveTaskToProject re-homes a task in another project. The task gets the
+// next ticket number of that project, its labels are matched by name (and
+// created when the project lacks them), its type and priority are matched by
+// name with the project defaults as fallback, and its comments, checklist and
+// history move with it. Relations with tasks outside the target project are
+// kept or dropped as req.Relations says; with RelationsUnset the move fails
+// with ErrCrossProjectRelations instead. Relations of the source project's
+// own types take the target's type of the same name, or are dropped when it
+// has none.
+func (s *service) MoveTaskToProject(ctx context.Context, req MoveToProjectRequest) (*MoveToProjectResult, error) {
+	if req.TaskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+	if req.ProjectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if req.ColumnID < 0 {
+		return nil, ErrInvalidColumnID
+	}
+	if _, err := ParseRelationPolicy(string(req.Relations)); err != nil {
+		return nil, err
+	}
+
+	var (
+		result          MoveToProjectResult
+		sourceProjectID int64
+		createdLabels   []generated.Label
+		droppedLinks    []int
+		changes         blockChanges
+	)
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+		taskID, projectID := int64(req.TaskID), int64(req.ProjectID)
+
+		before, err := qtx.GetTaskDetail(ctx, taskID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+		if before.ProjectID == projectID {
+			return ErrTaskAlreadyInProject
+		}
+		sourceProjectID = before.ProjectID
+		result.OldTicketNumber = int(before.TicketNumber.Int64)
+
+		target, err := qtx.GetProjectByID(ctx, projectID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidProjectID
+			}
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+
+		columnID, err := targetColumnTx(ctx, qtx, projectID, int64(req.ColumnID))
+		if err != nil {
+			return err
+		}
+		result.ColumnID = int(columnID)
+
+		// Dropped relations and the move itself can both change what is
+		// blocked, so compare against the state before either
+		snapshot := blockSnapshot{}
+		if err := snapshot.addTask(ctx, qtx, taskID); err != nil {
+			return err
+		}
+		if err := snapshot.addDependents(ctx, qtx, taskID); err != nil {
+			return err
+		}
+
+		droppedLinks, err = crossProjectRelationsTx(ctx, qtx, taskID, projectID, req.Relations)
+		if err != nil {
+			return err
+		}
+		untyped, err := remapRelationTypesTx(ctx, qtx, taskID, projectID)
+		if err != nil {
+			return err
+		}
+		droppedLinks = append(droppedLinks, untyped...)
+		result.DroppedRelations = len(droppedLinks)
+
+		createdLabels, err = remapLabelsTx(ctx, qtx, taskID, projectID)
+		if err != nil {
+			return err
+		}
+		for _, label := range createdLabels {
+			result.CreatedLabels = append(result.CreatedLabels, label.Name)
+		}
+
+		if err := remapTypeAndPriorityTx(ctx, qtx, before, projectID); err != nil {
+			return err
+		}
+
+		if _, err := placeTaskTx(ctx, qtx, taskID, columnID, -1); err != nil {
+			return err
+		}
+		// The history moves with the task, so deleting the source project
+		// later does not take it along
+		if err := qtx.MoveTaskEventsToProject(ctx, generated.MoveTaskEventsToProjectParams{
+			ProjectID: projectID,
+			TaskID:    sql.NullInt64{Int64: taskID, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to move task history: %w", err)
+		}
+		if changes, err = snapshot.changes(ctx, qtx); err != nil {
+			return err
+		}
+
+		ticketNumber, err := qtx.GetNextTicketNumber(ctx, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to get ticket number: %w", err)
+		}
+		if err := qtx.SetTaskTicketNumber(ctx, generated.SetTaskTicketNumberParams{
+			TicketNumber: ticketNumber,
+			ID:           taskID,
+		}); err != nil {
+			return fmt.Errorf("failed to set ticket number: %w", err)
+		}
+		if err := qtx.IncrementTicketNumber(ctx, projectID); err != nil {
+			return fmt.Errorf("failed to increment ticket number: %w", err)
+		}
+		result.TicketNumber = int(ticketNumber.Int64)
+
+		return recordTaskEvent(ctx, qtx, taskID, models.TaskEventMoved, "project",
+			fmt.Sprintf("%s #%d", before.ProjectName, before.TicketNumber.Int64),
+			fmt.Sprintf("%s #%d", target.Name, ticketNumber.Int64))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The source project's boards drop the task, the target's pick it up
+	s.publishEvent(events.Event{Type: events.EventTaskMoved, ProjectID: int(sourceProjectID), TaskID: req.TaskID, Fields: []string{"project"}})
+	for _, label := range createdLabels {
+		s.publishEvent(events.Event{Type: events.EventLabelCreated, ProjectID: req.ProjectID, LabelID: int(label.ID)})
+	}
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: req.TaskID, ColumnID: result.ColumnID, Fields: []string{"project", "column"}})
+	for _, id := range droppedLinks {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUnlinked, TaskID: id, RelatedTaskID: req.TaskID})
+	}
+	s.publishBlockChanges(ctx, req.TaskID, changes)
+	return &result, nil
+}
+
+// targetColumnTx resolves the column a task moving to a project lands in:
+// columnID when it belongs to the project, else the project's ready column,
+// else its first column
+func targetColumnTx(ctx context.Context, qtx generated.Querier, projectID, columnID int64) (int64, error) {
+	if columnID > 0 {
+		columnProjectID, err := qtx.GetProjectIDFromColumn(ctx, columnID)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && columnProjectID != projectID) {
+			return 0, ErrInvalidColumnID
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to get column project: %w", err)
+		}
+		return columnID, nil
+	}
+
+	ready, err := qtx.GetReadyColumnByProject(ctx, projectID)
+	switch {
+	case err == nil:
+		return ready.ID, nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, fmt.Errorf("failed to get ready column: %w", err)
+	}
+
+	columns, err := qtx.GetColumnsByProject(ctx, projectID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get columns: %w", err)
+	}
+	if len(columns) == 0 {
+		return 0, ErrInvalidColumnID
+	}
+	return columns[0].ID, nil
+}
+
+// crossProjectRelationsTx finds the relations of a task with tasks outside
+// projectID and applies policy to them. Dropped relations are logged on both
+// ends; the IDs of the tasks at their other end are returned.
+func crossProjectRelationsTx(ctx context.Context, qtx generated.Querier, taskID, projectID int64, policy RelationPolicy) ([]int, error) {
+	links, err := qtx.GetTaskLinks(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relations: %w", err)
+	}
+
+	var crossing []generated.TaskSubtask
+	for _, link := range links {
+		other := link.ChildID
+		if other == taskID {
+			other = link.ParentID
+		}
+		otherProjectID, err := qtx.GetProjectIDFromTask(ctx, other)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get related task project: %w", err)
+		}
+		if otherProjectID != projectID {
+			crossing = append(crossing, link)
+		}
+	}
+
+	switch {
+	case len(crossing) == 0 || policy == RelationsKeep:
+		return nil, nil
+	case policy == RelationsUnset:
+		return nil, fmt.Errorf("%w: %d relation(s) would link across projects", ErrCrossProjectRelations, len(crossing))
+	}
+
+	dropped := make([]int, 0, len(crossing))
+	for _, link := range crossing {
+		if err := recordRelationEvent(ctx, qtx, models.TaskEventRelationRemoved, link.ParentID, link.ChildID); err != nil {
+			return nil, err
+		}
+		if err := qtx.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
+			ParentID: link.ParentID,
+			ChildID:  link.ChildID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to remove relation: %w", err)
+		}
+		other := link.ChildID
+		if other == taskID {
+			other = link.ParentID
+		}
+		dropped = append(dropped, int(other))
+	}
+	return dropped, nil
+}
+
+// remapRelationTypesTx gives the relations a task is the parent of, which
+// take their type from its project, the relation type of projectID with the
+// same name when their type is another project's own. Relations whose type
+// has no counterpart in projectID are dropped and logged on both ends; the
+// IDs of the tasks at their other end are returned.
+func remapRelationTypesTx(ctx context.Context, qtx generated.Querier, taskID, projectID int64) ([]int, error) {
+	links, err := qtx.GetTaskLinks(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relations: %w", err)
+	}
+
+	var byName map[string]int64
+	var dropped []int
+	for _, link := range links {
+		if link.ParentID != taskID {
+			continue
+		}
+		rt, err := qtx.GetRelationTypeByID(ctx, link.RelationTypeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relation type: %w", err)
+		}
+		if !rt.ProjectID.Valid || rt.ProjectID.Int64 == projectID {
+			continue
+		}
+
+		if byName == nil {
+			types, err := qtx.GetRelationTypesByProject(ctx, sql.NullInt64{Int64: projectID, Valid: true})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get relation types: %w", err)
+			}
+			byName = make(map[string]int64, len(types))
+			for _, t := range types {
+				if t.ProjectID.Valid {
+					byName[t.Name] = t.ID
+				}
+			}
+		}
+
+		if typeID, ok := byName[rt.Name]; ok {
+			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+				ParentID:       link.ParentID,
+				ChildID:        link.ChildID,
+				RelationTypeID: typeID,
+			}); err != nil {
+				return nil, fmt.Errorf("failed to remap relation type '%s': %w", rt.Name, err)
+			}
+			continue
+		}
+
+		if err := recordRelationEvent(ctx, qtx, models.TaskEventRelationRemoved, link.ParentID, link.ChildID); err != nil {
+			return nil, err
+		}
+		if err := qtx.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
+			ParentID: link.ParentID,
+			ChildID:  link.ChildID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to remove relation: %w", err)
+		}
+		dropped = append(dropped, int(link.ChildID))
+	}
+	return dropped, nil
+}
+
+// remapLabelsTx swaps a task's labels for the labels of the same name in
+// projectID, creating the ones the project lacks with the original colour.
+// Returns the labels it created.
+func remapLabelsTx(ctx context.Context, qtx generated.Querier, taskID, projectID int64) ([]generated.Label, error) {
+	labels, err := qtx.GetLabelsForTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task labels: %w", err)
+	}
+	if len(labels) == 0 {
+		return nil, nil
+	}
+
+	existing, err := qtx.GetLabelsByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project labels: %w", err)
+	}
+	byName := make(map[string]int64, len(existing))
+	for _, label := range existing {
+		byName[label.Name] = label.ID
+	}
+
+	if err := qtx.DeleteAllLabelsFromTask(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("failed to detach labels: %w", err)
+	}
+
+	var created []generated.Label
+	for _, label := range labels {
+		labelID, ok := byName[label.Name]
+		if !ok {
+			newLabel, err := qtx.CreateLabel(ctx, generated.CreateLabelParams{
+				Name:      label.Name,
+				Color:     label.Color,
+				ProjectID: projectID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create label '%s': %w", label.Name, err)
+			}
+			if err := database.RecordTaskEvent(ctx, qtx, database.TaskEventRecord{
+				ProjectID: projectID,
+				EventType: models.TaskEventLabelCreated,
+				Field:     "name",
+				NewValue:  newLabel.Name,
+			}); err != nil {
+				return nil, err
+			}
+			created = append(created, newLabel)
+			labelID = newLabel.ID
+		}
+
+		if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+			TaskID:  taskID,
+			LabelID: labelID,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to attach label '%s': %w", label.Name, err)
+		}
+	}
+	return created, nil
+}
+
+// remapTypeAndPriorityTx gives a task the type and priority of projectID
+// named like its current ones, or the project's defaults when there are none
+func remapTypeAndPriorityTx(ctx context.Context, qtx generated.Querier, task generated.GetTaskDetailRow, projectID int64) error {
+	pid := sql.NullInt64{Int64: projectID, Valid: true}
+
+	types, err := qtx.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return fmt.Errorf("failed to get types: %w", err)
+	}
+	typeID := database.DefaultTypeID(types)
+	for _, t := range types {
+		if t.Description == task.TypeDescription.String {
+			typeID = t.ID
+			break
+		}
+	}
+
+	priorities, err := qtx.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return fmt.Errorf("failed to get priorities: %w", err)
+	}
+	priorityID := database.DefaultPriorityID(priorities)
+	for _, p := range priorities {
+		if p.Description == task.PriorityDescription.String {
+			priorityID = p.ID
+			break
+		}
+	}
+
+	if typeID == 0 {
+		return ErrInvalidType
+	}
+	if priorityID == 0 {
+		return ErrInvalidPriority
+	}
+	if err := qtx.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{TypeID: typeID, ID: task.ID}); err != nil {
+		return fmt.Errorf("failed to set type: %w", err)
+	}
+	if err := qtx.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{PriorityID: priorityID, ID: task.ID}); err != nil {
+		return fmt.Errorf("failed to set priority: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,397 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/recurrence"
+)
+
+// SetRecurrence attaches a repeat rule to a task, replacing any rule it
+// already has. The task becomes the template of the rule and counts as its
+// first instance: the next one is generated at the first occurrence after
+// now, or as soon as the task is completed. A monthly rule without a day
+// repeats on the day of the task's due date, or of now if it has none.
+func (s *service) SetRecurrence(ctx context.Context, taskID int, rule string, now time.Time) (*models.TaskRecurrence, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	parsed, err := recurrence.Parse(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved generated.TaskRecurrence
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		task, err := qtx.GetFullTask(ctx, int64(taskID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+
+		// A plain monthly rule repeats on the template's due day
+		anchor := now
+		if task.DueAt.Valid {
+			anchor = task.DueAt.Time.Local()
+		}
+		parsed = parsed.Anchor(anchor)
+
+		oldRule := ""
+		existing, err := qtx.GetTaskRecurrence(ctx, int64(taskID))
+		switch {
+		case err == nil:
+			oldRule = converters.TaskRecurrenceToModel(existing).Description
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("failed to get recurrence: %w", err)
+		}
+
+		saved, err = qtx.UpsertTaskRecurrence(ctx, generated.UpsertTaskRecurrenceParams{
+			TaskID:         int64(taskID),
+			Rule:           parsed.String(),
+			NextRunAt:      parsed.Next(now),
+			LastInstanceID: sql.NullInt64{Int64: int64(taskID), Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to save recurrence: %w", err)
+		}
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventRecurrenceSet, "rule", oldRule, parsed.Describe())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: taskID, Fields: []string{"recurrence"}})
+	return converters.TaskRecurrenceToModel(saved), nil
+}
+
+// GetRecurrence returns the repeat rule of a template task
+func (s *service) GetRecurrence(ctx context.Context, taskID int) (*models.TaskRecurrence, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	r, err := s.queries.GetTaskRecurrence(ctx, int64(taskID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRecurrenceNotFound
+		}
+		return nil, fmt.Errorf("failed to get recurrence: %w", err)
+	}
+	return converters.TaskRecurrenceToModel(r), nil
+}
+
+// GetRecurrencesByProject lists the recurring tasks of a project
+func (s *service) GetRecurrencesByProject(ctx context.Context, projectID int) ([]*models.TaskRecurrence, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	rows, err := s.queries.GetTaskRecurrencesByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurrences: %w", err)
+	}
+	return converters.TaskRecurrencesToModels(rows), nil
+}
+
+// RemoveRecurrence detaches the repeat rule from a template task. Instances
+// generated so far are kept.
+func (s *service) RemoveRecurrence(ctx context.Context, taskID int) error {
+	if taskID <= 0 {
+		return ErrInvalidTaskID
+	}
+
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		existing, err := qtx.GetTaskRecurrence(ctx, int64(taskID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrRecurrenceNotFound
+			}
+			return fmt.Errorf("failed to get recurrence: %w", err)
+		}
+
+		if err := qtx.DeleteTaskRecurrence(ctx, int64(taskID)); err != nil {
+			return fmt.Errorf("failed to remove recurrence: %w", err)
+		}
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventRecurrenceRemoved, "rule",
+			converters.TaskRecurrenceToModel(existing).Description, "")
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: taskID, Fields: []string{"recurrence"}})
+	return nil
+}
+
+// GenerateRecurringTasks creates the next instance of every rule whose
+// schedule has come due by now or whose last instance has been completed,
+// and returns the IDs of the new tasks. Each rule generates at most one
+// instance per call: occurrences missed while nothing ran are skipped, and
+// the instance is due on the latest of them.
+//
+// It is safe to run from several processes at once; each occurrence is
+// generated only once. A rule that fails does not stop the others.
+func (s *service) GenerateRecurringTasks(ctx context.Context, now time.Time) ([]int, error) {
+	schedule, err := s.queries.GetRecurrenceSchedule(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurrences: %w", err)
+	}
+
+	var (
+		created []int
+		errs    []error
+	)
+	for _, r := range schedule {
+		due := !r.NextRunAt.After(now)
+		if !due && r.LastInstanceCompleted == 0 {
+			continue
+		}
+
+		instanceID, err := s.generateInstance(ctx, r, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recurrence of task %d: %w", r.TaskID, err))
+			continue
+		}
+		if instanceID > 0 {
+			created = append(created, instanceID)
+		}
+	}
+
+	for _, id := range created {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskCreated, TaskID: id})
+	}
+	return created, errors.Join(errs...)
+}
+
+// generateInstance copies the template of a rule for its next occurrence and
+// moves the rule on. It returns 0 if another process generated it first.
+func (s *service) generateInstance(ctx context.Context, r generated.GetRecurrenceScheduleRow, now time.Time) (int, error) {
+	rule, err := recurrence.Parse(r.Rule)
+	if err != nil {
+		return 0, err
+	}
+
+	occurrence := r.NextRunAt.Local()
+	next := rule.Next(occurrence)
+	for !next.After(now) {
+		occurrence, next = next, rule.Next(next)
+	}
+
+	var instanceID int64
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		claimed, err := qtx.ClaimTaskRecurrence(ctx, generated.ClaimTaskRecurrenceParams{
+			NextRunAt:   next,
+			ID:          r.ID,
+			Occurrences: r.Occurrences,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to advance recurrence: %w", err)
+		}
+		if claimed == 0 {
+			return nil
+		}
+
+		instanceID, err = copyTemplateTx(ctx, qtx, r.TaskID, occurrence)
+		if err != nil {
+			return err
+		}
+		return qtx.SetTaskRecurrenceInstance(ctx, generated.SetTaskRecurrenceInstanceParams{
+			LastInstanceID: sql.NullInt64{Int64: instanceID, Valid: true},
+			ID:             r.ID,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(instanceID), nil
+}
+
+// copyTemplateTx creates an instance of a template task due on the given
+// day. The instance goes to the end of the project's ready column, or of the
+// template's column if the project has none; WIP limits are not checked.
+//
+// The instance gets the template's blocking and other non-hierarchical
+// relations, but not its parent: instances are not gathered under the
+// template's parent task. The template's child tasks are copied along with
+// it instead of shared, so every instance has subtasks of its own.
+func copyTemplateTx(ctx context.Context, qtx generated.Querier, templateID int64, due time.Time) (int64, error) {
+	template, err := qtx.GetFullTask(ctx, templateID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	projectID, err := qtx.GetProjectIDFromColumn(ctx, template.ColumnID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get project ID: %w", err)
+	}
+
+	columnID := template.ColumnID
+	ready, err := qtx.GetReadyColumnByProject(ctx, projectID)
+	switch {
+	case err == nil:
+		columnID = ready.ID
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, fmt.Errorf("failed to get ready column: %w", err)
+	}
+
+	// Keep the template's lead time between start and due date
+	var startAt sql.NullTime
+	if template.StartAt.Valid && template.DueAt.Valid {
+		startAt = sql.NullTime{Time: due.Add(template.StartAt.Time.Sub(template.DueAt.Time)), Valid: true}
+	}
+
+	instanceID, err := copyTaskTx(ctx, qtx, template, projectID, columnID, sql.NullTime{Time: due, Valid: true}, startAt)
+	if err != nil {
+		return 0, err
+	}
+
+	links, err := qtx.GetTaskLinks(ctx, templateID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get template links: %w", err)
+	}
+	for _, link := range links {
+		if link.RelationTypeID == models.RelationTypeParentChild {
+			continue
+		}
+		if link.ParentID == templateID {
+			link.ParentID = instanceID
+		} else {
+			link.ChildID = instanceID
+		}
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       link.ParentID,
+			ChildID:        link.ChildID,
+			RelationTypeID: link.RelationTypeID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to copy relation: %w", err)
+		}
+	}
+
+	if err := copySubtasksTx(ctx, qtx, templateID, instanceID, projectID, columnID); err != nil {
+		return 0, err
+	}
+	return instanceID, nil
+}
+
+// copySubtasksTx copies the child tasks of a task, and theirs in turn, under
+// its copy. The copies go to the end of the given column without dates;
+// archived child tasks are left out.
+func copySubtasksTx(ctx context.Context, qtx generated.Querier, sourceID, copyID, projectID, columnID int64) error {
+	links, err := qtx.GetTaskLinks(ctx, sourceID)
+	if err != nil {
+		return fmt.Errorf("failed to get child tasks: %w", err)
+	}
+	for _, link := range links {
+		if link.ParentID != sourceID || link.RelationTypeID != models.RelationTypeParentChild {
+			continue
+		}
+		child, err := qtx.GetFullTask(ctx, link.ChildID)
+		if err != nil {
+			return fmt.Errorf("failed to get child task: %w", err)
+		}
+		if child.ArchivedAt.Valid {
+			continue
+		}
+
+		childCopyID, err := copyTaskTx(ctx, qtx, child, projectID, columnID, sql.NullTime{}, sql.NullTime{})
+		if err != nil {
+			return err
+		}
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       copyID,
+			ChildID:        childCopyID,
+			RelationTypeID: models.RelationTypeParentChild,
+		}); err != nil {
+			return fmt.Errorf("failed to link child task: %w", err)
+		}
+		if err := copySubtasksTx(ctx, qtx, child.ID, childCopyID, projectID, columnID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTaskTx creates a copy of a task at the end of a column with the given
+// dates, and with the task's type, priority, labels and checklist
+// (unticked).
+func copyTaskTx(ctx context.Context, qtx generated.Querier, source generated.Task, projectID, columnID int64, dueAt, startAt sql.NullTime) (int64, error) {
+	count, err := qtx.GetTaskCountByColumn(ctx, columnID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get task count: %w", err)
+	}
+	position, err := freePosition(ctx, qtx, 0, columnID, count+1)
+	if err != nil {
+		return 0, err
+	}
+
+	ticketNumber, err := qtx.GetNextTicketNumber(ctx, projectID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get ticket number: %w", err)
+	}
+
+	copied, err := qtx.ImportTask(ctx, generated.ImportTaskParams{
+		Title:        source.Title,
+		Description:  source.Description,
+		ColumnID:     columnID,
+		Position:     position,
+		TicketNumber: ticketNumber,
+		TypeID:       source.TypeID,
+		PriorityID:   source.PriorityID,
+		DueAt:        dueAt,
+		StartAt:      startAt,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy task: %w", err)
+	}
+	if err := qtx.IncrementTicketNumber(ctx, projectID); err != nil {
+		return 0, fmt.Errorf("failed to increment ticket number: %w", err)
+	}
+
+	labels, err := qtx.GetTaskLabels(ctx, source.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get labels: %w", err)
+	}
+	for _, label := range labels {
+		if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+			TaskID:  copied.ID,
+			LabelID: label.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to attach label %d: %w", label.ID, err)
+		}
+	}
+
+	checklist, err := qtx.GetChecklistByTask(ctx, source.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	for _, item := range checklist {
+		if _, err := qtx.CreateChecklistItem(ctx, generated.CreateChecklistItemParams{
+			TaskID:  copied.ID,
+			Content: item.Content,
+		}); err != nil {
+			return 0, fmt.Errorf("failed to copy checklist item: %w", err)
+		}
+	}
+
+	if err := recordTaskEvent(ctx, qtx, copied.ID, models.TaskEventCreated, "", "", copied.Title); err != nil {
+		return 0, err
+	}
+	return copied.ID, nil
+}
@@ -0,0 +1,246 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// SnapshotTask captures a task with its labels, links, comments and
+// checklist, so that RestoreTask can bring it back after it has been deleted
+func (s *service) SnapshotTask(ctx context.Context, taskID int) (*models.TaskSnapshot, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	task, err := s.queries.GetFullTask(ctx, int64(taskID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	labels, err := s.queries.GetTaskLabels(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task labels: %w", err)
+	}
+	links, err := s.queries.GetTaskLinks(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task links: %w", err)
+	}
+	comments, err := s.queries.GetCommentsByTask(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task comments: %w", err)
+	}
+	checklist, err := s.queries.GetChecklistByTask(ctx, task.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task checklist: %w", err)
+	}
+
+	snapshot := &models.TaskSnapshot{
+		Task:         converters.TaskToModel(task),
+		TicketNumber: int(task.TicketNumber.Int64),
+		LabelIDs:     make([]int, 0, len(labels)),
+		Links:        make([]models.TaskLink, 0, len(links)),
+		Comments:     converters.CommentsToModels(comments),
+		Checklist:    converters.ChecklistToModels(checklist),
+	}
+	for _, l := range labels {
+		snapshot.LabelIDs = append(snapshot.LabelIDs, int(l.ID))
+	}
+	for _, l := range links {
+		snapshot.Links = append(snapshot.Links, models.TaskLink{
+			ParentID:       int(l.ParentID),
+			ChildID:        int(l.ChildID),
+			RelationTypeID: int(l.RelationTypeID),
+		})
+	}
+	return snapshot, nil
+}
+
+// RestoreTask re-creates a deleted task from its snapshot, under its original
+// ID and ticket number. The task goes back to its old position if that is
+// still free and to the end of its column otherwise. Labels and links whose
+// other end has been deleted since are dropped.
+func (s *service) RestoreTask(ctx context.Context, snapshot *models.TaskSnapshot) error {
+	if snapshot == nil || snapshot.Task == nil || snapshot.Task.ID <= 0 {
+		return ErrInvalidTaskID
+	}
+	task := snapshot.Task
+
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if _, err := qtx.GetTaskPosition(ctx, int64(task.ID)); err == nil {
+			return ErrTaskExists
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to check task: %w", err)
+		}
+
+		position, err := freePosition(ctx, qtx, 0, int64(task.ColumnID), int64(task.Position))
+		if err != nil {
+			return err
+		}
+
+		if err := qtx.RestoreTask(ctx, generated.RestoreTaskParams{
+			ID:           int64(task.ID),
+			Title:        task.Title,
+			Description:  sql.NullString{String: task.Description, Valid: task.Description != ""},
+			ColumnID:     int64(task.ColumnID),
+			Position:     position,
+			TicketNumber: sql.NullInt64{Int64: int64(snapshot.TicketNumber), Valid: snapshot.TicketNumber > 0},
+			TypeID:       int64(task.TypeID),
+			PriorityID:   int64(task.PriorityID),
+			CreatedAt:    sql.NullTime{Time: task.CreatedAt, Valid: !task.CreatedAt.IsZero()},
+			UpdatedAt:    sql.NullTime{Time: task.UpdatedAt, Valid: !task.UpdatedAt.IsZero()},
+			DueAt:        converters.PtrToNullTime(task.DueAt),
+			StartAt:      converters.PtrToNullTime(task.StartAt),
+			ArchivedAt:   converters.PtrToNullTime(task.ArchivedAt),
+		}); err != nil {
+			return fmt.Errorf("failed to restore task: %w", err)
+		}
+
+		for _, labelID := range snapshot.LabelIDs {
+			if _, err := qtx.GetLabelByID(ctx, int64(labelID)); errors.Is(err, sql.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to check label: %w", err)
+			}
+			if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+				TaskID:  int64(task.ID),
+				LabelID: int64(labelID),
+			}); err != nil {
+				return fmt.Errorf("failed to restore label: %w", err)
+			}
+		}
+
+		for _, link := range snapshot.Links {
+			other := link.ParentID
+			if other == task.ID {
+				other = link.ChildID
+			}
+			if _, err := qtx.GetTaskPosition(ctx, int64(other)); errors.Is(err, sql.ErrNoRows) {
+				continue
+			} else if err != nil {
+				return fmt.Errorf("failed to check linked task: %w", err)
+			}
+			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+				ParentID:       int64(link.ParentID),
+				ChildID:        int64(link.ChildID),
+				RelationTypeID: int64(link.RelationTypeID),
+			}); err != nil {
+				return fmt.Errorf("failed to restore link: %w", err)
+			}
+		}
+
+		for _, c := range snapshot.Comments {
+			if err := qtx.RestoreComment(ctx, generated.RestoreCommentParams{
+				ID:        int64(c.ID),
+				TaskID:    int64(task.ID),
+				Content:   c.Message,
+				Author:    c.Author,
+				CreatedAt: sql.NullTime{Time: c.CreatedAt, Valid: !c.CreatedAt.IsZero()},
+				UpdatedAt: sql.NullTime{Time: c.UpdatedAt, Valid: !c.UpdatedAt.IsZero()},
+			}); err != nil {
+				return fmt.Errorf("failed to restore comment: %w", err)
+			}
+		}
+
+		for _, item := range snapshot.Checklist {
+			if err := qtx.RestoreChecklistItem(ctx, generated.RestoreChecklistItemParams{
+				ID:        int64(item.ID),
+				TaskID:    int64(task.ID),
+				Content:   item.Text,
+				Done:      item.Done,
+				Position:  int64(item.Position),
+				CreatedAt: sql.NullTime{Time: item.CreatedAt, Valid: !item.CreatedAt.IsZero()},
+				UpdatedAt: sql.NullTime{Time: item.UpdatedAt, Valid: !item.UpdatedAt.IsZero()},
+			}); err != nil {
+				return fmt.Errorf("failed to restore checklist item: %w", err)
+			}
+		}
+
+		return recordTaskEvent(ctx, qtx, int64(task.ID), models.TaskEventRestored, "", "", task.Title)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskCreated, TaskID: task.ID})
+	return nil
+}
+
+// RestoreTaskPosition puts a task back at the column and position it had
+// before a move. If another task has taken that position since, the task is
+// appended to the column instead. The column's WIP limit is not checked.
+func (s *service) RestoreTaskPosition(ctx context.Context, taskID, columnID, position int) error {
+	if taskID <= 0 {
+		return ErrInvalidTaskID
+	}
+	if columnID <= 0 {
+		return ErrInvalidColumnID
+	}
+	if position < 0 {
+		return ErrInvalidPosition
+	}
+
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if _, err := qtx.GetTaskPosition(ctx, int64(taskID)); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrTaskNotFound
+			}
+			return fmt.Errorf("failed to get task position: %w", err)
+		}
+
+		pos, err := freePosition(ctx, qtx, int64(taskID), int64(columnID), int64(position))
+		if err != nil {
+			return err
+		}
+
+		changes, err = placeTaskTx(ctx, qtx, int64(taskID), int64(columnID), pos)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, ColumnID: columnID, Fields: []string{"column", "position"}})
+	s.publishBlockChanges(ctx, taskID, changes)
+	return nil
+}
+
+// freePosition returns position if no task other than taskID holds it in the
+// column, and the position after the column's last task otherwise
+func freePosition(ctx context.Context, qtx generated.Querier, taskID, columnID, position int64) (int64, error) {
+	tasks, err := qtx.GetTasksByColumn(ctx, columnID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get column tasks: %w", err)
+	}
+
+	taken := false
+	last := int64(0)
+	for _, t := range tasks {
+		if t.ID == taskID {
+			continue
+		}
+		if t.Position == position {
+			taken = true
+		}
+		last = max(last, t.Position)
+	}
+	if taken {
+		return last + 1, nil
+	}
+	return position, nil
+}
@@ -0,0 +1,82 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/filter"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// SearchTasks runs a ranked full-text search over task titles, descriptions
+// and comments within a project. Results are ordered best match first.
+// A limit <= 0 returns every match.
+//
+// The query is treated as plain words, not FTS5 syntax: every word must match
+// and each word also matches longer words it prefixes, so typing "auth ref"
+// finds "authentication refactor".
+func (s *service) SearchTasks(ctx context.Context, projectID int, query string, limit int) ([]*models.TaskSearchResult, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	match := BuildSearchMatch(query)
+	if match == "" {
+		return []*models.TaskSearchResult{}, nil
+	}
+
+	if limit <= 0 {
+		limit = -1 // SQLite treats a negative LIMIT as unbounded
+	}
+
+	rows, err := s.queries.SearchTasks(ctx, generated.SearchTasksParams{
+		Query:      match,
+		ProjectID:  int64(projectID),
+		MaxResults: int64(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search tasks: %w", err)
+	}
+
+	return converters.SearchResultsToModels(rows), nil
+}
+
+// GetTaskSummariesByFilter retrieves task summaries in a project matching a
+// filter query (see package filter for the syntax), ordered by column and
+// position. Malformed queries return an error wrapping filter.ErrInvalidFilter.
+func (s *service) GetTaskSummariesByFilter(ctx context.Context, projectID int, query string) ([]*models.TaskSummary, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	q, err := filter.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetTaskSummariesByFilter(ctx, s.db, int64(projectID), q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtered task summaries: %w", err)
+	}
+
+	result := make([]*models.TaskSummary, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, converters.TaskSummaryFromRowToModel(row))
+	}
+	return result, nil
+}
+
+// SearchTerms splits a user query into the words that are matched.
+// Callers use it to highlight matches consistently with SearchTasks.
+func SearchTerms(query string) []string {
+	return strings.Fields(query)
+}
+
+// BuildSearchMatch converts a plain user query into an FTS5 MATCH expression
+func BuildSearchMatch(query string) string {
+	return filter.MatchExpression(SearchTerms(query))
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/thenoetrevino/paso/internal/converters"
 	"github.com/thenoetrevino/paso/internal/database"
@@ -28,6 +30,10 @@ import (
 type TaskReader interface {
 	// Get single task details
 	GetTaskDetail(ctx context.Context, taskID int) (*models.TaskDetail, error)
+	GetTaskSummary(ctx context.Context, taskID int) (*models.TaskSummary, error)
+
+	// Resolve a project-scoped ticket number (the 42 of API-42) to a task ID
+	GetTaskIDByTicketNumber(ctx context.Context, projectID, ticketNumber int) (int, error)
 
 	// Get task summaries/lists grouped by column
 	GetTaskSummariesByProject(ctx context.Context, projectID int) (map[int][]*models.TaskSummary, error)
@@ -38,6 +44,15 @@ type TaskReader interface {
 	// Get task references and hierarchies
 	GetTaskReferencesForProject(ctx context.Context, projectID int) ([]*models.TaskReference, error)
 	GetTaskTreeByProject(ctx context.Context, projectID int) ([]*models.TaskTreeNode, error)
+
+	// Get task activity log
+	GetTaskHistory(ctx context.Context, taskID int) ([]*models.TaskEvent, error)
+
+	// Full-text search over title, description and comments
+	SearchTasks(ctx context.Context, projectID int, query string, limit int) ([]*models.TaskSearchResult, error)
+
+	// Task summaries matching a filter query (priority>=high label:backend is:blocked ...)
+	GetTaskSummariesByFilter(ctx context.Context, projectID int, query string) ([]*models.TaskSummary, error)
 }
 
 // TaskWriter defines write operations for creating, updating, and deleting tasks.
@@ -51,6 +66,10 @@ type TaskWriter interface {
 	CreateTask(ctx context.Context, req CreateTaskRequest) (*models.Task, error)
 	UpdateTask(ctx context.Context, req UpdateTaskRequest) error
 	DeleteTask(ctx context.Context, taskID int) error
+
+	// Snapshots taken before a delete let the task be restored under its ID
+	SnapshotTask(ctx context.Context, taskID int) (*models.TaskSnapshot, error)
+	RestoreTask(ctx context.Context, snapshot *models.TaskSnapshot) error
 }
 
 // TaskMover defines task movement operations within the task management system.
@@ -68,9 +87,13 @@ type TaskMover interface {
 	MoveTaskToCompletedColumn(ctx context.Context, taskID int) error
 	MoveTaskToInProgressColumn(ctx context.Context, taskID int) error
 
+	// Re-homing a task in another project
+	MoveTaskToProject(ctx context.Context, req MoveToProjectRequest) (*MoveToProjectResult, error)
+
 	// Position-based movement (ordering within column)
 	MoveTaskUp(ctx context.Context, taskID int) error
 	MoveTaskDown(ctx context.Context, taskID int) error
+	RestoreTaskPosition(ctx context.Context, taskID, columnID, position int) error
 }
 
 // TaskRelationer defines task relationship operations (parent/child/blocking relationships).
@@ -113,6 +136,66 @@ type TaskCommenter interface {
 	GetCommentsByTask(ctx context.Context, taskID int) ([]*models.Comment, error)
 }
 
+// TaskChecklister defines checklist operations on tasks.
+// A checklist holds the small ordered sub-steps of a task that don't deserve
+// tasks of their own.
+//
+// Use this interface when you need to track or report progress within a task.
+type TaskChecklister interface {
+	GetChecklist(ctx context.Context, taskID int) ([]*models.ChecklistItem, error)
+	AddChecklistItem(ctx context.Context, taskID int, text string) (*models.ChecklistItem, error)
+	ToggleChecklistItem(ctx context.Context, itemID int) (*models.ChecklistItem, error)
+	RemoveChecklistItem(ctx context.Context, itemID int) error
+}
+
+// TaskArchiver defines archive operations on tasks.
+// Archived tasks keep their column, relations and history but are left out
+// of the board, task lists and ready work until they are unarchived.
+//
+// Use this interface when you need to hide finished work without deleting it.
+type TaskArchiver interface {
+	ArchiveTask(ctx context.Context, taskID int) error
+	UnarchiveTask(ctx context.Context, taskID int) error
+	ArchiveCompletedTasks(ctx context.Context, projectID int, olderThan time.Duration) ([]int, error)
+	GetArchivedTasksByProject(ctx context.Context, projectID int) ([]*models.ArchivedTask, error)
+}
+
+// TaskRecurrer defines the repeat rules of recurring tasks.
+// A rule is attached to a template task; each run copies the template, with
+// its labels and relations, into the project's ready column.
+//
+// Use this interface when you need to manage recurring work or run the
+// scheduler that generates it.
+type TaskRecurrer interface {
+	SetRecurrence(ctx context.Context, taskID int, rule string, now time.Time) (*models.TaskRecurrence, error)
+	GetRecurrence(ctx context.Context, taskID int) (*models.TaskRecurrence, error)
+	GetRecurrencesByProject(ctx context.Context, projectID int) ([]*models.TaskRecurrence, error)
+	RemoveRecurrence(ctx context.Context, taskID int) error
+	GenerateRecurringTasks(ctx context.Context, now time.Time) ([]int, error)
+}
+
+// TaskTemplater defines the named task templates of a project.
+// A template holds a task's title, description, type, priority and labels and
+// a set of child tasks, with {{variable}} placeholders filled in on use.
+//
+// Use this interface when you need to create repeatable multi-step work.
+type TaskTemplater interface {
+	GetTaskTemplates(ctx context.Context, projectID int) ([]*models.TaskTemplate, error)
+	GetTaskTemplate(ctx context.Context, projectID int, name string) (*models.TaskTemplate, error)
+	SaveTaskTemplate(ctx context.Context, projectID int, template *models.TaskTemplate, replace bool) error
+	DeleteTaskTemplate(ctx context.Context, projectID int, name string) error
+	TaskTemplateFromTask(ctx context.Context, taskID int, name string) (*models.TaskTemplate, error)
+	CreateTaskFromTemplate(ctx context.Context, template *models.TaskTemplate, vars map[string]string, req CreateTaskRequest) (*models.Task, []int, error)
+}
+
+// TaskBulkUpdater defines changes applied to many tasks at once.
+// All selected tasks are changed in one transaction, or none are.
+//
+// Use this interface when you need to triage or re-file a batch of tasks.
+type TaskBulkUpdater interface {
+	BulkUpdateTasks(ctx context.Context, req BulkUpdateRequest) (*BulkUpdateResult, error)
+}
+
 // Service defines all task-related business operations as a composition of focused interfaces.
 // This composite interface provides better separation of concerns through interface segregation.
 //
@@ -129,6 +212,11 @@ type Service interface {
 	TaskRelationer
 	TaskLabeler
 	TaskCommenter
+	TaskChecklister
+	TaskArchiver
+	TaskRecurrer
+	TaskTemplater
+	TaskBulkUpdater
 }
 
 // CreateTaskRequest encapsulates all data needed to create a task
@@ -140,20 +228,26 @@ type CreateTaskRequest struct {
 	PriorityID   int // Optional: 0 means use default
 	TypeID       int // Optional: 0 means use default
 	LabelIDs     []int
-	ParentIDs    []int // Parent task IDs (tasks that depend on this task)
-	ChildIDs     []int // Child task IDs (tasks this task depends on)
-	BlockedByIDs []int // Tasks that block this task
-	BlocksIDs    []int // Tasks that are blocked by this task
+	ParentIDs    []int      // Parent task IDs (tasks that depend on this task)
+	ChildIDs     []int      // Child task IDs (tasks this task depends on)
+	BlockedByIDs []int      // Tasks that block this task
+	BlocksIDs    []int      // Tasks that are blocked by this task
+	DueAt        *time.Time // Optional due date
+	StartAt      *time.Time // Optional start date, not after DueAt
 }
 
 // UpdateTaskRequest encapsulates all data needed to update a task
 // Fields with pointers are optional - nil means don't update
 type UpdateTaskRequest struct {
-	TaskID      int
-	Title       *string
-	Description *string
-	PriorityID  *int
-	TypeID      *int
+	TaskID       int
+	Title        *string
+	Description  *string
+	PriorityID   *int
+	TypeID       *int
+	DueAt        *time.Time
+	StartAt      *time.Time
+	ClearDueAt   bool // Remove the due date (takes precedence over DueAt)
+	ClearStartAt bool // Remove the start date (takes precedence over StartAt)
 }
 
 // CreateCommentRequest encapsulates data for creating a comment
@@ -202,124 +296,151 @@ func (s *service) CreateTask(ctx context.Context, req CreateTaskRequest) (*model
 
 	// Use WithTx helper for transaction management
 	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
-		qtx := generated.New(tx)
+		var err error
+		createdTask, err = createTaskTx(ctx, generated.New(tx), projectID, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		// Get next ticket number
-		ticketNumber, err := qtx.GetNextTicketNumber(ctx, projectID)
-		if err != nil {
-			return fmt.Errorf("failed to get ticket number: %w", err)
-		}
+	// Publish event after successful commit
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskCreated, TaskID: int(createdTask.ID), ColumnID: int(createdTask.ColumnID)})
 
-		// Create task
-		var desc sql.NullString
-		if req.Description != "" {
-			desc = sql.NullString{String: req.Description, Valid: true}
-		}
+	// Convert to model
+	return converters.TaskToModel(createdTask), nil
+}
 
-		var taskErr error
-		createdTask, taskErr = qtx.CreateTask(ctx, generated.CreateTaskParams{
-			Title:        req.Title,
-			Description:  desc,
-			ColumnID:     int64(req.ColumnID),
-			Position:     int64(req.Position),
-			TicketNumber: ticketNumber,
-		})
-		if taskErr != nil {
-			return fmt.Errorf("failed to create task: %w", taskErr)
-		}
+// createTaskTx creates a task from a validated request in a project: it takes
+// the next ticket number, sets its type, priority, dates, labels and
+// relations and records its creation. qtx must be scoped to the caller's
+// transaction; publishing the event is left to the caller.
+func createTaskTx(ctx context.Context, qtx generated.Querier, projectID int64, req CreateTaskRequest) (generated.Task, error) {
+	if err := checkWIPLimit(ctx, qtx, int64(req.ColumnID)); err != nil {
+		return generated.Task{}, err
+	}
 
-		// Increment ticket number
-		if err := qtx.IncrementTicketNumber(ctx, projectID); err != nil {
-			return fmt.Errorf("failed to increment ticket number: %w", err)
-		}
+	// Get next ticket number
+	ticketNumber, err := qtx.GetNextTicketNumber(ctx, projectID)
+	if err != nil {
+		return generated.Task{}, fmt.Errorf("failed to get ticket number: %w", err)
+	}
 
-		// Set priority if provided (default is handled by database)
-		if req.PriorityID > 0 {
-			if err := qtx.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{
-				PriorityID: int64(req.PriorityID),
-				ID:         createdTask.ID,
-			}); err != nil {
-				return fmt.Errorf("failed to set priority: %w", err)
-			}
-		}
+	// Create task
+	var desc sql.NullString
+	if req.Description != "" {
+		desc = sql.NullString{String: req.Description, Valid: true}
+	}
 
-		// Set type if provided (default is handled by database)
-		if req.TypeID > 0 {
-			if err := qtx.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{
-				TypeID: int64(req.TypeID),
-				ID:     createdTask.ID,
-			}); err != nil {
-				return fmt.Errorf("failed to set type: %w", err)
-			}
-		}
+	createdTask, err := qtx.CreateTask(ctx, generated.CreateTaskParams{
+		Title:        req.Title,
+		Description:  desc,
+		ColumnID:     int64(req.ColumnID),
+		Position:     int64(req.Position),
+		TicketNumber: ticketNumber,
+	})
+	if err != nil {
+		return generated.Task{}, fmt.Errorf("failed to create task: %w", err)
+	}
 
-		// Attach labels
-		for _, labelID := range req.LabelIDs {
-			if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
-				TaskID:  createdTask.ID,
-				LabelID: int64(labelID),
-			}); err != nil {
-				return fmt.Errorf("failed to attach label %d: %w", labelID, err)
-			}
-		}
+	// Increment ticket number
+	if err := qtx.IncrementTicketNumber(ctx, projectID); err != nil {
+		return generated.Task{}, fmt.Errorf("failed to increment ticket number: %w", err)
+	}
 
-		// Add parent relationships (tasks that depend on this task)
-		for _, parentID := range req.ParentIDs {
-			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-				ParentID:       int64(parentID),
-				ChildID:        createdTask.ID,
-				RelationTypeID: models.RelationTypeParentChild,
-			}); err != nil {
-				return fmt.Errorf("failed to add parent relation: %w", err)
-			}
+	// Set type and priority, falling back to the project's defaults
+	typeID, priorityID, err := resolveTypeAndPriority(ctx, qtx, projectID, req.TypeID, req.PriorityID)
+	if err != nil {
+		return generated.Task{}, err
+	}
+	if err := qtx.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{
+		PriorityID: priorityID,
+		ID:         createdTask.ID,
+	}); err != nil {
+		return generated.Task{}, fmt.Errorf("failed to set priority: %w", err)
+	}
+	if err := qtx.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{
+		TypeID: typeID,
+		ID:     createdTask.ID,
+	}); err != nil {
+		return generated.Task{}, fmt.Errorf("failed to set type: %w", err)
+	}
+	createdTask.TypeID, createdTask.PriorityID = typeID, priorityID
+
+	// Set dates if provided
+	if req.DueAt != nil || req.StartAt != nil {
+		if err := qtx.UpdateTaskDates(ctx, generated.UpdateTaskDatesParams{
+			DueAt:   converters.PtrToNullTime(req.DueAt),
+			StartAt: converters.PtrToNullTime(req.StartAt),
+			ID:      createdTask.ID,
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to set dates: %w", err)
 		}
+		createdTask.DueAt = converters.PtrToNullTime(req.DueAt)
+		createdTask.StartAt = converters.PtrToNullTime(req.StartAt)
+	}
 
-		// Add child relationships (tasks this task depends on)
-		for _, childID := range req.ChildIDs {
-			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-				ParentID:       createdTask.ID,
-				ChildID:        int64(childID),
-				RelationTypeID: models.RelationTypeParentChild,
-			}); err != nil {
-				return fmt.Errorf("failed to add child relation: %w", err)
-			}
+	// Attach labels
+	for _, labelID := range req.LabelIDs {
+		if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+			TaskID:  createdTask.ID,
+			LabelID: int64(labelID),
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to attach label %d: %w", labelID, err)
 		}
+	}
 
-		// Add blocking relationships (tasks that block this task)
-		for _, blockerID := range req.BlockedByIDs {
-			// This task (Parent) is blocked by blockerID (Child)
-			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-				ParentID:       createdTask.ID,
-				ChildID:        int64(blockerID),
-				RelationTypeID: models.RelationTypeBlocking,
-			}); err != nil {
-				return fmt.Errorf("failed to add blocked-by relation: %w", err)
-			}
+	// Add parent relationships (tasks that depend on this task)
+	for _, parentID := range req.ParentIDs {
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       int64(parentID),
+			ChildID:        createdTask.ID,
+			RelationTypeID: models.RelationTypeParentChild,
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to add parent relation: %w", err)
 		}
+	}
 
-		// Add blocked relationships (tasks that are blocked by this task)
-		for _, blockedID := range req.BlocksIDs {
-			// blockedID (Parent) is blocked by this task (Child)
-			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-				ParentID:       int64(blockedID),
-				ChildID:        createdTask.ID,
-				RelationTypeID: models.RelationTypeBlocking,
-			}); err != nil {
-				return fmt.Errorf("failed to add blocks relation: %w", err)
-			}
+	// Add child relationships (tasks this task depends on)
+	for _, childID := range req.ChildIDs {
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       createdTask.ID,
+			ChildID:        int64(childID),
+			RelationTypeID: models.RelationTypeParentChild,
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to add child relation: %w", err)
 		}
+	}
 
-		return nil
-	})
-	if err != nil {
-		return nil, err
+	// Add blocking relationships (tasks that block this task)
+	for _, blockerID := range req.BlockedByIDs {
+		// This task (Parent) is blocked by blockerID (Child)
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       createdTask.ID,
+			ChildID:        int64(blockerID),
+			RelationTypeID: models.RelationTypeBlocking,
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to add blocked-by relation: %w", err)
+		}
 	}
 
-	// Publish event after successful commit
-	s.publishTaskEvent(ctx, int(createdTask.ID))
+	// Add blocked relationships (tasks that are blocked by this task)
+	for _, blockedID := range req.BlocksIDs {
+		// blockedID (Parent) is blocked by this task (Child)
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       int64(blockedID),
+			ChildID:        createdTask.ID,
+			RelationTypeID: models.RelationTypeBlocking,
+		}); err != nil {
+			return generated.Task{}, fmt.Errorf("failed to add blocks relation: %w", err)
+		}
+	}
 
-	// Convert to model
-	return converters.TaskToModel(createdTask), nil
+	// Record creation in the activity log
+	if err := recordTaskEvent(ctx, qtx, createdTask.ID, models.TaskEventCreated, "", "", req.Title); err != nil {
+		return generated.Task{}, err
+	}
+	return createdTask, nil
 }
 
 // UpdateTask handles task updates with validation
@@ -343,64 +464,111 @@ func (s *service) UpdateTask(ctx context.Context, req UpdateTaskRequest) error {
 		return ErrInvalidType
 	}
 
-	// Update basic fields if provided
-	if req.Title != nil || req.Description != nil {
-		var title string
-		var description sql.NullString
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
 
-		// Only query if we need to preserve existing values for fields not being updated
-		if req.Title == nil || req.Description == nil {
-			detail, err := s.queries.GetTaskDetail(ctx, int64(req.TaskID))
-			if err != nil {
-				return fmt.Errorf("failed to get task: %w", err)
+		// Snapshot the task so changes can be recorded in the activity log
+		before, err := qtx.GetTaskDetail(ctx, int64(req.TaskID))
+		if err != nil {
+			return fmt.Errorf("failed to get task: %w", err)
+		}
+
+		// Update basic fields if provided, preserving existing values otherwise
+		if req.Title != nil || req.Description != nil {
+			title := before.Title
+			description := before.Description
+
+			if req.Title != nil {
+				title = *req.Title
 			}
-			if req.Title == nil {
-				title = detail.Title
+			if req.Description != nil {
+				description = sql.NullString{String: *req.Description, Valid: true}
 			}
-			if req.Description == nil {
-				description = detail.Description
+
+			if err := qtx.UpdateTask(ctx, generated.UpdateTaskParams{
+				Title:       title,
+				Description: description,
+				ID:          int64(req.TaskID),
+			}); err != nil {
+				return fmt.Errorf("failed to update task: %w", err)
 			}
 		}
 
-		// Use provided values or fall back to existing values
-		if req.Title != nil {
-			title = *req.Title
+		// Type and priority must come from the task's project
+		if req.TypeID != nil || req.PriorityID != nil {
+			var typeID, priorityID int
+			if req.TypeID != nil {
+				typeID = *req.TypeID
+			}
+			if req.PriorityID != nil {
+				priorityID = *req.PriorityID
+			}
+			if _, _, err := resolveTypeAndPriority(ctx, qtx, before.ProjectID, typeID, priorityID); err != nil {
+				return err
+			}
 		}
-		if req.Description != nil {
-			description = sql.NullString{String: *req.Description, Valid: true}
+
+		// Update priority if provided
+		if req.PriorityID != nil {
+			if err := qtx.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{
+				PriorityID: int64(*req.PriorityID),
+				ID:         int64(req.TaskID),
+			}); err != nil {
+				return fmt.Errorf("failed to update priority: %w", err)
+			}
 		}
 
-		if err := s.queries.UpdateTask(ctx, generated.UpdateTaskParams{
-			Title:       title,
-			Description: description,
-			ID:          int64(req.TaskID),
-		}); err != nil {
-			return fmt.Errorf("failed to update task: %w", err)
+		// Update type if provided
+		if req.TypeID != nil {
+			if err := qtx.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{
+				TypeID: int64(*req.TypeID),
+				ID:     int64(req.TaskID),
+			}); err != nil {
+				return fmt.Errorf("failed to update type: %w", err)
+			}
 		}
-	}
 
-	// Update priority if provided
-	if req.PriorityID != nil {
-		if err := s.queries.UpdateTaskPriority(ctx, generated.UpdateTaskPriorityParams{
-			PriorityID: int64(*req.PriorityID),
-			ID:         int64(req.TaskID),
-		}); err != nil {
-			return fmt.Errorf("failed to update priority: %w", err)
+		// Update dates if provided, preserving the one not being changed
+		if req.DueAt != nil || req.StartAt != nil || req.ClearDueAt || req.ClearStartAt {
+			dueAt, startAt := before.DueAt, before.StartAt
+			if req.DueAt != nil {
+				dueAt = converters.PtrToNullTime(req.DueAt)
+			}
+			if req.StartAt != nil {
+				startAt = converters.PtrToNullTime(req.StartAt)
+			}
+			if req.ClearDueAt {
+				dueAt = sql.NullTime{}
+			}
+			if req.ClearStartAt {
+				startAt = sql.NullTime{}
+			}
+			if dueAt.Valid && startAt.Valid && startAt.Time.After(dueAt.Time) {
+				return ErrStartAfterDue
+			}
+
+			if err := qtx.UpdateTaskDates(ctx, generated.UpdateTaskDatesParams{
+				DueAt:   dueAt,
+				StartAt: startAt,
+				ID:      int64(req.TaskID),
+			}); err != nil {
+				return fmt.Errorf("failed to update dates: %w", err)
+			}
 		}
-	}
 
-	// Update type if provided
-	if req.TypeID != nil {
-		if err := s.queries.UpdateTaskType(ctx, generated.UpdateTaskTypeParams{
-			TypeID: int64(*req.TypeID),
-			ID:     int64(req.TaskID),
-		}); err != nil {
-			return fmt.Errorf("failed to update type: %w", err)
+		after, err := qtx.GetTaskDetail(ctx, int64(req.TaskID))
+		if err != nil {
+			return fmt.Errorf("failed to get updated task: %w", err)
 		}
+
+		return recordTaskDiff(ctx, qtx, before, after)
+	})
+	if err != nil {
+		return err
 	}
 
 	// Publish event
-	s.publishTaskEvent(ctx, req.TaskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: req.TaskID, Fields: updatedFields(req)})
 
 	return nil
 }
@@ -411,12 +579,50 @@ func (s *service) DeleteTask(ctx context.Context, taskID int) error {
 		return ErrInvalidTaskID
 	}
 
-	if err := s.queries.DeleteTask(ctx, int64(taskID)); err != nil {
-		return fmt.Errorf("failed to delete task: %w", err)
+	// Resolve the project before the task row disappears
+	projectID, err := s.queries.GetProjectIDFromTask(ctx, int64(taskID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get project ID: %w", err)
 	}
+	taskExists := err == nil
 
-	// Publish event
-	s.publishTaskEvent(ctx, taskID)
+	var changes blockChanges
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// The tasks it blocked may be free once it is gone
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, int64(taskID)); err != nil {
+			return err
+		}
+
+		// Record the deletion first; history outlives the task row
+		if taskExists {
+			task, err := qtx.GetTask(ctx, int64(taskID))
+			if err != nil {
+				return fmt.Errorf("failed to get task: %w", err)
+			}
+			if err := recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventDeleted, "", task.Title, ""); err != nil {
+				return err
+			}
+		}
+
+		if err := qtx.DeleteTask(ctx, int64(taskID)); err != nil {
+			return fmt.Errorf("failed to delete task: %w", err)
+		}
+
+		changes, err = snapshot.changes(ctx, qtx)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Publish event (the task is gone, so publish directly with the resolved project)
+	if taskExists {
+		s.publishEvent(events.Event{Type: events.EventTaskDeleted, ProjectID: int(projectID), TaskID: taskID})
+	}
+	s.publishBlockChanges(ctx, taskID, changes)
 
 	return nil
 }
@@ -457,20 +663,33 @@ func (s *service) GetTaskDetail(ctx context.Context, taskID int) (*models.TaskDe
 		return nil, fmt.Errorf("failed to get task comments: %w", err)
 	}
 
+	// Get checklist
+	checklistRows, err := s.queries.GetChecklistByTask(ctx, int64(taskID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task checklist: %w", err)
+	}
+
 	// Convert to model
 	detail := &models.TaskDetail{
-		ID:          int(taskRow.ID),
-		Title:       taskRow.Title,
-		Description: taskRow.Description.String,
-		ColumnID:    int(taskRow.ColumnID),
-		ColumnName:  taskRow.ColumnName,
-		ProjectName: taskRow.ProjectName,
-		Position:    int(taskRow.Position),
-		Labels:      converters.LabelsToModels(labels),
-		ParentTasks: converters.ParentTasksToReferences(parentRows),
-		ChildTasks:  converters.ChildTasksToReferences(childRows),
-		Comments:    converters.CommentsToModels(commentRows),
-		IsBlocked:   taskRow.IsBlocked > 0,
+		ID:            int(taskRow.ID),
+		Title:         taskRow.Title,
+		Description:   taskRow.Description.String,
+		ColumnID:      int(taskRow.ColumnID),
+		ColumnName:    taskRow.ColumnName,
+		ProjectName:   taskRow.ProjectName,
+		ProjectID:     int(taskRow.ProjectID),
+		Position:      int(taskRow.Position),
+		Labels:        converters.LabelsToModels(labels),
+		ParentTasks:   converters.ParentTasksToReferences(parentRows),
+		ChildTasks:    converters.ChildTasksToReferences(childRows),
+		Comments:      converters.CommentsToModels(commentRows),
+		Checklist:     converters.ChecklistToModels(checklistRows),
+		IsBlocked:     taskRow.IsBlocked > 0,
+		ChildrenDone:  int(taskRow.ChildrenDone),
+		ChildrenTotal: int(taskRow.ChildrenTotal),
+		DueAt:         converters.NullTimeToPtr(taskRow.DueAt),
+		StartAt:       converters.NullTimeToPtr(taskRow.StartAt),
+		ArchivedAt:    converters.NullTimeToPtr(taskRow.ArchivedAt),
 	}
 
 	if taskRow.TicketNumber.Valid {
@@ -495,6 +714,46 @@ func (s *service) GetTaskDetail(ctx context.Context, taskID int) (*models.TaskDe
 	return detail, nil
 }
 
+// GetTaskSummary retrieves the board summary of a single task
+func (s *service) GetTaskSummary(ctx context.Context, taskID int) (*models.TaskSummary, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	row, err := s.queries.GetTaskSummary(ctx, int64(taskID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("failed to get task summary: %w", err)
+	}
+
+	return converters.TaskSummaryFromRowToModel(generated.GetTaskSummariesByProjectRow(row)), nil
+}
+
+// GetTaskIDByTicketNumber returns the ID of the task with the given ticket
+// number in a project
+func (s *service) GetTaskIDByTicketNumber(ctx context.Context, projectID, ticketNumber int) (int, error) {
+	if projectID <= 0 {
+		return 0, ErrInvalidProjectID
+	}
+	if ticketNumber <= 0 {
+		return 0, ErrInvalidTicketNumber
+	}
+
+	id, err := s.queries.GetTaskIDByTicketNumber(ctx, generated.GetTaskIDByTicketNumberParams{
+		ProjectID:    int64(projectID),
+		TicketNumber: sql.NullInt64{Int64: int64(ticketNumber), Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, ErrTaskNotFound
+		}
+		return 0, fmt.Errorf("failed to get task by ticket number: %w", err)
+	}
+	return int(id), nil
+}
+
 // GetTaskSummariesByProject retrieves task summaries for a project, grouped by column
 func (s *service) GetTaskSummariesByProject(ctx context.Context, projectID int) (map[int][]*models.TaskSummary, error) {
 	rows, err := s.queries.GetTaskSummariesByProject(ctx, int64(projectID))
@@ -609,11 +868,13 @@ func (s *service) GetTaskTreeByProject(ctx context.Context, projectID int) ([]*m
 	taskMap := make(map[int]*models.TaskTreeNode)
 	for _, row := range taskRows {
 		node := &models.TaskTreeNode{
-			ID:          int(row.ID),
-			Title:       row.Title,
-			ColumnName:  row.ColumnName,
-			ProjectName: row.ProjectName,
-			Children:    []*models.TaskTreeNode{},
+			ID:            int(row.ID),
+			Title:         row.Title,
+			ColumnName:    row.ColumnName,
+			ProjectName:   row.ProjectName,
+			ChildrenDone:  int(row.ChildrenDone),
+			ChildrenTotal: int(row.ChildrenTotal),
+			Children:      []*models.TaskTreeNode{},
 		}
 		if row.TicketNumber.Valid {
 			node.TicketNumber = int(row.TicketNumber.Int64)
@@ -670,6 +931,8 @@ func (s *service) GetTaskTreeByProject(ctx context.Context, projectID int) ([]*m
 				Title:         childNode.Title,
 				ColumnName:    childNode.ColumnName,
 				ProjectName:   childNode.ProjectName,
+				ChildrenDone:  childNode.ChildrenDone,
+				ChildrenTotal: childNode.ChildrenTotal,
 				RelationLabel: childRel.relationLabel,
 				RelationColor: childRel.relationColor,
 				IsBlocking:    childRel.isBlocking,
@@ -686,12 +949,14 @@ func (s *service) GetTaskTreeByProject(ctx context.Context, projectID int) ([]*m
 		if !hasParent[node.ID] {
 			// This is a root task - build its children
 			rootCopy := &models.TaskTreeNode{
-				ID:           node.ID,
-				TicketNumber: node.TicketNumber,
-				Title:        node.Title,
-				ColumnName:   node.ColumnName,
-				ProjectName:  node.ProjectName,
-				Children:     buildChildren(node.ID, 0),
+				ID:            node.ID,
+				TicketNumber:  node.TicketNumber,
+				Title:         node.Title,
+				ColumnName:    node.ColumnName,
+				ProjectName:   node.ProjectName,
+				ChildrenDone:  node.ChildrenDone,
+				ChildrenTotal: node.ChildrenTotal,
+				Children:      buildChildren(node.ID, 0),
 			}
 			roots = append(roots, rootCopy)
 		}
@@ -728,40 +993,38 @@ func (s *service) MoveTaskToNextColumn(ctx context.Context, taskID int) error {
 		return ErrInvalidTaskID
 	}
 
-	// Get current column
-	posRow, err := s.queries.GetTaskPosition(ctx, int64(taskID))
-	if err != nil {
-		return fmt.Errorf("failed to get task position: %w", err)
-	}
+	var nextColID int64
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
 
-	// Get next column
-	nextColumnID, err := s.queries.GetNextColumnID(ctx, posRow.ColumnID)
-	if err != nil {
-		return fmt.Errorf("failed to get next column: %w", err)
-	}
+		// Get current column
+		posRow, err := qtx.GetTaskPosition(ctx, int64(taskID))
+		if err != nil {
+			return fmt.Errorf("failed to get task position: %w", err)
+		}
 
-	// Convert interface{} to int64 with proper error handling
-	nextColID, err := extractColumnID(nextColumnID)
-	if err != nil {
-		return fmt.Errorf("no next column available")
-	}
+		// Get next column
+		nextColumnID, err := qtx.GetNextColumnID(ctx, posRow.ColumnID)
+		if err != nil {
+			return fmt.Errorf("failed to get next column: %w", err)
+		}
 
-	// Get task count in target column to append at the end
-	taskCount, err := s.queries.GetTaskCountByColumn(ctx, nextColID)
-	if err != nil {
-		return fmt.Errorf("failed to get task count: %w", err)
-	}
+		// Convert interface{} to int64 with proper error handling
+		nextColID, err = extractColumnID(nextColumnID)
+		if err != nil {
+			return fmt.Errorf("no next column available")
+		}
 
-	// Move task to next column
-	if err := s.queries.MoveTaskToColumn(ctx, generated.MoveTaskToColumnParams{
-		ColumnID: nextColID,
-		Position: taskCount + 1,
-		ID:       int64(taskID),
-	}); err != nil {
-		return fmt.Errorf("failed to move task: %w", err)
+		changes, err = moveTaskToColumnTx(ctx, qtx, int64(taskID), nextColID)
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, ColumnID: int(nextColID), Fields: []string{"column"}})
+	s.publishBlockChanges(ctx, taskID, changes)
 	return nil
 }
 
@@ -771,40 +1034,38 @@ func (s *service) MoveTaskToPrevColumn(ctx context.Context, taskID int) error {
 		return ErrInvalidTaskID
 	}
 
-	// Get current column
-	posRow, err := s.queries.GetTaskPosition(ctx, int64(taskID))
-	if err != nil {
-		return fmt.Errorf("failed to get task position: %w", err)
-	}
+	var prevColID int64
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
 
-	// Get previous column
-	prevColumnID, err := s.queries.GetPrevColumnID(ctx, posRow.ColumnID)
-	if err != nil {
-		return fmt.Errorf("failed to get previous column: %w", err)
-	}
+		// Get current column
+		posRow, err := qtx.GetTaskPosition(ctx, int64(taskID))
+		if err != nil {
+			return fmt.Errorf("failed to get task position: %w", err)
+		}
 
-	// Convert interface{} to int64 with proper error handling
-	prevColID, err := extractColumnID(prevColumnID)
-	if err != nil {
-		return fmt.Errorf("no previous column available")
-	}
+		// Get previous column
+		prevColumnID, err := qtx.GetPrevColumnID(ctx, posRow.ColumnID)
+		if err != nil {
+			return fmt.Errorf("failed to get previous column: %w", err)
+		}
 
-	// Get task count in target column to append at the end
-	taskCount, err := s.queries.GetTaskCountByColumn(ctx, prevColID)
-	if err != nil {
-		return fmt.Errorf("failed to get task count: %w", err)
-	}
+		// Convert interface{} to int64 with proper error handling
+		prevColID, err = extractColumnID(prevColumnID)
+		if err != nil {
+			return fmt.Errorf("no previous column available")
+		}
 
-	// Move task to previous column
-	if err := s.queries.MoveTaskToColumn(ctx, generated.MoveTaskToColumnParams{
-		ColumnID: prevColID,
-		Position: taskCount + 1,
-		ID:       int64(taskID),
-	}); err != nil {
-		return fmt.Errorf("failed to move task: %w", err)
+		changes, err = moveTaskToColumnTx(ctx, qtx, int64(taskID), prevColID)
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, ColumnID: int(prevColID), Fields: []string{"column"}})
+	s.publishBlockChanges(ctx, taskID, changes)
 	return nil
 }
 
@@ -817,33 +1078,169 @@ func (s *service) MoveTaskToColumn(ctx context.Context, taskID, columnID int) er
 		return ErrInvalidColumnID
 	}
 
-	// Verify task exists before moving
-	_, err := s.queries.GetTaskPosition(ctx, int64(taskID))
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ErrInvalidTaskID
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Verify task exists before moving
+		_, err := qtx.GetTaskPosition(ctx, int64(taskID))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrInvalidTaskID
+			}
+			return fmt.Errorf("failed to verify task exists: %w", err)
 		}
-		return fmt.Errorf("failed to verify task exists: %w", err)
+
+		changes, err = moveTaskToColumnTx(ctx, qtx, int64(taskID), int64(columnID))
+		return err
+	})
+	if err != nil {
+		return err
 	}
 
-	// Get task count in target column to append at the end
-	taskCount, err := s.queries.GetTaskCountByColumn(ctx, int64(columnID))
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, ColumnID: columnID, Fields: []string{"column"}})
+	s.publishBlockChanges(ctx, taskID, changes)
+	return nil
+}
+
+// blockChanges lists the tasks whose blocked state changed because a task
+// they (transitively) depend on moved in or out of a completed column
+type blockChanges struct {
+	unblocked []int
+	reblocked []int
+}
+
+// moveTaskToColumnTx appends a task to the end of the target column and records
+// the move in the activity log. It fails with ErrWIPLimitExceeded if the target
+// column is full. qtx must be scoped to the caller's transaction.
+func moveTaskToColumnTx(ctx context.Context, qtx generated.Querier, taskID, columnID int64) (blockChanges, error) {
+	return placeTaskTx(ctx, qtx, taskID, columnID, -1)
+}
+
+// placeTaskTx moves a task to the given position of a column, or appends it
+// when position is negative, and records the move in the activity log.
+// Only appending checks the column's WIP limit: an explicit position puts a
+// task back where it was. qtx must be scoped to the caller's transaction.
+func placeTaskTx(ctx context.Context, qtx generated.Querier, taskID, columnID, position int64) (blockChanges, error) {
+	var changes blockChanges
+
+	before, err := qtx.GetTaskDetail(ctx, taskID)
 	if err != nil {
-		return fmt.Errorf("failed to get task count: %w", err)
+		return changes, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if position < 0 && before.ColumnID != columnID {
+		if err := checkWIPLimit(ctx, qtx, columnID); err != nil {
+			return changes, err
+		}
+	}
+
+	snapshot := blockSnapshot{}
+	if err := snapshot.addDependents(ctx, qtx, taskID); err != nil {
+		return changes, err
 	}
 
-	if err := s.queries.MoveTaskToColumn(ctx, generated.MoveTaskToColumnParams{
-		ColumnID: int64(columnID),
-		Position: taskCount + 1,
-		ID:       int64(taskID),
+	if position < 0 {
+		// Get task count in target column to append at the end
+		taskCount, err := qtx.GetTaskCountByColumn(ctx, columnID)
+		if err != nil {
+			return changes, fmt.Errorf("failed to get task count: %w", err)
+		}
+		position = taskCount + 1
+	}
+
+	if err := qtx.MoveTaskToColumn(ctx, generated.MoveTaskToColumnParams{
+		ColumnID: columnID,
+		Position: position,
+		ID:       taskID,
 	}); err != nil {
-		return fmt.Errorf("failed to move task: %w", err)
+		return changes, fmt.Errorf("failed to move task: %w", err)
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	after, err := qtx.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		return changes, fmt.Errorf("failed to get moved task: %w", err)
+	}
+
+	if before.ColumnID == after.ColumnID {
+		return changes, nil
+	}
+
+	if changes, err = snapshot.changes(ctx, qtx); err != nil {
+		return changes, err
+	}
+
+	return changes, recordTaskEvent(ctx, qtx, taskID, models.TaskEventMoved, "column", before.ColumnName, after.ColumnName)
+}
+
+// blockSnapshot remembers whether tasks were blocked before a change, so the
+// tasks the change unblocked or blocked again can be told apart afterwards
+type blockSnapshot map[int64]bool
+
+// addDependents adds the tasks blocked by taskID, directly or through a
+// blocker chain, to the snapshot
+func (b blockSnapshot) addDependents(ctx context.Context, qtx generated.Querier, taskID int64) error {
+	dependents, err := qtx.GetBlockingDependents(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get blocked tasks: %w", err)
+	}
+	for _, dep := range dependents {
+		b[dep.ID] = dep.IsBlocked > 0
+	}
 	return nil
 }
 
+// addTask adds a single task to the snapshot
+func (b blockSnapshot) addTask(ctx context.Context, qtx generated.Querier, taskID int64) error {
+	blocked, err := qtx.GetTaskBlocked(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to get blocked state: %w", err)
+	}
+	b[taskID] = blocked > 0
+	return nil
+}
+
+// changes compares the snapshot with the tasks' current state, in task ID
+// order. Tasks deleted since the snapshot are skipped.
+func (b blockSnapshot) changes(ctx context.Context, qtx generated.Querier) (blockChanges, error) {
+	var changes blockChanges
+
+	ids := make([]int64, 0, len(b))
+	for id := range b {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	for _, id := range ids {
+		blocked, err := qtx.GetTaskBlocked(ctx, id)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return changes, fmt.Errorf("failed to get blocked state: %w", err)
+		}
+		switch isBlocked := blocked > 0; {
+		case b[id] && !isBlocked:
+			changes.unblocked = append(changes.unblocked, int(id))
+		case !b[id] && isBlocked:
+			changes.reblocked = append(changes.reblocked, int(id))
+		}
+	}
+	return changes, nil
+}
+
+// publishBlockChanges announces the tasks a move unblocked or blocked again.
+// Unblocked tasks get a task.unblocked event naming the moved task; tasks
+// that became blocked again get a task.updated event for their badge.
+func (s *service) publishBlockChanges(ctx context.Context, movedTaskID int, changes blockChanges) {
+	for _, id := range changes.unblocked {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUnblocked, TaskID: id, RelatedTaskID: movedTaskID})
+	}
+	for _, id := range changes.reblocked {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUpdated, TaskID: id, Fields: []string{"blocked"}})
+	}
+}
+
 // MoveTaskToReadyColumn moves task to the column marked as holding ready tasks
 func (s *service) MoveTaskToReadyColumn(ctx context.Context, taskID int) error {
 	if taskID <= 0 {
@@ -1066,13 +1463,14 @@ func (s *service) MoveTaskUp(ctx context.Context, taskID int) error {
 			return fmt.Errorf("failed to move task up: %w", err)
 		}
 
-		return nil
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventReordered, "position",
+			strconv.FormatInt(posRow.Position, 10), strconv.FormatInt(aboveRow.Position, 10))
 	})
 	if err != nil {
 		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, Fields: []string{"position"}})
 	return nil
 }
 
@@ -1123,13 +1521,14 @@ func (s *service) MoveTaskDown(ctx context.Context, taskID int) error {
 			return fmt.Errorf("failed to move task down: %w", err)
 		}
 
-		return nil
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventReordered, "position",
+			strconv.FormatInt(posRow.Position, 10), strconv.FormatInt(belowRow.Position, 10))
 	})
 	if err != nil {
 		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskMoved, TaskID: taskID, Fields: []string{"position"}})
 	return nil
 }
 
@@ -1172,6 +1571,20 @@ func (s *service) wouldCreateCycle(ctx context.Context, parentID, childID int) (
 	return false, nil
 }
 
+// hasChildRelation reports whether childID is directly related to parentID
+func hasChildRelation(ctx context.Context, qtx generated.Querier, parentID, childID int64) (bool, error) {
+	children, err := qtx.GetChildTasks(ctx, parentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get child tasks: %w", err)
+	}
+	for _, child := range children {
+		if child.ID == childID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // AddParentRelation adds a parent relationship (parent depends on this task)
 func (s *service) AddParentRelation(ctx context.Context, taskID, parentID int, relationTypeID int) error {
 	if taskID <= 0 || parentID <= 0 {
@@ -1181,6 +1594,10 @@ func (s *service) AddParentRelation(ctx context.Context, taskID, parentID int, r
 		return ErrSelfRelation
 	}
 
+	if err := checkRelationType(ctx, s.queries, int64(parentID), relationTypeID); err != nil {
+		return err
+	}
+
 	// Check for circular dependency before adding
 	wouldCycle, err := s.wouldCreateCycle(ctx, parentID, taskID)
 	if err != nil {
@@ -1190,16 +1607,25 @@ func (s *service) AddParentRelation(ctx context.Context, taskID, parentID int, r
 		return ErrCircularRelation
 	}
 
-	// Add the relationship (this task is the child)
-	if err := s.queries.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-		ParentID:       int64(parentID),
-		ChildID:        int64(taskID),
-		RelationTypeID: int64(relationTypeID),
-	}); err != nil {
-		return fmt.Errorf("failed to add parent relation: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Add the relationship (this task is the child)
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       int64(parentID),
+			ChildID:        int64(taskID),
+			RelationTypeID: int64(relationTypeID),
+		}); err != nil {
+			return fmt.Errorf("failed to add parent relation: %w", err)
+		}
+
+		return recordRelationEvent(ctx, qtx, models.TaskEventRelationAdded, int64(parentID), int64(taskID))
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskLinked, TaskID: taskID, RelatedTaskID: parentID})
 	return nil
 }
 
@@ -1212,6 +1638,10 @@ func (s *service) AddChildRelation(ctx context.Context, taskID, childID int, rel
 		return ErrSelfRelation
 	}
 
+	if err := checkRelationType(ctx, s.queries, int64(taskID), relationTypeID); err != nil {
+		return err
+	}
+
 	// Check for circular dependency before adding
 	wouldCycle, err := s.wouldCreateCycle(ctx, taskID, childID)
 	if err != nil {
@@ -1221,16 +1651,25 @@ func (s *service) AddChildRelation(ctx context.Context, taskID, childID int, rel
 		return ErrCircularRelation
 	}
 
-	// Add the relationship (this task is the parent)
-	if err := s.queries.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
-		ParentID:       int64(taskID),
-		ChildID:        int64(childID),
-		RelationTypeID: int64(relationTypeID),
-	}); err != nil {
-		return fmt.Errorf("failed to add child relation: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Add the relationship (this task is the parent)
+		if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+			ParentID:       int64(taskID),
+			ChildID:        int64(childID),
+			RelationTypeID: int64(relationTypeID),
+		}); err != nil {
+			return fmt.Errorf("failed to add child relation: %w", err)
+		}
+
+		return recordRelationEvent(ctx, qtx, models.TaskEventRelationAdded, int64(taskID), int64(childID))
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskLinked, TaskID: taskID, RelatedTaskID: childID})
 	return nil
 }
 
@@ -1240,14 +1679,43 @@ func (s *service) RemoveParentRelation(ctx context.Context, taskID, parentID int
 		return ErrInvalidTaskID
 	}
 
-	if err := s.queries.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
-		ParentID: int64(parentID),
-		ChildID:  int64(taskID),
-	}); err != nil {
-		return fmt.Errorf("failed to remove parent relation: %w", err)
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Dropping a blocking relation can free the parent and what it blocks
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, int64(taskID)); err != nil {
+			return err
+		}
+
+		linked, err := hasChildRelation(ctx, qtx, int64(parentID), int64(taskID))
+		if err != nil {
+			return err
+		}
+
+		if err := qtx.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
+			ParentID: int64(parentID),
+			ChildID:  int64(taskID),
+		}); err != nil {
+			return fmt.Errorf("failed to remove parent relation: %w", err)
+		}
+
+		// Removing a relation that doesn't exist is a no-op and isn't logged
+		if !linked {
+			return nil
+		}
+		if changes, err = snapshot.changes(ctx, qtx); err != nil {
+			return err
+		}
+		return recordRelationEvent(ctx, qtx, models.TaskEventRelationRemoved, int64(parentID), int64(taskID))
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUnlinked, TaskID: taskID, RelatedTaskID: parentID})
+	s.publishBlockChanges(ctx, taskID, changes)
 	return nil
 }
 
@@ -1257,14 +1725,43 @@ func (s *service) RemoveChildRelation(ctx context.Context, taskID, childID int)
 		return ErrInvalidTaskID
 	}
 
-	if err := s.queries.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
-		ParentID: int64(taskID),
-		ChildID:  int64(childID),
-	}); err != nil {
-		return fmt.Errorf("failed to remove child relation: %w", err)
+	var changes blockChanges
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Dropping a blocking relation can free the parent and what it blocks
+		snapshot := blockSnapshot{}
+		if err := snapshot.addDependents(ctx, qtx, int64(childID)); err != nil {
+			return err
+		}
+
+		linked, err := hasChildRelation(ctx, qtx, int64(taskID), int64(childID))
+		if err != nil {
+			return err
+		}
+
+		if err := qtx.RemoveSubtask(ctx, generated.RemoveSubtaskParams{
+			ParentID: int64(taskID),
+			ChildID:  int64(childID),
+		}); err != nil {
+			return fmt.Errorf("failed to remove child relation: %w", err)
+		}
+
+		// Removing a relation that doesn't exist is a no-op and isn't logged
+		if !linked {
+			return nil
+		}
+		if changes, err = snapshot.changes(ctx, qtx); err != nil {
+			return err
+		}
+		return recordRelationEvent(ctx, qtx, models.TaskEventRelationRemoved, int64(taskID), int64(childID))
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskUnlinked, TaskID: taskID, RelatedTaskID: childID})
+	s.publishBlockChanges(ctx, childID, changes)
 	return nil
 }
 
@@ -1277,14 +1774,28 @@ func (s *service) AttachLabel(ctx context.Context, taskID, labelID int) error {
 		return ErrInvalidLabelID
 	}
 
-	if err := s.queries.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
-		TaskID:  int64(taskID),
-		LabelID: int64(labelID),
-	}); err != nil {
-		return fmt.Errorf("failed to attach label: %w", err)
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := qtx.AddLabelToTask(ctx, generated.AddLabelToTaskParams{
+			TaskID:  int64(taskID),
+			LabelID: int64(labelID),
+		}); err != nil {
+			return fmt.Errorf("failed to attach label: %w", err)
+		}
+
+		label, err := qtx.GetLabelByID(ctx, int64(labelID))
+		if err != nil {
+			return fmt.Errorf("failed to get label: %w", err)
+		}
+
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventLabelAttached, "label", "", label.Name)
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventLabelAttached, TaskID: taskID, LabelID: labelID})
 	return nil
 }
 
@@ -1297,14 +1808,39 @@ func (s *service) DetachLabel(ctx context.Context, taskID, labelID int) error {
 		return ErrInvalidLabelID
 	}
 
-	if err := s.queries.RemoveLabelFromTask(ctx, generated.RemoveLabelFromTaskParams{
-		TaskID:  int64(taskID),
-		LabelID: int64(labelID),
-	}); err != nil {
-		return fmt.Errorf("failed to detach label: %w", err)
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Find the label among the task's labels so only real detaches are logged
+		labels, err := qtx.GetTaskLabels(ctx, int64(taskID))
+		if err != nil {
+			return fmt.Errorf("failed to get task labels: %w", err)
+		}
+		labelName, attached := "", false
+		for _, l := range labels {
+			if l.ID == int64(labelID) {
+				labelName, attached = l.Name, true
+				break
+			}
+		}
+
+		if err := qtx.RemoveLabelFromTask(ctx, generated.RemoveLabelFromTaskParams{
+			TaskID:  int64(taskID),
+			LabelID: int64(labelID),
+		}); err != nil {
+			return fmt.Errorf("failed to detach label: %w", err)
+		}
+
+		if !attached {
+			return nil
+		}
+		return recordTaskEvent(ctx, qtx, int64(taskID), models.TaskEventLabelDetached, "label", labelName, "")
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, taskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventLabelDetached, TaskID: taskID, LabelID: labelID})
 	return nil
 }
 
@@ -1333,17 +1869,28 @@ func (s *service) CreateComment(ctx context.Context, req CreateCommentRequest) (
 		return nil, fmt.Errorf("failed to verify task exists: %w", err)
 	}
 
-	// Create comment
-	comment, err := s.queries.CreateComment(ctx, generated.CreateCommentParams{
-		TaskID:  int64(req.TaskID),
-		Content: req.Message,
-		Author:  req.Author,
+	var comment generated.TaskComment
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Create comment
+		var commentErr error
+		comment, commentErr = qtx.CreateComment(ctx, generated.CreateCommentParams{
+			TaskID:  int64(req.TaskID),
+			Content: req.Message,
+			Author:  req.Author,
+		})
+		if commentErr != nil {
+			return fmt.Errorf("failed to create comment: %w", commentErr)
+		}
+
+		return recordTaskEvent(ctx, qtx, int64(req.TaskID), models.TaskEventCommentAdded, "comment", "", req.Message)
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create comment: %w", err)
+		return nil, err
 	}
 
-	s.publishTaskEvent(ctx, req.TaskID)
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventCommentAdded, TaskID: req.TaskID, CommentID: int(comment.ID)})
 
 	return &models.Comment{
 		ID:        int(comment.ID),
@@ -1375,15 +1922,24 @@ func (s *service) UpdateComment(ctx context.Context, req UpdateCommentRequest) e
 		return fmt.Errorf("failed to get comment: %w", err)
 	}
 
-	// Update comment
-	if err := s.queries.UpdateComment(ctx, generated.UpdateCommentParams{
-		Content: req.Message,
-		ID:      int64(req.CommentID),
-	}); err != nil {
-		return fmt.Errorf("failed to update comment: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Update comment
+		if err := qtx.UpdateComment(ctx, generated.UpdateCommentParams{
+			Content: req.Message,
+			ID:      int64(req.CommentID),
+		}); err != nil {
+			return fmt.Errorf("failed to update comment: %w", err)
+		}
+
+		return recordTaskEvent(ctx, qtx, comment.TaskID, models.TaskEventCommentUpdated, "comment", comment.Content, req.Message)
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, int(comment.TaskID))
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventCommentUpdated, TaskID: int(comment.TaskID), CommentID: req.CommentID})
 	return nil
 }
 
@@ -1403,12 +1959,21 @@ func (s *service) DeleteComment(ctx context.Context, commentID int) error {
 		return fmt.Errorf("failed to get comment: %w", err)
 	}
 
-	// Delete comment
-	if err := s.queries.DeleteComment(ctx, int64(commentID)); err != nil {
-		return fmt.Errorf("failed to delete comment: %w", err)
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		// Delete comment
+		if err := qtx.DeleteComment(ctx, int64(commentID)); err != nil {
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		return recordTaskEvent(ctx, qtx, comment.TaskID, models.TaskEventCommentDeleted, "comment", comment.Content, "")
+	})
+	if err != nil {
+		return err
 	}
 
-	s.publishTaskEvent(ctx, int(comment.TaskID))
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventCommentDeleted, TaskID: int(comment.TaskID), CommentID: commentID})
 	return nil
 }
 
@@ -1446,6 +2011,9 @@ func (s *service) validateCreateTask(req CreateTaskRequest) error {
 	if req.TypeID < 0 {
 		return ErrInvalidType
 	}
+	if req.DueAt != nil && req.StartAt != nil && req.StartAt.After(*req.DueAt) {
+		return ErrStartAfterDue
+	}
 	return nil
 }
 
@@ -1460,26 +2028,59 @@ func validateCommentMessage(message string) error {
 	return nil
 }
 
-// publishTaskEvent publishes a task event with retry logic
-func (s *service) publishTaskEvent(ctx context.Context, taskID int) {
+// updatedFields lists the fields an update request changes
+func updatedFields(req UpdateTaskRequest) []string {
+	var fields []string
+	if req.Title != nil {
+		fields = append(fields, "title")
+	}
+	if req.Description != nil {
+		fields = append(fields, "description")
+	}
+	if req.PriorityID != nil {
+		fields = append(fields, "priority")
+	}
+	if req.TypeID != nil {
+		fields = append(fields, "type")
+	}
+	if req.DueAt != nil || req.ClearDueAt {
+		fields = append(fields, "due_at")
+	}
+	if req.StartAt != nil || req.ClearStartAt {
+		fields = append(fields, "start_at")
+	}
+	return fields
+}
+
+// publishTaskEvent publishes a typed task event with retry logic.
+// The project is resolved from event.TaskID, so the task must still exist.
+func (s *service) publishTaskEvent(ctx context.Context, event events.Event) {
 	if s.eventClient == nil {
 		return
 	}
 
 	// Get project ID for the task
-	projectID, err := s.queries.GetProjectIDFromTask(ctx, int64(taskID))
+	projectID, err := s.queries.GetProjectIDFromTask(ctx, int64(event.TaskID))
 	if err != nil {
 		slog.Error("failed to retrieve project ID for task event publishing",
-			"task_id", taskID,
+			"task_id", event.TaskID,
 			"error", err.Error(),
 		)
 		return
 	}
 
+	event.ProjectID = int(projectID)
+	s.publishEvent(event)
+}
+
+// publishEvent publishes an event with retry logic.
+// Used directly when the task row no longer exists (e.g. after deletion).
+func (s *service) publishEvent(event events.Event) {
+	if s.eventClient == nil {
+		return
+	}
+
 	// Publish with retry (3 attempts with exponential backoff)
 	// Non-blocking: errors are logged but don't affect the operation
-	_ = events.PublishWithRetry(s.eventClient, events.Event{
-		Type:      events.EventDatabaseChanged,
-		ProjectID: int(projectID),
-	}, 3)
+	_ = events.PublishWithRetry(s.eventClient, event, 3)
 }
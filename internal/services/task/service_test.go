@@ -4,11 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/filter"
 	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/recurrence"
 	"github.com/thenoetrevino/paso/internal/testutil"
 )
 
@@ -2230,6 +2236,228 @@ func TestMoveTaskToReadyColumn_MultipleTasksInProject(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// TEST CASES - BLOCKER RESOLUTION
+// ============================================================================
+
+// recordingPublisher is an events.EventPublisher that keeps sent events
+type recordingPublisher struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func (p *recordingPublisher) Connect(ctx context.Context) error { return nil }
+
+func (p *recordingPublisher) SendEvent(event events.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	return nil
+}
+
+func (p *recordingPublisher) Listen(ctx context.Context) (<-chan events.Event, error) {
+	ch := make(chan events.Event)
+	close(ch)
+	return ch, nil
+}
+
+func (p *recordingPublisher) Subscribe(projectID int) error      { return nil }
+func (p *recordingPublisher) SetNotifyFunc(fn events.NotifyFunc) {}
+func (p *recordingPublisher) Close() error                       { return nil }
+
+// ofType returns the recorded events of one type
+func (p *recordingPublisher) ofType(eventType events.EventType) []events.Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var matched []events.Event
+	for _, event := range p.events {
+		if event.Type == eventType {
+			matched = append(matched, event)
+		}
+	}
+	return matched
+}
+
+func isBlocked(t *testing.T, svc Service, taskID int) bool {
+	t.Helper()
+	summary, err := svc.GetTaskSummary(context.Background(), taskID)
+	require.NoError(t, err)
+	return summary.IsBlocked
+}
+
+func TestBlocking_CompletedBlockersStopBlocking(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoCol := createTestReadyColumn(t, db, projectID, "Todo")
+	doneCol := createTestCompletedColumn(t, db, projectID, "Done")
+	svc := NewService(db, nil)
+
+	blocked := createTestTask(t, db, todoCol, "Blocked")
+	blocker := createTestTask(t, db, todoCol, "Blocker")
+	addTaskRelation(t, db, blocked, blocker, models.RelationTypeBlocking)
+
+	assert.True(t, isBlocked(t, svc, blocked))
+
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), blocker, doneCol))
+	assert.False(t, isBlocked(t, svc, blocked), "a completed blocker should not block")
+
+	ready, err := svc.GetReadyTaskSummariesByProject(context.Background(), projectID)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, blocked, ready[0].ID)
+
+	blockedTasks, err := svc.GetTaskSummariesByFilter(context.Background(), projectID, "is:blocked")
+	require.NoError(t, err)
+	assert.Empty(t, blockedTasks)
+}
+
+func TestBlocking_TransitiveChain(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoCol := createTestColumn(t, db, projectID, "Todo")
+	doneCol := createTestCompletedColumn(t, db, projectID, "Done")
+	svc := NewService(db, nil)
+
+	// a is blocked by b, which is blocked by c
+	a := createTestTask(t, db, todoCol, "A")
+	b := createTestTask(t, db, todoCol, "B")
+	c := createTestTask(t, db, todoCol, "C")
+	addTaskRelation(t, db, a, b, models.RelationTypeBlocking)
+	addTaskRelation(t, db, b, c, models.RelationTypeBlocking)
+
+	// Blocked through the chain while every link is open
+	assert.True(t, isBlocked(t, svc, a))
+	assert.True(t, isBlocked(t, svc, b))
+
+	// b is done: it stops blocking a, even though its own blocker is open
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), b, doneCol))
+	assert.False(t, isBlocked(t, svc, a), "a completed blocker should end the chain")
+
+	// Reopening b blocks a again, through b and c
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), b, todoCol))
+	assert.True(t, isBlocked(t, svc, a))
+
+	// With c done, b is free and still blocks a
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), c, doneCol))
+	assert.False(t, isBlocked(t, svc, b))
+	assert.True(t, isBlocked(t, svc, a))
+}
+
+func TestBlocking_UnblockedEvents(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoCol := createTestColumn(t, db, projectID, "Todo")
+	doneCol := createTestCompletedColumn(t, db, projectID, "Done")
+	publisher := &recordingPublisher{}
+	svc := NewService(db, publisher)
+
+	a := createTestTask(t, db, todoCol, "A")
+	b := createTestTask(t, db, todoCol, "B")
+	c := createTestTask(t, db, todoCol, "C")
+	other := createTestTask(t, db, todoCol, "Other")
+	addTaskRelation(t, db, a, c, models.RelationTypeBlocking)
+	addTaskRelation(t, db, b, c, models.RelationTypeBlocking)
+	addTaskRelation(t, db, b, other, models.RelationTypeBlocking)
+
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), c, doneCol))
+
+	// Only a is unblocked; b still waits on other
+	unblocked := publisher.ofType(events.EventTaskUnblocked)
+	require.Len(t, unblocked, 1)
+	assert.Equal(t, a, unblocked[0].TaskID)
+	assert.Equal(t, c, unblocked[0].RelatedTaskID)
+	assert.Equal(t, projectID, unblocked[0].ProjectID)
+
+	// Reopening the blocker blocks a again
+	require.NoError(t, svc.MoveTaskToColumn(context.Background(), c, todoCol))
+	var reblocked []int
+	for _, event := range publisher.ofType(events.EventTaskUpdated) {
+		reblocked = append(reblocked, event.TaskID)
+		assert.Equal(t, []string{"blocked"}, event.Fields)
+	}
+	assert.Equal(t, []int{a}, reblocked)
+	assert.Len(t, publisher.ofType(events.EventTaskUnblocked), 1)
+}
+
+func TestBlocking_ArchivedBlocker(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoCol := createTestColumn(t, db, projectID, "Todo")
+	publisher := &recordingPublisher{}
+	svc := NewService(db, publisher)
+
+	a := createTestTask(t, db, todoCol, "A")
+	b := createTestTask(t, db, todoCol, "B")
+	c := createTestTask(t, db, todoCol, "C")
+	addTaskRelation(t, db, a, b, models.RelationTypeBlocking)
+	addTaskRelation(t, db, b, c, models.RelationTypeBlocking)
+
+	// An archived blocker no longer blocks, and ends the chain like a
+	// completed one
+	require.NoError(t, svc.ArchiveTask(ctx, b))
+	assert.False(t, isBlocked(t, svc, a))
+	unblocked := publisher.ofType(events.EventTaskUnblocked)
+	require.Len(t, unblocked, 1)
+	assert.Equal(t, a, unblocked[0].TaskID)
+	assert.Equal(t, b, unblocked[0].RelatedTaskID)
+
+	require.NoError(t, svc.UnarchiveTask(ctx, b))
+	assert.True(t, isBlocked(t, svc, a))
+}
+
+func TestBlocking_UnblockedByDeleteAndUnlink(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoCol := createTestColumn(t, db, projectID, "Todo")
+	publisher := &recordingPublisher{}
+	svc := NewService(db, publisher)
+
+	a := createTestTask(t, db, todoCol, "A")
+	b := createTestTask(t, db, todoCol, "B")
+	blockerA := createTestTask(t, db, todoCol, "Blocker of A")
+	blockerB := createTestTask(t, db, todoCol, "Blocker of B")
+	addTaskRelation(t, db, a, blockerA, models.RelationTypeBlocking)
+	addTaskRelation(t, db, b, blockerB, models.RelationTypeBlocking)
+
+	// Deleting a's only blocker frees it
+	require.NoError(t, svc.DeleteTask(ctx, blockerA))
+	unblocked := publisher.ofType(events.EventTaskUnblocked)
+	require.Len(t, unblocked, 1)
+	assert.Equal(t, a, unblocked[0].TaskID)
+	assert.Equal(t, blockerA, unblocked[0].RelatedTaskID)
+	assert.Equal(t, projectID, unblocked[0].ProjectID)
+
+	// So does removing the relation with b's blocker
+	require.NoError(t, svc.RemoveChildRelation(ctx, b, blockerB))
+	unblocked = publisher.ofType(events.EventTaskUnblocked)
+	require.Len(t, unblocked, 2)
+	assert.Equal(t, b, unblocked[1].TaskID)
+	assert.Equal(t, blockerB, unblocked[1].RelatedTaskID)
+	assert.False(t, isBlocked(t, svc, b))
+}
+
 // ============================================================================
 // TEST CASES - COMMENT OPERATIONS
 // ============================================================================
@@ -4752,6 +4980,464 @@ func TestCreateTask_MaxLengthTitle(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// TASK HISTORY TESTS
+// ============================================================================
+
+func TestGetTaskHistory_RecordsMutations(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "Todo")
+	doneID := createTestColumn(t, db, projectID, "Done")
+	labelID := createTestLabel(t, db, projectID, "backend")
+	svc := NewService(db, nil)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Original", ColumnID: todoID})
+	require.NoError(t, err)
+
+	newTitle := "Renamed"
+	priority := models.PriorityHigh
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, Title: &newTitle, PriorityID: &priority}))
+	require.NoError(t, svc.MoveTaskToColumn(ctx, task.ID, doneID))
+	require.NoError(t, svc.AttachLabel(ctx, task.ID, labelID))
+	_, err = svc.CreateComment(ctx, CreateCommentRequest{TaskID: task.ID, Message: "looks good", Author: "alice"})
+	require.NoError(t, err)
+
+	history, err := svc.GetTaskHistory(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 6)
+
+	// Newest first
+	type entry struct{ eventType, field, oldValue, newValue string }
+	got := make([]entry, 0, len(history))
+	for _, e := range history {
+		assert.Equal(t, task.ID, e.TaskID)
+		assert.Equal(t, projectID, e.ProjectID)
+		assert.NotEmpty(t, e.Actor)
+		got = append(got, entry{e.EventType, e.Field, e.OldValue, e.NewValue})
+	}
+	assert.Equal(t, []entry{
+		{models.TaskEventCommentAdded, "comment", "", "looks good"},
+		{models.TaskEventLabelAttached, "label", "", "backend"},
+		{models.TaskEventMoved, "column", "Todo", "Done"},
+		{models.TaskEventUpdated, "priority", "medium", "high"},
+		{models.TaskEventUpdated, "title", "Original", "Renamed"},
+		{models.TaskEventCreated, "", "", "Original"},
+	}, got)
+}
+
+func TestGetTaskHistory_NoOpUpdateNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Same", ColumnID: columnID})
+	require.NoError(t, err)
+
+	title := "Same"
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, Title: &title}))
+
+	history, err := svc.GetTaskHistory(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, models.TaskEventCreated, history[0].EventType)
+}
+
+func TestGetTaskHistory_SurvivesDeletion(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Doomed", ColumnID: columnID})
+	require.NoError(t, err)
+	require.NoError(t, svc.DeleteTask(ctx, task.ID))
+
+	history, err := svc.GetTaskHistory(ctx, task.ID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, models.TaskEventDeleted, history[0].EventType)
+	assert.Equal(t, "Doomed", history[0].OldValue)
+}
+
+func TestGetTaskHistory_RelationsRecordedOnBothTasks(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	parent, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Parent", ColumnID: columnID})
+	require.NoError(t, err)
+	child, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Child", ColumnID: columnID, Position: 1})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.AddChildRelation(ctx, parent.ID, child.ID, models.RelationTypeParentChild))
+	require.NoError(t, svc.RemoveChildRelation(ctx, parent.ID, child.ID))
+	// Removing again is a no-op and must not add another entry
+	require.NoError(t, svc.RemoveChildRelation(ctx, parent.ID, child.ID))
+
+	parentHistory, err := svc.GetTaskHistory(ctx, parent.ID)
+	require.NoError(t, err)
+	require.Len(t, parentHistory, 3)
+	assert.Equal(t, models.TaskEventRelationRemoved, parentHistory[0].EventType)
+	assert.Equal(t, "child", parentHistory[0].Field)
+	assert.Contains(t, parentHistory[0].OldValue, "Child")
+	assert.Equal(t, models.TaskEventRelationAdded, parentHistory[1].EventType)
+
+	childHistory, err := svc.GetTaskHistory(ctx, child.ID)
+	require.NoError(t, err)
+	require.Len(t, childHistory, 3)
+	assert.Equal(t, "parent", childHistory[1].Field)
+	assert.Contains(t, childHistory[1].NewValue, "Parent")
+}
+
+func TestGetTaskHistory_InvalidTaskID(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	svc := NewService(db, nil)
+	_, err := svc.GetTaskHistory(context.Background(), 0)
+	assert.ErrorIs(t, err, ErrInvalidTaskID)
+}
+
+// ============================================================================
+// SEARCH TESTS
+// ============================================================================
+
+func TestSearchTasks_MatchesTitleDescriptionAndComments(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+
+	titleID := createTestTask(t, db, columnID, "Refactor authentication")
+	descID := createTestTask(t, db, columnID, "Cleanup")
+	commentID := createTestTask(t, db, columnID, "Unrelated")
+	createTestTask(t, db, columnID, "Something else")
+
+	_, err := db.ExecContext(ctx, "UPDATE tasks SET description = ? WHERE id = ?", "the authentication middleware is messy", descID)
+	require.NoError(t, err)
+	createTestComment(t, db, commentID, "blocked on authentication review", "alice")
+
+	svc := NewService(db, nil)
+	results, err := svc.SearchTasks(ctx, projectID, "authentication", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	// Title matches rank above description matches, which rank above comments
+	assert.Equal(t, titleID, results[0].ID)
+	assert.Equal(t, descID, results[1].ID)
+	assert.Equal(t, commentID, results[2].ID)
+	assert.Contains(t, results[1].Snippet, "**authentication**")
+	assert.Equal(t, "Todo", results[0].ColumnName)
+}
+
+func TestSearchTasks_PrefixAndAllWords(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	matchID := createTestTask(t, db, columnID, "Authentication refactor")
+	createTestTask(t, db, columnID, "Authentication docs")
+
+	svc := NewService(db, nil)
+	results, err := svc.SearchTasks(ctx, projectID, "auth ref", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, matchID, results[0].ID)
+
+	// Operator characters are treated as plain text, not FTS5 syntax
+	results, err = svc.SearchTasks(ctx, projectID, `auth" OR -docs:`, 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchTasks_IndexFollowsChanges(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Original", ColumnID: columnID})
+	require.NoError(t, err)
+
+	newTitle := "Renamed"
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, Title: &newTitle}))
+
+	results, err := svc.SearchTasks(ctx, projectID, "original", 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, err = svc.SearchTasks(ctx, projectID, "renamed", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	comment, err := svc.CreateComment(ctx, CreateCommentRequest{TaskID: task.ID, Message: "needs benchmarks", Author: "bob"})
+	require.NoError(t, err)
+	results, err = svc.SearchTasks(ctx, projectID, "benchmarks", 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	require.NoError(t, svc.DeleteComment(ctx, comment.ID))
+	results, err = svc.SearchTasks(ctx, projectID, "benchmarks", 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	require.NoError(t, svc.DeleteTask(ctx, task.ID))
+	results, err = svc.SearchTasks(ctx, projectID, "renamed", 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchTasks_ScopedToProjectAndLimited(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectA := createTestProject(t, db)
+	projectB := createTestProject(t, db)
+	columnA := createTestColumn(t, db, projectA, "Todo")
+	columnB := createTestColumn(t, db, projectB, "Todo")
+	createTestTask(t, db, columnA, "Deploy api")
+	createTestTask(t, db, columnA, "Deploy web")
+	createTestTask(t, db, columnB, "Deploy worker")
+
+	svc := NewService(db, nil)
+	results, err := svc.SearchTasks(ctx, projectA, "deploy", 0)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	results, err = svc.SearchTasks(ctx, projectA, "deploy", 1)
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	results, err = svc.SearchTasks(ctx, projectA, "   ", 0)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	_, err = svc.SearchTasks(ctx, 0, "deploy", 0)
+	assert.ErrorIs(t, err, ErrInvalidProjectID)
+}
+
+func TestBuildSearchMatch(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", BuildSearchMatch("  "))
+	assert.Equal(t, `"auth"* "ref"*`, BuildSearchMatch("auth  ref"))
+	assert.Equal(t, `"say""hi"""*`, BuildSearchMatch(`say"hi"`))
+}
+
+// ============================================================================
+// FILTER TESTS
+// ============================================================================
+
+func TestGetTaskSummariesByFilter(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoID := createTestReadyColumn(t, db, projectID, "Todo")
+	inProgressID := createTestColumnWithFlag(t, db, projectID, "In Progress", true, false, false)
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+
+	urgentBug := createTestTask(t, db, todoID, "Login crash")
+	blockedTask := createTestTask(t, db, todoID, "Ship billing export")
+	blocker := createTestTask(t, db, inProgressID, "Billing schema")
+	staleTask := createTestTask(t, db, doneID, "Old cleanup")
+
+	_, err := db.ExecContext(ctx, "UPDATE tasks SET priority_id = 5, type_id = 3 WHERE id = ?", urgentBug)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE tasks SET priority_id = 4 WHERE id = ?", blockedTask)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE tasks SET updated_at = datetime('now', '-30 days') WHERE id = ?", staleTask)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx,
+		"INSERT INTO task_subtasks (parent_id, child_id, relation_type_id) VALUES (?, ?, 2)",
+		blockedTask, blocker)
+	require.NoError(t, err)
+
+	labelID := createTestLabel(t, db, projectID, "backend")
+	_, err = db.ExecContext(ctx, "INSERT INTO task_labels (task_id, label_id) VALUES (?, ?), (?, ?)",
+		blockedTask, labelID, blocker, labelID)
+	require.NoError(t, err)
+
+	svc := NewService(db, nil)
+
+	tests := []struct {
+		query string
+		want  []int
+	}{
+		{"", []int{urgentBug, blockedTask, blocker, staleTask}},
+		{"priority>=high", []int{urgentBug, blockedTask}},
+		{"priority<medium", nil},
+		{"type:BUG", []int{urgentBug}},
+		{"label:backend is:blocked", []int{blockedTask}},
+		{"label:backend -is:blocked", []int{blocker}},
+		{"is:ready", []int{urgentBug}},
+		{"is:in-progress", []int{blocker}},
+		{`column:"in progress"`, []int{blocker}},
+		{"column!=Todo", []int{blocker, staleTask}},
+		{"updated:<7d", []int{urgentBug, blockedTask, blocker}},
+		{"updated:>7d", []int{staleTask}},
+		{"billing -is:blocked", []int{blocker}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			tasks, err := svc.GetTaskSummariesByFilter(ctx, projectID, tt.query)
+			require.NoError(t, err)
+
+			var got []int
+			for _, task := range tasks {
+				got = append(got, task.ID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetTaskSummariesByFilter_InvalidQuery(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	svc := NewService(db, nil)
+
+	_, err := svc.GetTaskSummariesByFilter(context.Background(), projectID, "owner:me")
+	assert.ErrorIs(t, err, filter.ErrInvalidFilter)
+
+	_, err = svc.GetTaskSummariesByFilter(context.Background(), 0, "")
+	assert.ErrorIs(t, err, ErrInvalidProjectID)
+}
+
+// ============================================================================
+// TEST CASES - DUE AND START DATES
+// ============================================================================
+
+func TestTaskDates_CreateUpdateClear(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	start := time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local)
+	due := time.Date(2026, 11, 5, 17, 0, 0, 0, time.Local)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Dated", ColumnID: columnID, DueAt: &due, StartAt: &start})
+	require.NoError(t, err)
+	require.NotNil(t, task.DueAt)
+	assert.True(t, due.Equal(*task.DueAt))
+
+	detail, err := svc.GetTaskDetail(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, detail.DueAt)
+	require.NotNil(t, detail.StartAt)
+	assert.True(t, due.Equal(*detail.DueAt))
+	assert.True(t, start.Equal(*detail.StartAt))
+
+	summary, err := svc.GetTaskSummary(ctx, task.ID)
+	require.NoError(t, err)
+	require.NotNil(t, summary.DueAt)
+	assert.True(t, due.Equal(*summary.DueAt))
+
+	// Changing the due date keeps the start date
+	newDue := due.AddDate(0, 0, 7)
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, DueAt: &newDue}))
+	detail, err = svc.GetTaskDetail(ctx, task.ID)
+	require.NoError(t, err)
+	assert.True(t, newDue.Equal(*detail.DueAt))
+	assert.True(t, start.Equal(*detail.StartAt))
+
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, ClearDueAt: true, ClearStartAt: true}))
+	detail, err = svc.GetTaskDetail(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Nil(t, detail.DueAt)
+	assert.Nil(t, detail.StartAt)
+
+	history, err := svc.GetTaskHistory(ctx, task.ID)
+	require.NoError(t, err)
+	var dueChanges []string
+	for _, e := range history {
+		if e.Field == "due" {
+			dueChanges = append(dueChanges, e.OldValue+" -> "+e.NewValue)
+		}
+	}
+	assert.Equal(t, []string{"2026-11-12 17:00 -> ", "2026-11-05 17:00 -> 2026-11-12 17:00"}, dueChanges)
+}
+
+func TestTaskDates_StartAfterDue(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "Todo")
+	svc := NewService(db, nil)
+
+	due := time.Date(2026, 11, 5, 0, 0, 0, 0, time.Local)
+	start := due.AddDate(0, 0, 1)
+
+	_, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Backwards", ColumnID: columnID, DueAt: &due, StartAt: &start})
+	assert.ErrorIs(t, err, ErrStartAfterDue)
+
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Dated", ColumnID: columnID, DueAt: &due})
+	require.NoError(t, err)
+
+	// Checked against the stored due date when only the start changes
+	err = svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: task.ID, StartAt: &start})
+	assert.ErrorIs(t, err, ErrStartAfterDue)
+}
+
 // helpers
 
 // setupTestDB creates an in-memory database with full schema using testutil
@@ -4861,3 +5547,1030 @@ func createTestComment(t *testing.T, db *sql.DB, taskID int, message, author str
 	id, _ := result.LastInsertId()
 	return int(id)
 }
+
+func TestCreateTask_WIPLimit(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "In Progress")
+	_, err := db.ExecContext(context.Background(), "UPDATE columns SET wip_limit = 1 WHERE id = ?", columnID)
+	require.NoError(t, err)
+
+	svc := NewService(db, nil)
+
+	_, err = svc.CreateTask(context.Background(), CreateTaskRequest{Title: "First", ColumnID: columnID})
+	require.NoError(t, err)
+
+	_, err = svc.CreateTask(context.Background(), CreateTaskRequest{Title: "Second", ColumnID: columnID, Position: 1})
+	if !errors.Is(err, ErrWIPLimitExceeded) {
+		t.Fatalf("Expected ErrWIPLimitExceeded, got %v", err)
+	}
+
+	_, err = svc.CreateTask(WithWIPLimitOverride(context.Background()), CreateTaskRequest{Title: "Forced", ColumnID: columnID, Position: 1})
+	require.NoError(t, err, "override should allow exceeding the limit")
+}
+
+func TestMoveTaskToColumn_WIPLimit(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doingID := createTestColumn(t, db, projectID, "In Progress")
+	_, err := db.ExecContext(context.Background(), "UPDATE columns SET next_id = ? WHERE id = ?", doingID, todoID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(context.Background(), "UPDATE columns SET wip_limit = 1 WHERE id = ?", doingID)
+	require.NoError(t, err)
+
+	svc := NewService(db, nil)
+	createTestTask(t, db, doingID, "Already started")
+	taskID := createTestTask(t, db, todoID, "Waiting")
+
+	if err := svc.MoveTaskToColumn(context.Background(), taskID, doingID); !errors.Is(err, ErrWIPLimitExceeded) {
+		t.Fatalf("Expected ErrWIPLimitExceeded from MoveTaskToColumn, got %v", err)
+	}
+	if err := svc.MoveTaskToNextColumn(context.Background(), taskID); !errors.Is(err, ErrWIPLimitExceeded) {
+		t.Fatalf("Expected ErrWIPLimitExceeded from MoveTaskToNextColumn, got %v", err)
+	}
+
+	// The override lets the task in regardless
+	require.NoError(t, svc.MoveTaskToNextColumn(WithWIPLimitOverride(context.Background()), taskID))
+
+	var columnID int
+	err = db.QueryRowContext(context.Background(), "SELECT column_id FROM tasks WHERE id = ?", taskID).Scan(&columnID)
+	require.NoError(t, err)
+	if columnID != doingID {
+		t.Errorf("Expected task in column %d, got %d", doingID, columnID)
+	}
+}
+
+func TestArchiveTask(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "To Do")
+	taskID := createTestTask(t, db, columnID, "Old news")
+	createTestTask(t, db, columnID, "Still current")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	require.NoError(t, svc.ArchiveTask(ctx, taskID))
+	if err := svc.ArchiveTask(ctx, taskID); !errors.Is(err, ErrTaskArchived) {
+		t.Fatalf("Expected ErrTaskArchived, got %v", err)
+	}
+
+	// Archived tasks are left off the board
+	summaries, err := svc.GetTaskSummariesByProject(ctx, projectID)
+	require.NoError(t, err)
+	if len(summaries[columnID]) != 1 || summaries[columnID][0].Title != "Still current" {
+		t.Fatalf("Expected only the unarchived task on the board, got %+v", summaries[columnID])
+	}
+
+	archived, err := svc.GetArchivedTasksByProject(ctx, projectID)
+	require.NoError(t, err)
+	if len(archived) != 1 || archived[0].ID != taskID || archived[0].ColumnName != "To Do" {
+		t.Fatalf("Expected task %d in the archive, got %+v", taskID, archived)
+	}
+
+	detail, err := svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	if detail.ArchivedAt == nil {
+		t.Error("Expected ArchivedAt to be set on the task detail")
+	}
+
+	// Unarchiving puts the task back where it was
+	require.NoError(t, svc.UnarchiveTask(ctx, taskID))
+	if err := svc.UnarchiveTask(ctx, taskID); !errors.Is(err, ErrTaskNotArchived) {
+		t.Fatalf("Expected ErrTaskNotArchived, got %v", err)
+	}
+	summaries, err = svc.GetTaskSummariesByProject(ctx, projectID)
+	require.NoError(t, err)
+	if len(summaries[columnID]) != 2 || summaries[columnID][0].ID != taskID {
+		t.Errorf("Expected task %d back at the top of its column, got %+v", taskID, summaries[columnID])
+	}
+
+	if err := svc.ArchiveTask(ctx, 99999); !errors.Is(err, ErrTaskNotFound) {
+		t.Errorf("Expected ErrTaskNotFound, got %v", err)
+	}
+}
+
+func TestArchiveCompletedTasks(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+
+	oldDone := createTestTask(t, db, doneID, "Finished last month")
+	createTestTask(t, db, doneID, "Finished today")
+	oldTodo := createTestTask(t, db, todoID, "Forgotten")
+	lateFinish := createTestTask(t, db, todoID, "Started last month, finished today")
+	_, err := db.ExecContext(context.Background(),
+		"UPDATE tasks SET created_at = datetime('now', '-30 days') WHERE id IN (?, ?, ?)", oldDone, oldTodo, lateFinish)
+	require.NoError(t, err)
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	// Completion is counted from the move into the column, and later edits
+	// do not restart it
+	require.NoError(t, svc.MoveTaskToColumn(ctx, lateFinish, doneID))
+	notes := "Follow-up notes"
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: oldDone, Description: &notes}))
+
+	if _, err := svc.ArchiveCompletedTasks(ctx, projectID, 0); !errors.Is(err, ErrInvalidArchiveAge) {
+		t.Fatalf("Expected ErrInvalidArchiveAge, got %v", err)
+	}
+
+	ids, err := svc.ArchiveCompletedTasks(ctx, projectID, 14*24*time.Hour)
+	require.NoError(t, err)
+	if len(ids) != 1 || ids[0] != oldDone {
+		t.Fatalf("Expected only task %d to be archived, got %v", oldDone, ids)
+	}
+
+	// Running it again finds nothing new
+	ids, err = svc.ArchiveCompletedTasks(ctx, projectID, 14*24*time.Hour)
+	require.NoError(t, err)
+	if len(ids) != 0 {
+		t.Errorf("Expected no tasks on the second run, got %v", ids)
+	}
+}
+
+func TestRestoreTask(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "To Do")
+	parentID := createTestTask(t, db, columnID, "Parent")
+	taskID := createTestTask(t, db, columnID, "Doomed")
+	labelID := createTestLabel(t, db, projectID, "bug")
+	createTestComment(t, db, taskID, "Looked into it", "alice")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	require.NoError(t, svc.AttachLabel(ctx, taskID, labelID))
+	require.NoError(t, svc.AddParentRelation(ctx, taskID, parentID, 1))
+
+	snapshot, err := svc.SnapshotTask(ctx, taskID)
+	require.NoError(t, err)
+	require.NoError(t, svc.DeleteTask(ctx, taskID))
+
+	require.NoError(t, svc.RestoreTask(ctx, snapshot))
+	if err := svc.RestoreTask(ctx, snapshot); !errors.Is(err, ErrTaskExists) {
+		t.Errorf("Expected ErrTaskExists on a second restore, got %v", err)
+	}
+
+	detail, err := svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	if detail.Title != "Doomed" || detail.TicketNumber != snapshot.TicketNumber {
+		t.Errorf("Expected the task back with its ticket number, got %+v", detail)
+	}
+	if len(detail.Labels) != 1 || detail.Labels[0].ID != labelID {
+		t.Errorf("Expected label %d restored, got %+v", labelID, detail.Labels)
+	}
+	if len(detail.ParentTasks) != 1 || detail.ParentTasks[0].ID != parentID {
+		t.Errorf("Expected parent %d restored, got %+v", parentID, detail.ParentTasks)
+	}
+	comments, err := svc.GetCommentsByTask(ctx, taskID)
+	require.NoError(t, err)
+	if len(comments) != 1 || comments[0].Message != "Looked into it" {
+		t.Errorf("Expected the comment restored, got %+v", comments)
+	}
+}
+
+func TestRestoreTaskPosition(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestColumn(t, db, projectID, "Done")
+	createTestTask(t, db, todoID, "First")
+	taskID := createTestTask(t, db, todoID, "Second")
+	createTestTask(t, db, todoID, "Third")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	require.NoError(t, svc.MoveTaskToColumn(ctx, taskID, doneID))
+	require.NoError(t, svc.RestoreTaskPosition(ctx, taskID, todoID, 1))
+
+	summaries, err := svc.GetTaskSummariesByProject(ctx, projectID)
+	require.NoError(t, err)
+	var titles []string
+	for _, s := range summaries[todoID] {
+		titles = append(titles, s.Title)
+	}
+	if strings.Join(titles, ",") != "First,Second,Third" {
+		t.Errorf("Expected the task back in the middle, got %v", titles)
+	}
+
+	// A taken position falls back to the end of the column
+	require.NoError(t, svc.MoveTaskToColumn(ctx, taskID, doneID))
+	other := createTestTask(t, db, todoID, "Fourth")
+	_, err = db.ExecContext(ctx, "UPDATE tasks SET position = 1 WHERE id = ?", other)
+	require.NoError(t, err)
+	require.NoError(t, svc.RestoreTaskPosition(ctx, taskID, todoID, 1))
+
+	summaries, err = svc.GetTaskSummariesByProject(ctx, projectID)
+	require.NoError(t, err)
+	last := summaries[todoID][len(summaries[todoID])-1]
+	if last.ID != taskID {
+		t.Errorf("Expected task %d at the end of the column, got %d", taskID, last.ID)
+	}
+}
+
+func TestSetRecurrence(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "To Do")
+	taskID := createTestTask(t, db, columnID, "Dependency review")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	// Wednesday
+	now := time.Date(2026, 10, 14, 15, 30, 0, 0, time.Local)
+
+	_, err := svc.SetRecurrence(ctx, taskID, "every so often", now)
+	assert.ErrorIs(t, err, recurrence.ErrInvalidRule)
+	_, err = svc.SetRecurrence(ctx, 9999, "weekly", now)
+	assert.ErrorIs(t, err, ErrTaskNotFound)
+
+	rule, err := svc.SetRecurrence(ctx, taskID, "weekly on mon", now)
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO", rule.Rule)
+	assert.Equal(t, "every week on Mon", rule.Description)
+	assert.True(t, rule.NextRunAt.Equal(time.Date(2026, 10, 19, 0, 0, 0, 0, time.Local)), "next run %v", rule.NextRunAt)
+	assert.Equal(t, taskID, rule.LastInstanceID)
+
+	// Setting a new rule replaces the old one
+	_, err = svc.SetRecurrence(ctx, taskID, "monthly on the 1st", now)
+	require.NoError(t, err)
+	got, err := svc.GetRecurrence(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=1", got.Rule)
+
+	// A plain monthly rule keeps the day of the task's due date
+	due := time.Date(2027, 1, 31, 0, 0, 0, 0, time.Local)
+	require.NoError(t, svc.UpdateTask(ctx, UpdateTaskRequest{TaskID: taskID, DueAt: &due}))
+	got, err = svc.SetRecurrence(ctx, taskID, "monthly", now)
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=31", got.Rule)
+	assert.True(t, got.NextRunAt.Equal(time.Date(2026, 10, 31, 0, 0, 0, 0, time.Local)), "next run %v", got.NextRunAt)
+
+	rules, err := svc.GetRecurrencesByProject(ctx, projectID)
+	require.NoError(t, err)
+	require.Len(t, rules, 1)
+	assert.Equal(t, "Dependency review", rules[0].Title)
+
+	require.NoError(t, svc.RemoveRecurrence(ctx, taskID))
+	_, err = svc.GetRecurrence(ctx, taskID)
+	assert.ErrorIs(t, err, ErrRecurrenceNotFound)
+	assert.ErrorIs(t, svc.RemoveRecurrence(ctx, taskID), ErrRecurrenceNotFound)
+}
+
+func TestGenerateRecurringTasks(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	readyID := createTestReadyColumn(t, db, projectID, "Ready")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+	epicID := createTestTask(t, db, todoID, "Security chores")
+	templateID := createTestTask(t, db, todoID, "Rotate credentials")
+	subtaskID := createTestTask(t, db, todoID, "Update the vault")
+	blockerID := createTestTask(t, db, todoID, "Pick a password manager")
+	labelID := createTestLabel(t, db, projectID, "ops")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	require.NoError(t, svc.AttachLabel(ctx, templateID, labelID))
+	require.NoError(t, svc.AddParentRelation(ctx, templateID, epicID, models.RelationTypeParentChild))
+	require.NoError(t, svc.AddChildRelation(ctx, templateID, subtaskID, models.RelationTypeParentChild))
+	require.NoError(t, svc.AddChildRelation(ctx, templateID, blockerID, models.RelationTypeBlocking))
+
+	day := func(d int) time.Time { return time.Date(2026, 10, d, 0, 0, 0, 0, time.Local) }
+	_, err := svc.SetRecurrence(ctx, templateID, "daily", day(14).Add(10*time.Hour))
+	require.NoError(t, err)
+
+	// Nothing is due yet and the template is still open
+	created, err := svc.GenerateRecurringTasks(ctx, day(14).Add(20*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, created)
+
+	// The schedule fires
+	created, err = svc.GenerateRecurringTasks(ctx, day(15).Add(9*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+
+	instance, err := svc.GetTaskDetail(ctx, created[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Rotate credentials", instance.Title)
+	assert.Equal(t, readyID, instance.ColumnID)
+	require.NotNil(t, instance.DueAt)
+	assert.True(t, instance.DueAt.Equal(day(15)), "due %v", instance.DueAt)
+	require.Len(t, instance.Labels, 1)
+	assert.Equal(t, labelID, instance.Labels[0].ID)
+
+	// Blockers are shared, the parent is not and child tasks are copied
+	assert.Empty(t, instance.ParentTasks)
+	require.Len(t, instance.ChildTasks, 2)
+	var subtaskCopyID int
+	for _, child := range instance.ChildTasks {
+		switch child.RelationTypeID {
+		case models.RelationTypeBlocking:
+			assert.Equal(t, blockerID, child.ID)
+		case models.RelationTypeParentChild:
+			assert.NotEqual(t, subtaskID, child.ID)
+			subtaskCopyID = child.ID
+		}
+	}
+	subtaskCopy, err := svc.GetTaskDetail(ctx, subtaskCopyID)
+	require.NoError(t, err)
+	assert.Equal(t, "Update the vault", subtaskCopy.Title)
+	assert.Equal(t, readyID, subtaskCopy.ColumnID)
+	epic, err := svc.GetTaskDetail(ctx, epicID)
+	require.NoError(t, err)
+	assert.Len(t, epic.ChildTasks, 1, "instances are not added under the template's parent")
+	subtask, err := svc.GetTaskDetail(ctx, subtaskID)
+	require.NoError(t, err)
+	assert.Len(t, subtask.ParentTasks, 1, "the template's subtask is not shared")
+
+	// Each occurrence is generated once
+	created, err = svc.GenerateRecurringTasks(ctx, day(15).Add(9*time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, created)
+
+	// Completing the instance brings the next occurrence forward
+	require.NoError(t, svc.MoveTaskToColumn(ctx, instance.ID, doneID))
+	created, err = svc.GenerateRecurringTasks(ctx, day(15).Add(10*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	early, err := svc.GetTaskDetail(ctx, created[0])
+	require.NoError(t, err)
+	assert.True(t, early.DueAt.Equal(day(16)), "due %v", early.DueAt)
+
+	// Missed occurrences are skipped: one instance, due on the latest
+	created, err = svc.GenerateRecurringTasks(ctx, day(20).Add(8*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	late, err := svc.GetTaskDetail(ctx, created[0])
+	require.NoError(t, err)
+	assert.True(t, late.DueAt.Equal(day(20)), "due %v", late.DueAt)
+
+	rule, err := svc.GetRecurrence(ctx, templateID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, rule.Occurrences)
+	assert.Equal(t, late.ID, rule.LastInstanceID)
+	assert.True(t, rule.NextRunAt.Equal(day(21)), "next run %v", rule.NextRunAt)
+}
+
+func TestCreateTaskFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	labelID := createTestLabel(t, db, projectID, "release")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	template := &models.TaskTemplate{
+		Name:        "release",
+		Title:       "Release {{version}}",
+		Description: "Ship {{ version }} to {{channel}}",
+		Type:        "Feature",
+		Priority:    "high",
+		Labels:      []string{"release"},
+		Children: []models.TaskTemplateChild{
+			{Title: "Tag v{{version}}"},
+			{Title: "Write changelog", Type: "task", Priority: "low"},
+		},
+	}
+	require.NoError(t, svc.SaveTaskTemplate(ctx, projectID, template, false))
+	require.ErrorIs(t, svc.SaveTaskTemplate(ctx, projectID, template, false), ErrTaskTemplateExists)
+
+	saved, err := svc.GetTaskTemplate(ctx, projectID, "release")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"channel", "version"}, TemplateVariables(saved))
+
+	// Every variable must have a value, and nothing is created without one
+	_, _, err = svc.CreateTaskFromTemplate(ctx, saved, map[string]string{"version": "1.4"}, CreateTaskRequest{ColumnID: todoID})
+	require.ErrorIs(t, err, ErrMissingTemplateVar)
+
+	vars := map[string]string{"version": "1.4", "channel": "stable"}
+	task, childIDs, err := svc.CreateTaskFromTemplate(ctx, saved, vars, CreateTaskRequest{ColumnID: todoID})
+	require.NoError(t, err)
+	require.Len(t, childIDs, 2)
+
+	detail, err := svc.GetTaskDetail(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Release 1.4", detail.Title)
+	assert.Equal(t, "Ship 1.4 to stable", detail.Description)
+	assert.Equal(t, "feature", detail.TypeDescription)
+	assert.Equal(t, "high", detail.PriorityDescription)
+	require.Len(t, detail.Labels, 1)
+	assert.Equal(t, labelID, detail.Labels[0].ID)
+	require.Len(t, detail.ChildTasks, 2)
+
+	child, err := svc.GetTaskDetail(ctx, childIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Tag v1.4", child.Title)
+	assert.Equal(t, todoID, child.ColumnID)
+	require.Len(t, child.ParentTasks, 1)
+	assert.Equal(t, task.ID, child.ParentTasks[0].ID)
+
+	// Request fields take precedence over the template
+	task, _, err = svc.CreateTaskFromTemplate(ctx, saved, vars, CreateTaskRequest{ColumnID: todoID, Title: "Hotfix 1.4.1"})
+	require.NoError(t, err)
+	assert.Equal(t, "Hotfix 1.4.1", task.Title)
+
+	// A task and its children can be captured as a new template
+	captured, err := svc.TaskTemplateFromTask(ctx, task.ID, "hotfix")
+	require.NoError(t, err)
+	assert.Equal(t, "Hotfix 1.4.1", captured.Title)
+	assert.Equal(t, []string{"release"}, captured.Labels)
+	require.Len(t, captured.Children, 2)
+
+	require.NoError(t, svc.DeleteTaskTemplate(ctx, projectID, "release"))
+	require.ErrorIs(t, svc.DeleteTaskTemplate(ctx, projectID, "release"), ErrTaskTemplateNotFound)
+}
+
+func TestCreateTaskFromTemplate_ChildFailureCreatesNothing(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	// The column has room for the task and its first child only
+	_, err := db.ExecContext(ctx, "UPDATE columns SET wip_limit = 2 WHERE id = ?", todoID)
+	require.NoError(t, err)
+
+	template := &models.TaskTemplate{
+		Name:  "release",
+		Title: "Release",
+		Children: []models.TaskTemplateChild{
+			{Title: "Tag"},
+			{Title: "Write changelog"},
+		},
+	}
+	_, _, err = svc.CreateTaskFromTemplate(ctx, template, nil, CreateTaskRequest{ColumnID: todoID})
+	require.ErrorIs(t, err, ErrWIPLimitExceeded)
+
+	tasks, err := svc.GetTaskSummariesByProject(ctx, projectID)
+	require.NoError(t, err)
+	assert.Empty(t, tasks[todoID], "no task is left behind by a failed template")
+
+	// The ticket numbers taken by the rolled back tasks are free again
+	task, err := svc.CreateTask(ctx, CreateTaskRequest{Title: "Next", ColumnID: todoID})
+	require.NoError(t, err)
+	detail, err := svc.GetTaskDetail(ctx, task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, detail.TicketNumber)
+}
+
+func TestSaveTaskTemplate_Invalid(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		template *models.TaskTemplate
+	}{
+		{"no name", &models.TaskTemplate{Title: "Release"}},
+		{"no title", &models.TaskTemplate{Name: "release"}},
+		{"child without title", &models.TaskTemplate{Name: "release", Title: "Release", Children: []models.TaskTemplateChild{{}}}},
+		{"unknown type", &models.TaskTemplate{Name: "release", Title: "Release", Type: "epic"}},
+		{"unknown child label", &models.TaskTemplate{Name: "release", Title: "Release", Children: []models.TaskTemplateChild{{Title: "Tag", Labels: []string{"ops"}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := svc.SaveTaskTemplate(ctx, projectID, tt.template, false)
+			require.ErrorIs(t, err, ErrInvalidTaskTemplate)
+		})
+	}
+
+	templates, err := svc.GetTaskTemplates(ctx, projectID)
+	require.NoError(t, err)
+	assert.Empty(t, templates)
+}
+
+func TestChecklist(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	columnID := createTestColumn(t, db, projectID, "To Do")
+	taskID := createTestTask(t, db, columnID, "Ship release")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	_, err := svc.AddChecklistItem(ctx, taskID, "   ")
+	require.ErrorIs(t, err, ErrEmptyChecklistItem)
+	_, err = svc.AddChecklistItem(ctx, taskID, strings.Repeat("x", 501))
+	require.ErrorIs(t, err, ErrChecklistItemTooLong)
+	_, err = svc.AddChecklistItem(ctx, 999, "Tag")
+	require.ErrorIs(t, err, ErrTaskNotFound)
+
+	first, err := svc.AddChecklistItem(ctx, taskID, " Tag the release ")
+	require.NoError(t, err)
+	assert.Equal(t, "Tag the release", first.Text)
+	second, err := svc.AddChecklistItem(ctx, taskID, "Publish notes")
+	require.NoError(t, err)
+	assert.Greater(t, second.Position, first.Position)
+
+	toggled, err := svc.ToggleChecklistItem(ctx, first.ID)
+	require.NoError(t, err)
+	assert.True(t, toggled.Done)
+
+	summary, err := svc.GetTaskSummary(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.ChecklistDone)
+	assert.Equal(t, 2, summary.ChecklistTotal)
+
+	detail, err := svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, detail.Checklist, 2)
+	assert.True(t, detail.Checklist[0].Done)
+	assert.False(t, detail.Checklist[1].Done)
+
+	// Toggling again reopens the item
+	toggled, err = svc.ToggleChecklistItem(ctx, first.ID)
+	require.NoError(t, err)
+	assert.False(t, toggled.Done)
+
+	require.NoError(t, svc.RemoveChecklistItem(ctx, second.ID))
+	require.ErrorIs(t, svc.RemoveChecklistItem(ctx, second.ID), ErrChecklistItemNotFound)
+	_, err = svc.ToggleChecklistItem(ctx, second.ID)
+	require.ErrorIs(t, err, ErrChecklistItemNotFound)
+
+	checklist, err := svc.GetChecklist(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, checklist, 1)
+	assert.Equal(t, "Tag the release", checklist[0].Text)
+
+	history, err := svc.GetTaskHistory(ctx, taskID)
+	require.NoError(t, err)
+	var checklistEvents int
+	for _, event := range history {
+		if event.Field == "checklist" {
+			checklistEvents++
+		}
+	}
+	assert.Equal(t, 5, checklistEvents, "two adds, two toggles and one removal are recorded")
+}
+
+func TestChildProgressRollup(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+
+	epicID := createTestTask(t, db, todoID, "Checkout redesign")
+	cartID := createTestTask(t, db, doneID, "Cart page")
+	paymentID := createTestTask(t, db, todoID, "Payment page")
+	wallets := createTestTask(t, db, doneID, "Wallet buttons")
+	otherID := createTestTask(t, db, todoID, "Unrelated")
+	for i, id := range []int{epicID, cartID, paymentID, wallets, otherID} {
+		_, err := db.ExecContext(context.Background(), "UPDATE tasks SET ticket_number = ? WHERE id = ?", i+1, id)
+		require.NoError(t, err)
+	}
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddChildRelation(ctx, epicID, cartID, models.RelationTypeParentChild))
+	require.NoError(t, svc.AddChildRelation(ctx, epicID, paymentID, models.RelationTypeParentChild))
+	require.NoError(t, svc.AddChildRelation(ctx, paymentID, wallets, models.RelationTypeParentChild))
+
+	// Grandchildren count toward the epic
+	summary, err := svc.GetTaskSummary(ctx, epicID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.ChildrenDone)
+	assert.Equal(t, 3, summary.ChildrenTotal)
+
+	detail, err := svc.GetTaskDetail(ctx, paymentID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, detail.ChildrenDone)
+	assert.Equal(t, 1, detail.ChildrenTotal)
+
+	leaf, err := svc.GetTaskSummary(ctx, otherID)
+	require.NoError(t, err)
+	assert.Zero(t, leaf.ChildrenTotal)
+
+	tree, err := svc.GetTaskTreeByProject(ctx, projectID)
+	require.NoError(t, err)
+	require.Len(t, tree, 2)
+	assert.Equal(t, epicID, tree[0].ID)
+	assert.Equal(t, 2, tree[0].ChildrenDone)
+	assert.Equal(t, 3, tree[0].ChildrenTotal)
+
+	// The epic filter narrows the board to the parent's subtree
+	tasks, err := svc.GetTaskSummariesByFilter(ctx, projectID, "epic:1")
+	require.NoError(t, err)
+	ids := make([]int, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.ElementsMatch(t, []int{epicID, cartID, paymentID, wallets}, ids)
+
+	tasks, err = svc.GetTaskSummariesByFilter(ctx, projectID, "epic:3 -is:done")
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, paymentID, tasks[0].ID)
+}
+
+func TestChildProgressRollup_OnlyParentChild(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+
+	epicID := createTestTask(t, db, todoID, "Checkout redesign")
+	childID := createTestTask(t, db, todoID, "Payment page")
+	blockerID := createTestTask(t, db, doneID, "Pick a payment provider")
+	relatedID := createTestTask(t, db, todoID, "Old checkout notes")
+	for i, id := range []int{epicID, childID, blockerID, relatedID} {
+		_, err := db.ExecContext(context.Background(), "UPDATE tasks SET ticket_number = ? WHERE id = ?", i+1, id)
+		require.NoError(t, err)
+	}
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	require.NoError(t, svc.AddChildRelation(ctx, epicID, childID, models.RelationTypeParentChild))
+	require.NoError(t, svc.AddChildRelation(ctx, epicID, blockerID, models.RelationTypeBlocking))
+	require.NoError(t, svc.AddChildRelation(ctx, childID, relatedID, models.RelationTypeRelated))
+
+	// Blockers and related tasks are not subtasks, even below a child
+	summary, err := svc.GetTaskSummary(ctx, epicID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, summary.ChildrenDone)
+	assert.Equal(t, 1, summary.ChildrenTotal)
+
+	detail, err := svc.GetTaskDetail(ctx, childID)
+	require.NoError(t, err)
+	assert.Zero(t, detail.ChildrenTotal)
+
+	tasks, err := svc.GetTaskSummariesByFilter(ctx, projectID, "epic:1")
+	require.NoError(t, err)
+	ids := make([]int, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	assert.ElementsMatch(t, []int{epicID, childID}, ids)
+}
+
+func TestMoveTaskToProject(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	sourceID := createTestProject(t, db)
+	sourceTodo := createTestColumn(t, db, sourceID, "To Do")
+	targetID := createTestProject(t, db)
+	createTestColumn(t, db, targetID, "Inbox")
+	targetReady := createTestReadyColumn(t, db, targetID, "Ready")
+	targetDoing := createTestColumn(t, db, targetID, "Doing")
+
+	_, err := db.ExecContext(context.Background(), "UPDATE project_counters SET next_ticket_number = 8 WHERE project_id = ?", targetID)
+	require.NoError(t, err)
+
+	taskID := createTestTask(t, db, sourceTodo, "Rotate API keys")
+	parentID := createTestTask(t, db, sourceTodo, "Security review")
+	createTestComment(t, db, taskID, "Keys live in the vault", "ana")
+	sharedSource := createTestLabel(t, db, sourceID, "security")
+	onlySource := createTestLabel(t, db, sourceID, "ops")
+	sharedTarget := createTestLabel(t, db, targetID, "security")
+
+	svc := NewService(db, nil)
+	ctx := context.Background()
+
+	require.NoError(t, svc.AttachLabel(ctx, taskID, sharedSource))
+	require.NoError(t, svc.AttachLabel(ctx, taskID, onlySource))
+	require.NoError(t, svc.AddParentRelation(ctx, taskID, parentID, models.RelationTypeParentChild))
+	_, err = svc.AddChecklistItem(ctx, taskID, "Revoke old keys")
+	require.NoError(t, err)
+
+	// A relation that would cross projects needs an explicit choice
+	_, err = svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: taskID, ProjectID: targetID})
+	require.ErrorIs(t, err, ErrCrossProjectRelations)
+	detail, err := svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, sourceTodo, detail.ColumnID, "a refused move changes nothing")
+
+	_, err = svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: taskID, ProjectID: targetID, Relations: "both"})
+	require.ErrorIs(t, err, ErrInvalidRelationPolicy)
+
+	result, err := svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: taskID, ProjectID: targetID, Relations: RelationsDrop})
+	require.NoError(t, err)
+	assert.Equal(t, 8, result.TicketNumber)
+	assert.Equal(t, targetReady, result.ColumnID, "without a column the task lands in the ready column")
+	assert.Equal(t, []string{"ops"}, result.CreatedLabels)
+	assert.Equal(t, 1, result.DroppedRelations)
+
+	detail, err = svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	assert.Equal(t, 8, detail.TicketNumber)
+	assert.Empty(t, detail.ParentTasks)
+	require.Len(t, detail.Labels, 2)
+	for _, label := range detail.Labels {
+		assert.Equal(t, targetID, label.ProjectID)
+	}
+	assert.Contains(t, []int{detail.Labels[0].ID, detail.Labels[1].ID}, sharedTarget, "labels are matched by name")
+
+	comments, err := svc.GetCommentsByTask(ctx, taskID)
+	require.NoError(t, err)
+	assert.Len(t, comments, 1)
+	checklist, err := svc.GetChecklist(ctx, taskID)
+	require.NoError(t, err)
+	assert.Len(t, checklist, 1)
+
+	var next int
+	require.NoError(t, db.QueryRowContext(ctx, "SELECT next_ticket_number FROM project_counters WHERE project_id = ?", targetID).Scan(&next))
+	assert.Equal(t, 9, next)
+
+	_, err = svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: taskID, ProjectID: targetID})
+	require.ErrorIs(t, err, ErrTaskAlreadyInProject)
+
+	// Kept relations become links between projects
+	otherID := createTestTask(t, db, sourceTodo, "Audit log")
+	require.NoError(t, svc.AddChildRelation(ctx, otherID, parentID, models.RelationTypeParentChild))
+	result, err = svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: otherID, ProjectID: targetID, ColumnID: targetDoing, Relations: RelationsKeep})
+	require.NoError(t, err)
+	assert.Equal(t, targetDoing, result.ColumnID)
+	assert.Zero(t, result.DroppedRelations)
+	detail, err = svc.GetTaskDetail(ctx, otherID)
+	require.NoError(t, err)
+	require.Len(t, detail.ChildTasks, 1)
+	assert.Equal(t, parentID, detail.ChildTasks[0].ID)
+
+	// The column must belong to the target project
+	lastID := createTestTask(t, db, sourceTodo, "Stray")
+	_, err = svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: lastID, ProjectID: targetID, ColumnID: sourceTodo})
+	require.ErrorIs(t, err, ErrInvalidColumnID)
+}
+
+func TestMoveTaskToProject_HistoryAndRelationTypes(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	sourceID := createTestProject(t, db)
+	sourceTodo := createTestColumn(t, db, sourceID, "To Do")
+	targetID := createTestProject(t, db)
+	targetTodo := createTestColumn(t, db, targetID, "To Do")
+
+	createRelationType := func(projectID int, name string) int {
+		t.Helper()
+		res, err := db.ExecContext(ctx, `INSERT INTO relation_types (p_to_c_label, c_to_p_label, color, is_blocking, project_id, name)
+			VALUES (?, ?, '#888888', 0, ?, ?)`, name, name+" by", projectID, name)
+		require.NoError(t, err)
+		id, err := res.LastInsertId()
+		require.NoError(t, err)
+		return int(id)
+	}
+	sourceDuplicates := createRelationType(sourceID, "duplicates")
+	sourceSpawns := createRelationType(sourceID, "spawns")
+	targetDuplicates := createRelationType(targetID, "duplicates")
+
+	taskID := createTestTask(t, db, sourceTodo, "Flaky login")
+	duplicateID := createTestTask(t, db, targetTodo, "Login fails sometimes")
+	spawnedID := createTestTask(t, db, targetTodo, "Retry logins")
+
+	svc := NewService(db, nil)
+	require.NoError(t, svc.AddChildRelation(ctx, taskID, duplicateID, sourceDuplicates))
+	require.NoError(t, svc.AddChildRelation(ctx, taskID, spawnedID, sourceSpawns))
+
+	result, err := svc.MoveTaskToProject(ctx, MoveToProjectRequest{TaskID: taskID, ProjectID: targetID, Relations: RelationsKeep})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.DroppedRelations, "a relation type the target lacks is dropped")
+
+	// Source relation types are swapped for the target's of the same name
+	detail, err := svc.GetTaskDetail(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, detail.ChildTasks, 1)
+	assert.Equal(t, duplicateID, detail.ChildTasks[0].ID)
+	assert.Equal(t, targetDuplicates, detail.ChildTasks[0].RelationTypeID)
+
+	// The history survives the source project
+	history, err := svc.GetTaskHistory(ctx, taskID)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	_, err = db.ExecContext(ctx, "DELETE FROM projects WHERE id = ?", sourceID)
+	require.NoError(t, err)
+	after, err := svc.GetTaskHistory(ctx, taskID)
+	require.NoError(t, err)
+	assert.Len(t, after, len(history))
+}
+
+func TestBulkUpdateTasks(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+	backendID := createTestLabel(t, db, projectID, "backend")
+	staleID := createTestLabel(t, db, projectID, "stale")
+
+	first := createTestTask(t, db, todoID, "First")
+	second := createTestTask(t, db, todoID, "Second")
+	alreadyDone := createTestTask(t, db, doneID, "Already done")
+	_, err := db.ExecContext(ctx, "INSERT INTO task_labels (task_id, label_id) VALUES (?, ?), (?, ?)", first, staleID, alreadyDone, backendID)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, "UPDATE tasks SET priority_id = 5 WHERE id = ?", alreadyDone)
+	require.NoError(t, err)
+
+	publisher := &recordingPublisher{}
+	svc := NewService(db, publisher)
+
+	critical := 5
+	req := BulkUpdateRequest{
+		ProjectID:      projectID,
+		TaskIDs:        []int{first, second, alreadyDone, first},
+		ColumnID:       &doneID,
+		PriorityID:     &critical,
+		AttachLabelIDs: []int{backendID},
+		DetachLabelIDs: []int{staleID},
+		DryRun:         true,
+	}
+
+	// A dry run reports the changes but keeps none of them
+	preview, err := svc.BulkUpdateTasks(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, preview.Tasks, 3, "duplicate IDs are changed once")
+	assert.Equal(t, 2, preview.Updated)
+	assert.Empty(t, preview.Tasks[2].Changes, "a task already matching the request is left alone")
+	assert.Equal(t, []BulkChange{
+		{Field: "priority", OldValue: "medium", NewValue: "critical"},
+		{Field: "label", NewValue: "backend"},
+		{Field: "label", OldValue: "stale"},
+		{Field: "column", OldValue: "To Do", NewValue: "Done"},
+	}, preview.Tasks[0].Changes)
+
+	detail, err := svc.GetTaskDetail(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, todoID, detail.ColumnID)
+	assert.Empty(t, publisher.events)
+
+	req.DryRun = false
+	result, err := svc.BulkUpdateTasks(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, preview, result)
+
+	for _, id := range []int{first, second} {
+		detail, err := svc.GetTaskDetail(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, doneID, detail.ColumnID)
+		assert.Equal(t, "critical", detail.PriorityDescription)
+		require.Len(t, detail.Labels, 1)
+		assert.Equal(t, "backend", detail.Labels[0].Name)
+	}
+
+	// One db_changed event stands in for the per-task events
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, events.EventDatabaseChanged, publisher.events[0].Type)
+	assert.Equal(t, projectID, publisher.events[0].ProjectID)
+
+	history, err := svc.GetTaskHistory(ctx, second)
+	require.NoError(t, err)
+	assert.NotEmpty(t, history, "bulk changes are recorded in the task history")
+}
+
+func TestBulkUpdateTasks_UnblockedEvents(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doneID := createTestCompletedColumn(t, db, projectID, "Done")
+
+	blocker := createTestTask(t, db, todoID, "Blocker")
+	other := createTestTask(t, db, todoID, "Other")
+	dependent := createTestTask(t, db, todoID, "Dependent")
+	addTaskRelation(t, db, dependent, blocker, models.RelationTypeBlocking)
+
+	publisher := &recordingPublisher{}
+	svc := NewService(db, publisher)
+
+	_, err := svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{other, blocker}, ColumnID: &doneID})
+	require.NoError(t, err)
+
+	// The single db_changed event names the unblocked task
+	require.Len(t, publisher.events, 1)
+	assert.Equal(t, events.EventDatabaseChanged, publisher.events[0].Type)
+	assert.Equal(t, []int{dependent}, publisher.events[0].Unblocked)
+}
+
+func TestBulkUpdateTasks_Atomic(t *testing.T) {
+	t.Parallel()
+
+	db := setupTestDB(t)
+	defer func() { _ = db.Close() }()
+
+	ctx := context.Background()
+	projectID := createTestProject(t, db)
+	otherProjectID := createTestProject(t, db)
+	todoID := createTestColumn(t, db, projectID, "To Do")
+	doingID := createTestColumn(t, db, projectID, "In Progress")
+	otherTodoID := createTestColumn(t, db, otherProjectID, "To Do")
+	_, err := db.ExecContext(ctx, "UPDATE columns SET wip_limit = 1 WHERE id = ?", doingID)
+	require.NoError(t, err)
+
+	first := createTestTask(t, db, todoID, "First")
+	second := createTestTask(t, db, todoID, "Second")
+	stranger := createTestTask(t, db, otherTodoID, "Elsewhere")
+
+	svc := NewService(db, nil)
+
+	// The second task would exceed the WIP limit, so neither moves
+	_, err = svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{first, second}, ColumnID: &doingID})
+	require.ErrorIs(t, err, ErrWIPLimitExceeded)
+	detail, err := svc.GetTaskDetail(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, todoID, detail.ColumnID)
+
+	// A task of another project fails the whole batch
+	_, err = svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{first, stranger}, Archive: true})
+	require.ErrorIs(t, err, ErrTaskNotInProject)
+	detail, err = svc.GetTaskDetail(ctx, first)
+	require.NoError(t, err)
+	assert.Nil(t, detail.ArchivedAt)
+
+	_, err = svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{first}, ColumnID: &otherTodoID})
+	require.ErrorIs(t, err, ErrInvalidColumnID)
+
+	_, err = svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, Archive: true})
+	require.ErrorIs(t, err, ErrNoTasksSelected)
+
+	_, err = svc.BulkUpdateTasks(ctx, BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{first}})
+	require.ErrorIs(t, err, ErrNoBulkChanges)
+
+	result, err := svc.BulkUpdateTasks(WithWIPLimitOverride(ctx), BulkUpdateRequest{ProjectID: projectID, TaskIDs: []int{first, second}, ColumnID: &doingID, Archive: true})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Updated)
+	archived, err := svc.GetArchivedTasksByProject(ctx, projectID)
+	require.NoError(t, err)
+	assert.Len(t, archived, 2)
+}
@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+)
+
+// resolveTypeAndPriority checks that a type and priority belong to a project.
+// A zero ID resolves to the project's default (first type, middle priority).
+func resolveTypeAndPriority(ctx context.Context, q generated.Querier, projectID int64, typeID, priorityID int) (int64, int64, error) {
+	pid := sql.NullInt64{Int64: projectID, Valid: true}
+
+	types, err := q.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get types: %w", err)
+	}
+	resolvedType := database.DefaultTypeID(types)
+	if typeID != 0 {
+		resolvedType = 0
+		for _, t := range types {
+			if t.ID == int64(typeID) {
+				resolvedType = t.ID
+			}
+		}
+	}
+	if resolvedType == 0 {
+		return 0, 0, ErrInvalidType
+	}
+
+	priorities, err := q.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get priorities: %w", err)
+	}
+	resolvedPriority := database.DefaultPriorityID(priorities)
+	if priorityID != 0 {
+		resolvedPriority = 0
+		for _, p := range priorities {
+			if p.ID == int64(priorityID) {
+				resolvedPriority = p.ID
+			}
+		}
+	}
+	if resolvedPriority == 0 {
+		return 0, 0, ErrInvalidPriority
+	}
+
+	return resolvedType, resolvedPriority, nil
+}
+
+// checkRelationType checks that a relation type can link tasks of the parent
+// task's project: it is either built-in or one of that project's own.
+func checkRelationType(ctx context.Context, q generated.Querier, parentID int64, relationTypeID int) error {
+	rt, err := q.GetRelationTypeByID(ctx, int64(relationTypeID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalidRelationType
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get relation type: %w", err)
+	}
+	if !rt.ProjectID.Valid {
+		return nil
+	}
+
+	projectID, err := q.GetProjectIDFromTask(ctx, parentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTaskNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get task project: %w", err)
+	}
+	if projectID != rt.ProjectID.Int64 {
+		return ErrInvalidRelationType
+	}
+	return nil
+}
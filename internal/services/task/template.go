@@ -0,0 +1,470 @@
+package task
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// templateVar matches a {{variable}} placeholder in a task template
+var templateVar = regexp.MustCompile(`\{\{\s*([A-Za-z0-9_.-]+)\s*\}\}`)
+
+// TemplateVariables returns the names of the variables a task template uses,
+// sorted and without duplicates
+func TemplateVariables(t *models.TaskTemplate) []string {
+	var names []string
+	collect := func(s string) {
+		for _, m := range templateVar.FindAllStringSubmatch(s, -1) {
+			names = append(names, m[1])
+		}
+	}
+	collect(t.Title)
+	collect(t.Description)
+	for _, c := range t.Children {
+		collect(c.Title)
+		collect(c.Description)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// GetTaskTemplates returns the task templates of a project ordered by name
+func (s *service) GetTaskTemplates(ctx context.Context, projectID int) ([]*models.TaskTemplate, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	rows, err := s.queries.GetTaskTemplatesByProject(ctx, int64(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task templates: %w", err)
+	}
+
+	templates := make([]*models.TaskTemplate, 0, len(rows))
+	for _, row := range rows {
+		template, err := decodeTaskTemplate(row)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// GetTaskTemplate returns a task template of a project by name
+func (s *service) GetTaskTemplate(ctx context.Context, projectID int, name string) (*models.TaskTemplate, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+
+	row, err := s.queries.GetTaskTemplate(ctx, generated.GetTaskTemplateParams{
+		ProjectID: int64(projectID),
+		Name:      name,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTaskTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get task template: %w", err)
+	}
+	return decodeTaskTemplate(row)
+}
+
+// SaveTaskTemplate saves a task template in a project. Its type, priority
+// and label names must exist in the project. A template with the same name
+// is only replaced when replace is set.
+func (s *service) SaveTaskTemplate(ctx context.Context, projectID int, template *models.TaskTemplate, replace bool) error {
+	if projectID <= 0 {
+		return ErrInvalidProjectID
+	}
+	if err := validateTaskTemplate(template); err != nil {
+		return err
+	}
+
+	names, err := s.loadTemplateNames(ctx, int64(projectID))
+	if err != nil {
+		return err
+	}
+	if _, err := names.resolve(template.Type, template.Priority, template.Labels); err != nil {
+		return err
+	}
+	for _, c := range template.Children {
+		if _, err := names.resolve(c.Type, c.Priority, c.Labels); err != nil {
+			return err
+		}
+	}
+
+	if !replace {
+		_, err := s.queries.GetTaskTemplate(ctx, generated.GetTaskTemplateParams{
+			ProjectID: int64(projectID),
+			Name:      template.Name,
+		})
+		if err == nil {
+			return ErrTaskTemplateExists
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to get task template: %w", err)
+		}
+	}
+
+	definition, err := json.Marshal(template)
+	if err != nil {
+		return fmt.Errorf("failed to encode task template: %w", err)
+	}
+	if _, err := s.queries.UpsertTaskTemplate(ctx, generated.UpsertTaskTemplateParams{
+		ProjectID:  int64(projectID),
+		Name:       template.Name,
+		Definition: string(definition),
+	}); err != nil {
+		return fmt.Errorf("failed to save task template: %w", err)
+	}
+	return nil
+}
+
+// DeleteTaskTemplate deletes a task template of a project. Tasks created
+// from it are kept.
+func (s *service) DeleteTaskTemplate(ctx context.Context, projectID int, name string) error {
+	if projectID <= 0 {
+		return ErrInvalidProjectID
+	}
+
+	deleted, err := s.queries.DeleteTaskTemplate(ctx, generated.DeleteTaskTemplateParams{
+		ProjectID: int64(projectID),
+		Name:      name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete task template: %w", err)
+	}
+	if deleted == 0 {
+		return ErrTaskTemplateNotFound
+	}
+	return nil
+}
+
+// TaskTemplateFromTask builds a template, not yet saved, from a task and
+// its child tasks. Placeholders already in their titles and descriptions
+// become the template's variables.
+func (s *service) TaskTemplateFromTask(ctx context.Context, taskID int, name string) (*models.TaskTemplate, error) {
+	if taskID <= 0 {
+		return nil, ErrInvalidTaskID
+	}
+
+	detail, err := s.GetTaskDetail(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	template := &models.TaskTemplate{
+		Name:        name,
+		Title:       detail.Title,
+		Description: detail.Description,
+		Type:        detail.TypeDescription,
+		Priority:    detail.PriorityDescription,
+		Labels:      labelNames(detail.Labels),
+	}
+	for _, ref := range detail.ChildTasks {
+		if ref.RelationTypeID != models.RelationTypeParentChild {
+			continue
+		}
+		child, err := s.GetTaskDetail(ctx, ref.ID)
+		if err != nil {
+			return nil, err
+		}
+		template.Children = append(template.Children, models.TaskTemplateChild{
+			Title:       child.Title,
+			Description: child.Description,
+			Type:        child.TypeDescription,
+			Priority:    child.PriorityDescription,
+			Labels:      labelNames(child.Labels),
+		})
+	}
+	return template, nil
+}
+
+// CreateTaskFromTemplate creates a task and its child tasks from a template,
+// filling in its variables from vars. Fields set in req take precedence over
+// the template's, and the template's labels are added to req's. The task
+// takes the first free position from req.Position on and its children follow
+// it in the same column as its parent-child children. It returns the task and
+// the IDs of its children.
+//
+// Everything the template refers to is checked before anything is created,
+// and the task and its children are created in one transaction: if one of
+// them cannot be created, none is.
+func (s *service) CreateTaskFromTemplate(ctx context.Context, template *models.TaskTemplate, vars map[string]string, req CreateTaskRequest) (*models.Task, []int, error) {
+	if err := validateTaskTemplate(template); err != nil {
+		return nil, nil, err
+	}
+	if req.ColumnID <= 0 {
+		return nil, nil, ErrInvalidColumnID
+	}
+
+	rendered, err := renderTaskTemplate(template, vars)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	projectID, err := s.queries.GetProjectIDFromColumn(ctx, int64(req.ColumnID))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get project ID: %w", err)
+	}
+	names, err := s.loadTemplateNames(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ids, err := names.resolve(rendered.Type, rendered.Priority, rendered.Labels)
+	if err != nil {
+		return nil, nil, err
+	}
+	if req.Title == "" {
+		req.Title = rendered.Title
+	}
+	if req.Description == "" {
+		req.Description = rendered.Description
+	}
+	if req.TypeID == 0 {
+		req.TypeID = ids.typeID
+	}
+	if req.PriorityID == 0 {
+		req.PriorityID = ids.priorityID
+	}
+	for _, labelID := range ids.labelIDs {
+		if !slices.Contains(req.LabelIDs, labelID) {
+			req.LabelIDs = append(req.LabelIDs, labelID)
+		}
+	}
+	if err := s.validateCreateTask(req); err != nil {
+		return nil, nil, err
+	}
+
+	children := make([]CreateTaskRequest, len(rendered.Children))
+	for i, c := range rendered.Children {
+		ids, err := names.resolve(c.Type, c.Priority, c.Labels)
+		if err != nil {
+			return nil, nil, err
+		}
+		children[i] = CreateTaskRequest{
+			Title:       c.Title,
+			Description: c.Description,
+			ColumnID:    req.ColumnID,
+			TypeID:      ids.typeID,
+			PriorityID:  ids.priorityID,
+			LabelIDs:    ids.labelIDs,
+		}
+		if err := s.validateCreateTask(children[i]); err != nil {
+			return nil, nil, fmt.Errorf("child task '%s': %w", c.Title, err)
+		}
+	}
+
+	var task generated.Task
+	created := make([]generated.Task, 0, len(children))
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		position, err := freePosition(ctx, qtx, 0, int64(req.ColumnID), int64(req.Position))
+		if err != nil {
+			return err
+		}
+		req.Position = int(position)
+		task, err = createTaskTx(ctx, qtx, projectID, req)
+		if err != nil {
+			return err
+		}
+
+		for _, childReq := range children {
+			position, err = freePosition(ctx, qtx, 0, int64(req.ColumnID), position+1)
+			if err != nil {
+				return err
+			}
+			childReq.Position = int(position)
+			child, err := createTaskTx(ctx, qtx, projectID, childReq)
+			if err != nil {
+				return fmt.Errorf("failed to create child task '%s': %w", childReq.Title, err)
+			}
+			if err := qtx.AddSubtaskWithRelationType(ctx, generated.AddSubtaskWithRelationTypeParams{
+				ParentID:       task.ID,
+				ChildID:        child.ID,
+				RelationTypeID: models.RelationTypeParentChild,
+			}); err != nil {
+				return fmt.Errorf("failed to link child task '%s': %w", childReq.Title, err)
+			}
+			if err := recordRelationEvent(ctx, qtx, models.TaskEventRelationAdded, task.ID, child.ID); err != nil {
+				return err
+			}
+			created = append(created, child)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Publish events after successful commit
+	s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskCreated, TaskID: int(task.ID), ColumnID: int(task.ColumnID)})
+	childIDs := make([]int, 0, len(created))
+	for _, child := range created {
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskCreated, TaskID: int(child.ID), ColumnID: int(child.ColumnID)})
+		s.publishTaskEvent(ctx, events.Event{Type: events.EventTaskLinked, TaskID: int(task.ID), RelatedTaskID: int(child.ID)})
+		childIDs = append(childIDs, int(child.ID))
+	}
+
+	return converters.TaskToModel(task), childIDs, nil
+}
+
+// decodeTaskTemplate reads the definition of a saved task template
+func decodeTaskTemplate(row generated.TaskTemplate) (*models.TaskTemplate, error) {
+	var template models.TaskTemplate
+	if err := json.Unmarshal([]byte(row.Definition), &template); err != nil {
+		return nil, fmt.Errorf("failed to decode task template '%s': %w", row.Name, err)
+	}
+	template.Name = row.Name
+	return &template, nil
+}
+
+// validateTaskTemplate checks that a template has a name and that it and
+// its children have titles
+func validateTaskTemplate(t *models.TaskTemplate) error {
+	if t == nil {
+		return fmt.Errorf("%w: empty template", ErrInvalidTaskTemplate)
+	}
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("%w: template name cannot be empty", ErrInvalidTaskTemplate)
+	}
+	if strings.TrimSpace(t.Title) == "" {
+		return fmt.Errorf("%w: template '%s' has no title", ErrInvalidTaskTemplate, t.Name)
+	}
+	for i, c := range t.Children {
+		if strings.TrimSpace(c.Title) == "" {
+			return fmt.Errorf("%w: child task %d of template '%s' has no title", ErrInvalidTaskTemplate, i+1, t.Name)
+		}
+	}
+	return nil
+}
+
+// renderTaskTemplate returns a copy of a template with its variables filled
+// in. Every variable must have a value.
+func renderTaskTemplate(t *models.TaskTemplate, vars map[string]string) (*models.TaskTemplate, error) {
+	var missing []string
+	for _, name := range TemplateVariables(t) {
+		if _, ok := vars[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingTemplateVar, strings.Join(missing, ", "))
+	}
+
+	fill := func(s string) string {
+		return templateVar.ReplaceAllStringFunc(s, func(placeholder string) string {
+			return vars[templateVar.FindStringSubmatch(placeholder)[1]]
+		})
+	}
+
+	rendered := *t
+	rendered.Title = fill(t.Title)
+	rendered.Description = fill(t.Description)
+	rendered.Children = make([]models.TaskTemplateChild, len(t.Children))
+	for i, c := range t.Children {
+		c.Title = fill(c.Title)
+		c.Description = fill(c.Description)
+		rendered.Children[i] = c
+	}
+	return &rendered, nil
+}
+
+// templateNames maps the lowercased type, priority and label names of a
+// project to their IDs
+type templateNames struct {
+	types      map[string]int
+	priorities map[string]int
+	labels     map[string]int
+}
+
+// resolvedTemplateIDs are the IDs a template entry's names resolve to
+type resolvedTemplateIDs struct {
+	typeID     int
+	priorityID int
+	labelIDs   []int
+}
+
+// loadTemplateNames reads the type, priority and label names of a project
+func (s *service) loadTemplateNames(ctx context.Context, projectID int64) (*templateNames, error) {
+	pid := sql.NullInt64{Int64: projectID, Valid: true}
+
+	types, err := s.queries.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get types: %w", err)
+	}
+	priorities, err := s.queries.GetPrioritiesByProject(ctx, generated.GetPrioritiesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get priorities: %w", err)
+	}
+	labels, err := s.queries.GetLabelsByProject(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get labels: %w", err)
+	}
+
+	names := &templateNames{
+		types:      make(map[string]int, len(types)),
+		priorities: make(map[string]int, len(priorities)),
+		labels:     make(map[string]int, len(labels)),
+	}
+	for _, t := range types {
+		names.types[strings.ToLower(t.Description)] = int(t.ID)
+	}
+	for _, p := range priorities {
+		names.priorities[strings.ToLower(p.Description)] = int(p.ID)
+	}
+	for _, l := range labels {
+		names.labels[strings.ToLower(l.Name)] = int(l.ID)
+	}
+	return names, nil
+}
+
+// resolve maps a type, priority and labels to their IDs. Empty type and
+// priority names resolve to 0, the project's defaults.
+func (n *templateNames) resolve(typeName, priorityName string, labels []string) (resolvedTemplateIDs, error) {
+	var ids resolvedTemplateIDs
+	if typeName != "" {
+		id, ok := n.types[strings.ToLower(typeName)]
+		if !ok {
+			return ids, fmt.Errorf("%w: unknown type '%s'", ErrInvalidTaskTemplate, typeName)
+		}
+		ids.typeID = id
+	}
+	if priorityName != "" {
+		id, ok := n.priorities[strings.ToLower(priorityName)]
+		if !ok {
+			return ids, fmt.Errorf("%w: unknown priority '%s'", ErrInvalidTaskTemplate, priorityName)
+		}
+		ids.priorityID = id
+	}
+	for _, label := range labels {
+		id, ok := n.labels[strings.ToLower(label)]
+		if !ok {
+			return ids, fmt.Errorf("%w: unknown label '%s'", ErrInvalidTaskTemplate, label)
+		}
+		ids.labelIDs = append(ids.labelIDs, id)
+	}
+	return ids, nil
+}
+
+// labelNames returns the names of labels
+func labelNames(labels []*models.Label) []string {
+	var names []string
+	for _, l := range labels {
+		names = append(names, l.Name)
+	}
+	return names
+}
@@ -0,0 +1,44 @@
+package task
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thenoetrevino/paso/internal/database/generated"
+)
+
+// wipOverrideKey marks a context in which column WIP limits are not enforced
+type wipOverrideKey struct{}
+
+// WithWIPLimitOverride returns a context in which CreateTask and the task
+// moves may put more tasks in a column than its WIP limit allows. Callers use
+// it for an explicit --force.
+func WithWIPLimitOverride(ctx context.Context) context.Context {
+	return context.WithValue(ctx, wipOverrideKey{}, true)
+}
+
+// checkWIPLimit returns ErrWIPLimitExceeded if the column has a WIP limit and
+// already holds that many tasks, unless ctx carries WithWIPLimitOverride.
+// Archived tasks do not count.
+func checkWIPLimit(ctx context.Context, q generated.Querier, columnID int64) error {
+	if override, _ := ctx.Value(wipOverrideKey{}).(bool); override {
+		return nil
+	}
+
+	column, err := q.GetColumnByID(ctx, columnID)
+	if err != nil {
+		return fmt.Errorf("failed to get column: %w", err)
+	}
+	if !column.WipLimit.Valid {
+		return nil
+	}
+
+	count, err := q.GetActiveTaskCountByColumn(ctx, columnID)
+	if err != nil {
+		return fmt.Errorf("failed to get task count: %w", err)
+	}
+	if count >= column.WipLimit.Int64 {
+		return fmt.Errorf("%w: '%s' holds %d of %d tasks", ErrWIPLimitExceeded, column.Name, count, column.WipLimit.Int64)
+	}
+	return nil
+}
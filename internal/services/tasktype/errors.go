@@ -0,0 +1,22 @@
+package tasktype
+
+import "errors"
+
+// Type-related errors
+var (
+	// Validation errors
+	ErrEmptyName        = errors.New("name cannot be empty")
+	ErrNameTooLong      = errors.New("name cannot exceed 30 characters")
+	ErrInvalidColor     = errors.New("invalid color format (must be hex color like #FFFFFF)")
+	ErrIconTooLong      = errors.New("icon cannot exceed 4 characters")
+	ErrInvalidTypeID    = errors.New("invalid type ID")
+	ErrInvalidProjectID = errors.New("invalid project ID")
+
+	// Business logic errors
+	ErrTypeNotFound    = errors.New("type not found")
+	ErrTypeExists      = errors.New("a type with this name already exists in the project")
+	ErrBuiltinType     = errors.New("built-in types cannot be changed")
+	ErrTypeInUse       = errors.New("type is used by tasks; reassign them to another type first")
+	ErrLastType        = errors.New("a project must keep at least one type")
+	ErrInvalidReassign = errors.New("tasks can only be reassigned to another type of the same project")
+)
@@ -0,0 +1,299 @@
+package tasktype
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/thenoetrevino/paso/internal/converters"
+	"github.com/thenoetrevino/paso/internal/database"
+	"github.com/thenoetrevino/paso/internal/database/generated"
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+// Hex color regex pattern
+var hexColorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Service defines all task type operations. Every project has its own set of
+// types, copied from the built-in task/feature/bug when the project is created.
+type Service interface {
+	// Read operations
+	GetTypesByProject(ctx context.Context, projectID int) ([]*models.Type, error)
+
+	// Write operations
+	CreateType(ctx context.Context, req CreateTypeRequest) (*models.Type, error)
+	UpdateType(ctx context.Context, req UpdateTypeRequest) error
+	DeleteType(ctx context.Context, req DeleteTypeRequest) error
+}
+
+// CreateTypeRequest encapsulates data for creating a type
+type CreateTypeRequest struct {
+	ProjectID int
+	Name      string
+	Color     string // Hex color like #FF5733
+	Icon      string
+	SortOrder *int // nil appends after the project's last type
+}
+
+// UpdateTypeRequest encapsulates data for updating a type
+type UpdateTypeRequest struct {
+	ID        int
+	Name      *string
+	Color     *string
+	Icon      *string
+	SortOrder *int
+}
+
+// DeleteTypeRequest encapsulates data for deleting a type
+type DeleteTypeRequest struct {
+	ID         int
+	ReassignTo int // Type the deleted type's tasks move to; required while tasks use it
+}
+
+// service implements Service interface using SQLC directly
+type service struct {
+	db          *sql.DB
+	queries     generated.Querier
+	eventClient events.EventPublisher
+}
+
+// NewService creates a new type service
+func NewService(db *sql.DB, eventClient events.EventPublisher) Service {
+	return &service{
+		db:          db,
+		queries:     generated.New(db),
+		eventClient: eventClient,
+	}
+}
+
+// GetTypesByProject retrieves a project's types in sort order
+func (s *service) GetTypesByProject(ctx context.Context, projectID int) ([]*models.Type, error) {
+	if projectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	pid := sql.NullInt64{Int64: int64(projectID), Valid: true}
+	types, err := s.queries.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+	if err != nil {
+		return nil, err
+	}
+	return converters.TypesToModels(types), nil
+}
+
+// CreateType adds a type to a project
+func (s *service) CreateType(ctx context.Context, req CreateTypeRequest) (*models.Type, error) {
+	if req.ProjectID <= 0 {
+		return nil, ErrInvalidProjectID
+	}
+	if err := validateFields(&req.Name, &req.Color, &req.Icon); err != nil {
+		return nil, err
+	}
+
+	var created generated.Type
+	err := database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		if err := database.EnsureProjectTaxonomy(ctx, qtx, int64(req.ProjectID)); err != nil {
+			return err
+		}
+
+		var sortOrder int64
+		if req.SortOrder != nil {
+			sortOrder = int64(*req.SortOrder)
+		} else {
+			pid := sql.NullInt64{Int64: int64(req.ProjectID), Valid: true}
+			existing, err := qtx.GetTypesByProject(ctx, generated.GetTypesByProjectParams{ProjectID: pid, ProjectID_2: pid})
+			if err != nil {
+				return fmt.Errorf("failed to get types: %w", err)
+			}
+			if len(existing) > 0 {
+				sortOrder = existing[len(existing)-1].SortOrder
+			}
+			sortOrder++
+		}
+
+		var err error
+		created, err = qtx.CreateType(ctx, generated.CreateTypeParams{
+			ProjectID:   sql.NullInt64{Int64: int64(req.ProjectID), Valid: true},
+			Description: req.Name,
+			Color:       req.Color,
+			Icon:        req.Icon,
+			SortOrder:   sortOrder,
+		})
+		return err
+	})
+	if err != nil {
+		if isUniqueConstraintError(err) {
+			return nil, ErrTypeExists
+		}
+		return nil, fmt.Errorf("failed to create type: %w", err)
+	}
+
+	s.publishTypesChanged(req.ProjectID)
+
+	return converters.TypeToModel(created), nil
+}
+
+// UpdateType changes a type's name, colour, icon or position
+func (s *service) UpdateType(ctx context.Context, req UpdateTypeRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidTypeID
+	}
+
+	existing, err := s.getProjectType(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	name, color, icon := existing.Description, existing.Color, existing.Icon
+	if req.Name != nil {
+		name = *req.Name
+	}
+	if req.Color != nil {
+		color = *req.Color
+	}
+	if req.Icon != nil {
+		icon = *req.Icon
+	}
+	if err := validateFields(&name, &color, &icon); err != nil {
+		return err
+	}
+	sortOrder := existing.SortOrder
+	if req.SortOrder != nil {
+		sortOrder = int64(*req.SortOrder)
+	}
+
+	if err := s.queries.UpdateType(ctx, generated.UpdateTypeParams{
+		Description: name,
+		Color:       color,
+		Icon:        icon,
+		SortOrder:   sortOrder,
+		ID:          existing.ID,
+	}); err != nil {
+		if isUniqueConstraintError(err) {
+			return ErrTypeExists
+		}
+		return fmt.Errorf("failed to update type: %w", err)
+	}
+
+	s.publishTypesChanged(int(existing.ProjectID.Int64))
+
+	return nil
+}
+
+// DeleteType removes a type. Tasks using it are moved to req.ReassignTo; a
+// type still in use without a reassignment target, or a project's last type,
+// cannot be deleted.
+func (s *service) DeleteType(ctx context.Context, req DeleteTypeRequest) error {
+	if req.ID <= 0 {
+		return ErrInvalidTypeID
+	}
+
+	existing, err := s.getProjectType(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	projectID := existing.ProjectID
+
+	count, err := s.queries.CountTypesByProject(ctx, projectID)
+	if err != nil {
+		return fmt.Errorf("failed to count types: %w", err)
+	}
+	if count <= 1 {
+		return ErrLastType
+	}
+
+	if req.ReassignTo > 0 {
+		target, err := s.queries.GetTypeByID(ctx, int64(req.ReassignTo))
+		if err != nil || target.ProjectID != projectID || target.ID == existing.ID {
+			return ErrInvalidReassign
+		}
+	}
+
+	err = database.WithTx(ctx, s.db, func(tx *sql.Tx) error {
+		qtx := generated.New(tx)
+
+		used, err := qtx.CountTasksWithType(ctx, existing.ID)
+		if err != nil {
+			return fmt.Errorf("failed to count tasks: %w", err)
+		}
+		if used > 0 {
+			if req.ReassignTo <= 0 {
+				return ErrTypeInUse
+			}
+			if err := qtx.ReassignProjectTasksType(ctx, generated.ReassignProjectTasksTypeParams{
+				TypeID:    int64(req.ReassignTo),
+				TypeID_2:  existing.ID,
+				ProjectID: projectID.Int64,
+			}); err != nil {
+				return fmt.Errorf("failed to reassign tasks: %w", err)
+			}
+		}
+
+		return qtx.DeleteType(ctx, existing.ID)
+	})
+	if err != nil {
+		return err
+	}
+
+	s.publishTypesChanged(int(projectID.Int64))
+
+	return nil
+}
+
+// getProjectType loads a type that belongs to a project
+func (s *service) getProjectType(ctx context.Context, id int) (generated.Type, error) {
+	existing, err := s.queries.GetTypeByID(ctx, int64(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return existing, ErrTypeNotFound
+	}
+	if err != nil {
+		return existing, fmt.Errorf("failed to get type: %w", err)
+	}
+	if !existing.ProjectID.Valid {
+		return existing, ErrBuiltinType
+	}
+	return existing, nil
+}
+
+// validateFields trims and validates a type's name, colour and icon
+func validateFields(name, color, icon *string) error {
+	*name = strings.TrimSpace(*name)
+	if *name == "" {
+		return ErrEmptyName
+	}
+	if utf8.RuneCountInString(*name) > 30 {
+		return ErrNameTooLong
+	}
+	if !hexColorRegex.MatchString(*color) {
+		return ErrInvalidColor
+	}
+	if utf8.RuneCountInString(*icon) > 4 {
+		return ErrIconTooLong
+	}
+	return nil
+}
+
+// publishTypesChanged tells clients to reload a project's types
+func (s *service) publishTypesChanged(projectID int) {
+	if s.eventClient == nil {
+		return
+	}
+
+	// Publish with retry (3 attempts with exponential backoff)
+	// Non-blocking: errors are logged but don't affect the operation
+	_ = events.PublishWithRetry(s.eventClient, events.Event{
+		Type:      events.EventProjectUpdated,
+		ProjectID: projectID,
+		Fields:    []string{"types"},
+	}, 3)
+}
+
+// isUniqueConstraintError checks if an error is a SQLite unique constraint violation
+func isUniqueConstraintError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
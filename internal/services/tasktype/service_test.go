@@ -0,0 +1,176 @@
+package tasktype
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thenoetrevino/paso/internal/testutil"
+)
+
+func names(t *testing.T, svc Service, projectID int) []string {
+	t.Helper()
+	types, err := svc.GetTypesByProject(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("GetTypesByProject() error = %v", err)
+	}
+	out := make([]string, len(types))
+	for i, ty := range types {
+		out[i] = ty.Description
+	}
+	return out
+}
+
+func TestGetTypesByProject_FallsBackToBuiltins(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	projectID := testutil.CreateTestProject(t, db, "Ops")
+
+	got := names(t, svc, projectID)
+	want := []string{"task", "feature", "bug"}
+	if len(got) != len(want) {
+		t.Fatalf("types = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("types = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestCreateType_CopiesBuiltinsAndAppends(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "Ops")
+	columnID := testutil.CreateTestColumn(t, db, projectID, "Todo")
+	taskID := testutil.CreateTestTask(t, db, columnID, "Existing")
+
+	created, err := svc.CreateType(ctx, CreateTypeRequest{ProjectID: projectID, Name: "incident", Color: "#EF4444", Icon: "!"})
+	if err != nil {
+		t.Fatalf("CreateType() error = %v", err)
+	}
+	if created.ProjectID != projectID || created.SortOrder != 4 {
+		t.Errorf("created = %+v, want project %d and sort order 4", created, projectID)
+	}
+
+	if got := names(t, svc, projectID); len(got) != 4 || got[3] != "incident" {
+		t.Errorf("types = %v, want the built-ins followed by incident", got)
+	}
+
+	// The existing task was moved onto the project's own copy of "task"
+	var typeName string
+	var typeProject int
+	if err := db.QueryRowContext(ctx,
+		`SELECT ty.description, ty.project_id FROM tasks t JOIN types ty ON ty.id = t.type_id WHERE t.id = ?`,
+		taskID).Scan(&typeName, &typeProject); err != nil {
+		t.Fatalf("failed to read task type: %v", err)
+	}
+	if typeName != "task" || typeProject != projectID {
+		t.Errorf("task type = %s (project %d), want task (project %d)", typeName, typeProject, projectID)
+	}
+
+	if _, err := svc.CreateType(ctx, CreateTypeRequest{ProjectID: projectID, Name: "incident", Color: "#EF4444"}); !errors.Is(err, ErrTypeExists) {
+		t.Errorf("duplicate CreateType() error = %v, want ErrTypeExists", err)
+	}
+}
+
+func TestCreateType_Validation(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	projectID := testutil.CreateTestProject(t, db, "Ops")
+
+	tests := []struct {
+		name string
+		req  CreateTypeRequest
+		want error
+	}{
+		{"no project", CreateTypeRequest{Name: "chore", Color: "#6B7280"}, ErrInvalidProjectID},
+		{"empty name", CreateTypeRequest{ProjectID: projectID, Name: "  ", Color: "#6B7280"}, ErrEmptyName},
+		{"bad color", CreateTypeRequest{ProjectID: projectID, Name: "chore", Color: "grey"}, ErrInvalidColor},
+		{"long icon", CreateTypeRequest{ProjectID: projectID, Name: "chore", Color: "#6B7280", Icon: "abcde"}, ErrIconTooLong},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := svc.CreateType(context.Background(), tt.req); !errors.Is(err, tt.want) {
+				t.Errorf("CreateType() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateType(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "Ops")
+
+	spike, err := svc.CreateType(ctx, CreateTypeRequest{ProjectID: projectID, Name: "spike", Color: "#A855F7"})
+	if err != nil {
+		t.Fatalf("CreateType() error = %v", err)
+	}
+
+	name, order := "research", 0
+	if err := svc.UpdateType(ctx, UpdateTypeRequest{ID: spike.ID, Name: &name, SortOrder: &order}); err != nil {
+		t.Fatalf("UpdateType() error = %v", err)
+	}
+	if got := names(t, svc, projectID); got[0] != "research" {
+		t.Errorf("types = %v, want research first", got)
+	}
+
+	// Built-in rows are templates and cannot be edited
+	if err := svc.UpdateType(ctx, UpdateTypeRequest{ID: 1, Name: &name}); !errors.Is(err, ErrBuiltinType) {
+		t.Errorf("UpdateType(built-in) error = %v, want ErrBuiltinType", err)
+	}
+}
+
+func TestDeleteType(t *testing.T) {
+	db := testutil.SetupTestDB(t)
+	svc := NewService(db, nil)
+	ctx := context.Background()
+	projectID := testutil.CreateTestProject(t, db, "Ops")
+	columnID := testutil.CreateTestColumn(t, db, projectID, "Todo")
+	taskID := testutil.CreateTestTask(t, db, columnID, "Existing")
+
+	chore, err := svc.CreateType(ctx, CreateTypeRequest{ProjectID: projectID, Name: "chore", Color: "#6B7280"})
+	if err != nil {
+		t.Fatalf("CreateType() error = %v", err)
+	}
+	types, _ := svc.GetTypesByProject(ctx, projectID)
+	taskType := types[0]
+
+	if err := svc.DeleteType(ctx, DeleteTypeRequest{ID: taskType.ID}); !errors.Is(err, ErrTypeInUse) {
+		t.Fatalf("DeleteType(in use) error = %v, want ErrTypeInUse", err)
+	}
+	if err := svc.DeleteType(ctx, DeleteTypeRequest{ID: taskType.ID, ReassignTo: 2}); !errors.Is(err, ErrInvalidReassign) {
+		t.Errorf("DeleteType(reassign to built-in) error = %v, want ErrInvalidReassign", err)
+	}
+	if err := svc.DeleteType(ctx, DeleteTypeRequest{ID: taskType.ID, ReassignTo: chore.ID}); err != nil {
+		t.Fatalf("DeleteType(reassign) error = %v", err)
+	}
+
+	var typeID int
+	if err := db.QueryRowContext(ctx, `SELECT type_id FROM tasks WHERE id = ?`, taskID).Scan(&typeID); err != nil {
+		t.Fatalf("failed to read task type: %v", err)
+	}
+	if typeID != chore.ID {
+		t.Errorf("task type = %d, want %d", typeID, chore.ID)
+	}
+
+	for _, ty := range names(t, svc, projectID) {
+		if ty == "task" {
+			t.Errorf("task type still listed after delete")
+		}
+	}
+
+	// Delete down to one type; the last one stays
+	remaining, _ := svc.GetTypesByProject(ctx, projectID)
+	for _, ty := range remaining[:len(remaining)-1] {
+		if err := svc.DeleteType(ctx, DeleteTypeRequest{ID: ty.ID, ReassignTo: remaining[len(remaining)-1].ID}); err != nil {
+			t.Fatalf("DeleteType(%s) error = %v", ty.Description, err)
+		}
+	}
+	if err := svc.DeleteType(ctx, DeleteTypeRequest{ID: remaining[len(remaining)-1].ID}); !errors.Is(err, ErrLastType) {
+		t.Errorf("DeleteType(last) error = %v, want ErrLastType", err)
+	}
+}
@@ -0,0 +1,160 @@
+package taskimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Fields lists the item fields a CSV column can be mapped to
+var Fields = []string{"title", "description", "type", "priority", "column", "labels", "done"}
+
+// fieldAliases are header names recognised without an explicit mapping
+var fieldAliases = map[string]string{
+	"title":       "title",
+	"name":        "title",
+	"summary":     "title",
+	"task":        "title",
+	"description": "description",
+	"body":        "description",
+	"details":     "description",
+	"notes":       "description",
+	"type":        "type",
+	"kind":        "type",
+	"priority":    "priority",
+	"column":      "column",
+	"status":      "column",
+	"state":       "column",
+	"labels":      "labels",
+	"label":       "labels",
+	"tags":        "labels",
+	"done":        "done",
+	"completed":   "done",
+}
+
+// ParseCSV reads a CSV file with a header row. Columns are matched to item
+// fields by header name (see fieldAliases); mapping overrides that with
+// field -> header pairs, e.g. {"title": "Issue Summary"}. Labels may be
+// separated by commas, semicolons or pipes. A done column marks the row
+// finished when it holds true, yes, x or 1.
+func ParseCSV(r io.Reader, mapping map[string]string) ([]*Item, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: empty CSV file", ErrInvalidInput)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	columns, err := mapColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("%w: no title column (headers: %s); map one with --map title=<header>",
+			ErrInvalidInput, strings.Join(header, ", "))
+	}
+
+	var items []*Item
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+
+		get := func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		item := &Item{
+			Title:       get("title"),
+			Description: get("description"),
+			Type:        get("type"),
+			Priority:    get("priority"),
+			Column:      get("column"),
+			Labels:      splitLabels(get("labels")),
+			Line:        row,
+		}
+		switch strings.ToLower(get("done")) {
+		case "true", "yes", "y", "x", "1":
+			item.Done = true
+		}
+
+		if item.Title == "" {
+			// Skip blank rows, reject rows that have data but no title
+			if strings.TrimSpace(strings.Join(record, "")) == "" {
+				continue
+			}
+			return nil, fmt.Errorf("%w: row %d has no title", ErrInvalidInput, row)
+		}
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no rows found", ErrInvalidInput)
+	}
+	return items, nil
+}
+
+// mapColumns returns the column index of each mapped field
+func mapColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	columns := make(map[string]int)
+	for name, i := range index {
+		if field, ok := fieldAliases[name]; ok {
+			if _, taken := columns[field]; !taken || name == field {
+				columns[field] = i
+			}
+		}
+	}
+
+	// Explicit mappings win over aliases; apply them in a stable order
+	fields := make([]string, 0, len(mapping))
+	for field := range mapping {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		field = strings.ToLower(field)
+		if !isField(field) {
+			return nil, fmt.Errorf("%w: cannot map unknown field '%s' (must be: %s)",
+				ErrInvalidInput, field, strings.Join(Fields, ", "))
+		}
+		headerName := mapping[field]
+		i, ok := index[strings.ToLower(strings.TrimSpace(headerName))]
+		if !ok {
+			return nil, fmt.Errorf("%w: no column named '%s' (headers: %s)",
+				ErrInvalidInput, headerName, strings.Join(header, ", "))
+		}
+		columns[field] = i
+	}
+
+	return columns, nil
+}
+
+func isField(name string) bool {
+	for _, field := range Fields {
+		if field == name {
+			return true
+		}
+	}
+	return false
+}
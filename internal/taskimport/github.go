@@ -0,0 +1,113 @@
+package taskimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// githubIssue covers both the REST API issue objects and
+// gh issue list --json number,title,body,labels,state,url
+type githubIssue struct {
+	Number      int             `json:"number"`
+	Title       string          `json:"title"`
+	Body        string          `json:"body"`
+	State       string          `json:"state"`
+	Labels      []githubLabel   `json:"labels"`
+	URL         string          `json:"url"`
+	HTMLURL     string          `json:"html_url"`
+	PullRequest json.RawMessage `json:"pull_request"`
+}
+
+// githubLabel accepts a label object ({"name": ...}) or a bare name
+type githubLabel struct {
+	Name string `json:"name"`
+}
+
+func (l *githubLabel) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return json.Unmarshal(data, &l.Name)
+	}
+	type plain githubLabel
+	return json.Unmarshal(data, (*plain)(l))
+}
+
+// priorityNames are the priorities a GitHub label can set
+var priorityNames = []string{"trivial", "low", "medium", "high", "critical"}
+
+// ParseGitHub reads a JSON array of GitHub issues, as returned by the REST
+// API or gh issue list --json. Closed issues are marked done, pull requests
+// are skipped, and labels named after a priority ("high",
+// "priority: high", "priority/high") set the priority instead of becoming
+// labels. "enhancement" and "feature" labels make the task a feature.
+func ParseGitHub(r io.Reader) ([]*Item, error) {
+	var issues []githubIssue
+	if err := json.NewDecoder(r).Decode(&issues); err != nil {
+		return nil, fmt.Errorf("%w: expected a JSON array of issues: %v", ErrInvalidInput, err)
+	}
+
+	var items []*Item
+	for _, issue := range issues {
+		if len(issue.PullRequest) > 0 && string(issue.PullRequest) != "null" {
+			continue
+		}
+		if strings.TrimSpace(issue.Title) == "" {
+			return nil, fmt.Errorf("%w: issue #%d has no title", ErrInvalidInput, issue.Number)
+		}
+
+		item := &Item{
+			Title:       strings.TrimSpace(issue.Title),
+			Description: strings.TrimSpace(issue.Body),
+			Done:        strings.EqualFold(issue.State, "closed"),
+			Line:        issue.Number,
+		}
+
+		url := issue.HTMLURL
+		if url == "" {
+			url = issue.URL
+		}
+		if url != "" {
+			if item.Description != "" {
+				item.Description += "\n\n"
+			}
+			item.Description += "Imported from " + url
+		}
+
+		for _, label := range issue.Labels {
+			name := strings.TrimSpace(label.Name)
+			if priority := labelPriority(name); priority != "" {
+				item.Priority = priority
+				continue
+			}
+			switch strings.ToLower(name) {
+			case "enhancement", "feature":
+				item.Type = "feature"
+			}
+			if name != "" {
+				item.Labels = append(item.Labels, name)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: no issues found", ErrInvalidInput)
+	}
+	return items, nil
+}
+
+// labelPriority returns the priority a label names, if any
+func labelPriority(label string) string {
+	name := strings.ToLower(label)
+	for _, prefix := range []string{"priority:", "priority/", "priority-"} {
+		name = strings.TrimSpace(strings.TrimPrefix(name, prefix))
+	}
+	for _, priority := range priorityNames {
+		if name == priority {
+			return priority
+		}
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+package taskimport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// checklistItem matches "- [ ] title", "* [x] title" and "1. [ ] title"
+var checklistItem = regexp.MustCompile(`^([-*+]|\d+[.)])\s+\[([ xX])\]\s+(.+)$`)
+
+// ParseMarkdown reads a markdown checklist. Items indented under another
+// item become its children, and checked items are marked done. Indented
+// text that isn't a checklist item is added to the description of the item
+// above it; everything else (headings, prose) is ignored.
+func ParseMarkdown(r io.Reader) ([]*Item, error) {
+	type open struct {
+		indent int
+		item   *Item
+	}
+
+	var (
+		roots []*Item
+		stack []open // items that can still receive children, outermost first
+		last  *open
+	)
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.ReplaceAll(scanner.Text(), "\t", "    ")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		match := checklistItem.FindStringSubmatch(trimmed)
+		if match == nil {
+			if last != nil && indent > last.indent {
+				if last.item.Description != "" {
+					last.item.Description += "\n"
+				}
+				last.item.Description += trimmed
+			} else {
+				last = nil
+			}
+			continue
+		}
+
+		item := &Item{
+			Title: strings.TrimSpace(match[3]),
+			Done:  match[2] != " ",
+			Line:  lineNo,
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 {
+			roots = append(roots, item)
+		} else {
+			parent := stack[len(stack)-1].item
+			parent.Children = append(parent.Children, item)
+		}
+		stack = append(stack, open{indent: indent, item: item})
+		last = &stack[len(stack)-1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read markdown: %w", err)
+	}
+
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("%w: no checklist items (- [ ] ...) found", ErrInvalidInput)
+	}
+	return roots, nil
+}
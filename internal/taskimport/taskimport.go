@@ -0,0 +1,98 @@
+// Package taskimport parses task lists from other tools: markdown
+// checklists, CSV files and GitHub issue exports.
+//
+// Parsers only turn their input into Items. Mapping items onto a project's
+// columns, labels, types and priorities happens in paso task import.
+package taskimport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// ErrInvalidInput is wrapped by all parse errors
+var ErrInvalidInput = errors.New("invalid import file")
+
+// Format identifies an input format
+type Format string
+
+const (
+	FormatMarkdown Format = "markdown"
+	FormatCSV      Format = "csv"
+	FormatGitHub   Format = "github"
+)
+
+// Item is a task to import. Empty fields take the importer's defaults.
+type Item struct {
+	Title       string
+	Description string
+	Type        string
+	Priority    string
+	Column      string
+	Labels      []string
+	Done        bool    // Finished items go to the completed column
+	Children    []*Item // Nested items, imported as child tasks
+	Line        int     // Line (markdown), row (csv) or issue number (github) it came from
+}
+
+// Count returns the number of items including nested ones
+func Count(items []*Item) int {
+	n := 0
+	for _, item := range items {
+		n += 1 + Count(item.Children)
+	}
+	return n
+}
+
+// ParseFormat validates a --format value
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "csv":
+		return FormatCSV, nil
+	case "github", "gh":
+		return FormatGitHub, nil
+	}
+	return "", fmt.Errorf("invalid format '%s' (must be: markdown, csv, github)", s)
+}
+
+// DetectFormat guesses the format from a file name
+func DetectFormat(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown", ".txt":
+		return FormatMarkdown, nil
+	case ".csv":
+		return FormatCSV, nil
+	case ".json":
+		return FormatGitHub, nil
+	}
+	return "", fmt.Errorf("cannot tell the format of '%s' from its extension; use --format", path)
+}
+
+// Parse reads items in the given format. mapping is only used for CSV.
+func Parse(r io.Reader, format Format, mapping map[string]string) ([]*Item, error) {
+	switch format {
+	case FormatMarkdown:
+		return ParseMarkdown(r)
+	case FormatCSV:
+		return ParseCSV(r, mapping)
+	case FormatGitHub:
+		return ParseGitHub(r)
+	}
+	return nil, fmt.Errorf("unsupported format '%s'", format)
+}
+
+// splitLabels splits a label list on commas, semicolons or pipes
+func splitLabels(s string) []string {
+	var labels []string
+	for _, label := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' || r == '|' }) {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
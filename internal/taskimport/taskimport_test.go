@@ -0,0 +1,150 @@
+package taskimport
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	input := `# Launch
+
+Some prose that is ignored.
+
+- [ ] Write docs
+  Covers install and setup.
+  - [x] Install guide
+  - [ ] Setup guide
+    * [ ] Screenshots
+- [X] Ship it
+1. [ ] Announce
+`
+
+	items, err := ParseMarkdown(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseMarkdown() error = %v", err)
+	}
+
+	if len(items) != 3 {
+		t.Fatalf("got %d top-level items, want 3", len(items))
+	}
+	if got := Count(items); got != 6 {
+		t.Errorf("Count() = %d, want 6", got)
+	}
+
+	docs := items[0]
+	if docs.Title != "Write docs" || docs.Done || docs.Line != 5 {
+		t.Errorf("first item = %+v", docs)
+	}
+	if docs.Description != "Covers install and setup." {
+		t.Errorf("description = %q", docs.Description)
+	}
+	if len(docs.Children) != 2 || !docs.Children[0].Done || docs.Children[1].Title != "Setup guide" {
+		t.Fatalf("children = %+v", docs.Children)
+	}
+	if len(docs.Children[1].Children) != 1 || docs.Children[1].Children[0].Title != "Screenshots" {
+		t.Errorf("grandchildren = %+v", docs.Children[1].Children)
+	}
+	if !items[1].Done || items[1].Title != "Ship it" {
+		t.Errorf("second item = %+v", items[1])
+	}
+	if items[2].Title != "Announce" {
+		t.Errorf("third item = %+v", items[2])
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	input := `Summary,Notes,Priority,Status,Tags,Done
+Fix login,Users get logged out,high,In Progress,"backend; auth",
+Update deps,,low,,chore,yes
+,,,,,
+`
+
+	items, err := ParseCSV(strings.NewReader(input), map[string]string{"description": "Notes"})
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+
+	want := []*Item{
+		{Title: "Fix login", Description: "Users get logged out", Priority: "high", Column: "In Progress", Labels: []string{"backend", "auth"}, Line: 2},
+		{Title: "Update deps", Priority: "low", Labels: []string{"chore"}, Done: true, Line: 3},
+	}
+	if !reflect.DeepEqual(items, want) {
+		for _, item := range items {
+			t.Logf("got %+v", *item)
+		}
+		t.Errorf("ParseCSV() items differ from want")
+	}
+}
+
+func TestParseCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		mapping map[string]string
+	}{
+		{name: "empty", input: ""},
+		{name: "no title column", input: "Foo,Bar\na,b\n"},
+		{name: "unknown field", input: "Title\na\n", mapping: map[string]string{"owner": "Title"}},
+		{name: "unknown header", input: "Title\na\n", mapping: map[string]string{"title": "Name"}},
+		{name: "row without title", input: "Title,Priority\n,high\n"},
+		{name: "header only", input: "Title\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.input), tt.mapping)
+			if !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("ParseCSV() error = %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
+
+func TestParseGitHub(t *testing.T) {
+	input := `[
+		{"number": 12, "title": "Crash on start", "body": "Stack trace attached", "state": "OPEN",
+		 "labels": [{"name": "bug"}, {"name": "priority: high"}], "url": "https://github.com/o/r/issues/12"},
+		{"number": 13, "title": "Dark mode", "state": "closed", "labels": ["enhancement"]},
+		{"number": 14, "title": "Bump deps", "state": "open", "pull_request": {"url": "x"}}
+	]`
+
+	items, err := ParseGitHub(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseGitHub() error = %v", err)
+	}
+
+	want := []*Item{
+		{
+			Title:       "Crash on start",
+			Description: "Stack trace attached\n\nImported from https://github.com/o/r/issues/12",
+			Priority:    "high",
+			Labels:      []string{"bug"},
+			Line:        12,
+		},
+		{Title: "Dark mode", Type: "feature", Labels: []string{"enhancement"}, Done: true, Line: 13},
+	}
+	if !reflect.DeepEqual(items, want) {
+		for _, item := range items {
+			t.Logf("got %+v", *item)
+		}
+		t.Errorf("ParseGitHub() items differ from want")
+	}
+}
+
+func TestDetectFormat(t *testing.T) {
+	tests := map[string]Format{
+		"TODO.md":     FormatMarkdown,
+		"tasks.CSV":   FormatCSV,
+		"issues.json": FormatGitHub,
+	}
+	for path, want := range tests {
+		if got, err := DetectFormat(path); err != nil || got != want {
+			t.Errorf("DetectFormat(%q) = %q, %v; want %q", path, got, err, want)
+		}
+	}
+	if _, err := DetectFormat("tasks"); err == nil {
+		t.Error("DetectFormat without extension should fail")
+	}
+}
@@ -82,8 +82,10 @@ func createTestSchema(db *sql.DB) error {
 		name TEXT NOT NULL,
 		description TEXT,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		ticket_key TEXT
 	);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_projects_ticket_key ON projects(ticket_key);
 
 	-- Project counters for ticket numbers
 	CREATE TABLE IF NOT EXISTS project_counters (
@@ -102,34 +104,46 @@ func createTestSchema(db *sql.DB) error {
 		holds_ready_tasks BOOLEAN NOT NULL DEFAULT 0,
 		holds_completed_tasks BOOLEAN NOT NULL DEFAULT 0,
 		holds_in_progress_tasks BOOLEAN NOT NULL DEFAULT 0,
+		wip_limit INTEGER,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
 	);
 
-	-- Types lookup table
+	-- Types lookup table (project_id NULL is the built-in set)
 	CREATE TABLE IF NOT EXISTS types (
-		id INTEGER PRIMARY KEY,
-		description TEXT NOT NULL UNIQUE
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		project_id INTEGER NULL,
+		description TEXT NOT NULL,
+		color TEXT NOT NULL DEFAULT '#6B7280',
+		icon TEXT NOT NULL DEFAULT '',
+		sort_order INTEGER NOT NULL DEFAULT 0,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		UNIQUE(project_id, description)
 	);
 
-	INSERT OR IGNORE INTO types (id, description) VALUES
-		(1, 'task'),
-		(2, 'feature'),
-		(3, 'bug');
+	INSERT OR IGNORE INTO types (id, description, color, sort_order) VALUES
+		(1, 'task', '#3B82F6', 1),
+		(2, 'feature', '#A855F7', 2),
+		(3, 'bug', '#EF4444', 3);
 
-	-- Priorities lookup table
+	-- Priorities lookup table (project_id NULL is the built-in set)
 	CREATE TABLE IF NOT EXISTS priorities (
-		id INTEGER PRIMARY KEY,
-		description TEXT NOT NULL UNIQUE,
-		color TEXT NOT NULL
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		project_id INTEGER NULL,
+		description TEXT NOT NULL,
+		color TEXT NOT NULL,
+		icon TEXT NOT NULL DEFAULT '',
+		sort_order INTEGER NOT NULL DEFAULT 0,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE,
+		UNIQUE(project_id, description)
 	);
 
-	INSERT OR IGNORE INTO priorities (id, description, color) VALUES
-		(1, 'trivial', '#3B82F6'),
-		(2, 'low', '#22C55E'),
-		(3, 'medium', '#EAB308'),
-		(4, 'high', '#F97316'),
-		(5, 'critical', '#EF4444');
+	INSERT OR IGNORE INTO priorities (id, description, color, sort_order) VALUES
+		(1, 'trivial', '#3B82F6', 1),
+		(2, 'low', '#22C55E', 2),
+		(3, 'medium', '#EAB308', 3),
+		(4, 'high', '#F97316', 4),
+		(5, 'critical', '#EF4444', 5);
 
 	-- Relation types
 	CREATE TABLE IF NOT EXISTS relation_types (
@@ -137,13 +151,17 @@ func createTestSchema(db *sql.DB) error {
 		p_to_c_label TEXT NOT NULL,
 		c_to_p_label TEXT NOT NULL,
 		color TEXT NOT NULL,
-		is_blocking BOOLEAN NOT NULL DEFAULT 0
+		is_blocking BOOLEAN NOT NULL DEFAULT 0,
+		project_id INTEGER REFERENCES projects(id) ON DELETE CASCADE,
+		name TEXT NOT NULL DEFAULT ''
 	);
 
-	INSERT OR IGNORE INTO relation_types (id, p_to_c_label, c_to_p_label, color, is_blocking) VALUES
-		(1, 'Parent', 'Child', '#6B7280', 0),
-		(2, 'Blocked By', 'Blocker', '#EF4444', 1),
-		(3, 'Related To', 'Related To', '#3B82F6', 0);
+	CREATE UNIQUE INDEX IF NOT EXISTS idx_relation_types_project_name ON relation_types(project_id, name);
+
+	INSERT OR IGNORE INTO relation_types (id, p_to_c_label, c_to_p_label, color, is_blocking, name) VALUES
+		(1, 'Parent', 'Child', '#6B7280', 0, 'parent'),
+		(2, 'Blocked By', 'Blocker', '#EF4444', 1, 'blocker'),
+		(3, 'Related To', 'Related To', '#3B82F6', 0, 'related');
 
 	-- Tasks table
 	CREATE TABLE IF NOT EXISTS tasks (
@@ -157,6 +175,9 @@ func createTestSchema(db *sql.DB) error {
 		priority_id INTEGER NOT NULL DEFAULT 3,
 		created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 		updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+		due_at DATETIME,
+		start_at DATETIME,
+		archived_at DATETIME,
 		FOREIGN KEY (column_id) REFERENCES columns(id) ON DELETE CASCADE,
 		FOREIGN KEY (type_id) REFERENCES types(id),
 		FOREIGN KEY (priority_id) REFERENCES priorities(id),
@@ -204,6 +225,87 @@ func createTestSchema(db *sql.DB) error {
 		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
 	);
 
+	-- Task activity log (from 00003_add_task_events)
+	CREATE TABLE IF NOT EXISTS task_events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		project_id INTEGER NOT NULL,
+		task_id INTEGER NULL,
+		event_type TEXT NOT NULL,
+		field TEXT NOT NULL DEFAULT '',
+		old_value TEXT NOT NULL DEFAULT '',
+		new_value TEXT NOT NULL DEFAULT '',
+		actor TEXT NOT NULL DEFAULT '',
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	);
+
+	-- Recurring task rules (from 00010_task_recurrence)
+	CREATE TABLE IF NOT EXISTS task_recurrences (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		task_id INTEGER NOT NULL UNIQUE,
+		rule TEXT NOT NULL,
+		next_run_at DATETIME NOT NULL,
+		last_instance_id INTEGER,
+		occurrences INTEGER NOT NULL DEFAULT 0,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+		FOREIGN KEY (last_instance_id) REFERENCES tasks(id) ON DELETE SET NULL
+	);
+
+	-- Saved project templates (from 00011_project_templates)
+	CREATE TABLE IF NOT EXISTS project_templates (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		name TEXT NOT NULL UNIQUE,
+		definition TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	-- Task templates (from 00012_task_templates)
+	CREATE TABLE IF NOT EXISTS task_templates (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		project_id INTEGER NOT NULL,
+		name TEXT NOT NULL,
+		definition TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE (project_id, name),
+		FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+	);
+
+	-- Task checklists (from 00013_task_checklists)
+	CREATE TABLE IF NOT EXISTS task_checklist_items (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		task_id INTEGER NOT NULL,
+		content TEXT NOT NULL CHECK(length(content) <= 500),
+		done BOOLEAN NOT NULL DEFAULT 0,
+		position INTEGER NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+	);
+	CREATE INDEX IF NOT EXISTS idx_task_checklist_items_task ON task_checklist_items(task_id, position);
+
+	-- Open blockers of every task (from 00015_task_blockers and 00016_archived_blockers)
+	CREATE VIEW IF NOT EXISTS task_blockers AS
+	WITH RECURSIVE chain(task_id, blocker_id) AS (
+		SELECT ts.parent_id, ts.child_id
+		FROM task_subtasks ts
+		INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+		INNER JOIN tasks bt ON ts.child_id = bt.id
+		INNER JOIN columns bc ON bt.column_id = bc.id
+		WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0 AND bt.archived_at IS NULL
+		UNION
+		SELECT ch.task_id, ts.child_id
+		FROM chain ch
+		INNER JOIN task_subtasks ts ON ts.parent_id = ch.blocker_id
+		INNER JOIN relation_types rt ON ts.relation_type_id = rt.id
+		INNER JOIN tasks bt ON ts.child_id = bt.id
+		INNER JOIN columns bc ON bt.column_id = bc.id
+		WHERE rt.is_blocking = 1 AND bc.holds_completed_tasks = 0 AND bt.archived_at IS NULL
+	)
+	SELECT task_id, blocker_id FROM chain;
+
 	-- Indexes for performance (from 00001_initial_schema)
 	CREATE INDEX IF NOT EXISTS idx_tasks_column ON tasks(column_id, position);
 	CREATE INDEX IF NOT EXISTS idx_columns_project ON columns(project_id);
@@ -232,6 +334,57 @@ func createTestSchema(db *sql.DB) error {
 	CREATE UNIQUE INDEX IF NOT EXISTS idx_columns_ready_unique ON columns(project_id) WHERE holds_ready_tasks = 1;
 	CREATE UNIQUE INDEX IF NOT EXISTS idx_columns_completed_unique ON columns(project_id) WHERE holds_completed_tasks = 1;
 	CREATE UNIQUE INDEX IF NOT EXISTS idx_columns_in_progress_unique ON columns(project_id) WHERE holds_in_progress_tasks = 1;
+
+	-- Task activity log indexes (from 00003_add_task_events)
+	CREATE INDEX IF NOT EXISTS idx_task_events_task ON task_events(task_id, created_at);
+	CREATE INDEX IF NOT EXISTS idx_task_events_project ON task_events(project_id, created_at);
+
+	-- Full-text search index and sync triggers (from 00004_add_task_search)
+	CREATE VIRTUAL TABLE IF NOT EXISTS tasks_fts USING fts5(
+		title,
+		description,
+		comments,
+		tokenize = 'unicode61 remove_diacritics 2'
+	);
+
+	CREATE TRIGGER IF NOT EXISTS tasks_fts_after_insert AFTER INSERT ON tasks
+	BEGIN
+		INSERT INTO tasks_fts (rowid, title, description, comments)
+		VALUES (new.id, new.title, coalesce(new.description, ''), '');
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS tasks_fts_after_update AFTER UPDATE OF title, description ON tasks
+	BEGIN
+		UPDATE tasks_fts
+		SET title = new.title, description = coalesce(new.description, '')
+		WHERE rowid = new.id;
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS tasks_fts_after_delete AFTER DELETE ON tasks
+	BEGIN
+		DELETE FROM tasks_fts WHERE rowid = old.id;
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS task_comments_fts_after_insert AFTER INSERT ON task_comments
+	BEGIN
+		UPDATE tasks_fts
+		SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = new.task_id), '')
+		WHERE rowid = new.task_id;
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS task_comments_fts_after_update AFTER UPDATE OF content ON task_comments
+	BEGIN
+		UPDATE tasks_fts
+		SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = new.task_id), '')
+		WHERE rowid = new.task_id;
+	END;
+
+	CREATE TRIGGER IF NOT EXISTS task_comments_fts_after_delete AFTER DELETE ON task_comments
+	BEGIN
+		UPDATE tasks_fts
+		SET comments = coalesce((select group_concat(content, char(10)) from task_comments where task_id = old.task_id), '')
+		WHERE rowid = old.task_id;
+	END;
 	`
 
 	_, err := db.ExecContext(context.Background(), schema)
@@ -29,6 +29,8 @@ import (
 //   - selectedTaskIdx: Index of selected task in this column (-1 if not this column)
 //   - height: Fixed height for the column (0 for auto)
 //   - scrollOffset: Index of first visible task
+//   - highlight: Search terms to emphasize in task titles (nil when not searching)
+//   - ticketKey: The project's ticket key, shown on cards as KEY-N (empty to hide)
 func RenderColumn(
 	column *models.Column,
 	tasks []*models.TaskSummary,
@@ -36,6 +38,8 @@ func RenderColumn(
 	selectedTaskIdx int,
 	height int,
 	scrollOffset int,
+	highlight []string,
+	ticketKey string,
 ) string {
 	header := renderColumnHeader(column, len(tasks))
 
@@ -44,13 +48,21 @@ func RenderColumn(
 		return applyColumnStyle(content, selected, height)
 	}
 
-	content := renderColumnWithTasksContent(header, tasks, selected, selectedTaskIdx, height, scrollOffset)
+	content := renderColumnWithTasksContent(header, tasks, selected, selectedTaskIdx, height, scrollOffset, highlight, ticketKey)
 	return applyColumnStyle(content, selected, height)
 }
 
-// renderColumnHeader formats the column title with task count
+// renderColumnHeader formats the column title with task count, shown against
+// the WIP limit when the column has one and in red once it is exceeded
 func renderColumnHeader(column *models.Column, taskCount int) string {
-	header := fmt.Sprintf("%s (%d)", column.Name, taskCount)
+	if column.WIPLimit <= 0 {
+		return TitleStyle.Render(fmt.Sprintf("%s (%d)", column.Name, taskCount))
+	}
+
+	header := fmt.Sprintf("%s (%d/%d)", column.Name, taskCount, column.WIPLimit)
+	if taskCount > column.WIPLimit {
+		return TitleStyle.Foreground(lipgloss.Color(theme.ErrorFg)).Render(header)
+	}
 	return TitleStyle.Render(header)
 }
 
@@ -84,6 +96,8 @@ func renderColumnWithTasksContent(
 	selectedTaskIdx int,
 	height int,
 	scrollOffset int,
+	highlight []string,
+	ticketKey string,
 ) string {
 	content := header + "\n"
 
@@ -99,7 +113,7 @@ func renderColumnWithTasksContent(
 	for i, task := range visibleTasks {
 		actualIdx := scrollOffset + i
 		isTaskSelected := selected && actualIdx == selectedTaskIdx
-		content += RenderTask(task, isTaskSelected)
+		content += RenderTask(task, isTaskSelected, highlight, ticketKey)
 	}
 
 	showBottomIndicator := endIdx < len(tasks)
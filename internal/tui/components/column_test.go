@@ -32,6 +32,18 @@ func TestRenderColumnHeader(t *testing.T) {
 			taskCount: 42,
 			wantText:  "Done (42)",
 		},
+		{
+			name:      "under WIP limit",
+			column:    &models.Column{Name: "In Progress", WIPLimit: 3},
+			taskCount: 2,
+			wantText:  "In Progress (2/3)",
+		},
+		{
+			name:      "over WIP limit",
+			column:    &models.Column{Name: "In Progress", WIPLimit: 3},
+			taskCount: 5,
+			wantText:  "In Progress (5/3)",
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,7 +186,7 @@ func TestRenderColumnWithTasksContent_VisibleTaskCalculation(t *testing.T) {
 	height := 30
 	scrollOffset := 0
 
-	result := renderColumnWithTasksContent(header, tasks, false, -1, height, scrollOffset)
+	result := renderColumnWithTasksContent(header, tasks, false, -1, height, scrollOffset, nil, "")
 
 	// Should contain header
 	if !strings.Contains(result, header) {
@@ -205,13 +217,13 @@ func TestRenderColumnWithTasksContent_ScrollIndicators(t *testing.T) {
 	height := 30
 
 	// Test scrolled down (should show top indicator)
-	scrolledDown := renderColumnWithTasksContent(header, tasks, false, -1, height, 5)
+	scrolledDown := renderColumnWithTasksContent(header, tasks, false, -1, height, 5, nil, "")
 	if !strings.Contains(scrolledDown, "▲") {
 		t.Error("Should show top indicator when scrolled down")
 	}
 
 	// Test at top (should not show top indicator in indicator line)
-	atTop := renderColumnWithTasksContent(header, tasks, false, -1, height, 0)
+	atTop := renderColumnWithTasksContent(header, tasks, false, -1, height, 0, nil, "")
 	// The ▲ should not appear since we're at the top
 	lines := strings.Split(atTop, "\n")
 	hasTopIndicator := false
@@ -97,6 +97,10 @@ var (
 	// BlockedStyle defines the style for blocked tasks ! indicator
 	// Note that this needs its background passed in so it isn't transparent
 	BlockedStyle lipgloss.Style
+
+	// SearchMatchStyle defines the style for search matches in task titles
+	// Note that this needs its background passed in so it isn't transparent
+	SearchMatchStyle lipgloss.Style
 )
 
 // InitStyles initializes all styles with the given color scheme
@@ -213,4 +217,9 @@ func InitStyles(colors colors.ColorScheme) {
 		Foreground(lipgloss.Color(theme.Blocked)).
 		Bold(true).
 		Italic(true)
+
+	SearchMatchStyle = lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Highlight)).
+		Bold(true).
+		Underline(true)
 }
@@ -1,9 +1,13 @@
 package components
 
 import (
+	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/dates"
 	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/theme"
 )
@@ -12,11 +16,14 @@ import (
 //
 //		┌─────────────────────┐
 //		│ {Task Title}        │
-//		│ type | priority     │
-//		│ [label1] [label2]   │
+//		│ KEY-N | type | priority | due │
+//		│ ▰▰▱▱ 2/4 [label1]   │
 //		└─────────────────────┘
 //	 This has a fixed width and length
-func RenderTask(task *models.TaskSummary, selected bool) string {
+//
+// highlight lists search terms to emphasize in the title (nil when not searching)
+// and ticketKey is the project's ticket key, leaving out KEY-N when empty
+func RenderTask(task *models.TaskSummary, selected bool, highlight []string, ticketKey string) string {
 	var bg string
 	if selected {
 		bg = theme.SelectedBg
@@ -24,9 +31,10 @@ func RenderTask(task *models.TaskSummary, selected bool) string {
 		bg = theme.TaskBg
 	}
 
-	title := renderTaskSummaryTitle(task, bg)
-	metadataLine := renderTaskSummaryMetadata(task, bg)
-	labelChips := renderTaskCardLabels(task.Labels, bg)
+	title := renderTaskSummaryTitle(task, bg, highlight)
+	metadataLine := renderTaskSummaryMetadata(task, ticketKey, bg)
+	progress := renderChildProgressBar(task.ChildrenDone, task.ChildrenTotal, bg)
+	labelChips := renderTaskCardLabels(task.Labels, progress, bg)
 	content := title + metadataLine + labelChips
 
 	style := TaskStyle.
@@ -37,7 +45,7 @@ func RenderTask(task *models.TaskSummary, selected bool) string {
 	return style.Render(content)
 }
 
-func renderTaskSummaryTitle(task *models.TaskSummary, bg string) string {
+func renderTaskSummaryTitle(task *models.TaskSummary, bg string, highlight []string) string {
 	var blockedIndicator string
 	if task.IsBlocked {
 		blockedIndicator = BlockedStyle.
@@ -46,47 +54,119 @@ func renderTaskSummaryTitle(task *models.TaskSummary, bg string) string {
 	}
 
 	title := task.Title
-	if len(title) >= taskTitleMaxLength {
+	if runes := []rune(title); len(runes) >= taskTitleMaxLength {
 		ellipsisStyle := lipgloss.NewStyle().
 			Foreground(lipgloss.Color(theme.Subtle)).
 			Background(lipgloss.Color(bg)).
 			Italic(true)
-		title = title[:taskTitleMaxLength] + ellipsisStyle.Render("...")
+		title = highlightSearchTerms(string(runes[:taskTitleMaxLength]), highlight, bg) + ellipsisStyle.Render("...")
+	} else {
+		title = highlightSearchTerms(padTitleForIndicator(title), highlight, bg)
 	}
 
-	title = padTitleForIndicator(title)
-
 	return lipgloss.NewStyle().
 		Bold(true).
 		Render(" " + title + blockedIndicator)
 }
 
+// highlightSearchTerms emphasizes every case-insensitive occurrence of the
+// given terms in text. Text is returned unchanged when there are no terms.
+func highlightSearchTerms(text string, terms []string, bg string) string {
+	if len(terms) == 0 {
+		return text
+	}
+
+	// Mark which runes fall inside a match so overlapping terms merge cleanly.
+	// Runes are compared with EqualFold rather than searched in a lowered
+	// copy, whose byte offsets can differ from the text's (e.g. for 'İ').
+	runes := []rune(text)
+	marked := make([]bool, len(runes))
+	for _, term := range terms {
+		termLen := utf8.RuneCountInString(term)
+		if termLen == 0 {
+			continue
+		}
+		for i := 0; i+termLen <= len(runes); {
+			if !strings.EqualFold(string(runes[i:i+termLen]), term) {
+				i++
+				continue
+			}
+			for j := i; j < i+termLen; j++ {
+				marked[j] = true
+			}
+			i += termLen
+		}
+	}
+
+	matchStyle := SearchMatchStyle.Background(lipgloss.Color(bg))
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		j := i
+		for j < len(runes) && marked[j] == marked[i] {
+			j++
+		}
+		if marked[i] {
+			b.WriteString(matchStyle.Render(string(runes[i:j])))
+		} else {
+			b.WriteString(string(runes[i:j]))
+		}
+		i = j
+	}
+	return b.String()
+}
+
 // padTitleForIndicator pads the title to ensure the blocked indicator aligns on the right
 func padTitleForIndicator(title string) string {
-	if len(title) < taskTitlePaddedLength {
-		return title + strings.Repeat(" ", taskTitlePaddedLength-len(title))
+	if n := utf8.RuneCountInString(title); n < taskTitlePaddedLength {
+		return title + strings.Repeat(" ", taskTitlePaddedLength-n)
 	}
 	return title
 }
 
-// renderTaskCardLabels renders the labels as chips, with their color as the background
-func renderTaskCardLabels(labels []*models.Label, bg string) string {
+// renderTaskCardLabels renders the labels as chips, with their color as the background,
+// after the child progress bar of a parent task
+func renderTaskCardLabels(labels []*models.Label, progress string, bg string) string {
+	spacer := lipgloss.NewStyle().Background(lipgloss.Color(bg)).Render(" ")
+	if progress != "" {
+		if len(labels) == 0 {
+			return "\n " + progress
+		}
+		progress += spacer
+	}
+
 	if len(labels) == 0 {
 		emptyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Subtle)).Background(lipgloss.Color(bg)).Italic(true)
 		return "\n " + emptyStyle.Render("no labels")
 	}
 
-	spacer := lipgloss.NewStyle().Background(lipgloss.Color(bg)).Render(" ")
 	var chips []string
 	for _, label := range labels {
 		chips = append(chips, RenderLabelChip(label, bg))
 	}
 	labelChips := strings.Join(chips, spacer)
-	return "\n " + labelChips
+	return "\n " + progress + labelChips
+}
+
+// renderChildProgressBar renders how many descendants of a parent task are
+// done as a short bar followed by done/total, empty for tasks without children
+func renderChildProgressBar(done, total int, bg string) string {
+	if total == 0 {
+		return ""
+	}
+
+	filled := done * childProgressBarWidth / total
+	barStyle := lipgloss.NewStyle().Background(lipgloss.Color(bg)).Foreground(lipgloss.Color(theme.Highlight))
+	if done == total {
+		barStyle = barStyle.Foreground(lipgloss.Color(theme.Create))
+	}
+	emptyStyle := lipgloss.NewStyle().Background(lipgloss.Color(bg)).Foreground(lipgloss.Color(theme.Subtle))
+
+	return barStyle.Render(strings.Repeat("▰", filled)) +
+		emptyStyle.Render(strings.Repeat("▱", childProgressBarWidth-filled)+fmt.Sprintf(" %d/%d", done, total))
 }
 
 // renderTaskSummaryMetadata Renders type and priority on the same line, separated by │
-func renderTaskSummaryMetadata(task *models.TaskSummary, bg string) string {
+func renderTaskSummaryMetadata(task *models.TaskSummary, ticketKey, bg string) string {
 	var typeDisplay string
 	var priorityDisplay string
 
@@ -109,5 +189,48 @@ func renderTaskSummaryMetadata(task *models.TaskSummary, bg string) string {
 	separatorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Subtle)).Background(lipgloss.Color(bg))
 	separator := separatorStyle.Render(" │ ")
 
-	return "\n " + typeDisplay + separator + priorityDisplay
+	line := "\n " + typeDisplay + separator + priorityDisplay
+	if ticketKey != "" && task.TicketNumber > 0 {
+		ticketStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Subtle)).Background(lipgloss.Color(bg))
+		line = "\n " + ticketStyle.Render(models.TicketRef(ticketKey, task.TicketNumber)) + separator + typeDisplay + separator + priorityDisplay
+	}
+	if badge := renderDueBadge(task.DueAt, time.Now(), bg); badge != "" {
+		line += separator + badge
+	}
+	if badge := renderChecklistBadge(task.ChecklistDone, task.ChecklistTotal, bg); badge != "" {
+		line += separator + badge
+	}
+	return line
+}
+
+// renderChecklistBadge renders checklist progress as done/total, highlighted
+// once every item is ticked
+func renderChecklistBadge(done, total int, bg string) string {
+	if total == 0 {
+		return ""
+	}
+	style := lipgloss.NewStyle().Background(lipgloss.Color(bg)).Foreground(lipgloss.Color(theme.Subtle))
+	if done == total {
+		style = style.Foreground(lipgloss.Color(theme.Create))
+	}
+	return style.Render(fmt.Sprintf("%d/%d", done, total))
+}
+
+// renderDueBadge renders a due date relative to now, colored by how close it is:
+// overdue in the error color, due today or soon in the warning color
+func renderDueBadge(due *time.Time, now time.Time, bg string) string {
+	style := lipgloss.NewStyle().Background(lipgloss.Color(bg))
+	switch dates.DueState(due, now) {
+	case dates.StateNone:
+		return ""
+	case dates.StateOverdue:
+		style = style.Foreground(lipgloss.Color(theme.ErrorFg)).Bold(true)
+	case dates.StateToday:
+		style = style.Foreground(lipgloss.Color(theme.WarningFg)).Bold(true)
+	case dates.StateSoon:
+		style = style.Foreground(lipgloss.Color(theme.WarningFg))
+	default:
+		style = style.Foreground(lipgloss.Color(theme.Subtle))
+	}
+	return style.Render(dates.Relative(*due, now))
 }
@@ -0,0 +1,153 @@
+package components
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/models"
+)
+
+func TestHighlightSearchTerms(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		terms      []string
+		wantStyled bool
+		wantMatch  string // Highlighted text, when checked
+	}{
+		{name: "no terms", text: "Fix login bug", terms: nil, wantStyled: false},
+		{name: "single match", text: "Fix login bug", terms: []string{"login"}, wantStyled: true},
+		{name: "case insensitive", text: "Fix LOGIN bug", terms: []string{"login"}, wantStyled: true},
+		{name: "overlapping terms", text: "authentication", terms: []string{"auth", "then"}, wantStyled: true},
+		{name: "no match", text: "Fix login bug", terms: []string{"deploy"}, wantStyled: false},
+		{name: "lowering changes byte length", text: "İstanbul trip", terms: []string{"trip"}, wantStyled: true, wantMatch: "trip"},
+		{name: "non-ascii term", text: "Café menu", terms: []string{"CAFÉ"}, wantStyled: true, wantMatch: "Café"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := highlightSearchTerms(tt.text, tt.terms, "#000000")
+			// Highlighting must only add styling, never change the visible text
+			if got := stripANSI(result); got != tt.text {
+				t.Errorf("highlightSearchTerms() visible text = %q, want %q", got, tt.text)
+			}
+			if styled := result != tt.text; styled != tt.wantStyled {
+				t.Errorf("highlightSearchTerms() styled = %v, want %v", styled, tt.wantStyled)
+			}
+			if match := SearchMatchStyle.Background(lipgloss.Color("#000000")).Render(tt.wantMatch); tt.wantMatch != "" && !strings.Contains(result, match) {
+				t.Errorf("highlightSearchTerms() = %q, want %q highlighted", result, tt.wantMatch)
+			}
+		})
+	}
+}
+
+func TestRenderTaskSummaryTitle_TruncatesRunes(t *testing.T) {
+	task := &models.TaskSummary{Title: strings.Repeat("é", taskTitleMaxLength+5)}
+
+	got := stripANSI(renderTaskSummaryTitle(task, "#000000", nil))
+	want := " " + strings.Repeat("é", taskTitleMaxLength) + "..."
+	if got != want {
+		t.Errorf("renderTaskSummaryTitle() = %q, want %q", got, want)
+	}
+}
+
+// stripANSI removes escape sequences so tests can compare visible text
+func stripANSI(s string) string {
+	out := make([]rune, 0, len(s))
+	inEscape := false
+	for _, r := range s {
+		switch {
+		case r == '\x1b':
+			inEscape = true
+		case inEscape:
+			if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+				inEscape = false
+			}
+		default:
+			out = append(out, r)
+		}
+	}
+	return string(out)
+}
+
+func TestRenderDueBadge(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.Local)
+	day := func(offset int) *time.Time {
+		d := time.Date(2026, 10, 16+offset, 0, 0, 0, 0, time.Local)
+		return &d
+	}
+
+	tests := []struct {
+		name string
+		due  *time.Time
+		want string
+	}{
+		{name: "no due date", due: nil, want: ""},
+		{name: "overdue", due: day(-2), want: "2d late"},
+		{name: "today", due: day(0), want: "today"},
+		{name: "soon", due: day(1), want: "tomorrow"},
+		{name: "later", due: day(20), want: "Nov 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(renderDueBadge(tt.due, now, "#000000")); got != tt.want {
+				t.Errorf("renderDueBadge() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderChecklistBadge(t *testing.T) {
+	tests := []struct {
+		name        string
+		done, total int
+		want        string
+	}{
+		{name: "no checklist", done: 0, total: 0, want: ""},
+		{name: "in progress", done: 1, total: 3, want: "1/3"},
+		{name: "complete", done: 2, total: 2, want: "2/2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(renderChecklistBadge(tt.done, tt.total, "#000000")); got != tt.want {
+				t.Errorf("renderChecklistBadge() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderChildProgressBar(t *testing.T) {
+	tests := []struct {
+		name        string
+		done, total int
+		want        string
+	}{
+		{name: "no children", done: 0, total: 0, want: ""},
+		{name: "none done", done: 0, total: 4, want: "▱▱▱▱▱▱ 0/4"},
+		{name: "half done", done: 2, total: 4, want: "▰▰▰▱▱▱ 2/4"},
+		{name: "all done", done: 3, total: 3, want: "▰▰▰▰▰▰ 3/3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stripANSI(renderChildProgressBar(tt.done, tt.total, "#000000")); got != tt.want {
+				t.Errorf("renderChildProgressBar() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderTaskSummaryMetadata_TicketKey(t *testing.T) {
+	task := &models.TaskSummary{TicketNumber: 42, TypeDescription: "bug", PriorityDescription: "high", PriorityColor: "#FF0000"}
+
+	if got := stripANSI(renderTaskSummaryMetadata(task, "API", "#000000")); !strings.Contains(got, "API-42") {
+		t.Errorf("metadata with a ticket key = %q, want it to contain API-42", got)
+	}
+	if got := stripANSI(renderTaskSummaryMetadata(task, "", "#000000")); strings.Contains(got, "42") {
+		t.Errorf("metadata without a ticket key = %q, want no ticket number", got)
+	}
+}
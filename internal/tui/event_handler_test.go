@@ -7,6 +7,7 @@ import (
 
 	"github.com/thenoetrevino/paso/internal/events"
 	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
 	"github.com/thenoetrevino/paso/internal/tui/state"
 )
 
@@ -306,3 +307,70 @@ func TestEventHandler_ConnectionStateTracking(t *testing.T) {
 		t.Error("Connection should be marked as Reconnecting")
 	}
 }
+
+// TestApplyEvent_TypedTaskEvents verifies that v2 task events update only the
+// named task instead of reloading the board.
+// Edge case: Another session creates, moves and deletes a task.
+func TestApplyEvent_TypedTaskEvents(t *testing.T) {
+	m, db := SetupTestModelWithDB(t)
+	ctx := context.Background()
+
+	columns := m.AppState.Columns()
+	if len(columns) < 2 {
+		t.Fatalf("expected default columns, got %d", len(columns))
+	}
+	projectID := m.AppState.GetCurrentProjectID()
+
+	created, err := m.App.TaskService.CreateTask(ctx, taskservice.CreateTaskRequest{
+		Title:    "Live task",
+		ColumnID: columns[0].ID,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+
+	// A stale task that only a full reload would remove stays on the board
+	m.AppState.UpsertTask(&models.TaskSummary{ID: 9999, ColumnID: columns[1].ID})
+
+	m = UpdateModelWithMessage(m, RefreshMsg{Event: events.Event{
+		Type: events.EventTaskCreated, ProjectID: projectID, TaskID: created.ID,
+	}})
+	if got := m.AppState.Tasks()[columns[0].ID]; len(got) != 1 || got[0].Title != "Live task" {
+		t.Fatalf("after task.created column 0 = %+v, want the new task", got)
+	}
+	if m.AppState.TotalTaskCount() != 2 {
+		t.Errorf("TotalTaskCount() = %d, want 2 (no full reload)", m.AppState.TotalTaskCount())
+	}
+
+	if err := m.App.TaskService.MoveTaskToColumn(ctx, created.ID, columns[1].ID); err != nil {
+		t.Fatalf("MoveTaskToColumn() error = %v", err)
+	}
+	m = UpdateModelWithMessage(m, RefreshMsg{Event: events.Event{
+		Type: events.EventTaskMoved, ProjectID: projectID, TaskID: created.ID,
+	}})
+	if got := len(m.AppState.Tasks()[columns[0].ID]); got != 0 {
+		t.Errorf("after task.moved column 0 has %d tasks, want 0", got)
+	}
+	if got := len(m.AppState.Tasks()[columns[1].ID]); got != 2 {
+		t.Errorf("after task.moved column 1 has %d tasks, want 2", got)
+	}
+
+	m = UpdateModelWithMessage(m, RefreshMsg{Event: events.Event{
+		Type: events.EventTaskDeleted, ProjectID: projectID, TaskID: 9999,
+	}})
+	if m.AppState.TotalTaskCount() != 1 {
+		t.Errorf("after task.deleted TotalTaskCount() = %d, want 1", m.AppState.TotalTaskCount())
+	}
+
+	// v1 events still trigger a full reload
+	_, err = db.ExecContext(ctx, "UPDATE tasks SET title = 'Renamed' WHERE id = ?", created.ID)
+	if err != nil {
+		t.Fatalf("update title: %v", err)
+	}
+	m = UpdateModelWithMessage(m, RefreshMsg{Event: events.Event{
+		Type: events.EventDatabaseChanged, ProjectID: projectID,
+	}})
+	if got := m.AppState.Tasks()[columns[1].ID]; len(got) != 1 || got[0].Title != "Renamed" {
+		t.Errorf("after db_changed column 1 = %+v, want the renamed task", got)
+	}
+}
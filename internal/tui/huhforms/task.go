@@ -1,9 +1,19 @@
 package huhforms
 
 import (
+	"fmt"
+	"strings"
+
 	"charm.land/huh/v2"
 )
 
+// TaskTemplateOption is a task template offered in the add-task form
+type TaskTemplateOption struct {
+	Name      string
+	Title     string
+	Variables []string
+}
+
 // CreateTaskForm creates a huh form for adding/editing a task
 // The form uses pointers to update values in place, matching the existing pattern
 func CreateTaskForm(
@@ -11,15 +21,61 @@ func CreateTaskForm(
 	description *string,
 	confirm *bool,
 	descriptionLines int,
+) *huh.Form {
+	return CreateTaskFormWithTemplates(title, description, nil, nil, nil, confirm, descriptionLines)
+}
+
+// CreateTaskFormWithTemplates creates the add-task form with a choice of
+// task templates ahead of the task fields. A chosen template fills in
+// whatever the title and description are left without, and its variables
+// are entered as name=value pairs separated by commas. Without templates it
+// is the same form as CreateTaskForm.
+func CreateTaskFormWithTemplates(
+	title *string,
+	description *string,
+	template *string,
+	variables *string,
+	templates []TaskTemplateOption,
+	confirm *bool,
+	descriptionLines int,
 ) *huh.Form {
 	var fields []huh.Field
 
+	if len(templates) > 0 {
+		options := []huh.Option[string]{huh.NewOption("None", "")}
+		for _, t := range templates {
+			options = append(options, huh.NewOption(fmt.Sprintf("%s - %s", t.Name, t.Title), t.Name))
+		}
+
+		fields = append(fields,
+			huh.NewSelect[string]().
+				Key("template").
+				Title("Template").
+				Options(options...).
+				Value(template),
+
+			huh.NewInput().
+				Key("variables").
+				Title("Template Variables").
+				DescriptionFunc(func() string {
+					for _, t := range templates {
+						if t.Name == *template && len(t.Variables) > 0 {
+							return "Needs " + strings.Join(t.Variables, ", ")
+						}
+					}
+					return ""
+				}, template).
+				Placeholder("version=1.4, env=prod").
+				Value(variables),
+		)
+	}
+
 	// Title input field
 	fields = append(fields,
 		huh.NewInput().
 			Key("title").
 			Title("Title").
-			Placeholder("Enter task title...").
+			Placeholder(titlePlaceholder(templates)).
 			Value(title),
 	)
 
@@ -47,3 +103,11 @@ func CreateTaskForm(
 	form := huh.NewForm(huh.NewGroup(fields...))
 	return form.WithKeyMap(CreateKeyMapWithShiftEnter()).WithShowHelp(false)
 }
+
+// titlePlaceholder hints that a template can supply the title
+func titlePlaceholder(templates []TaskTemplateOption) string {
+	if len(templates) > 0 {
+		return "Enter task title, or leave empty to use the template's..."
+	}
+	return "Enter task title..."
+}
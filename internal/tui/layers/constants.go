@@ -23,16 +23,18 @@ const (
 	PickerTaskMaxWidth  = 70
 	PickerTaskMinHeight = 12
 
-	PickerPriorityWidth  = 40
-	PickerPriorityHeight = 12 // 5 options + chrome
+	PickerPriorityWidth        = 40 // height is dynamic based on the project's priority count
+	PickerPriorityChromeHeight = 7  // title, prompt, spacing, footer
 
-	PickerTypeWidth  = 40
-	PickerTypeHeight = 9 // 2 options + chrome
+	PickerTypeWidth        = 40 // height is dynamic based on the project's type count
+	PickerTypeChromeHeight = 7  // title, prompt, spacing, footer
 
-	PickerRelationTypeWidth  = 45 // wider for longer option descriptions
-	PickerRelationTypeHeight = 11 // 3 options + chrome. WARN: might need to be dynamic
+	PickerRelationTypeWidth        = 45 // wider for longer option descriptions; height is dynamic
+	PickerRelationTypeChromeHeight = 8  // title, prompt, spacing, footer
 
 	PickerStatusWidth           = 40 // height is dynamic based on column count
 	PickerStatusChromeHeight    = 6  // title, spacing, footer
+	PickerProjectWidth          = 44 // wide enough for the footer; height is dynamic based on project count
+	PickerProjectChromeHeight   = 8  // title, spacing, links choice, footer
 	PickerColorDefaultItemCount = 10
 )
@@ -204,6 +204,12 @@ func (m *Model) HandleDBError(err error, operation string) {
 		return
 	}
 
+	if errors.Is(err, tasksvc.ErrWIPLimitExceeded) {
+		// A full column is back-pressure, not a failure
+		m.UI.Notification.Add(state.LevelWarning, err.Error())
+		return
+	}
+
 	// Other errors - show detailed error message
 	m.UI.Notification.Add(state.LevelError, fmt.Sprintf("%s failed: %v", operation, err))
 }
@@ -343,14 +349,20 @@ func (m Model) moveTaskRight() {
 	// Use the new database function to move task
 	ctx, cancel := m.UIContext()
 	defer cancel()
+	fromColumnID, fromPosition, canUndo := m.taskPlacement(ctx, task.ID)
 	err := m.App.TaskService.MoveTaskToNextColumn(ctx, task.ID)
 	if err != nil {
 		slog.Error("failed to moving task to next column", "error", err)
-		if err != tasksvc.ErrAlreadyLastColumn {
+		if errors.Is(err, tasksvc.ErrWIPLimitExceeded) {
+			m.UI.Notification.Add(state.LevelWarning, err.Error())
+		} else if err != tasksvc.ErrAlreadyLastColumn {
 			m.UI.Notification.Add(state.LevelError, "Failed to move task to next column")
 		}
 		return
 	}
+	if canUndo {
+		m.recordTaskMove(ctx, task, fromColumnID, fromPosition)
+	}
 
 	// Update local state: remove from current column
 	tasks := m.AppState.Tasks()[currentCol.ID]
@@ -397,14 +409,20 @@ func (m Model) moveTaskLeft() {
 	// Use the new database function to move task
 	ctx, cancel := m.UIContext()
 	defer cancel()
+	fromColumnID, fromPosition, canUndo := m.taskPlacement(ctx, task.ID)
 	err := m.App.TaskService.MoveTaskToPrevColumn(ctx, task.ID)
 	if err != nil {
 		slog.Error("failed to moving task to previous column", "error", err)
-		if err != tasksvc.ErrAlreadyFirstColumn {
+		if errors.Is(err, tasksvc.ErrWIPLimitExceeded) {
+			m.UI.Notification.Add(state.LevelWarning, err.Error())
+		} else if err != tasksvc.ErrAlreadyFirstColumn {
 			m.UI.Notification.Add(state.LevelError, "Failed to move task to previous column")
 		}
 		return
 	}
+	if canUndo {
+		m.recordTaskMove(ctx, task, fromColumnID, fromPosition)
+	}
 
 	// Update local state: remove from current column
 	tasks := m.AppState.Tasks()[currentCol.ID]
@@ -453,6 +471,7 @@ func (m Model) moveTaskUp() {
 		}
 		return
 	}
+	m.recordTaskReorder(task, true)
 
 	// Update local state: swap tasks in slice
 	currentCol := m.getCurrentColumn()
@@ -516,6 +535,7 @@ func (m Model) moveTaskDown() {
 		}
 		return
 	}
+	m.recordTaskReorder(task, false)
 
 	// Update local state: swap tasks in slice
 	tasks[selectedIdx], tasks[selectedIdx+1] = tasks[selectedIdx+1], tasks[selectedIdx]
@@ -619,6 +639,14 @@ func (m *Model) initParentPickerForForm() bool {
 		return false
 	}
 
+	// Relation types label the selected items and feed the relation type picker
+	relationTypes, err := m.App.RelationTypeService.GetRelationTypesByProject(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading relation types", "error", err)
+		return false
+	}
+	m.Pickers.RelationType.SetRelationTypes(relationTypes)
+
 	// Build map of currently selected parent task IDs and their relation types from form state
 	parentTaskMap := make(map[int]int) // map[taskID]relationTypeID
 	for _, parentRef := range m.Forms.Form.FormParentRefs {
@@ -685,6 +713,14 @@ func (m *Model) initChildPickerForForm() bool {
 		return false
 	}
 
+	// Relation types label the selected items and feed the relation type picker
+	relationTypes, err := m.App.RelationTypeService.GetRelationTypesByProject(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading relation types", "error", err)
+		return false
+	}
+	m.Pickers.RelationType.SetRelationTypes(relationTypes)
+
 	// Build map of currently selected child task IDs and their relation types from form state
 	childTaskMap := make(map[int]int) // map[taskID]relationTypeID
 	for _, childRef := range m.Forms.Form.FormChildRefs {
@@ -773,78 +809,88 @@ func (m *Model) getFilteredLabelPickerItems() []state.LabelPickerItem {
 }
 
 // initPriorityPickerForForm initializes the priority picker for use in task form mode.
-// Loads the current priority from the form state.
+// Loads the project's priorities and selects the task's current one.
 func (m *Model) initPriorityPickerForForm() bool {
-	// Get current priority ID from form state
-	// If we're editing a task, get it from the task detail
-	// Otherwise, default to medium (id=3)
-	currentPriorityID := 3 // Default to medium
+	// If we're editing a task, its priority comes from the task detail and the
+	// scale from its project. Otherwise use the form state and the current
+	// project, falling back to the project's default (middle) priority.
+	ctx, cancel := m.DBContext()
+	defer cancel()
 
-	// If editing an existing task, we need to get the current priority from database
+	projectID := m.AppState.GetCurrentProjectID()
+	currentDescription := m.Forms.Form.FormPriorityDescription
 	if m.Forms.Form.EditingTaskID != 0 {
-		ctx, cancel := m.DBContext()
-		defer cancel()
-
 		taskDetail, err := m.App.TaskService.GetTaskDetail(ctx, m.Forms.Form.EditingTaskID)
 		if err != nil {
 			slog.Error("failed to loading task detail for priority picker", "error", err)
 			return false
 		}
+		projectID = taskDetail.ProjectID
+		currentDescription = taskDetail.PriorityDescription
+	}
 
-		// Find the priority ID from the priority description
-		// We need to match it against our priority options
-		priorities := renderers.GetPriorityOptions()
-		for _, p := range priorities {
-			if p.Description == taskDetail.PriorityDescription {
-				currentPriorityID = p.ID
-				break
-			}
+	priorities, err := m.App.PriorityService.GetPrioritiesByProject(ctx, projectID)
+	if err != nil || len(priorities) == 0 {
+		slog.Error("failed to loading priorities for priority picker", "error", err)
+		return false
+	}
+
+	cursor := len(priorities) / 2
+	for i, p := range priorities {
+		if p.Description == currentDescription {
+			cursor = i
+			break
 		}
 	}
 
 	// Initialize PriorityPickerState
-	m.Pickers.Priority.SetSelectedPriorityID(currentPriorityID)
-	// Set cursor to match the selected priority (adjust for 0-indexing)
-	m.Pickers.Priority.SetCursor(currentPriorityID - 1)
+	m.Pickers.Priority.SetPriorities(priorities)
+	m.Pickers.Priority.SetSelectedPriorityID(priorities[cursor].ID)
+	m.Pickers.Priority.SetCursor(cursor)
 	m.Pickers.Priority.ReturnMode = state.TicketFormMode
 
 	return true
 }
 
 // initTypePickerForForm initializes the type picker for use in task form mode.
-// Loads the current type from the form state.
+// Loads the project's types and selects the task's current one.
 func (m *Model) initTypePickerForForm() bool {
-	// Get current type ID from form state
-	// If we're editing a task, get it from the task detail
-	// Otherwise, default to task (id=1)
-	currentTypeID := 1 // Default to task
+	// If we're editing a task, its type comes from the task detail and the
+	// list from its project. Otherwise use the form state and the current
+	// project, falling back to the project's default (first) type.
+	ctx, cancel := m.DBContext()
+	defer cancel()
 
-	// If editing an existing task, we need to get the current type from database
+	projectID := m.AppState.GetCurrentProjectID()
+	currentDescription := m.Forms.Form.FormTypeDescription
 	if m.Forms.Form.EditingTaskID != 0 {
-		ctx, cancel := m.DBContext()
-		defer cancel()
-
 		taskDetail, err := m.App.TaskService.GetTaskDetail(ctx, m.Forms.Form.EditingTaskID)
 		if err != nil {
 			slog.Error("failed to loading task detail for type picker", "error", err)
 			return false
 		}
+		projectID = taskDetail.ProjectID
+		currentDescription = taskDetail.TypeDescription
+	}
 
-		// Find the type ID from the type description
-		// We need to match it against our type options
-		types := renderers.GetTypeOptions()
-		for _, t := range types {
-			if t.Description == taskDetail.TypeDescription {
-				currentTypeID = t.ID
-				break
-			}
+	types, err := m.App.TypeService.GetTypesByProject(ctx, projectID)
+	if err != nil || len(types) == 0 {
+		slog.Error("failed to loading types for type picker", "error", err)
+		return false
+	}
+
+	cursor := 0
+	for i, t := range types {
+		if t.Description == currentDescription {
+			cursor = i
+			break
 		}
 	}
 
 	// Initialize TypePickerState
-	m.Pickers.Type.SetSelectedTypeID(currentTypeID)
-	// Set cursor to match the selected type (adjust for 0-indexing)
-	m.Pickers.Type.SetCursor(currentTypeID - 1)
+	m.Pickers.Type.SetTypes(types)
+	m.Pickers.Type.SetSelectedTypeID(types[cursor].ID)
+	m.Pickers.Type.SetCursor(cursor)
 	m.Pickers.Type.ReturnMode = state.TicketFormMode
 
 	return true
@@ -883,6 +929,13 @@ func (m Model) sortListViewRows(rows []renderers.ListViewRow) {
 			cmp = strings.Compare(rows[i].Task.Title, rows[j].Task.Title)
 		case state.SortByStatus:
 			cmp = strings.Compare(rows[i].ColumnName, rows[j].ColumnName)
+		case state.SortByDue:
+			a, b := rows[i].Task.DueAt, rows[j].Task.DueAt
+			if a == nil || b == nil {
+				// Tasks without a due date go last in both directions
+				return a != nil && b == nil
+			}
+			cmp = a.Compare(*b)
 		default:
 			return false
 		}
@@ -44,6 +44,13 @@ func SortListViewRows(m *tui.Model, rows []renderers.ListViewRow) {
 			cmp = strings.Compare(rows[i].Task.Title, rows[j].Task.Title)
 		case state.SortByStatus:
 			cmp = strings.Compare(rows[i].ColumnName, rows[j].ColumnName)
+		case state.SortByDue:
+			a, b := rows[i].Task.DueAt, rows[j].Task.DueAt
+			if a == nil || b == nil {
+				// Tasks without a due date go last in both directions
+				return a != nil && b == nil
+			}
+			cmp = a.Compare(*b)
 		default:
 			return false
 		}
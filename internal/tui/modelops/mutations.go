@@ -1,6 +1,7 @@
 package modelops
 
 import (
+	"errors"
 	"log/slog"
 
 	"github.com/thenoetrevino/paso/internal/models"
@@ -77,7 +78,9 @@ func MoveTaskRight(m *tui.Model) {
 	err := m.App.TaskService.MoveTaskToNextColumn(ctx, task.ID)
 	if err != nil {
 		slog.Error("failed to moving task to next column", "error", err)
-		if err != tasksvc.ErrAlreadyLastColumn {
+		if errors.Is(err, tasksvc.ErrWIPLimitExceeded) {
+			m.UI.Notification.Add(state.LevelWarning, err.Error())
+		} else if err != tasksvc.ErrAlreadyLastColumn {
 			m.UI.Notification.Add(state.LevelError, "Failed to move task to next column")
 		}
 		return
@@ -128,7 +131,9 @@ func MoveTaskLeft(m *tui.Model) {
 	err := m.App.TaskService.MoveTaskToPrevColumn(ctx, task.ID)
 	if err != nil {
 		slog.Error("failed to moving task to previous column", "error", err)
-		if err != tasksvc.ErrAlreadyFirstColumn {
+		if errors.Is(err, tasksvc.ErrWIPLimitExceeded) {
+			m.UI.Notification.Add(state.LevelWarning, err.Error())
+		} else if err != tasksvc.ErrAlreadyFirstColumn {
 			m.UI.Notification.Add(state.LevelError, "Failed to move task to previous column")
 		}
 		return
@@ -4,7 +4,6 @@ import (
 	"log/slog"
 
 	"github.com/thenoetrevino/paso/internal/tui"
-	"github.com/thenoetrevino/paso/internal/tui/renderers"
 	"github.com/thenoetrevino/paso/internal/tui/state"
 )
 
@@ -28,6 +27,14 @@ func InitParentPickerForForm(m *tui.Model) bool {
 		return false
 	}
 
+	// Relation types label the selected items and feed the relation type picker
+	relationTypes, err := m.App.RelationTypeService.GetRelationTypesByProject(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading relation types", "error", err)
+		return false
+	}
+	m.Pickers.RelationType.SetRelationTypes(relationTypes)
+
 	// Build map of currently selected parent task IDs and their relation types from form state
 	parentTaskMap := make(map[int]int) // map[taskID]relationTypeID
 	for _, parentRef := range m.Forms.Form.FormParentRefs {
@@ -95,6 +102,14 @@ func InitChildPickerForForm(m *tui.Model) bool {
 		return false
 	}
 
+	// Relation types label the selected items and feed the relation type picker
+	relationTypes, err := m.App.RelationTypeService.GetRelationTypesByProject(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading relation types", "error", err)
+		return false
+	}
+	m.Pickers.RelationType.SetRelationTypes(relationTypes)
+
 	// Build map of currently selected child task IDs and their relation types from form state
 	childTaskMap := make(map[int]int) // map[taskID]relationTypeID
 	for _, childRef := range m.Forms.Form.FormChildRefs {
@@ -183,78 +198,88 @@ func GetFilteredLabelPickerItems(m *tui.Model) []state.LabelPickerItem {
 }
 
 // InitPriorityPickerForForm initializes the priority picker for use in task form mode.
-// Loads the current priority from the form state.
+// Loads the project's priorities and selects the task's current one.
 func InitPriorityPickerForForm(m *tui.Model) bool {
-	// Get current priority ID from form state
-	// If we're editing a task, get it from the task detail
-	// Otherwise, default to medium (id=3)
-	currentPriorityID := 3 // Default to medium
+	// If we're editing a task, its priority comes from the task detail and the
+	// scale from its project. Otherwise use the form state and the current
+	// project, falling back to the project's default (middle) priority.
+	ctx, cancel := m.DBContext()
+	defer cancel()
 
-	// If editing an existing task, we need to get the current priority from database
+	projectID := m.AppState.GetCurrentProjectID()
+	currentDescription := m.Forms.Form.FormPriorityDescription
 	if m.Forms.Form.EditingTaskID != 0 {
-		ctx, cancel := m.DBContext()
-		defer cancel()
-
 		taskDetail, err := m.App.TaskService.GetTaskDetail(ctx, m.Forms.Form.EditingTaskID)
 		if err != nil {
 			slog.Error("failed to loading task detail for priority picker", "error", err)
 			return false
 		}
+		projectID = taskDetail.ProjectID
+		currentDescription = taskDetail.PriorityDescription
+	}
+
+	priorities, err := m.App.PriorityService.GetPrioritiesByProject(ctx, projectID)
+	if err != nil || len(priorities) == 0 {
+		slog.Error("failed to loading priorities for priority picker", "error", err)
+		return false
+	}
 
-		// Find the priority ID from the priority description
-		// We need to match it against our priority options
-		priorities := renderers.GetPriorityOptions()
-		for _, p := range priorities {
-			if p.Description == taskDetail.PriorityDescription {
-				currentPriorityID = p.ID
-				break
-			}
+	cursor := len(priorities) / 2
+	for i, p := range priorities {
+		if p.Description == currentDescription {
+			cursor = i
+			break
 		}
 	}
 
 	// Initialize PriorityPickerState
-	m.Pickers.Priority.SetSelectedPriorityID(currentPriorityID)
-	// Set cursor to match the selected priority (adjust for 0-indexing)
-	m.Pickers.Priority.SetCursor(currentPriorityID - 1)
+	m.Pickers.Priority.SetPriorities(priorities)
+	m.Pickers.Priority.SetSelectedPriorityID(priorities[cursor].ID)
+	m.Pickers.Priority.SetCursor(cursor)
 	m.Pickers.Priority.ReturnMode = state.TicketFormMode
 
 	return true
 }
 
 // InitTypePickerForForm initializes the type picker for use in task form mode.
-// Loads the current type from the form state.
+// Loads the project's types and selects the task's current one.
 func InitTypePickerForForm(m *tui.Model) bool {
-	// Get current type ID from form state
-	// If we're editing a task, get it from the task detail
-	// Otherwise, default to task (id=1)
-	currentTypeID := 1 // Default to task
+	// If we're editing a task, its type comes from the task detail and the
+	// list from its project. Otherwise use the form state and the current
+	// project, falling back to the project's default (first) type.
+	ctx, cancel := m.DBContext()
+	defer cancel()
 
-	// If editing an existing task, we need to get the current type from database
+	projectID := m.AppState.GetCurrentProjectID()
+	currentDescription := m.Forms.Form.FormTypeDescription
 	if m.Forms.Form.EditingTaskID != 0 {
-		ctx, cancel := m.DBContext()
-		defer cancel()
-
 		taskDetail, err := m.App.TaskService.GetTaskDetail(ctx, m.Forms.Form.EditingTaskID)
 		if err != nil {
 			slog.Error("failed to loading task detail for type picker", "error", err)
 			return false
 		}
+		projectID = taskDetail.ProjectID
+		currentDescription = taskDetail.TypeDescription
+	}
+
+	types, err := m.App.TypeService.GetTypesByProject(ctx, projectID)
+	if err != nil || len(types) == 0 {
+		slog.Error("failed to loading types for type picker", "error", err)
+		return false
+	}
 
-		// Find the type ID from the type description
-		// We need to match it against our type options
-		types := renderers.GetTypeOptions()
-		for _, t := range types {
-			if t.Description == taskDetail.TypeDescription {
-				currentTypeID = t.ID
-				break
-			}
+	cursor := 0
+	for i, t := range types {
+		if t.Description == currentDescription {
+			cursor = i
+			break
 		}
 	}
 
 	// Initialize TypePickerState
-	m.Pickers.Type.SetSelectedTypeID(currentTypeID)
-	// Set cursor to match the selected type (adjust for 0-indexing)
-	m.Pickers.Type.SetCursor(currentTypeID - 1)
+	m.Pickers.Type.SetTypes(types)
+	m.Pickers.Type.SetSelectedTypeID(types[cursor].ID)
+	m.Pickers.Type.SetCursor(cursor)
 	m.Pickers.Type.ReturnMode = state.TicketFormMode
 
 	return true
@@ -3,8 +3,10 @@ package renderers
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/dates"
 	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/state"
 	"github.com/thenoetrevino/paso/internal/tui/theme"
@@ -37,10 +39,12 @@ func RenderListView(
 ) string {
 	var output strings.Builder
 
-	// Calculate column widths (70% title, 30% status)
-	// Reserve space for selection indicator (2 chars), padding (4 chars), and borders
+	// Calculate column widths (70% title, 30% status, fixed width due date)
+	// Reserve space for selection indicator (2 chars), padding (4 chars), borders,
+	// and the due column with its padding
 	const reservedWidth = 8
-	availableWidth := width - reservedWidth
+	const dueWidth = 10
+	availableWidth := width - reservedWidth - dueWidth - 2
 	titleWidth := int(float64(availableWidth) * 0.7)
 	statusWidth := availableWidth - titleWidth
 
@@ -58,6 +62,7 @@ func RenderListView(
 	// Build header with sort indicators
 	titleHeader := "Title" + getSortIndicator(state.SortByTitle, sortField, sortOrder)
 	statusHeader := "Status" + getSortIndicator(state.SortByStatus, sortField, sortOrder)
+	dueHeader := "Due" + getSortIndicator(state.SortByDue, sortField, sortOrder)
 
 	titleHeaderPadded := truncateString(titleHeader, titleWidth)
 	statusHeaderPadded := truncateString(statusHeader, statusWidth)
@@ -66,7 +71,7 @@ func RenderListView(
 	titleHeaderPadded = titleHeaderPadded + strings.Repeat(" ", titleWidth-len(titleHeaderPadded))
 	statusHeaderPadded = statusHeaderPadded + strings.Repeat(" ", statusWidth-len(statusHeaderPadded))
 
-	header := fmt.Sprintf("  %s  %s", titleHeaderPadded, statusHeaderPadded)
+	header := fmt.Sprintf("  %s  %s  %-*s", titleHeaderPadded, statusHeaderPadded, dueWidth, dueHeader)
 	output.WriteString(headerStyle.Render(header))
 	output.WriteString("\n")
 
@@ -94,6 +99,7 @@ func RenderListView(
 	endIdx := min(scrollOffset+visibleHeight, len(rows))
 
 	// Render visible rows
+	now := time.Now()
 	if len(rows) == 0 {
 		// Empty state
 		emptyStyle := lipgloss.NewStyle().
@@ -114,13 +120,17 @@ func RenderListView(
 			// Pad to full width
 			title = title + strings.Repeat(" ", titleWidth-len(title))
 			status = status + strings.Repeat(" ", statusWidth-len(status))
+			due := ""
+			if row.Task.DueAt != nil {
+				due = truncateString(dates.Relative(*row.Task.DueAt, now), dueWidth)
+			}
 
 			// Build row content
 			var rowContent string
 			if isSelected {
-				rowContent = fmt.Sprintf("> %s  %s", title, status)
+				rowContent = fmt.Sprintf("> %s  %s  %s", title, status, due)
 			} else {
-				rowContent = fmt.Sprintf("  %s  %s", title, status)
+				rowContent = fmt.Sprintf("  %s  %s  %s", title, status, due)
 			}
 
 			// Apply styling
@@ -129,6 +139,9 @@ func RenderListView(
 				rowStyle = lipgloss.NewStyle().
 					Foreground(lipgloss.Color(theme.Highlight)).
 					Bold(true)
+			} else if dates.DueState(row.Task.DueAt, now) == dates.StateOverdue {
+				rowStyle = lipgloss.NewStyle().
+					Foreground(lipgloss.Color(theme.ErrorFg))
 			} else {
 				rowStyle = lipgloss.NewStyle().
 					Foreground(lipgloss.Color(theme.Normal))
@@ -5,63 +5,12 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/components"
 	"github.com/thenoetrevino/paso/internal/tui/state"
 	"github.com/thenoetrevino/paso/internal/tui/theme"
 )
 
-// PriorityOption represents a single priority option in the picker
-type PriorityOption struct {
-	ID          int
-	Description string
-	Color       string
-}
-
-// GetPriorityOptions returns all available priority options
-func GetPriorityOptions() []PriorityOption {
-	return []PriorityOption{
-		{ID: 1, Description: "trivial", Color: "#3B82F6"},
-		{ID: 2, Description: "low", Color: "#22C55E"},
-		{ID: 3, Description: "medium", Color: "#EAB308"},
-		{ID: 4, Description: "high", Color: "#F97316"},
-		{ID: 5, Description: "critical", Color: "#EF4444"},
-	}
-}
-
-// TypeOption represents a single type option in the picker
-type TypeOption struct {
-	ID          int
-	Description string
-	Color       string
-}
-
-// GetTypeOptions returns all available type options
-func GetTypeOptions() []TypeOption {
-	return []TypeOption{
-		{ID: 1, Description: "task", Color: "#3B82F6"},    // Blue
-		{ID: 2, Description: "feature", Color: "#A855F7"}, // Purple
-	}
-}
-
-// RelationTypeOption represents a single relation type option in the picker
-type RelationTypeOption struct {
-	ID         int
-	PToCLabel  string // Label from parent's perspective
-	CToPLabel  string // Label from child's perspective
-	Color      string
-	IsBlocking bool
-}
-
-// GetRelationTypeOptions returns all available relation type options
-// These are system-defined and hardcoded (no CRUD)
-func GetRelationTypeOptions() []RelationTypeOption {
-	return []RelationTypeOption{
-		{ID: 1, PToCLabel: "Parent", CToPLabel: "Child", Color: "#6B7280", IsBlocking: false},
-		{ID: 2, PToCLabel: "Blocked By", CToPLabel: "Blocker", Color: "#EF4444", IsBlocking: true},
-		{ID: 3, PToCLabel: "Related To", CToPLabel: "Related To", Color: "#3B82F6", IsBlocking: false},
-	}
-}
-
 // GetDefaultLabelColors returns the predefined color options for labels
 func GetDefaultLabelColors() []struct {
 	Name  string
@@ -231,6 +180,7 @@ func RenderLabelColorPicker(
 //   - width: Maximum width for the picker content in characters.
 //   - height: Maximum height for the picker content in rows (currently unused but reserved for scrolling).
 //   - isParentPicker: True for parent picker, false for child picker. Determines which label to show.
+//   - relationTypes: The project's relation types keyed by ID, used to label selected items.
 //
 // Returns:
 //   - A formatted string ready for rendering in the terminal.
@@ -242,6 +192,7 @@ func RenderTaskPicker(
 	width int,
 	height int,
 	isParentPicker bool,
+	relationTypes map[int]*models.RelationType,
 ) string {
 	var content strings.Builder
 
@@ -282,18 +233,14 @@ func RenderTaskPicker(
 		var relationLabel string
 		var relationLabelPlain string // For length calculation
 		if item.Selected && item.RelationTypeID > 0 {
-			relationTypes := GetRelationTypeOptions()
-			for _, rt := range relationTypes {
-				if rt.ID == item.RelationTypeID {
-					label := rt.CToPLabel
-					if isParentPicker {
-						label = rt.PToCLabel
-					}
-					labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(rt.Color))
-					relationLabel = labelStyle.Render(label)
-					relationLabelPlain = label
-					break
+			if rt, ok := relationTypes[item.RelationTypeID]; ok {
+				label := rt.CToPLabel
+				if isParentPicker {
+					label = rt.PToCLabel
 				}
+				labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(rt.Color))
+				relationLabel = labelStyle.Render(label)
+				relationLabelPlain = label
 			}
 		}
 
@@ -349,7 +296,7 @@ func RenderTaskPicker(
 
 // RenderPriorityPicker renders the priority picker popup
 func RenderPriorityPicker(
-	priorities []PriorityOption,
+	priorities []*models.Priority,
 	selectedPriorityID int,
 	cursorIdx int,
 	width int,
@@ -383,6 +330,9 @@ func RenderPriorityPicker(
 		// Priority name with color
 		priorityNameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(priority.Color))
 		priorityName := priorityNameStyle.Render(priority.Description)
+		if priority.Icon != "" {
+			priorityName = priority.Icon + " " + priorityName
+		}
 
 		line := indicator + " " + colorBlock + " " + priorityName
 
@@ -403,7 +353,7 @@ func RenderPriorityPicker(
 
 // RenderTypePicker renders the type picker popup
 func RenderTypePicker(
-	types []TypeOption,
+	types []*models.Type,
 	selectedTypeID int,
 	cursorIdx int,
 	width int,
@@ -437,6 +387,9 @@ func RenderTypePicker(
 		// Type name with color
 		typeNameStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(typeOpt.Color))
 		typeName := typeNameStyle.Render(typeOpt.Description)
+		if typeOpt.Icon != "" {
+			typeName = typeOpt.Icon + " " + typeName
+		}
 
 		line := indicator + " " + colorBlock + " " + typeName
 
@@ -457,7 +410,7 @@ func RenderTypePicker(
 
 // RenderRelationTypePicker renders the relation type picker popup
 func RenderRelationTypePicker(
-	relationTypes []RelationTypeOption,
+	relationTypes []*models.RelationType,
 	selectedRelationTypeID int,
 	cursorIdx int,
 	width int,
@@ -1,6 +1,8 @@
 package state
 
 import (
+	"sort"
+
 	"github.com/thenoetrevino/paso/internal/models"
 )
 
@@ -133,6 +135,41 @@ func (s *AppState) SetTasks(tasks map[int][]*models.TaskSummary) {
 	s.totalTaskCount = calculateTotalTaskCount(tasks)
 }
 
+// UpsertTask inserts or replaces a single task summary, keeping each column
+// ordered by position. A task that changed column is removed from its old one.
+// Used to apply live updates without reloading every task.
+func (s *AppState) UpsertTask(task *models.TaskSummary) {
+	s.RemoveTask(task.ID)
+
+	tasks := s.tasks[task.ColumnID]
+	idx := sort.Search(len(tasks), func(i int) bool {
+		return tasks[i].Position > task.Position
+	})
+
+	// Build a new slice; views may still hold the old one
+	updated := make([]*models.TaskSummary, 0, len(tasks)+1)
+	updated = append(updated, tasks[:idx]...)
+	updated = append(updated, task)
+	updated = append(updated, tasks[idx:]...)
+	s.tasks[task.ColumnID] = updated
+	s.totalTaskCount++
+}
+
+// RemoveTask removes a task from whichever column holds it.
+// Returns false if the task is not on the board.
+func (s *AppState) RemoveTask(taskID int) bool {
+	for columnID, tasks := range s.tasks {
+		for i, task := range tasks {
+			if task.ID == taskID {
+				s.tasks[columnID] = append(tasks[:i:i], tasks[i+1:]...)
+				s.totalTaskCount--
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // TotalTaskCount returns the cached total number of tasks across all columns.
 func (s *AppState) TotalTaskCount() int {
 	return s.totalTaskCount
@@ -197,3 +197,45 @@ func TestSetColumns_UpdatesMap(t *testing.T) {
 		t.Error("GetColumnByID(3) after SetColumns failed, map not rebuilt")
 	}
 }
+
+// TestUpsertTask_MovesBetweenColumns ensures live updates keep the board consistent.
+// Edge case: A task moved by another session arrives as a single typed event.
+func TestUpsertTask_MovesBetweenColumns(t *testing.T) {
+	state := NewAppState(nil, 0, nil, map[int][]*models.TaskSummary{
+		1: {{ID: 10, ColumnID: 1, Position: 0}, {ID: 11, ColumnID: 1, Position: 1}},
+		2: {{ID: 20, ColumnID: 2, Position: 0}, {ID: 21, ColumnID: 2, Position: 2}},
+	}, nil)
+
+	state.UpsertTask(&models.TaskSummary{ID: 11, ColumnID: 2, Position: 1, Title: "Moved"})
+
+	if got := len(state.Tasks()[1]); got != 1 {
+		t.Errorf("column 1 has %d tasks after move, want 1", got)
+	}
+	var order []int
+	for _, task := range state.Tasks()[2] {
+		order = append(order, task.ID)
+	}
+	if len(order) != 3 || order[0] != 20 || order[1] != 11 || order[2] != 21 {
+		t.Errorf("column 2 order = %v, want [20 11 21]", order)
+	}
+	if state.TotalTaskCount() != 4 {
+		t.Errorf("TotalTaskCount() = %d, want 4", state.TotalTaskCount())
+	}
+}
+
+// TestRemoveTask_Missing ensures removing an unknown task is a no-op.
+func TestRemoveTask_Missing(t *testing.T) {
+	state := NewAppState(nil, 0, nil, map[int][]*models.TaskSummary{
+		1: {{ID: 10, ColumnID: 1}},
+	}, nil)
+
+	if state.RemoveTask(99) {
+		t.Error("RemoveTask(99) = true, want false")
+	}
+	if !state.RemoveTask(10) {
+		t.Error("RemoveTask(10) = false, want true")
+	}
+	if state.TotalTaskCount() != 0 {
+		t.Errorf("TotalTaskCount() = %d, want 0", state.TotalTaskCount())
+	}
+}
@@ -0,0 +1,97 @@
+package state
+
+import "github.com/thenoetrevino/paso/internal/models"
+
+// ArchiveState manages the archive browser, which lists the current
+// project's archived tasks and lets the user bring them back.
+type ArchiveState struct {
+	// Tasks contains the project's archived tasks, most recently archived first
+	Tasks []*models.ArchivedTask
+
+	// Selected is the index of the highlighted task
+	Selected int
+
+	// ScrollOffset is the index of the first visible task
+	ScrollOffset int
+}
+
+// NewArchiveState creates a new ArchiveState with default values.
+func NewArchiveState() *ArchiveState {
+	return &ArchiveState{
+		Tasks:        []*models.ArchivedTask{},
+		Selected:     0,
+		ScrollOffset: 0,
+	}
+}
+
+// Clear resets all state to default values.
+func (s *ArchiveState) Clear() {
+	s.Tasks = []*models.ArchivedTask{}
+	s.Selected = 0
+	s.ScrollOffset = 0
+}
+
+// SetTasks replaces the task list and selects the first entry.
+func (s *ArchiveState) SetTasks(tasks []*models.ArchivedTask) {
+	s.Tasks = tasks
+	s.Selected = 0
+	s.ScrollOffset = 0
+}
+
+// SelectedTask returns the highlighted task, or nil if the list is empty.
+func (s *ArchiveState) SelectedTask() *models.ArchivedTask {
+	if s.Selected < 0 || s.Selected >= len(s.Tasks) {
+		return nil
+	}
+	return s.Tasks[s.Selected]
+}
+
+// MoveUp selects the previous task, scrolling if needed.
+// Returns true if the selection changed.
+func (s *ArchiveState) MoveUp() bool {
+	if s.Selected == 0 {
+		return false
+	}
+	s.Selected--
+	if s.Selected < s.ScrollOffset {
+		s.ScrollOffset = s.Selected
+	}
+	return true
+}
+
+// MoveDown selects the next task, scrolling if needed.
+// Returns true if the selection changed.
+//
+// Parameters:
+//   - maxVisible: number of tasks that fit on screen at once
+func (s *ArchiveState) MoveDown(maxVisible int) bool {
+	if s.Selected >= len(s.Tasks)-1 {
+		return false
+	}
+	s.Selected++
+	if s.Selected >= s.ScrollOffset+maxVisible {
+		s.ScrollOffset = s.Selected - maxVisible + 1
+	}
+	return true
+}
+
+// Remove drops a task from the list, keeping the selection in range.
+func (s *ArchiveState) Remove(taskID int) {
+	for i, t := range s.Tasks {
+		if t.ID == taskID {
+			s.Tasks = append(s.Tasks[:i], s.Tasks[i+1:]...)
+			break
+		}
+	}
+	if s.Selected >= len(s.Tasks) {
+		s.Selected = max(len(s.Tasks)-1, 0)
+	}
+	if s.ScrollOffset > s.Selected {
+		s.ScrollOffset = s.Selected
+	}
+}
+
+// IsEmpty returns true if the project has no archived tasks
+func (s *ArchiveState) IsEmpty() bool {
+	return len(s.Tasks) == 0
+}
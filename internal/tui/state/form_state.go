@@ -21,6 +21,10 @@ type FormState struct {
 	FormLabelIDs    []int     // Form field: selected label IDs
 	FormConfirm     bool      // Form field: confirmation (submit vs cancel)
 
+	// Task template fields (new tasks only)
+	FormTemplate          string // Form field: name of the task template to create from
+	FormTemplateVariables string // Form field: template variables as name=value pairs
+
 	// Parent/child issue tracking for task form
 	FormParentIDs  []int                   // Selected parent task IDs
 	FormChildIDs   []int                   // Selected child task IDs
@@ -31,12 +35,17 @@ type FormState struct {
 	FormComments        []*models.Comment // Current comments loaded from DB
 	InitialFormComments []*models.Comment // Snapshot for change detection
 
+	// Checklist for task form (edit mode only, saved immediately on toggle)
+	FormChecklist   []*models.ChecklistItem // Checklist items loaded from DB
+	ChecklistCursor int                     // Index of the selected checklist item
+
 	// Comment viewport for scrollable comments section
 	CommentsViewport viewport.Model // Viewport for scrollable comments
 	ViewportReady    bool           // Track if viewport is initialized
 	ViewportFocused  bool           // Track if viewport has focus (for border color)
 
 	// Task metadata for display (edit mode only)
+	FormTicketNumber        int       // Task ticket number, shown in the form title
 	FormCreatedAt           time.Time // Task creation timestamp (only populated in edit mode)
 	FormUpdatedAt           time.Time // Task last update timestamp (only populated in edit mode)
 	FormTypeDescription     string    // Task type (e.g., "task", "feature")
@@ -100,6 +109,7 @@ func NewFormState() *FormState {
 		FormChildRefs:             []*models.TaskReference{},
 		FormComments:              []*models.Comment{},
 		InitialFormComments:       []*models.Comment{},
+		FormChecklist:             []*models.ChecklistItem{},
 		CommentsViewport:          viewport.Model{},
 		ViewportReady:             false,
 		ViewportFocused:           false,
@@ -135,12 +145,16 @@ func (s *FormState) ClearTaskForm() {
 	s.FormDescription = ""
 	s.FormLabelIDs = []int{}
 	s.FormConfirm = true
+	s.FormTemplate = ""
+	s.FormTemplateVariables = ""
 	s.FormParentIDs = []int{}
 	s.FormChildIDs = []int{}
 	s.FormParentRefs = []*models.TaskReference{}
 	s.FormChildRefs = []*models.TaskReference{}
 	s.FormComments = []*models.Comment{}
 	s.InitialFormComments = []*models.Comment{}
+	s.FormChecklist = []*models.ChecklistItem{}
+	s.ChecklistCursor = 0
 	s.CommentsViewport = viewport.Model{}
 	s.ViewportReady = false
 	s.ViewportFocused = false
@@ -212,6 +226,10 @@ func (s *FormState) HasTaskFormChanges() bool {
 		return true
 	}
 
+	if s.FormTemplate != "" {
+		return true
+	}
+
 	if strings.TrimSpace(s.FormDescription) != strings.TrimSpace(s.InitialFormDescription) {
 		return true
 	}
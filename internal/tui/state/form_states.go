@@ -7,6 +7,7 @@ type FormStates struct {
 	Form    *FormState    // Main task form state (title, description, labels, etc.)
 	Input   *InputState   // Input field state (editing mode, cursor position, etc.)
 	Comment *CommentState // Comment state (for managing comments on tasks)
+	History *HistoryState // Activity log state (read-only task history view)
 }
 
 // NewFormStates creates a new FormStates instance with all form states initialized.
@@ -15,5 +16,6 @@ func NewFormStates() *FormStates {
 		Form:    NewFormState(),
 		Input:   NewInputState(),
 		Comment: NewCommentState(),
+		History: NewHistoryState(),
 	}
 }
@@ -0,0 +1,66 @@
+package state
+
+import "github.com/thenoetrevino/paso/internal/models"
+
+// HistoryState manages the read-only activity log view for a task.
+type HistoryState struct {
+	// Events contains the task's activity log, newest first
+	Events []*models.TaskEvent
+
+	// TaskID is the ID of the task being viewed
+	TaskID int
+
+	// ScrollOffset is the index of the first visible event
+	ScrollOffset int
+}
+
+// NewHistoryState creates a new HistoryState with default values.
+func NewHistoryState() *HistoryState {
+	return &HistoryState{
+		Events:       []*models.TaskEvent{},
+		TaskID:       0,
+		ScrollOffset: 0,
+	}
+}
+
+// Clear resets all state to default values.
+func (s *HistoryState) Clear() {
+	s.Events = []*models.TaskEvent{}
+	s.TaskID = 0
+	s.ScrollOffset = 0
+}
+
+// SetEvents replaces the event list and scrolls back to the newest entry.
+func (s *HistoryState) SetEvents(taskID int, events []*models.TaskEvent) {
+	s.TaskID = taskID
+	s.Events = events
+	s.ScrollOffset = 0
+}
+
+// ScrollUp scrolls one entry towards the newest event.
+// Returns true if the offset changed.
+func (s *HistoryState) ScrollUp() bool {
+	if s.ScrollOffset > 0 {
+		s.ScrollOffset--
+		return true
+	}
+	return false
+}
+
+// ScrollDown scrolls one entry towards the oldest event.
+// Returns true if the offset changed.
+//
+// Parameters:
+//   - maxVisible: number of events that fit on screen at once
+func (s *HistoryState) ScrollDown(maxVisible int) bool {
+	if s.ScrollOffset+maxVisible < len(s.Events) {
+		s.ScrollOffset++
+		return true
+	}
+	return false
+}
+
+// IsEmpty returns true if the task has no recorded history
+func (s *HistoryState) IsEmpty() bool {
+	return len(s.Events) == 0
+}
@@ -16,6 +16,7 @@ const (
 	SortNone     SortField = iota // No sorting applied
 	SortByTitle                   // Sort by task title
 	SortByStatus                  // Sort by column/status
+	SortByDue                     // Sort by due date, tasks without one last
 )
 
 // SortOrder represents the sort direction.
@@ -132,7 +133,8 @@ func (s *ListViewState) SortOrder() SortOrder {
 }
 
 // CycleSort cycles through the sort configurations.
-// Order: None -> Title Asc -> Title Desc -> Status Asc -> Status Desc -> None
+// Order: None -> Title Asc -> Title Desc -> Status Asc -> Status Desc ->
+// Due Asc -> Due Desc -> None
 func (s *ListViewState) CycleSort() {
 	switch {
 	case s.sortField == SortNone:
@@ -150,7 +152,14 @@ func (s *ListViewState) CycleSort() {
 		// Status Asc -> Status Desc
 		s.sortOrder = SortDesc
 	case s.sortField == SortByStatus && s.sortOrder == SortDesc:
-		// Status Desc -> None
+		// Status Desc -> Due Asc
+		s.sortField = SortByDue
+		s.sortOrder = SortAsc
+	case s.sortField == SortByDue && s.sortOrder == SortAsc:
+		// Due Asc -> Due Desc
+		s.sortOrder = SortDesc
+	case s.sortField == SortByDue && s.sortOrder == SortDesc:
+		// Due Desc -> None
 		s.sortField = SortNone
 		s.sortOrder = SortAsc
 	default:
@@ -9,7 +9,7 @@ const (
 	// LevelInfo represents informational notifications (blue, bell icon)
 	LevelInfo NotificationLevel = iota
 	// LevelWarning represents warning notifications (yellow, warning icon)
-	LevelWarning // currently not used
+	LevelWarning
 	// LevelError represents error notifications (red, error icon)
 	LevelError
 )
@@ -1,9 +1,14 @@
 package state
 
+import "github.com/thenoetrevino/paso/internal/models"
+
 // PriorityPickerState manages the priority picker modal state.
 // This modal allows users to change the priority of a task.
 type PriorityPickerState struct {
-	// selectedPriorityID is the currently selected priority ID
+	// priorities is the current project's priority scale, lowest first
+	priorities []*models.Priority
+
+	// selectedPriorityID is the currently selected priority ID (0 means the project default)
 	selectedPriorityID int
 
 	// cursor is the current cursor position in the priority picker
@@ -16,12 +21,23 @@ type PriorityPickerState struct {
 // NewPriorityPickerState creates a new PriorityPickerState with default values.
 func NewPriorityPickerState() *PriorityPickerState {
 	return &PriorityPickerState{
-		selectedPriorityID: 3, // Default to medium (id=3)
+		priorities:         []*models.Priority{},
+		selectedPriorityID: 0,
 		cursor:             0,
 		ReturnMode:         TicketFormMode,
 	}
 }
 
+// Priorities returns the list of available priorities.
+func (s *PriorityPickerState) Priorities() []*models.Priority {
+	return s.priorities
+}
+
+// SetPriorities updates the list of available priorities.
+func (s *PriorityPickerState) SetPriorities(priorities []*models.Priority) {
+	s.priorities = priorities
+}
+
 // SelectedPriorityID returns the currently selected priority ID.
 func (s *PriorityPickerState) SelectedPriorityID() int {
 	return s.selectedPriorityID
@@ -50,17 +66,24 @@ func (s *PriorityPickerState) MoveUp() {
 }
 
 // MoveDown moves the cursor down one position if possible.
-// There are 5 priority levels (indices 0-4).
 func (s *PriorityPickerState) MoveDown() {
-	const maxPriorities = 5
-	if s.cursor < maxPriorities-1 {
+	if len(s.priorities) > 0 && s.cursor < len(s.priorities)-1 {
 		s.cursor++
 	}
 }
 
+// PriorityAtCursor returns the priority under the cursor.
+// Returns nil if no priorities are loaded or cursor is out of bounds.
+func (s *PriorityPickerState) PriorityAtCursor() *models.Priority {
+	if s.cursor < 0 || s.cursor >= len(s.priorities) {
+		return nil
+	}
+	return s.priorities[s.cursor]
+}
+
 // Reset resets all state to default values.
 func (s *PriorityPickerState) Reset() {
-	s.selectedPriorityID = 3 // Default to medium
+	s.selectedPriorityID = 0 // Project default
 	s.cursor = 0
 	s.ReturnMode = TicketFormMode
 }
@@ -0,0 +1,110 @@
+package state
+
+import "github.com/thenoetrevino/paso/internal/models"
+
+// Relation choices of the project picker, named like the values of
+// paso task move --relations
+const (
+	RelationsAsk  = ""
+	RelationsKeep = "keep"
+	RelationsDrop = "drop"
+)
+
+// ProjectPickerState manages the project picker modal state.
+// This modal allows users to move a task to another project.
+type ProjectPickerState struct {
+	// taskID is the ID of the task being moved
+	taskID int
+
+	// projects is the list of projects the task can move to
+	projects []*models.Project
+
+	// cursor is the current cursor position in the project picker
+	cursor int
+
+	// relations is what happens to relations that would cross projects:
+	// RelationsAsk refuses the move when there are any
+	relations string
+}
+
+// NewProjectPickerState creates a new ProjectPickerState with default values.
+func NewProjectPickerState() *ProjectPickerState {
+	return &ProjectPickerState{
+		taskID:   0,
+		projects: []*models.Project{},
+		cursor:   0,
+	}
+}
+
+// TaskID returns the ID of the task being moved.
+func (s *ProjectPickerState) TaskID() int {
+	return s.taskID
+}
+
+// SetTaskID updates the task ID.
+func (s *ProjectPickerState) SetTaskID(id int) {
+	s.taskID = id
+}
+
+// Projects returns the list of target projects.
+func (s *ProjectPickerState) Projects() []*models.Project {
+	return s.projects
+}
+
+// SetProjects updates the list of target projects.
+func (s *ProjectPickerState) SetProjects(projects []*models.Project) {
+	s.projects = projects
+}
+
+// Cursor returns the current cursor position.
+func (s *ProjectPickerState) Cursor() int {
+	return s.cursor
+}
+
+// MoveUp moves the cursor up one position if possible.
+func (s *ProjectPickerState) MoveUp() {
+	if s.cursor > 0 {
+		s.cursor--
+	}
+}
+
+// MoveDown moves the cursor down one position if possible.
+func (s *ProjectPickerState) MoveDown() {
+	if len(s.projects) > 0 && s.cursor < len(s.projects)-1 {
+		s.cursor++
+	}
+}
+
+// SelectedProject returns the currently selected project.
+// Returns nil if no projects are available or cursor is out of bounds.
+func (s *ProjectPickerState) SelectedProject() *models.Project {
+	if len(s.projects) == 0 || s.cursor < 0 || s.cursor >= len(s.projects) {
+		return nil
+	}
+	return s.projects[s.cursor]
+}
+
+// Relations returns the policy for relations that would cross projects.
+func (s *ProjectPickerState) Relations() string {
+	return s.relations
+}
+
+// CycleRelations switches the relation policy between ask, keep and drop.
+func (s *ProjectPickerState) CycleRelations() {
+	switch s.relations {
+	case RelationsAsk:
+		s.relations = RelationsKeep
+	case RelationsKeep:
+		s.relations = RelationsDrop
+	default:
+		s.relations = RelationsAsk
+	}
+}
+
+// Reset resets all state to default values.
+func (s *ProjectPickerState) Reset() {
+	s.taskID = 0
+	s.projects = []*models.Project{}
+	s.cursor = 0
+	s.relations = RelationsAsk
+}
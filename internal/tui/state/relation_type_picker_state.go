@@ -5,6 +5,9 @@ import "github.com/thenoetrevino/paso/internal/models"
 // RelationTypePickerState manages the relation type picker modal state.
 // This modal allows users to change the relation type when adding parent/child tasks.
 type RelationTypePickerState struct {
+	// relationTypes are the built-in relation types followed by the project's own
+	relationTypes []*models.RelationType
+
 	// selectedRelationTypeID is the currently selected relation type ID
 	selectedRelationTypeID int
 
@@ -21,6 +24,7 @@ type RelationTypePickerState struct {
 // NewRelationTypePickerState creates a new RelationTypePickerState with default values.
 func NewRelationTypePickerState() *RelationTypePickerState {
 	return &RelationTypePickerState{
+		relationTypes:          []*models.RelationType{},
 		selectedRelationTypeID: models.DefaultRelationTypeID, // Default to Parent/Child
 		cursor:                 0,
 		ReturnMode:             ParentPickerMode,
@@ -28,6 +32,16 @@ func NewRelationTypePickerState() *RelationTypePickerState {
 	}
 }
 
+// RelationTypes returns the list of available relation types.
+func (s *RelationTypePickerState) RelationTypes() []*models.RelationType {
+	return s.relationTypes
+}
+
+// SetRelationTypes updates the list of available relation types.
+func (s *RelationTypePickerState) SetRelationTypes(relationTypes []*models.RelationType) {
+	s.relationTypes = relationTypes
+}
+
 // SelectedRelationTypeID returns the currently selected relation type ID.
 func (s *RelationTypePickerState) SelectedRelationTypeID() int {
 	return s.selectedRelationTypeID
@@ -66,13 +80,21 @@ func (s *RelationTypePickerState) MoveUp() {
 }
 
 // MoveDown moves the cursor down one position if possible.
-// There are 3 relation types (indices 0-2).
 func (s *RelationTypePickerState) MoveDown() {
-	if s.cursor < models.MaxRelationTypes-1 {
+	if len(s.relationTypes) > 0 && s.cursor < len(s.relationTypes)-1 {
 		s.cursor++
 	}
 }
 
+// RelationTypeAtCursor returns the relation type under the cursor.
+// Returns nil if no relation types are loaded or cursor is out of bounds.
+func (s *RelationTypePickerState) RelationTypeAtCursor() *models.RelationType {
+	if s.cursor < 0 || s.cursor >= len(s.relationTypes) {
+		return nil
+	}
+	return s.relationTypes[s.cursor]
+}
+
 // Reset resets all state to default values.
 func (s *RelationTypePickerState) Reset() {
 	s.selectedRelationTypeID = models.DefaultRelationTypeID // Default to Parent/Child
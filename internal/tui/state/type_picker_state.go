@@ -1,9 +1,14 @@
 package state
 
+import "github.com/thenoetrevino/paso/internal/models"
+
 // TypePickerState manages the type picker modal state.
-// This modal allows users to change the type of a task (task vs feature).
+// This modal allows users to change the type of a task.
 type TypePickerState struct {
-	// selectedTypeID is the currently selected type ID
+	// types is the current project's types, default first
+	types []*models.Type
+
+	// selectedTypeID is the currently selected type ID (0 means the project default)
 	selectedTypeID int
 
 	// cursor is the current cursor position in the type picker
@@ -16,12 +21,23 @@ type TypePickerState struct {
 // NewTypePickerState creates a new TypePickerState with default values.
 func NewTypePickerState() *TypePickerState {
 	return &TypePickerState{
-		selectedTypeID: 1, // Default to task (id=1)
+		types:          []*models.Type{},
+		selectedTypeID: 0,
 		cursor:         0,
 		ReturnMode:     TicketFormMode,
 	}
 }
 
+// Types returns the list of available types.
+func (s *TypePickerState) Types() []*models.Type {
+	return s.types
+}
+
+// SetTypes updates the list of available types.
+func (s *TypePickerState) SetTypes(types []*models.Type) {
+	s.types = types
+}
+
 // SelectedTypeID returns the currently selected type ID.
 func (s *TypePickerState) SelectedTypeID() int {
 	return s.selectedTypeID
@@ -50,17 +66,24 @@ func (s *TypePickerState) MoveUp() {
 }
 
 // MoveDown moves the cursor down one position if possible.
-// There are 2 type options (indices 0-1).
 func (s *TypePickerState) MoveDown() {
-	const maxTypes = 2
-	if s.cursor < maxTypes-1 {
+	if len(s.types) > 0 && s.cursor < len(s.types)-1 {
 		s.cursor++
 	}
 }
 
+// TypeAtCursor returns the type under the cursor.
+// Returns nil if no types are loaded or cursor is out of bounds.
+func (s *TypePickerState) TypeAtCursor() *models.Type {
+	if s.cursor < 0 || s.cursor >= len(s.types) {
+		return nil
+	}
+	return s.types[s.cursor]
+}
+
 // Reset resets all state to default values.
 func (s *TypePickerState) Reset() {
-	s.selectedTypeID = 1 // Default to task
+	s.selectedTypeID = 0 // Project default
 	s.cursor = 0
 	s.ReturnMode = TicketFormMode
 }
@@ -7,6 +7,8 @@ type UIElements struct {
 	Notification *NotificationState // Notification state (for displaying user messages)
 	Search       *SearchState       // Search state (for filtering/searching tasks)
 	ListView     *ListViewState     // List view state (for rendering tasks in list format)
+	Archive      *ArchiveState      // Archive browser state (archived tasks of the current project)
+	Undo         *UndoState         // Undo/redo stacks for board mutations
 }
 
 // NewUIElements creates a new UIElements instance with all UI element states initialized.
@@ -15,5 +17,7 @@ func NewUIElements() *UIElements {
 		Notification: NewNotificationState(),
 		Search:       NewSearchState(),
 		ListView:     NewListViewState(),
+		Archive:      NewArchiveState(),
+		Undo:         NewUndoState(),
 	}
 }
@@ -28,6 +28,9 @@ const (
 	SearchMode                          // Vim-style search mode (/)
 	StatusPickerMode                    // Status picker popup for list view
 	TaskFormHelpMode                    // Help screen for task form shortcuts
+	TaskHistoryMode                     // Read-only activity log for a task
+	ArchiveBrowserMode                  // Archived tasks of the current project
+	ProjectPickerMode                   // Project picker popup for moving a task
 )
 
 // UsesLayers returns true if this mode uses layer-based rendering.
@@ -43,6 +46,8 @@ func (m Mode) UsesLayers() bool {
 		CommentsViewMode,
 		HelpMode,
 		TaskFormHelpMode,
+		TaskHistoryMode,
+		ArchiveBrowserMode,
 		LabelPickerMode,
 		ParentPickerMode,
 		ChildPickerMode,
@@ -50,6 +55,7 @@ func (m Mode) UsesLayers() bool {
 		TypePickerMode,
 		RelationTypePickerMode,
 		StatusPickerMode,
+		ProjectPickerMode,
 		DiscardConfirmMode,
 		NormalMode,
 		SearchMode:
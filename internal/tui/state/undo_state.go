@@ -0,0 +1,84 @@
+package state
+
+import "context"
+
+// maxUndoDepth is the number of actions kept on the undo stack
+const maxUndoDepth = 50
+
+// UndoAction is a TUI mutation that can be reversed and applied again.
+// Undo and Redo call the services directly and must be safe to run
+// repeatedly in alternation.
+type UndoAction struct {
+	// Description names the action for notifications, e.g. `delete task "Fix bug"`
+	Description string
+
+	// Undo reverses the action
+	Undo func(ctx context.Context) error
+
+	// Redo applies the action again after it was undone
+	Redo func(ctx context.Context) error
+}
+
+// UndoState holds the undo and redo stacks of the current session.
+type UndoState struct {
+	undo []UndoAction
+	redo []UndoAction
+}
+
+// NewUndoState creates a new UndoState with empty stacks.
+func NewUndoState() *UndoState {
+	return &UndoState{}
+}
+
+// Push records a new action. It clears the redo stack, since redoing
+// actions from another branch of history would not make sense.
+func (s *UndoState) Push(action UndoAction) {
+	s.undo = append(s.undo, action)
+	if len(s.undo) > maxUndoDepth {
+		s.undo = s.undo[len(s.undo)-maxUndoDepth:]
+	}
+	s.redo = nil
+}
+
+// PopUndo removes and returns the most recent action.
+// Returns false if there is nothing to undo.
+func (s *UndoState) PopUndo() (UndoAction, bool) {
+	if len(s.undo) == 0 {
+		return UndoAction{}, false
+	}
+	action := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	return action, true
+}
+
+// PopRedo removes and returns the most recently undone action.
+// Returns false if there is nothing to redo.
+func (s *UndoState) PopRedo() (UndoAction, bool) {
+	if len(s.redo) == 0 {
+		return UndoAction{}, false
+	}
+	action := s.redo[len(s.redo)-1]
+	s.redo = s.redo[:len(s.redo)-1]
+	return action, true
+}
+
+// Undone records an action that was just undone, so it can be redone.
+func (s *UndoState) Undone(action UndoAction) {
+	s.redo = append(s.redo, action)
+}
+
+// Redone records an action that was just redone, so it can be undone again
+// without clearing the rest of the redo stack.
+func (s *UndoState) Redone(action UndoAction) {
+	s.undo = append(s.undo, action)
+}
+
+// CanUndo returns true if there is an action to undo
+func (s *UndoState) CanUndo() bool {
+	return len(s.undo) > 0
+}
+
+// CanRedo returns true if there is an action to redo
+func (s *UndoState) CanRedo() bool {
+	return len(s.redo) > 0
+}
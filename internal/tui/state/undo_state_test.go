@@ -0,0 +1,85 @@
+package state
+
+import (
+	"fmt"
+	"testing"
+)
+
+func action(name string) UndoAction {
+	return UndoAction{Description: name}
+}
+
+// TestUndoState_UndoRedo ensures actions come back in reverse order and
+// move between the two stacks.
+func TestUndoState_UndoRedo(t *testing.T) {
+	s := NewUndoState()
+	if s.CanUndo() || s.CanRedo() {
+		t.Fatal("new UndoState should be empty")
+	}
+
+	s.Push(action("first"))
+	s.Push(action("second"))
+
+	got, ok := s.PopUndo()
+	if !ok || got.Description != "second" {
+		t.Fatalf("PopUndo() = %q, %v, want \"second\", true", got.Description, ok)
+	}
+	s.Undone(got)
+
+	got, ok = s.PopRedo()
+	if !ok || got.Description != "second" {
+		t.Fatalf("PopRedo() = %q, %v, want \"second\", true", got.Description, ok)
+	}
+	s.Redone(got)
+
+	if !s.CanUndo() || s.CanRedo() {
+		t.Errorf("after redo: CanUndo() = %v, CanRedo() = %v, want true, false", s.CanUndo(), s.CanRedo())
+	}
+}
+
+// TestUndoState_PushClearsRedo ensures a new action drops undone actions,
+// while redoing one keeps the rest of the redo stack.
+func TestUndoState_PushClearsRedo(t *testing.T) {
+	s := NewUndoState()
+	s.Push(action("first"))
+	s.Push(action("second"))
+
+	for range 2 {
+		a, _ := s.PopUndo()
+		s.Undone(a)
+	}
+
+	a, _ := s.PopRedo()
+	s.Redone(a)
+	if !s.CanRedo() {
+		t.Fatal("Redone() should keep the remaining redo stack")
+	}
+
+	s.Push(action("third"))
+	if s.CanRedo() {
+		t.Error("Push() should clear the redo stack")
+	}
+}
+
+// TestUndoState_MaxDepth ensures the oldest actions are dropped once the
+// stack is full.
+func TestUndoState_MaxDepth(t *testing.T) {
+	s := NewUndoState()
+	for i := range maxUndoDepth + 5 {
+		s.Push(action(fmt.Sprintf("action %d", i)))
+	}
+
+	count := 0
+	var last UndoAction
+	for s.CanUndo() {
+		last, _ = s.PopUndo()
+		count++
+	}
+
+	if count != maxUndoDepth {
+		t.Errorf("stack held %d actions, want %d", count, maxUndoDepth)
+	}
+	if last.Description != "action 5" {
+		t.Errorf("oldest kept action = %q, want \"action 5\"", last.Description)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/thenoetrevino/paso/internal/services/column"
 	"github.com/thenoetrevino/paso/internal/services/label"
 	"github.com/thenoetrevino/paso/internal/services/project"
+	"github.com/thenoetrevino/paso/internal/services/relationtype"
 	"github.com/thenoetrevino/paso/internal/services/task"
 	"github.com/thenoetrevino/paso/internal/testutil"
 	"github.com/thenoetrevino/paso/internal/tui/state"
@@ -32,6 +33,8 @@ func SetupTestModelWithDB(t *testing.T) (Model, *sql.DB) {
 		ColumnService:  column.NewService(db, nil),
 		LabelService:   label.NewService(db, nil),
 		ProjectService: project.NewService(db, nil),
+
+		RelationTypeService: relationtype.NewService(db, nil),
 	}
 
 	// Create test project and columns
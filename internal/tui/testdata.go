@@ -105,16 +105,20 @@ func createTestModelWithProjects(numProjects int, columnsPerProject int, tasksPe
 			Type:         state.NewTypePickerState(),
 			RelationType: state.NewRelationTypePickerState(),
 			Status:       state.NewStatusPickerState(),
+			Project:      state.NewProjectPickerState(),
 		},
 		Forms: &state.FormStates{
 			Input:   state.NewInputState(),
 			Form:    state.NewFormState(),
 			Comment: state.NewCommentState(),
+			History: state.NewHistoryState(),
 		},
 		UI: &state.UIElements{
 			Notification: state.NewNotificationState(),
 			Search:       state.NewSearchState(),
 			ListView:     state.NewListViewState(),
+			Archive:      state.NewArchiveState(),
+			Undo:         state.NewUndoState(),
 		},
 	}
 }
@@ -57,15 +57,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case RefreshMsg:
 		currentProject := m.AppState.GetCurrentProject()
-		// Reload if event is for current project OR for all projects (0)
+		// Apply if event is for current project OR for all projects (0).
+		// Project events also change the project list, whichever project they name.
+		isProjectEvent := msg.Event.Type == events.EventProjectCreated ||
+			msg.Event.Type == events.EventProjectUpdated ||
+			msg.Event.Type == events.EventProjectDeleted
+		willApply := currentProject != nil &&
+			(msg.Event.ProjectID == currentProject.ID || msg.Event.ProjectID == 0 || isProjectEvent)
 		if currentProject != nil {
 			slog.Info("received refresh event",
+				"event_type", msg.Event.Type,
 				"event_project_id", msg.Event.ProjectID,
 				"current_project_id", currentProject.ID,
-				"will_reload", msg.Event.ProjectID == currentProject.ID || msg.Event.ProjectID == 0)
+				"will_apply", willApply)
 		}
-		if currentProject != nil && (msg.Event.ProjectID == currentProject.ID || msg.Event.ProjectID == 0) {
-			m.reloadCurrentProject()
+		if willApply {
+			m.applyEvent(msg.Event)
 		}
 
 		cmd = m.subscribeToEvents()
@@ -108,6 +115,10 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleDeleteColumnConfirm(msg)
 	case state.CommentsViewMode:
 		return m.handleCommentsViewInput(msg)
+	case state.TaskHistoryMode:
+		return m.handleHistoryViewInput(msg)
+	case state.ArchiveBrowserMode:
+		return m.handleArchiveBrowserInput(msg)
 	case state.HelpMode:
 		switch msg.String() {
 		case m.Config.KeyMappings.ShowHelp, m.Config.KeyMappings.Quit, "esc", "enter", " ":
@@ -138,6 +149,8 @@ func (m Model) handleKeyMsg(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleSearchMode(msg)
 	case state.StatusPickerMode:
 		return m.handleStatusPickerMode(msg)
+	case state.ProjectPickerMode:
+		return m.handleProjectPickerMode(msg)
 	}
 	return m, nil
 }
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"fmt"
+	"log/slog"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/thenoetrevino/paso/internal/tui/state"
+)
+
+// handleArchiveTask archives the selected task, taking it off the board
+func (m Model) handleArchiveTask() (tea.Model, tea.Cmd) {
+	task := m.getCurrentTask()
+	if task == nil {
+		m.UI.Notification.Add(state.LevelError, "No task selected to archive")
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	if err := m.App.TaskService.ArchiveTask(ctx, task.ID); err != nil {
+		slog.Error("failed to archive task", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to archive task")
+		return m, nil
+	}
+
+	m.removeCurrentTask()
+	m.recordArchive(task.ID, task.Title, true)
+	m.UI.Notification.Add(state.LevelInfo, fmt.Sprintf("Archived \"%s\"", task.Title))
+	return m, nil
+}
+
+// handleOpenArchiveBrowser loads the current project's archived tasks and
+// opens the archive browser on top of the board
+func (m Model) handleOpenArchiveBrowser() (tea.Model, tea.Cmd) {
+	project := m.getCurrentProject()
+	if project == nil {
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	tasks, err := m.App.TaskService.GetArchivedTasksByProject(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading archived tasks", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to load archived tasks")
+		return m, nil
+	}
+
+	m.UI.Archive.SetTasks(tasks)
+	m.UIState.SetMode(state.ArchiveBrowserMode)
+	return m, nil
+}
+
+// handleArchiveBrowserInput handles keyboard input in the archive browser
+func (m Model) handleArchiveBrowserInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.UI.Archive.MoveUp()
+	case "down", "j":
+		// Must match the height calculation in renderArchiveBrowserLayer
+		layerHeight := m.UIState.Height() * 8 / 10
+		m.UI.Archive.MoveDown(archiveMaxVisible(layerHeight))
+	case "enter", "u":
+		return m.unarchiveSelectedTask()
+	case "esc", "q", m.Config.KeyMappings.ShowArchive:
+		m.UI.Archive.Clear()
+		m.UIState.SetMode(state.NormalMode)
+	}
+	return m, nil
+}
+
+// unarchiveSelectedTask puts the highlighted archived task back on the board
+func (m Model) unarchiveSelectedTask() (tea.Model, tea.Cmd) {
+	task := m.UI.Archive.SelectedTask()
+	if task == nil {
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	if err := m.App.TaskService.UnarchiveTask(ctx, task.ID); err != nil {
+		slog.Error("failed to unarchive task", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to restore task")
+		return m, nil
+	}
+
+	m.UI.Archive.Remove(task.ID)
+	m.refreshTask(task.ID)
+	m.recordArchive(task.ID, task.Title, false)
+	m.UI.Notification.Add(state.LevelInfo, fmt.Sprintf("Restored \"%s\" to %s", task.Title, task.ColumnName))
+	return m, nil
+}
@@ -41,12 +41,22 @@ func (m Model) confirmDeleteTask() (tea.Model, tea.Cmd) {
 	if task != nil {
 		ctx, cancel := m.DBContext()
 		defer cancel()
+
+		// Snapshot the task first so the delete can be undone
+		snapshot, snapErr := m.App.TaskService.SnapshotTask(ctx, task.ID)
+		if snapErr != nil {
+			slog.Error("failed to snapshot task for undo", "error", snapErr)
+		}
+
 		err := m.App.TaskService.DeleteTask(ctx, task.ID)
 		if err != nil {
 			slog.Error("failed to delete task", "error", err)
 			m.UI.Notification.Add(state.LevelError, "Failed to delete task")
 		} else {
 			m.removeCurrentTask()
+			if snapErr == nil {
+				m.recordTaskDelete(snapshot)
+			}
 		}
 	}
 	m.UIState.SetMode(state.NormalMode)
@@ -171,6 +181,7 @@ func (m Model) confirmDeleteColumn() (tea.Model, tea.Cmd) {
 		} else {
 			delete(m.AppState.Tasks(), column.ID)
 			m.removeCurrentColumn()
+			m.recordColumnDelete(column)
 		}
 	}
 	m.UIState.SetMode(state.NormalMode)
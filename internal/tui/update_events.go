@@ -0,0 +1,147 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/thenoetrevino/paso/internal/events"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	"github.com/thenoetrevino/paso/internal/tui/state"
+)
+
+// applyEvent updates the board for an event from another session.
+// Typed (protocol v2) events only refetch the entities they name, so a busy
+// project doesn't reload every column and task on each keystroke elsewhere.
+// db_changed (protocol v1, or a coalesced burst) falls back to a full reload.
+func (m *Model) applyEvent(event events.Event) {
+	switch event.Type {
+	case events.EventTaskCreated, events.EventTaskUpdated, events.EventTaskMoved,
+		events.EventLabelAttached, events.EventLabelDetached:
+		m.refreshTask(event.TaskID)
+
+	case events.EventTaskLinked, events.EventTaskUnlinked:
+		// Blocking relations change the blocked badge on both ends
+		m.refreshTask(event.TaskID)
+		m.refreshTask(event.RelatedTaskID)
+
+	case events.EventTaskUnblocked:
+		m.notifyUnblocked(event.TaskID)
+
+	case events.EventTaskDeleted, events.EventTaskArchived:
+		m.AppState.RemoveTask(event.TaskID)
+
+	case events.EventTaskUnarchived:
+		m.refreshTask(event.TaskID)
+
+	case events.EventCommentAdded, events.EventCommentUpdated, events.EventCommentDeleted:
+		// Comments are not shown on the board
+
+	case events.EventColumnCreated, events.EventColumnRenamed, events.EventColumnUpdated:
+		m.reloadColumns()
+
+	case events.EventLabelCreated:
+		m.reloadLabels()
+
+	case events.EventProjectCreated, events.EventProjectUpdated:
+		m.reloadProjects()
+		// Renamed or recoloured types and priorities show on every task card,
+		// and a relation type's blocking flag changes which tasks are blocked
+		if slices.Contains(event.Fields, "types") || slices.Contains(event.Fields, "priorities") ||
+			slices.Contains(event.Fields, "relation_types") {
+			m.reloadCurrentProject()
+		}
+
+	case events.EventProjectDeleted:
+		m.reloadProjects()
+		m.reloadCurrentProject()
+
+	default:
+		// db_changed, column/label deletions and unknown event types
+		m.reloadCurrentProject()
+		// A bulk change sends one db_changed naming the tasks it unblocked
+		for _, id := range event.Unblocked {
+			m.notifyUnblocked(id)
+		}
+	}
+}
+
+// notifyUnblocked refreshes a task that is no longer blocked and tells the
+// user about it
+func (m *Model) notifyUnblocked(taskID int) {
+	if task := m.refreshTask(taskID); task != nil {
+		m.UI.Notification.Add(state.LevelInfo, fmt.Sprintf("'%s' is no longer blocked", task.Title))
+	}
+}
+
+// refreshTask refetches one task summary and puts it in place on the board.
+// A task that no longer exists or is archived is removed. Returns the task if
+// it is on the board.
+func (m *Model) refreshTask(taskID int) *models.TaskSummary {
+	if taskID <= 0 {
+		return nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	task, err := m.App.TaskService.GetTaskSummary(ctx, taskID)
+	if errors.Is(err, taskservice.ErrTaskNotFound) {
+		m.AppState.RemoveTask(taskID)
+		return nil
+	}
+	if err != nil {
+		slog.Error("failed to refreshing task", "task_id", taskID, "error", err)
+		m.HandleDBError(err, "refresh task")
+		return nil
+	}
+
+	// The task may have moved to another project or been archived
+	if m.AppState.GetColumnByID(task.ColumnID) == nil || task.ArchivedAt != nil {
+		m.AppState.RemoveTask(taskID)
+		return nil
+	}
+
+	m.AppState.UpsertTask(task)
+	return task
+}
+
+// reloadColumns refreshes the columns of the current project
+func (m *Model) reloadColumns() {
+	currentProject := m.AppState.GetCurrentProject()
+	if currentProject == nil {
+		return
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	columns, err := m.App.ColumnService.GetColumnsByProject(ctx, currentProject.ID)
+	if err != nil {
+		slog.Error("failed to reloading columns", "error", err)
+		m.HandleDBError(err, "reload columns")
+		return
+	}
+	m.AppState.SetColumns(columns)
+}
+
+// reloadLabels refreshes the labels of the current project
+func (m *Model) reloadLabels() {
+	currentProject := m.AppState.GetCurrentProject()
+	if currentProject == nil {
+		return
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	labels, err := m.App.LabelService.GetLabelsByProject(ctx, currentProject.ID)
+	if err != nil {
+		slog.Error("failed to reloading labels", "error", err)
+		m.HandleDBError(err, "reload labels")
+		return
+	}
+	m.AppState.SetLabels(labels)
+}
@@ -2,6 +2,8 @@ package tui
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 	"strings"
 
@@ -16,20 +18,24 @@ import (
 )
 
 type taskFormValues struct {
-	title       string
-	description string
-	confirm     bool
-	labelIDs    []int
+	title             string
+	description       string
+	confirm           bool
+	labelIDs          []int
+	template          string
+	templateVariables string
 }
 
 // extractTaskFormValues extracts and returns form values from the task form
 // Since our forms update pointers in place, we can just read from formState
 func (m Model) extractTaskFormValues() taskFormValues {
 	return taskFormValues{
-		title:       strings.TrimSpace(m.Forms.Form.FormTitle),
-		description: strings.TrimSpace(m.Forms.Form.FormDescription),
-		confirm:     m.Forms.Form.FormConfirm,
-		labelIDs:    m.Forms.Form.FormLabelIDs,
+		title:             strings.TrimSpace(m.Forms.Form.FormTitle),
+		description:       strings.TrimSpace(m.Forms.Form.FormDescription),
+		confirm:           m.Forms.Form.FormConfirm,
+		labelIDs:          m.Forms.Form.FormLabelIDs,
+		template:          m.Forms.Form.FormTemplate,
+		templateVariables: m.Forms.Form.FormTemplateVariables,
 	}
 }
 
@@ -62,7 +68,7 @@ func (m *Model) createNewTaskWithLabelsAndRelationships(values taskFormValues) {
 	}
 
 	// 1. Create the task with all data in one call
-	task, err := m.App.TaskService.CreateTask(ctx, taskService.CreateTaskRequest{
+	req := taskService.CreateTaskRequest{
 		Title:       values.title,
 		Description: values.description,
 		ColumnID:    currentCol.ID,
@@ -70,10 +76,23 @@ func (m *Model) createNewTaskWithLabelsAndRelationships(values taskFormValues) {
 		LabelIDs:    values.labelIDs,
 		ParentIDs:   parentIDs,
 		ChildIDs:    childIDs,
-	})
+		PriorityID:  m.Pickers.Priority.SelectedPriorityID(),
+		TypeID:      m.Pickers.Type.SelectedTypeID(),
+	}
+	var task *models.Task
+	var err error
+	if values.template != "" {
+		task, err = m.createTaskFromTemplate(ctx, values, req)
+	} else {
+		task, err = m.App.TaskService.CreateTask(ctx, req)
+	}
 	if err != nil {
 		slog.Error("failed to creating task", "error", err)
-		m.UI.Notification.Add(state.LevelError, "Error creating task")
+		if errors.Is(err, taskService.ErrMissingTemplateVar) || errors.Is(err, taskService.ErrInvalidTaskTemplate) {
+			m.UI.Notification.Add(state.LevelError, err.Error())
+		} else {
+			m.UI.Notification.Add(state.LevelError, "Error creating task")
+		}
 		return
 	}
 
@@ -95,6 +114,31 @@ func (m *Model) createNewTaskWithLabelsAndRelationships(values taskFormValues) {
 	}
 }
 
+// createTaskFromTemplate creates a task and its subtasks from the task
+// template chosen in the form
+func (m *Model) createTaskFromTemplate(ctx context.Context, values taskFormValues, req taskService.CreateTaskRequest) (*models.Task, error) {
+	project := m.getCurrentProject()
+	if project == nil {
+		return nil, fmt.Errorf("no project selected")
+	}
+
+	template, err := m.App.TaskService.GetTaskTemplate(ctx, project.ID, values.template)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make(map[string]string)
+	for _, pair := range strings.Split(values.templateVariables, ",") {
+		name, value, ok := strings.Cut(pair, "=")
+		if ok && strings.TrimSpace(name) != "" {
+			vars[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+
+	task, _, err := m.App.TaskService.CreateTaskFromTemplate(ctx, template, vars, req)
+	return task, err
+}
+
 // updateExistingTaskWithLabelsAndRelationships updates task, labels, and parent/child relationships
 func (m *Model) updateExistingTaskWithLabelsAndRelationships(values taskFormValues) {
 	// create context for database operations
@@ -476,6 +520,21 @@ func (m Model) updateTaskForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// Open comments view
 			return m.handleOpenCommentsView()
 
+		case "ctrl+y":
+			// Open task history view
+			return m.handleOpenHistoryView()
+
+		case "ctrl+g":
+			// Select the next checklist item
+			if n := len(m.Forms.Form.FormChecklist); n > 0 {
+				m.Forms.Form.ChecklistCursor = (m.Forms.Form.ChecklistCursor + 1) % n
+			}
+			return m, nil
+
+		case "ctrl+x":
+			// Tick or reopen the selected checklist item
+			return m.handleToggleChecklistItem()
+
 		case m.Config.KeyMappings.SaveForm:
 			// Quick save via C-s
 			return m.handleFormSave(formConfig{
@@ -492,7 +551,7 @@ func (m Model) updateTaskForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 					if !values.confirm {
 						return
 					}
-					if values.title != "" {
+					if values.title != "" || values.template != "" {
 						if m.Forms.Form.EditingTaskID == 0 {
 							m.createNewTaskWithLabelsAndRelationships(values)
 						} else {
@@ -524,7 +583,7 @@ func (m Model) updateTaskForm(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return
 			}
 
-			if values.title != "" {
+			if values.title != "" || values.template != "" {
 				if m.Forms.Form.EditingTaskID == 0 {
 					m.createNewTaskWithLabelsAndRelationships(values)
 				} else {
@@ -852,3 +911,28 @@ func (m Model) handleOpenCommentsView() (tea.Model, tea.Cmd) {
 
 	return m, nil
 }
+
+// handleToggleChecklistItem ticks or reopens the selected checklist item of the
+// task being edited. The change is saved immediately, like comments.
+func (m Model) handleToggleChecklistItem() (tea.Model, tea.Cmd) {
+	checklist := m.Forms.Form.FormChecklist
+	if m.Forms.Form.EditingTaskID == 0 || len(checklist) == 0 {
+		m.UI.Notification.Add(state.LevelInfo, "No checklist items (use 'paso task check add')")
+		return m, nil
+	}
+	cursor := min(m.Forms.Form.ChecklistCursor, len(checklist)-1)
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	item, err := m.App.TaskService.ToggleChecklistItem(ctx, checklist[cursor].ID)
+	if err != nil {
+		slog.Error("failed to toggling checklist item", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to update checklist")
+		return m, nil
+	}
+
+	m.Forms.Form.FormChecklist[cursor] = item
+	m.refreshTask(m.Forms.Form.EditingTaskID)
+	return m, nil
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"log/slog"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/thenoetrevino/paso/internal/tui/state"
+)
+
+// handleOpenHistoryView loads the activity log for the task being edited
+// and opens the history view on top of the task form
+func (m Model) handleOpenHistoryView() (tea.Model, tea.Cmd) {
+	taskID := m.Forms.Form.EditingTaskID
+	if taskID == 0 {
+		m.UI.Notification.Add(state.LevelInfo, "No history until the task is saved")
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	events, err := m.App.TaskService.GetTaskHistory(ctx, taskID)
+	if err != nil {
+		slog.Error("failed to loading task history", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to load task history")
+		return m, nil
+	}
+
+	m.Forms.History.SetEvents(taskID, events)
+	m.UIState.SetMode(state.TaskHistoryMode)
+	return m, nil
+}
+
+// handleHistoryViewInput handles keyboard input in the task history view
+func (m Model) handleHistoryViewInput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		m.Forms.History.ScrollUp()
+	case "down", "j":
+		// Must match the height calculation in renderHistoryViewLayer
+		layerHeight := m.UIState.Height() * 8 / 10
+		m.Forms.History.ScrollDown(historyMaxVisible(layerHeight))
+	case "esc", "q", "ctrl+y":
+		m.Forms.History.Clear()
+		m.UIState.SetMode(state.TicketFormMode)
+	}
+	return m, nil
+}
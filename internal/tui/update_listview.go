@@ -102,6 +102,7 @@ func (m Model) confirmStatusChange() (tea.Model, tea.Cmd) {
 	ctx, cancel := m.DBContext()
 	defer cancel()
 
+	fromColumnID, fromPosition, canUndo := m.taskPlacement(ctx, taskID)
 	err := m.App.TaskService.MoveTaskToColumn(ctx, taskID, selectedCol.ID)
 	if err != nil {
 		m.HandleDBError(err, "Moving task to new status")
@@ -109,6 +110,9 @@ func (m Model) confirmStatusChange() (tea.Model, tea.Cmd) {
 		m.UIState.SetMode(state.NormalMode)
 		return m, nil
 	}
+	if canUndo && taskToMove != nil {
+		m.recordTaskMove(ctx, taskToMove, fromColumnID, fromPosition)
+	}
 
 	if taskToMove != nil {
 		currentTasks := m.AppState.Tasks()[currentColumnID]
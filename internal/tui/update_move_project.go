@@ -0,0 +1,104 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/thenoetrevino/paso/internal/models"
+	taskservice "github.com/thenoetrevino/paso/internal/services/task"
+	"github.com/thenoetrevino/paso/internal/tui/state"
+)
+
+// handleMoveToProject opens the project picker for the selected task
+func (m Model) handleMoveToProject() (tea.Model, tea.Cmd) {
+	task := m.getCurrentTask()
+	if task == nil {
+		m.UI.Notification.Add(state.LevelError, "No task selected to move")
+		return m, nil
+	}
+
+	current := m.getCurrentProject()
+	var targets []*models.Project
+	for _, project := range m.AppState.Projects() {
+		if current == nil || project.ID != current.ID {
+			targets = append(targets, project)
+		}
+	}
+	if len(targets) == 0 {
+		m.UI.Notification.Add(state.LevelInfo, "No other project to move the task to")
+		return m, nil
+	}
+
+	m.Pickers.Project.Reset()
+	m.Pickers.Project.SetTaskID(task.ID)
+	m.Pickers.Project.SetProjects(targets)
+	m.UIState.SetMode(state.ProjectPickerMode)
+	return m, nil
+}
+
+// handleProjectPickerMode handles keyboard input in the project picker
+func (m Model) handleProjectPickerMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.Pickers.Project.Reset()
+		m.UIState.SetMode(state.NormalMode)
+		return m, nil
+	case "enter":
+		return m.confirmMoveToProject()
+	case "tab":
+		m.Pickers.Project.CycleRelations()
+		return m, nil
+	case "j", "down":
+		m.Pickers.Project.MoveDown()
+		return m, nil
+	case "k", "up":
+		m.Pickers.Project.MoveUp()
+		return m, nil
+	}
+	return m, nil
+}
+
+// confirmMoveToProject moves the task to the selected project and takes it
+// off the board. A move that would leave relations across projects without a
+// keep or drop choice keeps the picker open.
+func (m Model) confirmMoveToProject() (tea.Model, tea.Cmd) {
+	project := m.Pickers.Project.SelectedProject()
+	taskID := m.Pickers.Project.TaskID()
+	if project == nil {
+		m.Pickers.Project.Reset()
+		m.UIState.SetMode(state.NormalMode)
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	result, err := m.App.TaskService.MoveTaskToProject(ctx, taskservice.MoveToProjectRequest{
+		TaskID:    taskID,
+		ProjectID: project.ID,
+		Relations: taskservice.RelationPolicy(m.Pickers.Project.Relations()),
+	})
+	if errors.Is(err, taskservice.ErrCrossProjectRelations) {
+		m.UI.Notification.Add(state.LevelWarning, "Task has links to tasks in this project: press tab to keep or drop them")
+		return m, nil
+	}
+	if err != nil {
+		m.HandleDBError(err, "Moving task to project")
+		m.Pickers.Project.Reset()
+		m.UIState.SetMode(state.NormalMode)
+		return m, nil
+	}
+
+	m.removeCurrentTask()
+	m.Pickers.Project.Reset()
+	m.UIState.SetMode(state.NormalMode)
+
+	message := fmt.Sprintf("Moved to %s as %s", project.Name, models.TicketRef(project.TicketKey, result.TicketNumber))
+	if len(result.CreatedLabels) > 0 {
+		message += fmt.Sprintf(" (created labels: %s)", strings.Join(result.CreatedLabels, ", "))
+	}
+	m.UI.Notification.Add(state.LevelInfo, message)
+	return m, nil
+}
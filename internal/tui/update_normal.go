@@ -5,6 +5,7 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 	"github.com/thenoetrevino/paso/internal/models"
+	taskService "github.com/thenoetrevino/paso/internal/services/task"
 	"github.com/thenoetrevino/paso/internal/tui/components"
 	"github.com/thenoetrevino/paso/internal/tui/huhforms"
 	"github.com/thenoetrevino/paso/internal/tui/state"
@@ -67,6 +68,18 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.handleChangeStatus()
 	case km.SortList:
 		return m.handleSortList()
+	case km.ArchiveTask:
+		return m.handleArchiveTask()
+	case km.MoveToProject:
+		return m.handleMoveToProject()
+	case km.ShowArchive:
+		return m.handleOpenArchiveBrowser()
+	case km.FilterEpic:
+		return m.handleFilterEpic()
+	case km.Undo:
+		return m.handleUndo()
+	case km.Redo:
+		return m.handleRedo()
 	case "/":
 		return m.handleEnterSearch()
 	}
@@ -207,15 +220,27 @@ func (m Model) handleAddTask() (tea.Model, tea.Cmd) {
 	m.Forms.Form.FormChildIDs = []int{}
 	m.Forms.Form.FormParentRefs = []*models.TaskReference{}
 	m.Forms.Form.FormChildRefs = []*models.TaskReference{}
+	m.Forms.Form.FormTypeDescription = ""
+	m.Forms.Form.FormPriorityDescription = ""
+	m.Forms.Form.FormPriorityColor = ""
 	m.Forms.Form.FormConfirm = true
 	m.Forms.Form.EditingTaskID = 0
+	// Zero IDs let the task service pick the project's default type and priority
+	m.Pickers.Priority.Reset()
+	m.Pickers.Type.Reset()
+
+	m.Forms.Form.FormTemplate = ""
+	m.Forms.Form.FormTemplateVariables = ""
 
 	// Calculate description lines based on current screen size
 	descriptionLines := m.calculateDescriptionLines()
 
-	m.Forms.Form.TaskForm = huhforms.CreateTaskForm(
+	m.Forms.Form.TaskForm = huhforms.CreateTaskFormWithTemplates(
 		&m.Forms.Form.FormTitle,
 		&m.Forms.Form.FormDescription,
+		&m.Forms.Form.FormTemplate,
+		&m.Forms.Form.FormTemplateVariables,
+		m.taskTemplateOptions(),
 		&m.Forms.Form.FormConfirm,
 		descriptionLines,
 	).WithTheme(huhforms.CreatePasoTheme(m.Config.ColorScheme))
@@ -224,6 +249,33 @@ func (m Model) handleAddTask() (tea.Model, tea.Cmd) {
 	return m, m.Forms.Form.TaskForm.Init()
 }
 
+// taskTemplateOptions returns the current project's task templates for the
+// add-task form
+func (m Model) taskTemplateOptions() []huhforms.TaskTemplateOption {
+	project := m.getCurrentProject()
+	if project == nil {
+		return nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+	templates, err := m.App.TaskService.GetTaskTemplates(ctx, project.ID)
+	if err != nil {
+		slog.Error("failed to loading task templates", "error", err)
+		return nil
+	}
+
+	options := make([]huhforms.TaskTemplateOption, len(templates))
+	for i, t := range templates {
+		options[i] = huhforms.TaskTemplateOption{
+			Name:      t.Name,
+			Title:     t.Title,
+			Variables: taskService.TemplateVariables(t),
+		}
+	}
+	return options
+}
+
 func (m Model) handleEditTask() (tea.Model, tea.Cmd) {
 	task := m.getCurrentTask()
 	if task == nil {
@@ -263,6 +315,10 @@ func (m Model) handleEditTask() (tea.Model, tea.Cmd) {
 	m.Forms.Form.InitialFormComments = make([]*models.Comment, len(taskDetail.Comments))
 	copy(m.Forms.Form.InitialFormComments, taskDetail.Comments)
 
+	m.Forms.Form.FormChecklist = taskDetail.Checklist
+	m.Forms.Form.ChecklistCursor = 0
+
+	m.Forms.Form.FormTicketNumber = taskDetail.TicketNumber
 	m.Forms.Form.FormCreatedAt = taskDetail.CreatedAt
 	m.Forms.Form.FormUpdatedAt = taskDetail.UpdatedAt
 	m.Forms.Form.FormTypeDescription = taskDetail.TypeDescription
@@ -81,6 +81,7 @@ func (m Model) updateLabelPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 								m.UI.Notification.Add(state.LevelError, "Failed to remove label from task")
 							} else {
 								m.Pickers.Label.Items[i].Selected = false
+								m.recordLabelToggle(m.Pickers.Label.TaskID, item.Label, false)
 							}
 						} else {
 							// Add label to task
@@ -90,6 +91,7 @@ func (m Model) updateLabelPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 								m.UI.Notification.Add(state.LevelError, "Failed to add label to task")
 							} else {
 								m.Pickers.Label.Items[i].Selected = true
+								m.recordLabelToggle(m.Pickers.Label.TaskID, item.Label, true)
 							}
 						}
 						// Reload task summaries for the current column
@@ -324,19 +326,12 @@ func (m Model) updateParentPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 				currentRelationTypeID = item.RelationTypeID
 			}
 
-			m.Pickers.RelationType.SetSelectedRelationTypeID(currentRelationTypeID)
+			if !m.initRelationTypePicker(currentRelationTypeID) {
+				return m, nil
+			}
 			m.Pickers.RelationType.SetCurrentTaskPickerIndex(m.Pickers.Parent.Cursor)
 			m.Pickers.RelationType.ReturnMode = state.ParentPickerMode
 
-			// Set cursor to match selected relation type
-			relationTypes := renderers.GetRelationTypeOptions()
-			for i, rt := range relationTypes {
-				if rt.ID == currentRelationTypeID {
-					m.Pickers.RelationType.SetCursor(i)
-					break
-				}
-			}
-
 			m.UIState.SetMode(state.RelationTypePickerMode)
 		}
 		return m, nil
@@ -479,19 +474,12 @@ func (m Model) updateChildPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 				currentRelationTypeID = item.RelationTypeID
 			}
 
-			m.Pickers.RelationType.SetSelectedRelationTypeID(currentRelationTypeID)
+			if !m.initRelationTypePicker(currentRelationTypeID) {
+				return m, nil
+			}
 			m.Pickers.RelationType.SetCurrentTaskPickerIndex(m.Pickers.Child.Cursor)
 			m.Pickers.RelationType.ReturnMode = state.ChildPickerMode
 
-			// Set cursor to match selected relation type
-			relationTypes := renderers.GetRelationTypeOptions()
-			for i, rt := range relationTypes {
-				if rt.ID == currentRelationTypeID {
-					m.Pickers.RelationType.SetCursor(i)
-					break
-				}
-			}
-
 			m.UIState.SetMode(state.RelationTypePickerMode)
 		}
 		return m, nil
@@ -531,8 +519,8 @@ func (m Model) updatePriorityPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch keyMsg.String() {
 	case "esc":
 		// Return to ticket form mode without changing priority
+		// Keep the selection so a new task still gets what was picked earlier
 		m.UIState.SetMode(m.Pickers.Priority.ReturnMode)
-		m.Pickers.Priority.Reset()
 		return m, nil
 
 	case "up", "k":
@@ -547,11 +535,7 @@ func (m Model) updatePriorityPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case "enter":
 		// Select the priority at cursor position
-		priorities := renderers.GetPriorityOptions()
-		cursorIdx := m.Pickers.Priority.Cursor()
-
-		if cursorIdx >= 0 && cursorIdx < len(priorities) {
-			selectedPriority := priorities[cursorIdx]
+		if selectedPriority := m.Pickers.Priority.PriorityAtCursor(); selectedPriority != nil {
 
 			// If we're editing a task, update it in the database
 			if m.Forms.Form.EditingTaskID != 0 {
@@ -607,8 +591,8 @@ func (m Model) updateTypePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch keyMsg.String() {
 	case "esc":
 		// Return to ticket form mode without changing type
+		// Keep the selection so a new task still gets what was picked earlier
 		m.UIState.SetMode(m.Pickers.Type.ReturnMode)
-		m.Pickers.Type.Reset()
 		return m, nil
 
 	case "up", "k":
@@ -623,11 +607,7 @@ func (m Model) updateTypePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case "enter":
 		// Select the type at cursor position
-		types := renderers.GetTypeOptions()
-		cursorIdx := m.Pickers.Type.Cursor()
-
-		if cursorIdx >= 0 && cursorIdx < len(types) {
-			selectedType := types[cursorIdx]
+		if selectedType := m.Pickers.Type.TypeAtCursor(); selectedType != nil {
 
 			// If we're editing a task, update it in the database
 			if m.Forms.Form.EditingTaskID != 0 {
@@ -696,11 +676,7 @@ func (m Model) updateRelationTypePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case "enter":
 		// Select the relation type at cursor position
-		relationTypes := renderers.GetRelationTypeOptions()
-		cursorIdx := m.Pickers.RelationType.Cursor()
-
-		if cursorIdx >= 0 && cursorIdx < len(relationTypes) {
-			selectedRelationType := relationTypes[cursorIdx]
+		if selectedRelationType := m.Pickers.RelationType.RelationTypeAtCursor(); selectedRelationType != nil {
 
 			// Update the TaskPickerItem's RelationTypeID
 			itemIdx := m.Pickers.RelationType.CurrentTaskPickerIndex()
@@ -751,7 +727,7 @@ func (m Model) updateRelationTypePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 func buildTaskRefWithRelationType(
 	taskRef *models.TaskReference,
 	relationTypeID int,
-	relationMap map[int]renderers.RelationTypeOption,
+	relationMap map[int]*models.RelationType,
 	useParentPerspective bool,
 ) *models.TaskReference {
 	ref := &models.TaskReference{
@@ -776,24 +752,60 @@ func buildTaskRefWithRelationType(
 	return ref
 }
 
-// getRelationTypeMap returns a map of relation type IDs to RelationTypeOptions.
-// This avoids repeatedly building the same map from the slice.
-func getRelationTypeMap() map[int]renderers.RelationTypeOption {
-	relationOptions := renderers.GetRelationTypeOptions()
-	relationMap := make(map[int]renderers.RelationTypeOption, len(relationOptions))
-	for _, opt := range relationOptions {
-		relationMap[opt.ID] = opt
+// getRelationTypeMap returns the relation types loaded when the parent or
+// child picker was opened, keyed by ID.
+func (m Model) getRelationTypeMap() map[int]*models.RelationType {
+	relationTypes := m.Pickers.RelationType.RelationTypes()
+	relationMap := make(map[int]*models.RelationType, len(relationTypes))
+	for _, rt := range relationTypes {
+		relationMap[rt.ID] = rt
 	}
 	return relationMap
 }
 
+// loadRelationTypes fetches the relation types available in the current
+// project: the built-in ones followed by the project's own.
+func (m *Model) loadRelationTypes() []*models.RelationType {
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	relationTypes, err := m.App.RelationTypeService.GetRelationTypesByProject(ctx, m.AppState.GetCurrentProjectID())
+	if err != nil {
+		slog.Error("failed to loading relation types", "error", err)
+		return nil
+	}
+	return relationTypes
+}
+
+// initRelationTypePicker loads the current project's relation types into the
+// relation type picker and puts the cursor on currentRelationTypeID.
+// Returns false if the relation types could not be loaded.
+func (m *Model) initRelationTypePicker(currentRelationTypeID int) bool {
+	relationTypes := m.loadRelationTypes()
+	if len(relationTypes) == 0 {
+		m.UI.Notification.Add(state.LevelError, "Failed to load relation types")
+		return false
+	}
+
+	m.Pickers.RelationType.SetRelationTypes(relationTypes)
+	m.Pickers.RelationType.SetSelectedRelationTypeID(currentRelationTypeID)
+	m.Pickers.RelationType.SetCursor(0)
+	for i, rt := range relationTypes {
+		if rt.ID == currentRelationTypeID {
+			m.Pickers.RelationType.SetCursor(i)
+			break
+		}
+	}
+	return true
+}
+
 // syncParentPickerToFormState syncs parent picker selections back to form state.
 // Extracts all selected task IDs and references from the picker and updates FormState.
 func (m *Model) syncParentPickerToFormState() {
 	var parentIDs []int
 	var parentRefs []*models.TaskReference
 
-	relationMap := getRelationTypeMap()
+	relationMap := m.getRelationTypeMap()
 
 	for _, item := range m.Pickers.Parent.Items {
 		if item.Selected {
@@ -813,7 +825,7 @@ func (m *Model) syncChildPickerToFormState() {
 	var childIDs []int
 	var childRefs []*models.TaskReference
 
-	relationMap := getRelationTypeMap()
+	relationMap := m.getRelationTypeMap()
 
 	for _, item := range m.Pickers.Child.Items {
 		if item.Selected {
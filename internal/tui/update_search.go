@@ -1,9 +1,12 @@
 package tui
 
 import (
+	"errors"
+	"fmt"
 	"log/slog"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/thenoetrevino/paso/internal/filter"
 	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/state"
 )
@@ -32,6 +35,12 @@ func (m Model) handleSearchMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.executeSearch()
 		}
 		return m, nil
+	case "space":
+		// Filter terms are space separated
+		if m.UI.Search.AppendChar(' ') {
+			return m.executeSearch()
+		}
+		return m, nil
 	default:
 		key := msg.String()
 		if len(key) == 1 {
@@ -60,8 +69,37 @@ func (m Model) handleSearchCancel() (tea.Model, tea.Cmd) {
 	return m.executeSearch()
 }
 
+// handleFilterEpic narrows the board to the selected task and everything
+// below it, by filtering on epic:<ticket number>
+func (m Model) handleFilterEpic() (tea.Model, tea.Cmd) {
+	task := m.getCurrentTask()
+	if task == nil {
+		m.UI.Notification.Add(state.LevelInfo, "No task selected")
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	detail, err := m.App.TaskService.GetTaskDetail(ctx, task.ID)
+	if err != nil {
+		slog.Error("failed to loading task for epic filter", "error", err)
+		m.UI.Notification.Add(state.LevelError, "Failed to load task")
+		return m, nil
+	}
+
+	m.UI.Search.Query = fmt.Sprintf("epic:%d", detail.TicketNumber)
+	m.UI.Search.Activate()
+	if detail.ChildrenTotal == 0 {
+		m.UI.Notification.Add(state.LevelInfo, fmt.Sprintf("#%d has no subtasks", detail.TicketNumber))
+	}
+	return m.executeSearch()
+}
+
 // executeSearch runs the search query and updates the task list.
-// Inlined from search.go (deleted to reduce duplication)
+// The query uses the filter language (priority>=high label:backend is:blocked
+// ...); plain words are matched with the full-text index. While a query is
+// incomplete (e.g. "priority>=") the board keeps showing the last valid result.
 func (m Model) executeSearch() (tea.Model, tea.Cmd) {
 	project := m.getCurrentProject()
 	if project == nil {
@@ -70,23 +108,28 @@ func (m Model) executeSearch() (tea.Model, tea.Cmd) {
 
 	ctx, cancel := m.DBContext()
 	defer cancel()
-	var tasksByColumn map[int][]*models.TaskSummary
-	var err error
 
-	if m.UI.Search.Query == "" {
-		tasksByColumn, err = m.App.TaskService.GetTaskSummariesByProject(ctx, project.ID)
-	} else {
-		tasksByColumn, err = m.App.TaskService.GetTaskSummariesByProjectFiltered(ctx, project.ID, m.UI.Search.Query)
+	tasks, err := m.App.TaskService.GetTaskSummariesByFilter(ctx, project.ID, m.UI.Search.Query)
+	if errors.Is(err, filter.ErrInvalidFilter) {
+		return m, nil
 	}
-
 	if err != nil {
 		slog.Error("failed to filtering tasks", "error", err)
 		return m, nil
 	}
 
-	m.AppState.SetTasks(tasksByColumn)
+	m.AppState.SetTasks(groupTasksByColumn(tasks))
 	// Reset task selection to 0 to avoid out-of-bounds
 	m.UIState.SetSelectedTask(0)
 
 	return m, nil
 }
+
+// groupTasksByColumn buckets task summaries by column, keeping their order
+func groupTasksByColumn(tasks []*models.TaskSummary) map[int][]*models.TaskSummary {
+	tasksByColumn := make(map[int][]*models.TaskSummary)
+	for _, task := range tasks {
+		tasksByColumn[task.ColumnID] = append(tasksByColumn[task.ColumnID], task)
+	}
+	return tasksByColumn
+}
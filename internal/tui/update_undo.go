@@ -0,0 +1,176 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/thenoetrevino/paso/internal/models"
+	"github.com/thenoetrevino/paso/internal/tui/state"
+)
+
+// handleUndo reverses the most recent recorded action
+func (m Model) handleUndo() (tea.Model, tea.Cmd) {
+	action, ok := m.UI.Undo.PopUndo()
+	if !ok {
+		m.UI.Notification.Add(state.LevelInfo, "Nothing to undo")
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	if err := action.Undo(ctx); err != nil {
+		slog.Error("failed to undo action", "action", action.Description, "error", err)
+		m.UI.Notification.Add(state.LevelError, fmt.Sprintf("Could not undo %s", action.Description))
+		m.reloadAfterUndo()
+		return m, nil
+	}
+
+	m.UI.Undo.Undone(action)
+	m.reloadAfterUndo()
+	m.UI.Notification.Add(state.LevelInfo,
+		fmt.Sprintf("Undid %s (%s to redo)", action.Description, m.Config.KeyMappings.Redo))
+	return m, nil
+}
+
+// handleRedo applies the most recently undone action again
+func (m Model) handleRedo() (tea.Model, tea.Cmd) {
+	action, ok := m.UI.Undo.PopRedo()
+	if !ok {
+		m.UI.Notification.Add(state.LevelInfo, "Nothing to redo")
+		return m, nil
+	}
+
+	ctx, cancel := m.DBContext()
+	defer cancel()
+
+	if err := action.Redo(ctx); err != nil {
+		slog.Error("failed to redo action", "action", action.Description, "error", err)
+		m.UI.Notification.Add(state.LevelError, fmt.Sprintf("Could not redo %s", action.Description))
+		m.reloadAfterUndo()
+		return m, nil
+	}
+
+	m.UI.Undo.Redone(action)
+	m.reloadAfterUndo()
+	m.UI.Notification.Add(state.LevelInfo, fmt.Sprintf("Redid %s", action.Description))
+	return m, nil
+}
+
+// reloadAfterUndo reloads the board after an undo or redo, which may have
+// touched any column, and keeps the selection in range
+func (m *Model) reloadAfterUndo() {
+	m.reloadCurrentProject()
+
+	if n := len(m.AppState.Columns()); m.UIState.SelectedColumn() >= n {
+		m.UIState.SetSelectedColumn(max(n-1, 0))
+	}
+	if n := len(m.getCurrentTasks()); m.UIState.SelectedTask() >= n {
+		m.UIState.SetSelectedTask(max(n-1, 0))
+	}
+}
+
+// recordUndo pushes an action onto the undo stack
+func (m Model) recordUndo(description string, undo, redo func(ctx context.Context) error) {
+	m.UI.Undo.Push(state.UndoAction{Description: description, Undo: undo, Redo: redo})
+}
+
+// taskPlacement returns the column and stored position of a task, which
+// column moves need to be undone. ok is false if the lookup fails.
+func (m Model) taskPlacement(ctx context.Context, taskID int) (columnID, position int, ok bool) {
+	task, err := m.App.TaskService.GetTaskSummary(ctx, taskID)
+	if err != nil {
+		slog.Error("failed to get task position for undo", "task_id", taskID, "error", err)
+		return 0, 0, false
+	}
+	return task.ColumnID, task.Position, true
+}
+
+// recordTaskMove records a move of a task between columns. from is the
+// task's placement before the move; the placement after it is looked up.
+func (m Model) recordTaskMove(ctx context.Context, task *models.TaskSummary, fromColumnID, fromPosition int) {
+	toColumnID, toPosition, ok := m.taskPlacement(ctx, task.ID)
+	if !ok {
+		return
+	}
+
+	description := fmt.Sprintf("move %q", task.Title)
+	if col := m.AppState.GetColumnByID(toColumnID); col != nil {
+		description = fmt.Sprintf("move %q to %s", task.Title, col.Name)
+	}
+
+	taskID := task.ID
+	m.recordUndo(description,
+		func(ctx context.Context) error {
+			return m.App.TaskService.RestoreTaskPosition(ctx, taskID, fromColumnID, fromPosition)
+		},
+		func(ctx context.Context) error {
+			return m.App.TaskService.RestoreTaskPosition(ctx, taskID, toColumnID, toPosition)
+		},
+	)
+}
+
+// recordTaskReorder records a move of a task one place up or down its column.
+// Both directions swap the task with its neighbour, so each undoes the other.
+func (m Model) recordTaskReorder(task *models.TaskSummary, up bool) {
+	taskID := task.ID
+	moveUp := func(ctx context.Context) error { return m.App.TaskService.MoveTaskUp(ctx, taskID) }
+	moveDown := func(ctx context.Context) error { return m.App.TaskService.MoveTaskDown(ctx, taskID) }
+
+	if up {
+		m.recordUndo(fmt.Sprintf("move %q up", task.Title), moveDown, moveUp)
+	} else {
+		m.recordUndo(fmt.Sprintf("move %q down", task.Title), moveUp, moveDown)
+	}
+}
+
+// recordTaskDelete records the deletion of a task, restored from the snapshot
+// taken just before it, and tells the user how to get it back
+func (m Model) recordTaskDelete(snapshot *models.TaskSnapshot) {
+	taskID := snapshot.Task.ID
+	m.recordUndo(fmt.Sprintf("delete %q", snapshot.Task.Title),
+		func(ctx context.Context) error { return m.App.TaskService.RestoreTask(ctx, snapshot) },
+		func(ctx context.Context) error { return m.App.TaskService.DeleteTask(ctx, taskID) },
+	)
+	m.UI.Notification.Add(state.LevelInfo,
+		fmt.Sprintf("Deleted %q (%s to undo)", snapshot.Task.Title, m.Config.KeyMappings.Undo))
+}
+
+// recordColumnDelete records the deletion of a column, restored in place
+// under its original ID
+func (m Model) recordColumnDelete(column *models.Column) {
+	deleted := *column
+	m.recordUndo(fmt.Sprintf("delete column %q", deleted.Name),
+		func(ctx context.Context) error { return m.App.ColumnService.RestoreColumn(ctx, &deleted) },
+		func(ctx context.Context) error { return m.App.ColumnService.DeleteColumn(ctx, deleted.ID) },
+	)
+	m.UI.Notification.Add(state.LevelInfo,
+		fmt.Sprintf("Deleted column %q (%s to undo)", deleted.Name, m.Config.KeyMappings.Undo))
+}
+
+// recordLabelToggle records a label being attached to or detached from a task
+func (m Model) recordLabelToggle(taskID int, label *models.Label, attached bool) {
+	labelID := label.ID
+	attach := func(ctx context.Context) error { return m.App.TaskService.AttachLabel(ctx, taskID, labelID) }
+	detach := func(ctx context.Context) error { return m.App.TaskService.DetachLabel(ctx, taskID, labelID) }
+
+	if attached {
+		m.recordUndo(fmt.Sprintf("add label %q", label.Name), detach, attach)
+	} else {
+		m.recordUndo(fmt.Sprintf("remove label %q", label.Name), attach, detach)
+	}
+}
+
+// recordArchive records a task being archived or brought back from the archive
+func (m Model) recordArchive(taskID int, title string, archived bool) {
+	archive := func(ctx context.Context) error { return m.App.TaskService.ArchiveTask(ctx, taskID) }
+	unarchive := func(ctx context.Context) error { return m.App.TaskService.UnarchiveTask(ctx, taskID) }
+
+	if archived {
+		m.recordUndo(fmt.Sprintf("archive %q", title), unarchive, archive)
+	} else {
+		m.recordUndo(fmt.Sprintf("restore %q", title), archive, unarchive)
+	}
+}
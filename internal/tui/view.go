@@ -45,6 +45,11 @@ func (m Model) View() tea.View {
 		case state.CommentsViewMode:
 			layers = append(layers, m.renderTaskFormLayer())
 			modalLayer = m.renderCommentsViewLayer()
+		case state.TaskHistoryMode:
+			layers = append(layers, m.renderTaskFormLayer())
+			modalLayer = m.renderHistoryViewLayer()
+		case state.ArchiveBrowserMode:
+			modalLayer = m.renderArchiveBrowserLayer()
 		case state.HelpMode:
 			modalLayer = m.renderHelpLayer()
 		case state.DiscardConfirmMode:
@@ -78,6 +83,8 @@ func (m Model) View() tea.View {
 			layers = m.buildPickerLayers(layers, returnMode, m.renderRelationTypePickerLayer(), intermediateLayer)
 		case state.StatusPickerMode:
 			modalLayer = m.renderStatusPickerLayer()
+		case state.ProjectPickerMode:
+			modalLayer = m.renderProjectPickerLayer()
 		}
 
 		if modalLayer != nil {
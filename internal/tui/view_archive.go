@@ -0,0 +1,91 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/tui/theme"
+)
+
+// archiveViewOverhead is the number of lines reserved for the title bar,
+// scroll indicators, help text and the blank lines between them.
+const archiveViewOverhead = 8
+
+// renderArchiveBrowserContent renders the archived task list (without layer wrapping)
+func (m Model) renderArchiveBrowserContent(width, height int) string {
+	archive := m.UI.Archive
+
+	projectName := "Unknown Project"
+	if project := m.getCurrentProject(); project != nil {
+		projectName = project.Name
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(theme.Highlight))
+	titleBar := titleStyle.Render(fmt.Sprintf("Archive - %s (%d tasks)", projectName, len(archive.Tasks)))
+
+	helpText := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Subtle)).
+		Render("[↑↓: select | Enter/u: restore | Esc: close]")
+
+	if archive.IsEmpty() {
+		emptyContent := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(theme.Subtle)).
+			Italic(true).
+			Align(lipgloss.Center).
+			Width(width).
+			Render("No archived tasks in this project.")
+		return lipgloss.JoinVertical(lipgloss.Left, titleBar, "", emptyContent, "", helpText)
+	}
+
+	maxVisible := archiveMaxVisible(height)
+	startIdx := min(archive.ScrollOffset, len(archive.Tasks))
+	endIdx := min(startIdx+maxVisible, len(archive.Tasks))
+
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Subtle))
+	textStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Normal))
+	selectedStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(theme.Highlight))
+
+	lines := make([]string, 0, endIdx-startIdx)
+	for i, t := range archive.Tasks[startIdx:endIdx] {
+		cursor, style := "  ", textStyle
+		if startIdx+i == archive.Selected {
+			cursor, style = "> ", selectedStyle
+		}
+		line := fmt.Sprintf("%s%s  %s  %s",
+			cursor,
+			timeStyle.Render(t.ArchivedAt.Format("Jan 2 15:04")),
+			style.Render(fmt.Sprintf("#%d %s", t.TicketNumber, t.Title)),
+			timeStyle.Render("("+t.ColumnName+")"))
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(width).Render(line))
+	}
+
+	indicatorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Subtle)).
+		Align(lipgloss.Center)
+	var top, bottom string
+	if startIdx > 0 {
+		top = indicatorStyle.Render("▲ newer above")
+	}
+	if endIdx < len(archive.Tasks) {
+		bottom = indicatorStyle.Render("▼ older below")
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleBar,
+		"",
+		top,
+		strings.Join(lines, "\n"),
+		bottom,
+		"",
+		helpText,
+	)
+}
+
+// archiveMaxVisible returns how many archived tasks fit in a view of the given height
+func archiveMaxVisible(height int) int {
+	return max(height-archiveViewOverhead, 1)
+}
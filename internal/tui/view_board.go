@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/filter"
 	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/components"
 	"github.com/thenoetrevino/paso/internal/tui/helpers"
@@ -57,6 +58,18 @@ func (m Model) viewKanbanBoard() string {
 	columnHeight := m.UIState.ContentHeight()
 
 	// Render only visible columns
+	// Emphasize the free-text words of the filter query in task titles
+	var highlight []string
+	if q, err := filter.Parse(m.UI.Search.Query); err == nil {
+		highlight = q.Text()
+	}
+
+	// Cards show KEY-N once the project has a ticket key
+	var ticketKey string
+	if project := m.getCurrentProject(); project != nil {
+		ticketKey = project.TicketKey
+	}
+
 	var columns []string
 	for i, col := range visibleColumns {
 		// Calculate global index for selection check
@@ -79,7 +92,7 @@ func (m Model) viewKanbanBoard() string {
 
 		scrollOffset := m.UIState.TaskScrollOffset(col.ID)
 
-		columns = append(columns, components.RenderColumn(col, tasks, isSelected, selectedTaskIdx, columnHeight, scrollOffset))
+		columns = append(columns, components.RenderColumn(col, tasks, isSelected, selectedTaskIdx, columnHeight, scrollOffset, highlight, ticketKey))
 	}
 
 	scrollIndicators := helpers.GetScrollIndicators(
@@ -130,6 +130,10 @@ func (m Model) renderFormMetadataZone(width, height int) string {
 			parts = append(parts, taskLine)
 		}
 	}
+	parts = append(parts, "")
+
+	// Checklist section
+	parts = append(parts, m.renderFormChecklist(labelHeaderStyle, subtleStyle)...)
 
 	content := strings.Join(parts, "\n")
 
@@ -146,6 +150,38 @@ func (m Model) renderFormMetadataZone(width, height int) string {
 	return style.Render(content)
 }
 
+// renderFormChecklist renders the checklist lines of the metadata column,
+// marking the item that Ctrl+X toggles
+func (m Model) renderFormChecklist(headerStyle, subtleStyle lipgloss.Style) []string {
+	checklist := m.Forms.Form.FormChecklist
+	if len(checklist) == 0 {
+		return []string{headerStyle.Render("Checklist"), subtleStyle.Render("No checklist")}
+	}
+
+	done := 0
+	for _, item := range checklist {
+		if item.Done {
+			done++
+		}
+	}
+
+	selectedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Highlight))
+	lines := []string{headerStyle.Render(fmt.Sprintf("Checklist %d/%d", done, len(checklist)))}
+	for i, item := range checklist {
+		line := "[ ] " + item.Text
+		if item.Done {
+			line = subtleStyle.Render("[x] " + item.Text)
+		}
+		if i == m.Forms.Form.ChecklistCursor {
+			line = selectedStyle.Render("› ") + line
+		} else {
+			line = "  " + line
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
 // renderFormCommentsPreview renders a read-only preview of recent comments
 // Users press Ctrl+N to open the full comments view
 func (m *Model) renderFormCommentsPreview(width, height int) string {
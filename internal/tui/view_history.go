@@ -0,0 +1,86 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/tui/theme"
+)
+
+// historyViewOverhead is the number of lines reserved for the title bar,
+// scroll indicators, help text and the blank lines between them.
+const historyViewOverhead = 8
+
+// renderHistoryViewContent renders the task activity log (without layer wrapping)
+func (m Model) renderHistoryViewContent(width, height int) string {
+	history := m.Forms.History
+
+	taskTitle := "Unknown Task"
+	if task := m.getCurrentTask(); task != nil {
+		taskTitle = task.Title
+	}
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color(theme.Highlight))
+	titleBar := titleStyle.Render(fmt.Sprintf("Task History - \"%s\" (%d events)", taskTitle, len(history.Events)))
+
+	helpText := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Subtle)).
+		Render("[↑↓: scroll | Esc: close]")
+
+	if history.IsEmpty() {
+		emptyContent := lipgloss.NewStyle().
+			Foreground(lipgloss.Color(theme.Subtle)).
+			Italic(true).
+			Align(lipgloss.Center).
+			Width(width).
+			Render("No history recorded for this task.")
+		return lipgloss.JoinVertical(lipgloss.Left, titleBar, "", emptyContent, "", helpText)
+	}
+
+	maxVisible := historyMaxVisible(height)
+	startIdx := min(history.ScrollOffset, len(history.Events))
+	endIdx := min(startIdx+maxVisible, len(history.Events))
+
+	timeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Subtle))
+	actorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Highlight))
+	textStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(theme.Normal))
+
+	lines := make([]string, 0, endIdx-startIdx)
+	for _, e := range history.Events[startIdx:endIdx] {
+		line := fmt.Sprintf("%s  %s  %s",
+			timeStyle.Render(e.CreatedAt.Format("Jan 2 15:04")),
+			actorStyle.Render(e.Actor),
+			textStyle.Render(e.Summary()))
+		lines = append(lines, lipgloss.NewStyle().MaxWidth(width).Render(line))
+	}
+
+	indicatorStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color(theme.Subtle)).
+		Align(lipgloss.Center)
+	var top, bottom string
+	if startIdx > 0 {
+		top = indicatorStyle.Render("▲ newer above")
+	}
+	if endIdx < len(history.Events) {
+		bottom = indicatorStyle.Render("▼ older below")
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		titleBar,
+		"",
+		top,
+		strings.Join(lines, "\n"),
+		bottom,
+		"",
+		helpText,
+	)
+}
+
+// historyMaxVisible returns how many history entries fit in a view of the given height
+func historyMaxVisible(height int) int {
+	return max(height-historyViewOverhead, 1)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"charm.land/lipgloss/v2"
+	"github.com/thenoetrevino/paso/internal/models"
 	"github.com/thenoetrevino/paso/internal/tui/components"
 	"github.com/thenoetrevino/paso/internal/tui/layers"
 	"github.com/thenoetrevino/paso/internal/tui/renderers"
@@ -45,7 +46,15 @@ func (m Model) renderTaskFormLayer() *lipgloss.Layer {
 	if m.Forms.Form.EditingTaskID == 0 {
 		formTitle = titleStyle.Render("Create New Task")
 	} else {
-		formTitle = titleStyle.Render("Edit Task")
+		title := "Edit Task"
+		if m.Forms.Form.FormTicketNumber > 0 {
+			var key string
+			if project := m.getCurrentProject(); project != nil {
+				key = project.TicketKey
+			}
+			title += " " + models.TicketRef(key, m.Forms.Form.FormTicketNumber)
+		}
+		formTitle = titleStyle.Render(title)
 	}
 
 	titleWithHint := lipgloss.JoinHorizontal(
@@ -147,6 +156,10 @@ TASKS
   %s     Move task up in column
   %s     Move task down in column
   %s     Edit task details
+  %s     Archive selected task
+  %s     Move task to another project
+  %s     Undo last change
+  %s     Redo last undone change
 
 COLUMNS
   %s     Create new column (after current)
@@ -170,6 +183,8 @@ VIEW
   %s     Toggle between kanban and list view
   %s     Change status (list view)
   %s     Toggle sort order (list view)
+  %s     Browse archived tasks
+  %s     Show only selected task and its subtasks
   /         Search tasks
 
 OTHER
@@ -185,6 +200,10 @@ Press any key to close`,
 		km.MoveTaskUp,
 		km.MoveTaskDown,
 		km.ViewTask,
+		km.ArchiveTask,
+		km.MoveToProject,
+		km.Undo,
+		km.Redo,
 		km.CreateColumn,
 		km.RenameColumn,
 		km.DeleteColumn,
@@ -200,6 +219,8 @@ Press any key to close`,
 		km.ToggleView,
 		km.ChangeStatus,
 		km.SortList,
+		km.ShowArchive,
+		km.FilterEpic,
 		km.ShowHelp,
 		km.Quit,
 	)
@@ -220,6 +241,36 @@ func (m Model) renderCommentsViewLayer() *lipgloss.Layer {
 	return layers.CreateCenteredLayer(commentsBox, m.UIState.Width(), m.UIState.Height())
 }
 
+// renderHistoryViewLayer renders the task history modal as a full-screen layer
+func (m Model) renderHistoryViewLayer() *lipgloss.Layer {
+	layerWidth := m.UIState.Width() * 8 / 10
+	layerHeight := m.UIState.Height() * 8 / 10
+
+	content := m.renderHistoryViewContent(layerWidth, layerHeight)
+
+	historyBox := components.HelpBoxStyle.
+		Width(layerWidth).
+		Height(layerHeight).
+		Render(content)
+
+	return layers.CreateCenteredLayer(historyBox, m.UIState.Width(), m.UIState.Height())
+}
+
+// renderArchiveBrowserLayer renders the archive browser modal as a full-screen layer
+func (m Model) renderArchiveBrowserLayer() *lipgloss.Layer {
+	layerWidth := m.UIState.Width() * 8 / 10
+	layerHeight := m.UIState.Height() * 8 / 10
+
+	content := m.renderArchiveBrowserContent(layerWidth, layerHeight)
+
+	archiveBox := components.HelpBoxStyle.
+		Width(layerWidth).
+		Height(layerHeight).
+		Render(content)
+
+	return layers.CreateCenteredLayer(archiveBox, m.UIState.Width(), m.UIState.Height())
+}
+
 // renderCommentFormLayer renders the comment creation/edit form modal as a layer
 func (m Model) renderCommentFormLayer() *lipgloss.Layer {
 	if m.Forms.Form.CommentForm == nil {
@@ -267,6 +318,10 @@ COMMENTS SECTION
   Mouse wheel     Scroll comments (when focused)
   Tab/Shift+Tab   Return to form fields
 
+CHECKLIST (editing only)
+  Ctrl+G          Select next checklist item
+  Ctrl+X          Tick/reopen selected item
+
 QUICK ACTIONS
   Ctrl+N          Create new comment
   Ctrl+L          Manage labels
@@ -274,6 +329,7 @@ QUICK ACTIONS
   Ctrl+C          Select child tasks
   Ctrl+R          Change priority
   Ctrl+T          Change task type
+  Ctrl+Y          Show task history
 
 HELP
   Ctrl+/          Toggle this help menu
@@ -407,6 +463,7 @@ func (m Model) renderLabelPickerLayer() *lipgloss.Layer {
 func (m Model) renderParentPickerLayer() *lipgloss.Layer {
 	filteredItems := m.Pickers.Parent.GetFilteredItems()
 	hasFilter := m.Pickers.Parent.Filter != ""
+	relationTypeMap := m.getRelationTypeMap()
 	isParentPicker := true
 
 	return m.createPickerLayer(pickerLayerConfig{
@@ -425,6 +482,7 @@ func (m Model) renderParentPickerLayer() *lipgloss.Layer {
 				width-layers.PickerBorderPaddingWidth,
 				height-layers.PickerBorderPaddingHeight,
 				isParentPicker,
+				relationTypeMap,
 			)
 		},
 		boxStyle: components.LabelPickerBoxStyle,
@@ -435,6 +493,7 @@ func (m Model) renderParentPickerLayer() *lipgloss.Layer {
 func (m Model) renderChildPickerLayer() *lipgloss.Layer {
 	filteredItems := m.Pickers.Child.GetFilteredItems()
 	hasFilter := m.Pickers.Child.Filter != ""
+	relationTypeMap := m.getRelationTypeMap()
 	isParentPicker := false
 
 	return m.createPickerLayer(pickerLayerConfig{
@@ -453,6 +512,7 @@ func (m Model) renderChildPickerLayer() *lipgloss.Layer {
 				width-layers.PickerBorderPaddingWidth,
 				height-layers.PickerBorderPaddingHeight,
 				isParentPicker,
+				relationTypeMap,
 			)
 		},
 		boxStyle: components.LabelPickerBoxStyle,
@@ -464,11 +524,11 @@ func (m Model) renderPriorityPickerLayer() *lipgloss.Layer {
 	return m.createPickerLayer(pickerLayerConfig{
 		dimensionStrategy: fixedPickerDimensions{
 			width:  layers.PickerPriorityWidth,
-			height: layers.PickerPriorityHeight,
+			height: len(m.Pickers.Priority.Priorities()) + layers.PickerPriorityChromeHeight,
 		},
 		contentRenderer: func(width, height int) string {
 			return renderers.RenderPriorityPicker(
-				renderers.GetPriorityOptions(),
+				m.Pickers.Priority.Priorities(),
 				m.Pickers.Priority.SelectedPriorityID(),
 				m.Pickers.Priority.Cursor(),
 				width-layers.PickerBorderPaddingWidth,
@@ -483,11 +543,11 @@ func (m Model) renderTypePickerLayer() *lipgloss.Layer {
 	return m.createPickerLayer(pickerLayerConfig{
 		dimensionStrategy: fixedPickerDimensions{
 			width:  layers.PickerTypeWidth,
-			height: layers.PickerTypeHeight,
+			height: len(m.Pickers.Type.Types()) + layers.PickerTypeChromeHeight,
 		},
 		contentRenderer: func(width, height int) string {
 			return renderers.RenderTypePicker(
-				renderers.GetTypeOptions(),
+				m.Pickers.Type.Types(),
 				m.Pickers.Type.SelectedTypeID(),
 				m.Pickers.Type.Cursor(),
 				width-layers.PickerBorderPaddingWidth,
@@ -507,11 +567,11 @@ func (m Model) renderRelationTypePickerLayer() *lipgloss.Layer {
 	return m.createPickerLayer(pickerLayerConfig{
 		dimensionStrategy: fixedPickerDimensions{
 			width:  layers.PickerRelationTypeWidth,
-			height: layers.PickerRelationTypeHeight,
+			height: len(m.Pickers.RelationType.RelationTypes()) + layers.PickerRelationTypeChromeHeight,
 		},
 		contentRenderer: func(width, height int) string {
 			return renderers.RenderRelationTypePicker(
-				renderers.GetRelationTypeOptions(),
+				m.Pickers.RelationType.RelationTypes(),
 				m.Pickers.RelationType.SelectedRelationTypeID(),
 				m.Pickers.RelationType.Cursor(),
 				width-layers.PickerBorderPaddingWidth,
@@ -545,3 +605,34 @@ func (m Model) renderStatusPickerLayer() *lipgloss.Layer {
 		boxStyle: components.LabelPickerBoxStyle,
 	})
 }
+
+// renderProjectPickerLayer renders the move-to-project picker modal as a layer
+func (m Model) renderProjectPickerLayer() *lipgloss.Layer {
+	projects := m.Pickers.Project.Projects()
+	cursor := m.Pickers.Project.Cursor()
+
+	relations := m.Pickers.Project.Relations()
+	if relations == state.RelationsAsk {
+		relations = "ask"
+	}
+
+	return m.createPickerLayer(pickerLayerConfig{
+		dimensionStrategy: fixedPickerDimensions{
+			width:  layers.PickerProjectWidth,
+			height: len(projects) + layers.PickerProjectChromeHeight,
+		},
+		contentRenderer: func(width, height int) string {
+			var items []string
+			for i, project := range projects {
+				prefix := "  "
+				if i == cursor {
+					prefix = "> "
+				}
+				items = append(items, prefix+project.Name)
+			}
+			return "Move Task to Project:\n\n" + lipgloss.JoinVertical(lipgloss.Left, items...) +
+				"\n\nLinks: " + relations + "\n\n" + components.PickerFooterMoveProject
+		},
+		boxStyle: components.LabelPickerBoxStyle,
+	})
+}
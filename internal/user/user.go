@@ -24,3 +24,13 @@ func GetCurrentUsername() string {
 	}
 	return currentUser.Username
 }
+
+// GetActor returns the name recorded in the task activity log.
+// PASO_ACTOR takes precedence so agents and scripts can identify themselves;
+// otherwise the current system username is used.
+func GetActor() string {
+	if actor := os.Getenv("PASO_ACTOR"); actor != "" {
+		return actor
+	}
+	return GetCurrentUsername()
+}
@@ -49,3 +49,19 @@ func TestGetCurrentUsernameFallback(t *testing.T) {
 		t.Error("GetCurrentUsername() returned empty string, should have returned fallback")
 	}
 }
+
+func TestGetActor(t *testing.T) {
+	t.Run("uses PASO_ACTOR when set", func(t *testing.T) {
+		t.Setenv("PASO_ACTOR", "nightly-agent")
+		if got := GetActor(); got != "nightly-agent" {
+			t.Errorf("GetActor() = %q, want %q", got, "nightly-agent")
+		}
+	})
+
+	t.Run("falls back to current username", func(t *testing.T) {
+		t.Setenv("PASO_ACTOR", "")
+		if got := GetActor(); got != GetCurrentUsername() {
+			t.Errorf("GetActor() = %q, want %q", got, GetCurrentUsername())
+		}
+	})
+}
@@ -7,9 +7,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/thenoetrevino/paso/internal/cli/column"
 	"github.com/thenoetrevino/paso/internal/cli/label"
+	"github.com/thenoetrevino/paso/internal/cli/mcp"
+	"github.com/thenoetrevino/paso/internal/cli/priority"
 	"github.com/thenoetrevino/paso/internal/cli/project"
+	"github.com/thenoetrevino/paso/internal/cli/relationtype"
+	"github.com/thenoetrevino/paso/internal/cli/serve"
 	"github.com/thenoetrevino/paso/internal/cli/setup"
 	"github.com/thenoetrevino/paso/internal/cli/task"
+	"github.com/thenoetrevino/paso/internal/cli/tasktype"
 	"github.com/thenoetrevino/paso/internal/cli/tutorial"
 	"github.com/thenoetrevino/paso/internal/cli/use"
 	"github.com/thenoetrevino/paso/internal/launcher"
@@ -49,9 +54,14 @@ func init() {
 	rootCmd.AddCommand(project.ProjectCmd())
 	rootCmd.AddCommand(column.ColumnCmd())
 	rootCmd.AddCommand(label.LabelCmd())
+	rootCmd.AddCommand(tasktype.TypeCmd())
+	rootCmd.AddCommand(priority.PriorityCmd())
+	rootCmd.AddCommand(relationtype.RelationTypeCmd())
 	rootCmd.AddCommand(use.UseCmd())
 	rootCmd.AddCommand(tutorial.TutorialCmd())
 	rootCmd.AddCommand(setup.SetupCmd())
+	rootCmd.AddCommand(serve.ServeCmd())
+	rootCmd.AddCommand(mcp.MCPCmd(version))
 
 	// Add TUI subcommand
 	tuiCmd := &cobra.Command{